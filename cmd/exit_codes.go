@@ -17,6 +17,7 @@ const (
 	ExitAuth     = 3 // Authentication failure (missing, unauthorized, forbidden)
 	ExitNotFound = 4 // Resource not found
 	ExitConflict = 5 // Conflict / resource already exists
+	ExitDrift    = 6 // Check completed and found changes (e.g. metadata plan)
 
 	// HTTP 4xx range: 10 + (status - 400)
 	// Note: 404 and 409 are mapped to ExitNotFound and ExitConflict above.
@@ -29,6 +30,9 @@ const (
 	ExitHTTPInternalServer     = 60 // 500
 	ExitHTTPBadGateway         = 62 // 502
 	ExitHTTPServiceUnavailable = 63 // 503
+
+	// Interrupted by SIGINT/SIGTERM (128 + SIGINT, matching shell convention)
+	ExitInterrupted = 130
 )
 
 // ExitCodeFromError maps an error to the appropriate exit code.
@@ -38,11 +42,21 @@ func ExitCodeFromError(err error) int {
 		return ExitSuccess
 	}
 
+	// Cancelled by a signal; checked first so CI can tell a cancel from a failure
+	if errors.Is(err, ErrInterrupted) {
+		return ExitInterrupted
+	}
+
 	// Usage errors
 	if errors.Is(err, flag.ErrHelp) {
 		return ExitUsage
 	}
 
+	// Drift found by a check that otherwise succeeded
+	if errors.Is(err, shared.ErrDrift) {
+		return ExitDrift
+	}
+
 	// Well-known error types
 	if errors.Is(err, shared.ErrMissingAuth) ||
 		errors.Is(err, asc.ErrUnauthorized) ||
@@ -1,9 +1,11 @@
 package cmd
 
 import (
+	"context"
 	"encoding/xml"
 	"errors"
 	"flag"
+	"fmt"
 	"net/http"
 	"os"
 	"os/exec"
@@ -58,6 +60,21 @@ func TestExitCodeFromError(t *testing.T) {
 			err:      asc.ErrConflict,
 			expected: ExitConflict,
 		},
+		{
+			name:     "reported drift returns drift",
+			err:      NewReportedError(fmt.Errorf("metadata plan: 2 change(s) pending: %w", shared.ErrDrift)),
+			expected: ExitDrift,
+		},
+		{
+			name:     "ErrInterrupted returns interrupted",
+			err:      interruptedError(context.Canceled),
+			expected: ExitInterrupted,
+		},
+		{
+			name:     "interrupted reported error returns interrupted",
+			err:      interruptedError(NewReportedError(asc.ErrConflict)),
+			expected: ExitInterrupted,
+		},
 		{
 			name:     "generic error returns generic error",
 			err:      errors.New("something went wrong"),
@@ -106,6 +123,9 @@ func TestExitCodeConstants(t *testing.T) {
 	if ExitConflict != 5 {
 		t.Errorf("ExitConflict = %d, want 5", ExitConflict)
 	}
+	if ExitDrift != 6 {
+		t.Errorf("ExitDrift = %d, want 6", ExitDrift)
+	}
 }
 
 func TestAPIErrorCodeToExitCode(t *testing.T) {
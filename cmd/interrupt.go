@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// ErrInterrupted marks a command that was cancelled by SIGINT or SIGTERM.
+var ErrInterrupted = errors.New("interrupted")
+
+// signalContext returns a context that is cancelled on SIGINT or SIGTERM.
+// Once the first signal arrives, default signal handling is restored so a
+// second Ctrl-C terminates the process immediately.
+func signalContext(parent context.Context) (context.Context, context.CancelFunc) {
+	ctx, stop := signal.NotifyContext(parent, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-ctx.Done()
+		stop()
+	}()
+	return ctx, stop
+}
+
+// interruptedError wraps a command error caused by signal cancellation so it
+// maps to ExitInterrupted while keeping the original cause.
+func interruptedError(err error) error {
+	if err == nil || errors.Is(err, ErrInterrupted) {
+		return err
+	}
+	return fmt.Errorf("%w: %w", ErrInterrupted, err)
+}
+
+// flushPartialResult prints the pages an interrupted command collected
+// before it was cancelled, using the command's own --output and --pretty.
+func flushPartialResult(root *ffcli.Command, args []string, runErr error) {
+	var partial *asc.PartialResultError
+	if !errors.As(runErr, &partial) || partial.Result == nil {
+		return
+	}
+
+	format, pretty := shared.DefaultOutputFormat(), false
+	if command, _ := resolveCommand(root, args); command.FlagSet != nil {
+		if f := command.FlagSet.Lookup("output"); f != nil {
+			format = f.Value.String()
+		}
+		if f := command.FlagSet.Lookup("pretty"); f != nil {
+			pretty = f.Value.String() == "true"
+		}
+	}
+
+	fmt.Fprintln(os.Stderr, "Interrupted: printing results collected so far")
+	if err := shared.PrintOutput(partial.Result, format, pretty); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: failed to print partial results: %v\n", err)
+	}
+}
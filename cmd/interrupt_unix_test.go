@@ -0,0 +1,25 @@
+//go:build unix
+
+package cmd
+
+import (
+	"context"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestSignalContext_CancelsOnSIGTERM(t *testing.T) {
+	ctx, stop := signalContext(context.Background())
+	defer stop()
+
+	if err := syscall.Kill(syscall.Getpid(), syscall.SIGTERM); err != nil {
+		t.Fatalf("Kill() error: %v", err)
+	}
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected context to be cancelled after SIGTERM")
+	}
+}
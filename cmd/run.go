@@ -22,6 +22,9 @@ func Run(args []string, versionInfo string) int {
 	root := RootCommand(versionInfo)
 	defer CleanupTempPrivateKeys()
 
+	ctx, stop := signalContext(context.Background())
+	defer stop()
+
 	if err := root.Parse(args); err != nil {
 		if err == flag.ErrHelp {
 			return ExitSuccess
@@ -35,9 +38,13 @@ func Run(args []string, versionInfo string) int {
 		fmt.Fprint(os.Stderr, errfmt.FormatStderr(err))
 		return ExitUsage
 	}
+	if err := shared.ValidateProjectionFlags(); err != nil {
+		fmt.Fprint(os.Stderr, errfmt.FormatStderr(err))
+		return ExitUsage
+	}
 
 	if versionRequested {
-		if err := root.Run(context.Background()); err != nil {
+		if err := root.Run(ctx); err != nil {
 			if errors.Is(err, flag.ErrHelp) {
 				return ExitUsage
 			}
@@ -47,7 +54,7 @@ func Run(args []string, versionInfo string) int {
 		return ExitSuccess
 	}
 
-	updateResult, err := update.CheckAndUpdate(context.Background(), update.Options{
+	updateResult, err := update.CheckAndUpdate(ctx, update.Options{
 		CurrentVersion: versionInfo,
 		AutoUpdate:     true,
 		NoUpdate:       shared.NoUpdate(),
@@ -67,9 +74,14 @@ func Run(args []string, versionInfo string) int {
 		}
 	}
 
+	shared.SetReportTestCases(nil)
 	start := time.Now()
-	runErr := root.Run(context.Background())
+	runErr := root.Run(ctx)
 	elapsed := time.Since(start)
+	if runErr != nil && ctx.Err() != nil {
+		runErr = interruptedError(runErr)
+		flushPartialResult(root, args, runErr)
+	}
 
 	// Get command name (full subcommand path)
 	commandName := getCommandName(root, args)
@@ -105,6 +117,13 @@ func Run(args []string, versionInfo string) int {
 // args is os.Args[1:] (without program name).
 // It finds the first token matching a known subcommand name, then walks the tree.
 func getCommandName(root *ffcli.Command, args []string) string {
+	_, path := resolveCommand(root, args)
+	return strings.Join(path, " ")
+}
+
+// resolveCommand walks args to the innermost subcommand and returns it with
+// its name path from root.
+func resolveCommand(root *ffcli.Command, args []string) (*ffcli.Command, []string) {
 	current := root
 	path := []string{current.Name}
 
@@ -137,7 +156,7 @@ func getCommandName(root *ffcli.Command, args []string) string {
 		break
 	}
 
-	return strings.Join(path, " ")
+	return current, path
 }
 
 func findDirectSubcommand(current *ffcli.Command, token string) *ffcli.Command {
@@ -208,11 +227,19 @@ func writeJUnitReport(commandName string, runErr error, elapsed time.Duration) e
 
 	if runErr != nil {
 		testCase.Failure = "ERROR"
+		if errors.Is(runErr, ErrInterrupted) {
+			testCase.Failure = "INTERRUPTED"
+		}
 		testCase.Message = runErr.Error()
 	}
 
+	tests := []shared.JUnitTestCase{testCase}
+	if cases := shared.ReportTestCases(); len(cases) > 0 {
+		tests = cases
+	}
+
 	report := shared.JUnitReport{
-		Tests:     []shared.JUnitTestCase{testCase},
+		Tests:     tests,
 		Timestamp: time.Now(),
 		Name:      "asc",
 	}
@@ -6,6 +6,7 @@ import (
 	"encoding/xml"
 	"errors"
 	"flag"
+	"fmt"
 	"io"
 	"os"
 	"path/filepath"
@@ -15,6 +16,7 @@ import (
 
 	"github.com/peterbourgon/ff/v3/ffcli"
 
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
 )
 
@@ -147,6 +149,59 @@ func TestWriteJUnitReport(t *testing.T) {
 	}
 }
 
+func TestWriteJUnitReport_Interrupted(t *testing.T) {
+	resetReportFlags(t)
+
+	reportPath := filepath.Join(t.TempDir(), "junit.xml")
+	shared.SetReportFile(reportPath)
+	t.Cleanup(func() {
+		shared.SetReportFile("")
+	})
+
+	runErr := interruptedError(context.Canceled)
+	if err := writeJUnitReport("asc builds upload", runErr, time.Second); err != nil {
+		t.Fatalf("writeJUnitReport() error: %v", err)
+	}
+
+	data, err := os.ReadFile(reportPath)
+	if err != nil {
+		t.Fatalf("ReadFile() error: %v", err)
+	}
+	if !strings.Contains(string(data), `type="INTERRUPTED"`) {
+		t.Fatalf("expected INTERRUPTED failure type, got %s", data)
+	}
+}
+
+func TestWriteJUnitReport_CommandTestCases(t *testing.T) {
+	resetReportFlags(t)
+
+	reportPath := filepath.Join(t.TempDir(), "junit.xml")
+	shared.SetReportFile(reportPath)
+	shared.SetReportTestCases([]shared.JUnitTestCase{
+		{Name: "field-length", Classname: "metadata lint", Failure: "LINT", Message: "1 error(s)"},
+		{Name: "url-syntax", Classname: "metadata lint"},
+	})
+	t.Cleanup(func() {
+		resetReportFlags(t)
+	})
+
+	if err := writeJUnitReport("asc metadata lint", errors.New("found 1 error(s)"), time.Second); err != nil {
+		t.Fatalf("writeJUnitReport() error: %v", err)
+	}
+
+	data, err := os.ReadFile(reportPath)
+	if err != nil {
+		t.Fatalf("ReadFile() error: %v", err)
+	}
+	report := string(data)
+	if !strings.Contains(report, `tests="2"`) || !strings.Contains(report, `failures="1"`) {
+		t.Fatalf("expected command test cases in report, got %s", report)
+	}
+	if !strings.Contains(report, `name="url-syntax"`) || strings.Contains(report, `name="asc metadata lint"`) {
+		t.Fatalf("unexpected test cases in report: %s", report)
+	}
+}
+
 func TestCmdSharedWrappersAndReportedError(t *testing.T) {
 	CleanupTempPrivateKey()
 	CleanupTempPrivateKeys()
@@ -171,10 +226,44 @@ func TestCmdSharedWrappersAndReportedError(t *testing.T) {
 	}
 }
 
+func TestFlushPartialResult_UsesCommandOutputFlags(t *testing.T) {
+	fs := flag.NewFlagSet("list", flag.ContinueOnError)
+	fs.String("output", "json", "")
+	fs.Bool("pretty", false, "")
+	root := &ffcli.Command{
+		Name:        "asc",
+		Subcommands: []*ffcli.Command{{Name: "list", FlagSet: fs}},
+	}
+	if err := fs.Parse([]string{"--output", "json"}); err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	partial := &asc.AppsResponse{Data: []asc.Resource[asc.AppAttributes]{{Type: asc.ResourceTypeApps, ID: "app-1"}}}
+	runErr := interruptedError(fmt.Errorf("list: page 2: %w", &asc.PartialResultError{Result: partial, Err: context.Canceled}))
+
+	stdout, stderr := captureCommandOutput(t, func() {
+		flushPartialResult(root, []string{"list", "--output", "json"}, runErr)
+	})
+	if !strings.Contains(stdout, `"id":"app-1"`) {
+		t.Fatalf("expected partial results on stdout, got %q", stdout)
+	}
+	if !strings.Contains(stderr, "results collected so far") {
+		t.Fatalf("expected interruption notice on stderr, got %q", stderr)
+	}
+
+	stdout, _ = captureCommandOutput(t, func() {
+		flushPartialResult(root, []string{"list"}, interruptedError(context.Canceled))
+	})
+	if stdout != "" {
+		t.Fatalf("expected no output without partial results, got %q", stdout)
+	}
+}
+
 func resetReportFlags(t *testing.T) {
 	t.Helper()
 	shared.SetReportFormat("")
 	shared.SetReportFile("")
+	shared.SetReportTestCases(nil)
 }
 
 func captureCommandOutput(t *testing.T, fn func()) (string, string) {
@@ -423,7 +423,7 @@ func (c *Client) GetAnalyticsReportRequests(ctx context.Context, appID string, o
 	}
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("analyticsReportRequests: %w", err)
 		}
 		path = query.nextURL
@@ -490,7 +490,7 @@ func (c *Client) GetAnalyticsReports(ctx context.Context, requestID string, opts
 	path := fmt.Sprintf("/v1/analyticsReportRequests/%s/reports", strings.TrimSpace(requestID))
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("analyticsReports: %w", err)
 		}
 		path = query.nextURL
@@ -521,7 +521,7 @@ func (c *Client) GetAnalyticsReportInstancesRelationships(ctx context.Context, r
 	path := fmt.Sprintf("/v1/analyticsReports/%s/relationships/instances", reportID)
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("analyticsReportInstancesRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -551,7 +551,7 @@ func (c *Client) GetAnalyticsReportInstances(ctx context.Context, reportID strin
 	path := fmt.Sprintf("/v1/analyticsReports/%s/instances", strings.TrimSpace(reportID))
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("analyticsReportInstances: %w", err)
 		}
 		path = query.nextURL
@@ -597,7 +597,7 @@ func (c *Client) GetAnalyticsReportInstanceSegmentsRelationships(ctx context.Con
 	path := fmt.Sprintf("/v1/analyticsReportInstances/%s/relationships/segments", instanceID)
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("analyticsReportInstanceSegmentsRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -627,7 +627,7 @@ func (c *Client) GetAnalyticsReportSegments(ctx context.Context, instanceID stri
 	path := fmt.Sprintf("/v1/analyticsReportInstances/%s/segments", strings.TrimSpace(instanceID))
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("analyticsReportSegments: %w", err)
 		}
 		path = query.nextURL
@@ -1,6 +1,9 @@
 package asc
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 // SalesReportResult represents CLI output for sales report downloads.
 type SalesReportResult struct {
@@ -44,6 +47,25 @@ type AnalyticsReportDownloadResult struct {
 	DecompressedSize int64  `json:"decompressedSize,omitempty"`
 }
 
+// AnalyticsFetchResult represents CLI output for analytics fetch.
+type AnalyticsFetchResult struct {
+	AppID             string `json:"appId"`
+	RequestID         string `json:"requestId"`
+	RequestCreated    bool   `json:"requestCreated"`
+	ReportID          string `json:"reportId"`
+	ReportName        string `json:"reportName"`
+	Category          string `json:"category,omitempty"`
+	Granularity       string `json:"granularity"`
+	From              string `json:"from"`
+	To                string `json:"to"`
+	Instances         int    `json:"instances"`
+	Segments          int    `json:"segments"`
+	Rows              int    `json:"rows"`
+	DuplicatesRemoved int    `json:"duplicatesRemoved"`
+	FilePath          string `json:"filePath"`
+	FileSize          int64  `json:"fileSize"`
+}
+
 // AnalyticsReportGetResult represents CLI output for report metadata with instances.
 type AnalyticsReportGetResult struct {
 	RequestID string                     `json:"requestId"`
@@ -142,6 +164,23 @@ func analyticsReportDownloadResultRows(result *AnalyticsReportDownloadResult) ([
 	return headers, rows
 }
 
+func analyticsFetchResultRows(result *AnalyticsFetchResult) ([]string, [][]string) {
+	headers := []string{"Request ID", "Report", "Granularity", "From", "To", "Instances", "Segments", "Rows", "Duplicates Removed", "File"}
+	rows := [][]string{{
+		result.RequestID,
+		result.ReportName,
+		result.Granularity,
+		result.From,
+		result.To,
+		fmt.Sprintf("%d", result.Instances),
+		fmt.Sprintf("%d", result.Segments),
+		fmt.Sprintf("%d", result.Rows),
+		fmt.Sprintf("%d", result.DuplicatesRemoved),
+		result.FilePath,
+	}}
+	return headers, rows
+}
+
 func analyticsReportGetResultRows(result *AnalyticsReportGetResult) ([]string, [][]string) {
 	headers := []string{"Report ID", "Name", "Category", "Granularity", "Instances", "Segments"}
 	rows := make([][]string, 0, len(result.Data))
@@ -216,3 +255,108 @@ func countSegments(instances []AnalyticsReportGetInstance) int {
 	}
 	return total
 }
+
+// SalesSyncResult represents CLI output for sales report store syncs.
+type SalesSyncResult struct {
+	Store        string   `json:"store"`
+	VendorNumber string   `json:"vendorNumber"`
+	Frequency    string   `json:"frequency"`
+	From         string   `json:"from"`
+	To           string   `json:"to"`
+	Downloaded   int      `json:"downloaded"`
+	Empty        int      `json:"empty"`
+	Skipped      int      `json:"skipped"`
+	Rows         int      `json:"rows"`
+	Pending      []string `json:"pending,omitempty"`
+}
+
+// SalesQueryRow is an aggregate of stored sales rows for one group and
+// proceeds currency.
+type SalesQueryRow struct {
+	Date        string  `json:"date,omitempty"`
+	SKU         string  `json:"sku,omitempty"`
+	Title       string  `json:"title,omitempty"`
+	Country     string  `json:"country,omitempty"`
+	Device      string  `json:"device,omitempty"`
+	ProductType string  `json:"productType,omitempty"`
+	Currency    string  `json:"currency"`
+	Units       int     `json:"units"`
+	Proceeds    float64 `json:"proceeds"`
+}
+
+// SalesQueryResult represents CLI output for sales report store queries.
+type SalesQueryResult struct {
+	Store     string          `json:"store"`
+	Frequency string          `json:"frequency"`
+	From      string          `json:"from,omitempty"`
+	To        string          `json:"to,omitempty"`
+	Reports   int             `json:"reports"`
+	GroupBy   []string        `json:"groupBy"`
+	Rows      []SalesQueryRow `json:"rows"`
+}
+
+func salesSyncResultRows(result *SalesSyncResult) ([]string, [][]string) {
+	headers := []string{"Store", "Vendor", "Frequency", "From", "To", "Downloaded", "Empty", "Skipped", "Rows", "Pending"}
+	rows := [][]string{{
+		result.Store,
+		result.VendorNumber,
+		result.Frequency,
+		result.From,
+		result.To,
+		fmt.Sprintf("%d", result.Downloaded),
+		fmt.Sprintf("%d", result.Empty),
+		fmt.Sprintf("%d", result.Skipped),
+		fmt.Sprintf("%d", result.Rows),
+		strings.Join(result.Pending, ", "),
+	}}
+	return headers, rows
+}
+
+func salesQueryResultRows(result *SalesQueryResult) ([]string, [][]string) {
+	headers := make([]string, 0, len(result.GroupBy)+3)
+	for _, key := range result.GroupBy {
+		headers = append(headers, salesQueryHeader(key))
+	}
+	headers = append(headers, "Currency", "Units", "Proceeds")
+
+	rows := make([][]string, 0, len(result.Rows))
+	for _, row := range result.Rows {
+		values := make([]string, 0, len(headers))
+		for _, key := range result.GroupBy {
+			values = append(values, salesQueryKey(row, key))
+		}
+		values = append(values, row.Currency, fmt.Sprintf("%d", row.Units), fmt.Sprintf("%.2f", row.Proceeds))
+		rows = append(rows, values)
+	}
+	return headers, rows
+}
+
+func salesQueryHeader(key string) string {
+	switch key {
+	case "sku":
+		return "SKU"
+	case "product-type":
+		return "Product Type"
+	default:
+		return strings.ToUpper(key[:1]) + key[1:]
+	}
+}
+
+func salesQueryKey(row SalesQueryRow, key string) string {
+	switch key {
+	case "date":
+		return row.Date
+	case "sku":
+		return row.SKU
+	case "title":
+		return row.Title
+	case "country":
+		return row.Country
+	case "device":
+		return row.Device
+	case "product-type":
+		return row.ProductType
+	default:
+		return ""
+	}
+}
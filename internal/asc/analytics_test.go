@@ -436,3 +436,40 @@ func TestDownloadAnalyticsReport_CDNHostWithSignature(t *testing.T) {
 	}
 	_ = download.Body.Close()
 }
+
+func TestParseSalesReport(t *testing.T) {
+	lines, err := ParseSalesReport(strings.NewReader(
+		"Provider\tProvider Country\tSKU\tDeveloper\tTitle\tVersion\tProduct Type Identifier\tUnits\tDeveloper Proceeds\tBegin Date\tEnd Date\tCustomer Currency\tCountry Code\tCurrency of Proceeds\tApple Identifier\tCustomer Price\tPromo Code\tParent Identifier\tSubscription\tPeriod\tCategory\tCMB\tDevice\n" +
+			"APPLE\tUS\tcom.example.pro\tDev\tExample Pro\t1.0\tIA1\t3\t0.7\t01/20/2024\t01/20/2024\tUSD\tUS\tUSD\t123\t0.99\t\tcom.example.app\t\t\tUtilities\t\tiPhone\n" +
+			"APPLE\tUS\tcom.example.pro\tDev\tExample Pro\t1.0\tIA1\t-1\t-0.7\t01/20/2024\t01/20/2024\tEUR\tDE\tEUR\t123\t-0.99\t\tcom.example.app\t\t\tUtilities\t\tiPad\n",
+	))
+	if err != nil {
+		t.Fatalf("ParseSalesReport() error: %v", err)
+	}
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %d", len(lines))
+	}
+	line := lines[1]
+	if line.SKU != "com.example.pro" || line.CountryCode != "DE" || line.Device != "iPad" || line.Units != -1 || line.DeveloperProceeds != -0.7 || line.ProceedsCurrency != "EUR" || line.ParentIdentifier != "com.example.app" {
+		t.Fatalf("unexpected line: %+v", line)
+	}
+
+	if _, err := ParseSalesReport(strings.NewReader("Provider\tSKU\n")); err == nil {
+		t.Fatal("expected error for a report without a Units column")
+	}
+}
+
+func TestIsSalesReportNotAvailable(t *testing.T) {
+	notAvailable := &APIError{Code: "NOT_FOUND", Title: "The request expected results but none were found.", Detail: "Report is not available yet. Daily reports for the Americas are available by 5 am Pacific Time."}
+	noSales := &APIError{Code: "NOT_FOUND", Title: "The request expected results but none were found.", Detail: "There were no sales for the date specified."}
+
+	if !IsSalesReportNotAvailable(notAvailable) {
+		t.Fatal("expected not-yet-available error to be detected")
+	}
+	if IsSalesReportNotAvailable(noSales) {
+		t.Fatal("expected no-sales error not to be treated as unavailable")
+	}
+	if IsSalesReportNotAvailable(errors.New("report is not available yet")) {
+		t.Fatal("expected non-API errors not to be treated as unavailable")
+	}
+}
@@ -53,7 +53,7 @@ func (c *Client) GetAppTags(ctx context.Context, appID string, opts ...AppTagsOp
 	path := fmt.Sprintf("/v1/apps/%s/appTags", appID)
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appTags: %w", err)
 		}
 		path = query.nextURL
@@ -116,7 +116,7 @@ func (c *Client) GetAppTagTerritories(ctx context.Context, tagID string, opts ..
 	path := fmt.Sprintf("/v1/appTags/%s/territories", tagID)
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appTagTerritories: %w", err)
 		}
 		path = query.nextURL
@@ -148,7 +148,7 @@ func (c *Client) GetAppTagTerritoriesRelationships(ctx context.Context, tagID st
 	path := fmt.Sprintf("/v1/appTags/%s/relationships/territories", tagID)
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appTagTerritoriesRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -180,7 +180,7 @@ func (c *Client) GetAppTagsRelationshipsForApp(ctx context.Context, appID string
 	path := fmt.Sprintf("/v1/apps/%s/relationships/appTags", appID)
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appTagsRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -0,0 +1,64 @@
+package asc
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// allowedAssetDownloadHosts are the CDN hosts that serve screenshot and
+// preview files (subdomains included).
+var allowedAssetDownloadHosts = []string{
+	"mzstatic.com",
+	"apple.com",
+}
+
+// URL expands the image template URL for the asset's full size in format
+// (e.g. "png" or "jpg").
+func (a ImageAsset) URL(format string) string {
+	replacer := strings.NewReplacer(
+		"{w}", strconv.Itoa(a.Width),
+		"{h}", strconv.Itoa(a.Height),
+		"{f}", format,
+	)
+	return replacer.Replace(a.TemplateURL)
+}
+
+// DownloadAsset downloads a screenshot or preview file from its CDN URL.
+func (c *Client) DownloadAsset(ctx context.Context, downloadURL string) (*ReportDownload, error) {
+	if err := validateAssetDownloadURL(downloadURL); err != nil {
+		return nil, fmt.Errorf("asset download: %w", err)
+	}
+
+	resp, err := c.doStreamNoAuth(ctx, "GET", downloadURL, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReportDownload{Body: resp.Body, ContentLength: resp.ContentLength}, nil
+}
+
+func validateAssetDownloadURL(downloadURL string) error {
+	if strings.TrimSpace(downloadURL) == "" {
+		return fmt.Errorf("empty download URL")
+	}
+	parsedURL, err := url.Parse(downloadURL)
+	if err != nil {
+		return fmt.Errorf("invalid download URL: %w", err)
+	}
+	if parsedURL.Scheme != "https" {
+		return fmt.Errorf("rejected download URL with insecure scheme %q (expected https)", parsedURL.Scheme)
+	}
+	host := strings.ToLower(parsedURL.Hostname())
+	if host == "" {
+		return fmt.Errorf("rejected asset download URL with empty host")
+	}
+	for _, allowed := range allowedAssetDownloadHosts {
+		if host == allowed || strings.HasSuffix(host, "."+allowed) {
+			return nil
+		}
+	}
+	return fmt.Errorf("rejected asset download URL from untrusted host %q", parsedURL.Host)
+}
@@ -0,0 +1,74 @@
+package asc
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestImageAssetURL(t *testing.T) {
+	asset := ImageAsset{
+		TemplateURL: "https://is1-ssl.mzstatic.com/image/thumb/Purple/v4/ab/source/{w}x{h}bb.{f}",
+		Width:       1242,
+		Height:      2688,
+	}
+	want := "https://is1-ssl.mzstatic.com/image/thumb/Purple/v4/ab/source/1242x2688bb.png"
+	if got := asset.URL("png"); got != want {
+		t.Fatalf("URL() = %q, want %q", got, want)
+	}
+}
+
+func TestValidateAssetDownloadURL(t *testing.T) {
+	tests := []struct {
+		url     string
+		wantErr string
+	}{
+		{url: "https://is1-ssl.mzstatic.com/image/thumb/a.png"},
+		{url: "https://mzstatic.com/a.png"},
+		{url: "https://apptrailers.itunes.apple.com/a.mov"},
+		{url: "", wantErr: "empty download URL"},
+		{url: "http://is1-ssl.mzstatic.com/a.png", wantErr: "insecure scheme"},
+		{url: "https://evil-mzstatic.com/a.png", wantErr: "untrusted host"},
+		{url: "https://mzstatic.com.evil.example/a.png", wantErr: "untrusted host"},
+	}
+
+	for _, test := range tests {
+		err := validateAssetDownloadURL(test.url)
+		if test.wantErr == "" {
+			if err != nil {
+				t.Fatalf("validateAssetDownloadURL(%q) unexpected error: %v", test.url, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+			t.Fatalf("validateAssetDownloadURL(%q) expected %q, got %v", test.url, test.wantErr, err)
+		}
+	}
+}
+
+func TestDownloadAsset(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) {
+		if req.URL.Host != "is1-ssl.mzstatic.com" {
+			t.Fatalf("unexpected host: %s", req.URL.Host)
+		}
+		if req.Header.Get("Authorization") != "" {
+			t.Fatalf("expected no Authorization header on CDN download")
+		}
+	}, &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader("image-bytes")),
+		Header:     http.Header{},
+	})
+
+	download, err := client.DownloadAsset(context.Background(), "https://is1-ssl.mzstatic.com/image/a.png")
+	if err != nil {
+		t.Fatalf("DownloadAsset() error: %v", err)
+	}
+	defer download.Body.Close()
+	data, _ := io.ReadAll(download.Body)
+	if string(data) != "image-bytes" {
+		t.Fatalf("unexpected body: %q", data)
+	}
+}
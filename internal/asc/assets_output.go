@@ -26,12 +26,49 @@ type AppPreviewListResult struct {
 	Sets                  []AppPreviewSetWithPreviews `json:"sets"`
 }
 
+// Asset validation issue severities.
+const (
+	AssetIssueError   = "error"
+	AssetIssueWarning = "warning"
+)
+
+// AssetValidationFile describes one local asset file checked by validation.
+type AssetValidationFile struct {
+	Path        string `json:"path"`
+	Kind        string `json:"kind"`
+	DisplayType string `json:"displayType,omitempty"`
+	Format      string `json:"format,omitempty"`
+	Width       int    `json:"width,omitempty"`
+	Height      int    `json:"height,omitempty"`
+	HasAlpha    bool   `json:"hasAlpha,omitempty"`
+	ColorSpace  string `json:"colorSpace,omitempty"`
+}
+
+// AssetValidationIssue is a problem found in a local asset file or set.
+type AssetValidationIssue struct {
+	Path        string `json:"path"`
+	DisplayType string `json:"displayType,omitempty"`
+	Severity    string `json:"severity"`
+	Message     string `json:"message"`
+}
+
+// AssetValidationResult represents local asset validation output.
+type AssetValidationResult struct {
+	Path     string                 `json:"path"`
+	Valid    bool                   `json:"valid"`
+	Errors   int                    `json:"errors"`
+	Warnings int                    `json:"warnings"`
+	Files    []AssetValidationFile  `json:"files"`
+	Issues   []AssetValidationIssue `json:"issues"`
+}
+
 // AssetUploadResultItem represents a single uploaded asset.
 type AssetUploadResultItem struct {
 	FileName string `json:"fileName"`
 	FilePath string `json:"filePath"`
 	AssetID  string `json:"assetId"`
 	State    string `json:"state,omitempty"`
+	Action   string `json:"action,omitempty"`
 }
 
 // AppScreenshotUploadResult represents screenshot upload output.
@@ -40,6 +77,7 @@ type AppScreenshotUploadResult struct {
 	SetID                 string                  `json:"setId"`
 	DisplayType           string                  `json:"displayType"`
 	Results               []AssetUploadResultItem `json:"results"`
+	Reordered             bool                    `json:"reordered,omitempty"`
 }
 
 // AppPreviewUploadResult represents preview upload output.
@@ -48,6 +86,7 @@ type AppPreviewUploadResult struct {
 	SetID                 string                  `json:"setId"`
 	PreviewType           string                  `json:"previewType"`
 	Results               []AssetUploadResultItem `json:"results"`
+	Reordered             bool                    `json:"reordered,omitempty"`
 }
 
 // AssetDeleteResult represents deletion output for assets.
@@ -177,10 +216,45 @@ func appPreviewUploadResultMainRows(result *AppPreviewUploadResult) ([]string, [
 }
 
 func assetUploadResultItemRows(results []AssetUploadResultItem) ([]string, [][]string) {
+	withAction := false
+	for _, item := range results {
+		if item.Action != "" {
+			withAction = true
+			break
+		}
+	}
 	headers := []string{"File Name", "Asset ID", "State"}
+	if withAction {
+		headers = append(headers, "Action")
+	}
 	rows := make([][]string, 0, len(results))
 	for _, item := range results {
-		rows = append(rows, []string{item.FileName, item.AssetID, item.State})
+		row := []string{item.FileName, item.AssetID, item.State}
+		if withAction {
+			row = append(row, item.Action)
+		}
+		rows = append(rows, row)
+	}
+	return headers, rows
+}
+
+func assetValidationSummaryRows(result *AssetValidationResult) ([]string, [][]string) {
+	headers := []string{"Path", "Files", "Errors", "Warnings", "Valid"}
+	rows := [][]string{{
+		result.Path,
+		fmt.Sprintf("%d", len(result.Files)),
+		fmt.Sprintf("%d", result.Errors),
+		fmt.Sprintf("%d", result.Warnings),
+		fmt.Sprintf("%t", result.Valid),
+	}}
+	return headers, rows
+}
+
+func assetValidationIssueRows(issues []AssetValidationIssue) ([]string, [][]string) {
+	headers := []string{"Severity", "Path", "Display Type", "Message"}
+	rows := make([][]string, 0, len(issues))
+	for _, issue := range issues {
+		rows = append(rows, []string{issue.Severity, issue.Path, issue.DisplayType, issue.Message})
 	}
 	return headers, rows
 }
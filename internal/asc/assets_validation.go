@@ -0,0 +1,443 @@
+package asc
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/jpeg" // register JPEG header decoding
+	"io"
+	"strings"
+	"time"
+)
+
+// Per-set limits enforced by App Store Connect.
+const (
+	MaxScreenshotsPerSet = 10
+	MaxPreviewsPerSet    = 3
+)
+
+// App preview file limits enforced by App Store Connect.
+const (
+	MinPreviewDuration = 15 * time.Second
+	MaxPreviewDuration = 30 * time.Second
+	MaxPreviewFileSize = 500 << 20
+)
+
+// ImageSize is a pixel size.
+type ImageSize struct {
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
+
+func (s ImageSize) String() string {
+	return fmt.Sprintf("%dx%d", s.Width, s.Height)
+}
+
+// screenshotPortraitSizes lists accepted portrait sizes; the landscape
+// rotation of each is accepted too.
+var screenshotPortraitSizes = map[string][]ImageSize{
+	"APP_IPHONE_69":         {{1320, 2868}, {1290, 2796}, {1260, 2736}},
+	"APP_IPHONE_67":         {{1290, 2796}},
+	"APP_IPHONE_65":         {{1242, 2688}, {1284, 2778}},
+	"APP_IPHONE_61":         {{1179, 2556}, {1170, 2532}},
+	"APP_IPHONE_58":         {{1125, 2436}, {1080, 2340}, {1170, 2532}},
+	"APP_IPHONE_55":         {{1242, 2208}},
+	"APP_IPHONE_47":         {{750, 1334}},
+	"APP_IPHONE_40":         {{640, 1136}, {640, 1096}},
+	"APP_IPHONE_35":         {{640, 960}, {640, 920}},
+	"APP_IPAD_PRO_3GEN_129": {{2064, 2752}, {2048, 2732}},
+	"APP_IPAD_PRO_3GEN_11":  {{1668, 2420}, {1668, 2388}, {1640, 2360}, {1488, 2266}},
+	"APP_IPAD_PRO_129":      {{2048, 2732}},
+	"APP_IPAD_105":          {{1668, 2224}},
+	"APP_IPAD_97":           {{1536, 2048}, {1536, 2008}, {768, 1024}, {768, 1004}},
+	"APP_WATCH_ULTRA":       {{422, 514}, {410, 502}},
+	"APP_WATCH_SERIES_10":   {{416, 496}},
+	"APP_WATCH_SERIES_7":    {{396, 484}},
+	"APP_WATCH_SERIES_4":    {{368, 448}},
+	"APP_WATCH_SERIES_3":    {{312, 390}},
+}
+
+// screenshotLandscapeSizes lists display types that only accept landscape sizes.
+var screenshotLandscapeSizes = map[string][]ImageSize{
+	"APP_DESKTOP":          {{1280, 800}, {1440, 900}, {2560, 1600}, {2880, 1800}},
+	"APP_APPLE_TV":         {{1920, 1080}, {3840, 2160}},
+	"APP_APPLE_VISION_PRO": {{3840, 2160}},
+}
+
+// ScreenshotSizes returns the pixel sizes accepted for a screenshot display
+// type. iMessage display types accept the sizes of their app counterpart.
+func ScreenshotSizes(displayType string) []ImageSize {
+	base := strings.TrimPrefix(strings.ToUpper(displayType), "IMESSAGE_")
+	if sizes, ok := screenshotLandscapeSizes[base]; ok {
+		return append([]ImageSize(nil), sizes...)
+	}
+	portrait := screenshotPortraitSizes[base]
+	sizes := make([]ImageSize, 0, len(portrait)*2)
+	for _, size := range portrait {
+		sizes = append(sizes, size, ImageSize{Width: size.Height, Height: size.Width})
+	}
+	return sizes
+}
+
+// IsValidScreenshotSize reports whether width x height is accepted for displayType.
+func IsValidScreenshotSize(displayType string, width, height int) bool {
+	for _, size := range ScreenshotSizes(displayType) {
+		if size.Width == width && size.Height == height {
+			return true
+		}
+	}
+	return false
+}
+
+// screenshotSizeOwners picks the inferred display type for sizes that more
+// than one app display type accepts. App Store Connect takes these sizes for
+// every listed type, so validation against a known type still accepts them;
+// only inference from the size alone needs a single answer. Keys use the
+// orientation of the size tables.
+var screenshotSizeOwners = map[ImageSize]string{
+	{1290, 2796}: "APP_IPHONE_69",         // also APP_IPHONE_67
+	{1170, 2532}: "APP_IPHONE_61",         // also APP_IPHONE_58
+	{2048, 2732}: "APP_IPAD_PRO_3GEN_129", // also APP_IPAD_PRO_129
+	{3840, 2160}: "APP_APPLE_TV",          // also APP_APPLE_VISION_PRO
+}
+
+// ScreenshotDisplayTypeForSize returns the app display type for an image size,
+// using screenshotSizeOwners for sizes several types accept. It returns ""
+// for unknown sizes.
+func ScreenshotDisplayTypeForSize(width, height int) string {
+	for _, size := range []ImageSize{{width, height}, {height, width}} {
+		if displayType, ok := screenshotSizeOwners[size]; ok && IsValidScreenshotSize(displayType, width, height) {
+			return displayType
+		}
+	}
+	for _, displayType := range ValidScreenshotDisplayTypes {
+		if strings.HasPrefix(displayType, "IMESSAGE_") {
+			continue
+		}
+		if IsValidScreenshotSize(displayType, width, height) {
+			return displayType
+		}
+	}
+	return ""
+}
+
+// previewPortraitSizes lists accepted portrait app preview sizes; the
+// landscape rotation of each is accepted too. Several types share a size;
+// previews are always checked against a known type, never inferred by size.
+var previewPortraitSizes = map[string][]ImageSize{
+	"IPHONE_67":         {{886, 1920}},
+	"IPHONE_65":         {{886, 1920}},
+	"IPHONE_61":         {{886, 1920}},
+	"IPHONE_58":         {{886, 1920}},
+	"IPHONE_55":         {{1080, 1920}},
+	"IPHONE_47":         {{750, 1334}},
+	"IPHONE_40":         {{1080, 1920}},
+	"IPAD_PRO_3GEN_129": {{1200, 1600}},
+	"IPAD_PRO_3GEN_11":  {{1200, 1600}},
+	"IPAD_PRO_129":      {{1200, 1600}},
+	"IPAD_105":          {{1200, 1600}},
+	"IPAD_97":           {{900, 1200}},
+}
+
+// previewLandscapeSizes lists preview types that only accept landscape sizes.
+var previewLandscapeSizes = map[string][]ImageSize{
+	"DESKTOP":          {{1920, 1080}},
+	"APPLE_TV":         {{1920, 1080}},
+	"APPLE_VISION_PRO": {{3840, 2160}},
+}
+
+// PreviewSizes returns the pixel sizes accepted for a preview type, or nil
+// when the type has no published sizes.
+func PreviewSizes(previewType string) []ImageSize {
+	base := strings.ToUpper(previewType)
+	if sizes, ok := previewLandscapeSizes[base]; ok {
+		return append([]ImageSize(nil), sizes...)
+	}
+	portrait := previewPortraitSizes[base]
+	if len(portrait) == 0 {
+		return nil
+	}
+	sizes := make([]ImageSize, 0, len(portrait)*2)
+	for _, size := range portrait {
+		sizes = append(sizes, size, ImageSize{Width: size.Height, Height: size.Width})
+	}
+	return sizes
+}
+
+// IsValidPreviewSize reports whether width x height is accepted for
+// previewType. Types without published sizes accept any size.
+func IsValidPreviewSize(previewType string, width, height int) bool {
+	sizes := PreviewSizes(previewType)
+	if len(sizes) == 0 {
+		return true
+	}
+	for _, size := range sizes {
+		if size.Width == width && size.Height == height {
+			return true
+		}
+	}
+	return false
+}
+
+// ImageInfo describes an image from its file header.
+type ImageInfo struct {
+	Format     string `json:"format"`
+	Width      int    `json:"width"`
+	Height     int    `json:"height"`
+	HasAlpha   bool   `json:"hasAlpha"`
+	ColorSpace string `json:"colorSpace"`
+}
+
+// InspectImage reads the header of a PNG or JPEG file. HasAlpha is set for
+// PNGs with an alpha channel or a transparency (tRNS) chunk. ColorSpace is
+// "RGB", "Gray", or "CMYK"; PNGs with an embedded ICC profile report
+// "RGB (ICC profile)". Callers open the file so they control how paths are
+// resolved.
+func InspectImage(file io.Reader) (*ImageInfo, error) {
+	reader := bufio.NewReader(file)
+	header, err := reader.Peek(len(pngSignature))
+	if err == nil && bytes.Equal(header, pngSignature) {
+		return inspectPNG(reader)
+	}
+
+	config, format, err := image.DecodeConfig(reader)
+	if err != nil {
+		return nil, fmt.Errorf("unsupported image format (expected PNG or JPEG): %w", err)
+	}
+	if format != "jpeg" {
+		return nil, fmt.Errorf("unsupported image format %q (expected PNG or JPEG)", format)
+	}
+	info := &ImageInfo{Format: "jpeg", Width: config.Width, Height: config.Height, ColorSpace: "RGB"}
+	switch config.ColorModel {
+	case color.GrayModel:
+		info.ColorSpace = "Gray"
+	case color.CMYKModel:
+		info.ColorSpace = "CMYK"
+	}
+	return info, nil
+}
+
+var pngSignature = []byte{0x89, 'P', 'N', 'G', '\r', '\n', 0x1a, '\n'}
+
+// inspectPNG walks the chunks before the image data.
+func inspectPNG(reader io.Reader) (*ImageInfo, error) {
+	if _, err := io.CopyN(io.Discard, reader, int64(len(pngSignature))); err != nil {
+		return nil, err
+	}
+
+	info := &ImageInfo{Format: "png"}
+	sawHeader := false
+	for {
+		var chunk struct {
+			Length uint32
+			Type   [4]byte
+		}
+		if err := binary.Read(reader, binary.BigEndian, &chunk); err != nil {
+			return nil, fmt.Errorf("invalid PNG: %w", err)
+		}
+		chunkType := string(chunk.Type[:])
+		switch chunkType {
+		case "IHDR":
+			var ihdr struct {
+				Width, Height uint32
+				BitDepth      uint8
+				ColorType     uint8
+			}
+			if err := binary.Read(reader, binary.BigEndian, &ihdr); err != nil {
+				return nil, fmt.Errorf("invalid PNG header: %w", err)
+			}
+			info.Width = int(ihdr.Width)
+			info.Height = int(ihdr.Height)
+			switch ihdr.ColorType {
+			case 0:
+				info.ColorSpace = "Gray"
+			case 4:
+				info.ColorSpace = "Gray"
+				info.HasAlpha = true
+			case 6:
+				info.ColorSpace = "RGB"
+				info.HasAlpha = true
+			default:
+				info.ColorSpace = "RGB"
+			}
+			sawHeader = true
+			// Skip the rest of IHDR (compression, filter, interlace) and the CRC.
+			if _, err := io.CopyN(io.Discard, reader, int64(chunk.Length)-10+4); err != nil {
+				return nil, fmt.Errorf("invalid PNG: %w", err)
+			}
+			continue
+		case "tRNS":
+			info.HasAlpha = true
+		case "iCCP":
+			if info.ColorSpace == "RGB" {
+				info.ColorSpace = "RGB (ICC profile)"
+			}
+		case "IDAT", "IEND":
+			if !sawHeader {
+				return nil, fmt.Errorf("invalid PNG: missing IHDR")
+			}
+			return info, nil
+		}
+		if !sawHeader {
+			return nil, fmt.Errorf("invalid PNG: missing IHDR")
+		}
+		if _, err := io.CopyN(io.Discard, reader, int64(chunk.Length)+4); err != nil {
+			return nil, fmt.Errorf("invalid PNG: %w", err)
+		}
+	}
+}
+
+// VideoInfo describes a movie from its container metadata.
+type VideoInfo struct {
+	Format   string        `json:"format"`
+	Width    int           `json:"width"`
+	Height   int           `json:"height"`
+	Duration time.Duration `json:"duration"`
+}
+
+// mp4Atom is a box of an MP4 or QuickTime file: its type and the offset and
+// size of its payload.
+type mp4Atom struct {
+	kind   string
+	offset int64
+	size   int64
+}
+
+// InspectVideo reads the movie header (moov) of an MP4 or QuickTime file for
+// its duration and the display size of its first video track. Media data is
+// never read, so large files are cheap to inspect. size is the file size.
+func InspectVideo(file io.ReaderAt, size int64) (*VideoInfo, error) {
+	atoms, err := readMP4Atoms(file, 0, size)
+	if err != nil {
+		return nil, fmt.Errorf("invalid movie: %w", err)
+	}
+	info := &VideoInfo{Format: "mov"}
+	var moov *mp4Atom
+	for i, atom := range atoms {
+		switch atom.kind {
+		case "ftyp":
+			brand := make([]byte, 4)
+			if atom.size >= 4 {
+				if _, err := file.ReadAt(brand, atom.offset); err != nil {
+					return nil, fmt.Errorf("invalid movie: %w", err)
+				}
+				if string(brand) != "qt  " {
+					info.Format = "mp4"
+				}
+			}
+		case "moov":
+			moov = &atoms[i]
+		}
+	}
+	if moov == nil {
+		return nil, fmt.Errorf("unsupported video format (expected MP4 or QuickTime movie)")
+	}
+
+	children, err := readMP4Atoms(file, moov.offset, moov.offset+moov.size)
+	if err != nil {
+		return nil, fmt.Errorf("invalid movie header: %w", err)
+	}
+	for _, child := range children {
+		switch child.kind {
+		case "mvhd":
+			if info.Duration, err = readMovieDuration(file, child); err != nil {
+				return nil, err
+			}
+		case "trak":
+			if info.Width != 0 {
+				continue
+			}
+			tracks, err := readMP4Atoms(file, child.offset, child.offset+child.size)
+			if err != nil {
+				return nil, fmt.Errorf("invalid movie track: %w", err)
+			}
+			for _, track := range tracks {
+				if track.kind != "tkhd" {
+					continue
+				}
+				if info.Width, info.Height, err = readTrackSize(file, track); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	if info.Width == 0 || info.Height == 0 {
+		return nil, fmt.Errorf("movie has no video track")
+	}
+	return info, nil
+}
+
+// readMP4Atoms lists the atoms between start and end.
+func readMP4Atoms(r io.ReaderAt, start, end int64) ([]mp4Atom, error) {
+	var atoms []mp4Atom
+	for offset := start; offset+8 <= end; {
+		header := make([]byte, 16)
+		if _, err := r.ReadAt(header[:8], offset); err != nil {
+			return nil, err
+		}
+		size := int64(binary.BigEndian.Uint32(header[:4]))
+		headerSize := int64(8)
+		switch size {
+		case 0:
+			size = end - offset
+		case 1:
+			if _, err := r.ReadAt(header[8:16], offset+8); err != nil {
+				return nil, err
+			}
+			size = int64(binary.BigEndian.Uint64(header[8:16]))
+			headerSize = 16
+		}
+		if size < headerSize || offset+size > end {
+			return nil, fmt.Errorf("atom %q at offset %d has invalid size %d", header[4:8], offset, size)
+		}
+		atoms = append(atoms, mp4Atom{kind: string(header[4:8]), offset: offset + headerSize, size: size - headerSize})
+		offset += size
+	}
+	return atoms, nil
+}
+
+// readMovieDuration reads the duration from a movie header (mvhd) atom.
+func readMovieDuration(r io.ReaderAt, atom mp4Atom) (time.Duration, error) {
+	data := make([]byte, min(atom.size, 32))
+	if _, err := r.ReadAt(data, atom.offset); err != nil || len(data) < 20 {
+		return 0, fmt.Errorf("invalid movie header")
+	}
+	var timescale, duration uint64
+	if data[0] == 1 {
+		if len(data) < 32 {
+			return 0, fmt.Errorf("invalid movie header")
+		}
+		timescale = uint64(binary.BigEndian.Uint32(data[20:24]))
+		duration = binary.BigEndian.Uint64(data[24:32])
+	} else {
+		timescale = uint64(binary.BigEndian.Uint32(data[12:16]))
+		duration = uint64(binary.BigEndian.Uint32(data[16:20]))
+	}
+	if timescale == 0 {
+		return 0, fmt.Errorf("invalid movie header: zero timescale")
+	}
+	return time.Duration(float64(duration) / float64(timescale) * float64(time.Second)), nil
+}
+
+// readTrackSize reads the display size from a track header (tkhd) atom; audio
+// tracks report 0x0.
+func readTrackSize(r io.ReaderAt, atom mp4Atom) (int, int, error) {
+	data := make([]byte, min(atom.size, 96))
+	if _, err := r.ReadAt(data, atom.offset); err != nil || len(data) < 84 {
+		return 0, 0, fmt.Errorf("invalid track header")
+	}
+	sizeOffset := 76
+	if data[0] == 1 {
+		sizeOffset = 88
+		if len(data) < 96 {
+			return 0, 0, fmt.Errorf("invalid track header")
+		}
+	}
+	// Width and height are 16.16 fixed-point numbers.
+	width := int(binary.BigEndian.Uint32(data[sizeOffset:sizeOffset+4]) >> 16)
+	height := int(binary.BigEndian.Uint32(data[sizeOffset+4:sizeOffset+8]) >> 16)
+	return width, height, nil
+}
@@ -0,0 +1,237 @@
+package asc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestScreenshotSizesCoverDisplayTypes(t *testing.T) {
+	for _, displayType := range ValidScreenshotDisplayTypes {
+		if len(ScreenshotSizes(displayType)) == 0 {
+			t.Fatalf("no sizes for %s", displayType)
+		}
+	}
+}
+
+func TestIsValidScreenshotSize(t *testing.T) {
+	tests := []struct {
+		displayType   string
+		width, height int
+		want          bool
+	}{
+		{"APP_IPHONE_65", 1242, 2688, true},
+		{"APP_IPHONE_65", 2688, 1242, true},
+		{"APP_IPHONE_65", 1290, 2796, false},
+		{"IMESSAGE_APP_IPHONE_65", 1284, 2778, true},
+		{"APP_DESKTOP", 2880, 1800, true},
+		{"APP_DESKTOP", 1800, 2880, false},
+		{"APP_APPLE_TV", 1920, 1080, true},
+		{"UNKNOWN", 1242, 2688, false},
+	}
+	for _, test := range tests {
+		if got := IsValidScreenshotSize(test.displayType, test.width, test.height); got != test.want {
+			t.Fatalf("IsValidScreenshotSize(%s, %d, %d) = %t, want %t", test.displayType, test.width, test.height, got, test.want)
+		}
+	}
+}
+
+func TestScreenshotDisplayTypeForSize(t *testing.T) {
+	tests := map[ImageSize]string{
+		{1320, 2868}: "APP_IPHONE_69",
+		{1242, 2688}: "APP_IPHONE_65",
+		{2532, 1170}: "APP_IPHONE_61",
+		{2048, 2732}: "APP_IPAD_PRO_3GEN_129",
+		{2560, 1600}: "APP_DESKTOP",
+		{3840, 2160}: "APP_APPLE_TV",
+		{100, 200}:   "",
+	}
+	for size, want := range tests {
+		if got := ScreenshotDisplayTypeForSize(size.Width, size.Height); got != want {
+			t.Fatalf("ScreenshotDisplayTypeForSize(%s) = %q, want %q", size, got, want)
+		}
+	}
+}
+
+func TestScreenshotDisplayTypeForSize_Unambiguous(t *testing.T) {
+	accepted := make(map[ImageSize][]string)
+	for _, displayType := range ValidScreenshotDisplayTypes {
+		if strings.HasPrefix(displayType, "IMESSAGE_") {
+			continue
+		}
+		for _, size := range ScreenshotSizes(displayType) {
+			accepted[size] = append(accepted[size], displayType)
+		}
+	}
+
+	for size, displayTypes := range accepted {
+		got := ScreenshotDisplayTypeForSize(size.Width, size.Height)
+		if len(displayTypes) == 1 {
+			if got != displayTypes[0] {
+				t.Fatalf("ScreenshotDisplayTypeForSize(%s) = %q, want %q", size, got, displayTypes[0])
+			}
+			continue
+		}
+		owner, ok := screenshotSizeOwners[size]
+		if !ok {
+			owner, ok = screenshotSizeOwners[ImageSize{Width: size.Height, Height: size.Width}]
+		}
+		if !ok {
+			t.Fatalf("size %s is accepted by %v but has no entry in screenshotSizeOwners", size, displayTypes)
+		}
+		if got != owner {
+			t.Fatalf("ScreenshotDisplayTypeForSize(%s) = %q, want owner %q", size, got, owner)
+		}
+	}
+
+	for size, owner := range screenshotSizeOwners {
+		if len(accepted[size]) < 2 {
+			t.Fatalf("screenshotSizeOwners lists %s, which only %v accepts", size, accepted[size])
+		}
+		if !IsValidScreenshotSize(owner, size.Width, size.Height) {
+			t.Fatalf("owner %s does not accept %s", owner, size)
+		}
+	}
+}
+
+func writeImageFile(t *testing.T, path string, encode func(*os.File) error) {
+	t.Helper()
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("Create() error: %v", err)
+	}
+	defer file.Close()
+	if err := encode(file); err != nil {
+		t.Fatalf("encode error: %v", err)
+	}
+}
+
+func opaqueRGBA(width, height int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for i := 3; i < len(img.Pix); i += 4 {
+		img.Pix[i] = 0xff
+	}
+	return img
+}
+
+func TestInspectImage(t *testing.T) {
+	dir := t.TempDir()
+	paletted := image.NewPaletted(image.Rect(0, 0, 4, 4), color.Palette{color.Transparent, color.Black})
+
+	files := map[string]func(*os.File) error{
+		"opaque.png":      func(f *os.File) error { return png.Encode(f, opaqueRGBA(12, 8)) },
+		"transparent.png": func(f *os.File) error { return png.Encode(f, image.NewNRGBA(image.Rect(0, 0, 4, 4))) },
+		"gray.png":        func(f *os.File) error { return png.Encode(f, image.NewGray(image.Rect(0, 0, 4, 4))) },
+		"paletted.png":    func(f *os.File) error { return png.Encode(f, paletted) },
+		"photo.jpg":       func(f *os.File) error { return jpeg.Encode(f, opaqueRGBA(6, 10), nil) },
+		"gray.jpg":        func(f *os.File) error { return jpeg.Encode(f, image.NewGray(image.Rect(0, 0, 4, 4)), nil) },
+	}
+	for name, encode := range files {
+		writeImageFile(t, filepath.Join(dir, name), encode)
+	}
+
+	tests := []struct {
+		name string
+		want ImageInfo
+	}{
+		{"opaque.png", ImageInfo{Format: "png", Width: 12, Height: 8, ColorSpace: "RGB"}},
+		{"transparent.png", ImageInfo{Format: "png", Width: 4, Height: 4, HasAlpha: true, ColorSpace: "RGB"}},
+		{"gray.png", ImageInfo{Format: "png", Width: 4, Height: 4, ColorSpace: "Gray"}},
+		{"paletted.png", ImageInfo{Format: "png", Width: 4, Height: 4, HasAlpha: true, ColorSpace: "RGB"}},
+		{"photo.jpg", ImageInfo{Format: "jpeg", Width: 6, Height: 10, ColorSpace: "RGB"}},
+		{"gray.jpg", ImageInfo{Format: "jpeg", Width: 4, Height: 4, ColorSpace: "Gray"}},
+	}
+	for _, test := range tests {
+		got, err := inspectImagePath(filepath.Join(dir, test.name))
+		if err != nil {
+			t.Fatalf("InspectImage(%s) error: %v", test.name, err)
+		}
+		if *got != test.want {
+			t.Fatalf("InspectImage(%s) = %+v, want %+v", test.name, *got, test.want)
+		}
+	}
+}
+
+func inspectImagePath(path string) (*ImageInfo, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return InspectImage(file)
+}
+
+func TestInspectImage_RejectsUnknownFormat(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "notes.png")
+	if err := os.WriteFile(path, []byte("not an image"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+	if _, err := inspectImagePath(path); err == nil {
+		t.Fatal("expected error for non-image file")
+	}
+}
+
+// testMovie builds a minimal movie: ftyp, then moov with a version 0 mvhd and
+// one track header per size (0x0 for an audio track).
+func testMovie(brand string, seconds uint32, sizes ...ImageSize) []byte {
+	atom := func(kind string, payload ...[]byte) []byte {
+		body := bytes.Join(payload, nil)
+		out := binary.BigEndian.AppendUint32(nil, uint32(8+len(body)))
+		return append(append(out, kind...), body...)
+	}
+	mvhd := make([]byte, 100)
+	binary.BigEndian.PutUint32(mvhd[12:], 600)
+	binary.BigEndian.PutUint32(mvhd[16:], seconds*600)
+	traks := make([][]byte, 0, len(sizes))
+	for _, size := range sizes {
+		tkhd := make([]byte, 84)
+		binary.BigEndian.PutUint32(tkhd[76:], uint32(size.Width)<<16)
+		binary.BigEndian.PutUint32(tkhd[80:], uint32(size.Height)<<16)
+		traks = append(traks, atom("trak", atom("tkhd", tkhd)))
+	}
+	moov := atom("moov", append([][]byte{atom("mvhd", mvhd)}, traks...)...)
+	return bytes.Join([][]byte{atom("ftyp", []byte(brand), make([]byte, 4)), atom("mdat", make([]byte, 32)), moov}, nil)
+}
+
+func TestInspectVideo(t *testing.T) {
+	movie := testMovie("qt  ", 20, ImageSize{}, ImageSize{Width: 886, Height: 1920})
+	info, err := InspectVideo(bytes.NewReader(movie), int64(len(movie)))
+	if err != nil {
+		t.Fatalf("InspectVideo() error: %v", err)
+	}
+	if info.Format != "mov" || info.Width != 886 || info.Height != 1920 || info.Duration != 20*time.Second {
+		t.Fatalf("unexpected info: %+v", info)
+	}
+
+	movie = testMovie("isom", 20)
+	if _, err := InspectVideo(bytes.NewReader(movie), int64(len(movie))); err == nil || !strings.Contains(err.Error(), "no video track") {
+		t.Fatalf("expected missing video track error, got %v", err)
+	}
+
+	if _, err := InspectVideo(strings.NewReader("movie"), 5); err == nil {
+		t.Fatal("expected error for a file that is not a movie")
+	}
+}
+
+func TestIsValidPreviewSize(t *testing.T) {
+	if !IsValidPreviewSize("IPHONE_65", 1920, 886) || !IsValidPreviewSize("IPHONE_65", 886, 1920) {
+		t.Fatal("expected both orientations of 886x1920 for IPHONE_65")
+	}
+	if IsValidPreviewSize("IPHONE_65", 1080, 1920) {
+		t.Fatal("expected 1080x1920 to be rejected for IPHONE_65")
+	}
+	if IsValidPreviewSize("APPLE_TV", 1080, 1920) {
+		t.Fatal("expected portrait to be rejected for APPLE_TV")
+	}
+	if !IsValidPreviewSize("IPHONE_35", 640, 960) {
+		t.Fatal("expected types without published sizes to accept any size")
+	}
+}
@@ -51,7 +51,7 @@ func (c *Client) GetAppCategories(ctx context.Context, opts ...AppCategoriesOpti
 
 	path := "/v1/appCategories"
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appCategories: %w", err)
 		}
 		path = query.nextURL
@@ -158,7 +158,7 @@ func (c *Client) GetAppCategorySubcategories(ctx context.Context, categoryID str
 
 	path := fmt.Sprintf("/v1/appCategories/%s/subcategories", categoryID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appCategorySubcategories: %w", err)
 		}
 		path = query.nextURL
@@ -248,3 +248,50 @@ func (c *Client) UpdateAppInfoCategories(ctx context.Context, appInfoID string,
 
 	return &response, nil
 }
+
+// AppInfoClearSecondaryCategoryRelationships clears the secondary category.
+// A nil Data pointer is sent as an explicit null relationship.
+type AppInfoClearSecondaryCategoryRelationships struct {
+	SecondaryCategory struct {
+		Data *ResourceData `json:"data"`
+	} `json:"secondaryCategory"`
+}
+
+// AppInfoClearSecondaryCategoryData is the data for clearing the secondary category.
+type AppInfoClearSecondaryCategoryData struct {
+	Type          ResourceType                               `json:"type"`
+	ID            string                                     `json:"id"`
+	Relationships AppInfoClearSecondaryCategoryRelationships `json:"relationships"`
+}
+
+// AppInfoClearSecondaryCategoryRequest is a request to clear the secondary category.
+type AppInfoClearSecondaryCategoryRequest struct {
+	Data AppInfoClearSecondaryCategoryData `json:"data"`
+}
+
+// ClearAppInfoSecondaryCategory removes the secondary category from an app info.
+func (c *Client) ClearAppInfoSecondaryCategory(ctx context.Context, appInfoID string) (*AppInfoResponse, error) {
+	request := AppInfoClearSecondaryCategoryRequest{
+		Data: AppInfoClearSecondaryCategoryData{
+			Type: ResourceTypeAppInfos,
+			ID:   appInfoID,
+		},
+	}
+
+	body, err := BuildRequestBody(request)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.do(ctx, "PATCH", fmt.Sprintf("/v1/appInfos/%s", appInfoID), body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response AppInfoResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &response, nil
+}
@@ -429,7 +429,7 @@ func (c *Client) GetFeedback(ctx context.Context, appID string, opts ...Feedback
 	path := fmt.Sprintf("/v1/apps/%s/betaFeedbackScreenshotSubmissions", appID)
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("feedback: %w", err)
 		}
 		path = query.nextURL
@@ -460,7 +460,7 @@ func (c *Client) GetCrashes(ctx context.Context, appID string, opts ...CrashOpti
 	path := fmt.Sprintf("/v1/apps/%s/betaFeedbackCrashSubmissions", appID)
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("crashes: %w", err)
 		}
 		path = query.nextURL
@@ -491,7 +491,7 @@ func (c *Client) GetReviews(ctx context.Context, appID string, opts ...ReviewOpt
 	path := fmt.Sprintf("/v1/apps/%s/customerReviews", appID)
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("reviews: %w", err)
 		}
 		path = query.nextURL
@@ -543,7 +543,7 @@ func (c *Client) GetBetaGroups(ctx context.Context, appID string, opts ...BetaGr
 	path := fmt.Sprintf("/v1/apps/%s/betaGroups", appID)
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("betaGroups: %w", err)
 		}
 		path = query.nextURL
@@ -574,7 +574,7 @@ func (c *Client) GetBetaGroupBuilds(ctx context.Context, groupID string, opts ..
 	path := fmt.Sprintf("/v1/betaGroups/%s/builds", groupID)
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("betaGroupBuilds: %w", err)
 		}
 		path = query.nextURL
@@ -605,7 +605,7 @@ func (c *Client) GetBetaGroupTesters(ctx context.Context, groupID string, opts .
 	path := fmt.Sprintf("/v1/betaGroups/%s/betaTesters", groupID)
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("betaGroupTesters: %w", err)
 		}
 		path = query.nextURL
@@ -626,12 +626,13 @@ func (c *Client) GetBetaGroupTesters(ctx context.Context, groupID string, opts .
 	return &response, nil
 }
 
-// CreateBetaGroup creates a beta group for an app.
-func (c *Client) CreateBetaGroup(ctx context.Context, appID, name string) (*BetaGroupResponse, error) {
+// CreateBetaGroup creates a beta group for an app. Whether a group is internal
+// can only be set when it is created.
+func (c *Client) CreateBetaGroup(ctx context.Context, appID, name string, isInternalGroup bool) (*BetaGroupResponse, error) {
 	payload := BetaGroupCreateRequest{
 		Data: BetaGroupCreateData{
 			Type:       ResourceTypeBetaGroups,
-			Attributes: BetaGroupAttributes{Name: name},
+			Attributes: BetaGroupAttributes{Name: name, IsInternalGroup: isInternalGroup},
 			Relationships: &BetaGroupRelationships{
 				App: &Relationship{
 					Data: ResourceData{
@@ -760,7 +761,7 @@ func (c *Client) GetBetaTesters(ctx context.Context, appID string, opts ...BetaT
 	path := "/v1/betaTesters"
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("betaTesters: %w", err)
 		}
 		path = query.nextURL
@@ -1094,7 +1095,7 @@ func (c *Client) GetAppStoreVersionLocalizations(ctx context.Context, versionID
 	path := fmt.Sprintf("/v1/appStoreVersions/%s/appStoreVersionLocalizations", versionID)
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appStoreVersionLocalizations: %w", err)
 		}
 		path = query.nextURL
@@ -1215,7 +1216,7 @@ func (c *Client) GetBetaBuildLocalizations(ctx context.Context, buildID string,
 
 	path := fmt.Sprintf("/v1/builds/%s/betaBuildLocalizations", buildID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("betaBuildLocalizations: %w", err)
 		}
 		path = query.nextURL
@@ -1356,7 +1357,7 @@ func (c *Client) GetAppInfoLocalizations(ctx context.Context, appInfoID string,
 	path := fmt.Sprintf("/v1/appInfos/%s/appInfoLocalizations", appInfoID)
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appInfoLocalizations: %w", err)
 		}
 		path = query.nextURL
@@ -21,7 +21,7 @@ func (c *Client) GetAndroidToIosAppMappingDetails(ctx context.Context, appID str
 
 	path := fmt.Sprintf("/v1/apps/%s/androidToIosAppMappingDetails", appID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("androidToIosAppMappingDetails: %w", err)
 		}
 		path = query.nextURL
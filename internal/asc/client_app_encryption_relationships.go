@@ -63,7 +63,7 @@ func (c *Client) GetAppEncryptionDeclarationsForApp(ctx context.Context, appID s
 
 	path := fmt.Sprintf("/v1/apps/%s/appEncryptionDeclarations", appID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appEncryptionDeclarations: %w", err)
 		}
 		path = query.nextURL
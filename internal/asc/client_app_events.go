@@ -164,7 +164,7 @@ func (c *Client) GetAppEvents(ctx context.Context, appID string, opts ...AppEven
 
 	path := fmt.Sprintf("/v1/apps/%s/appEvents", appID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appEvents: %w", err)
 		}
 		path = query.nextURL
@@ -304,7 +304,7 @@ func (c *Client) GetAppEventLocalizations(ctx context.Context, eventID string, o
 
 	path := fmt.Sprintf("/v1/appEvents/%s/localizations", eventID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appEventLocalizations: %w", err)
 		}
 		path = query.nextURL
@@ -339,7 +339,7 @@ func (c *Client) GetAppEventLocalizationsRelationships(ctx context.Context, even
 
 	path := fmt.Sprintf("/v1/appEvents/%s/relationships/localizations", eventID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appEventLocalizationsRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -478,7 +478,7 @@ func (c *Client) GetAppEventScreenshots(ctx context.Context, localizationID stri
 
 	path := fmt.Sprintf("/v1/appEventLocalizations/%s/appEventScreenshots", localizationID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appEventScreenshots: %w", err)
 		}
 		path = query.nextURL
@@ -513,7 +513,7 @@ func (c *Client) GetAppEventScreenshotsRelationships(ctx context.Context, locali
 
 	path := fmt.Sprintf("/v1/appEventLocalizations/%s/relationships/appEventScreenshots", localizationID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appEventScreenshotsRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -659,7 +659,7 @@ func (c *Client) GetAppEventVideoClips(ctx context.Context, localizationID strin
 
 	path := fmt.Sprintf("/v1/appEventLocalizations/%s/appEventVideoClips", localizationID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appEventVideoClips: %w", err)
 		}
 		path = query.nextURL
@@ -694,7 +694,7 @@ func (c *Client) GetAppEventVideoClipsRelationships(ctx context.Context, localiz
 
 	path := fmt.Sprintf("/v1/appEventLocalizations/%s/relationships/appEventVideoClips", localizationID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appEventVideoClipsRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -210,7 +210,7 @@ func (c *Client) GetAppInfoTerritoryAgeRatingsRelationships(ctx context.Context,
 
 	path := fmt.Sprintf("/v1/appInfos/%s/relationships/territoryAgeRatings", appInfoID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("territoryAgeRatingsRelationships: %w", err)
 		}
 		path = query.nextURL
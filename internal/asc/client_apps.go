@@ -59,7 +59,7 @@ func (c *Client) GetApps(ctx context.Context, opts ...AppsOption) (*AppsResponse
 	path := "/v1/apps"
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("apps: %w", err)
 		}
 		path = query.nextURL
@@ -111,7 +111,7 @@ func (c *Client) GetAppBetaAppLocalizations(ctx context.Context, appID string, o
 
 	path := fmt.Sprintf("/v1/apps/%s/betaAppLocalizations", appID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appBetaAppLocalizations: %w", err)
 		}
 		path = query.nextURL
@@ -189,7 +189,7 @@ func (c *Client) GetAppBetaTesterUsagesMetrics(ctx context.Context, appID string
 	query.appID = ""
 	path := fmt.Sprintf("/v1/apps/%s/metrics/betaTesterUsages", appID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("betaTesterUsages: %w", err)
 		}
 		path = query.nextURL
@@ -219,7 +219,7 @@ func (c *Client) GetAppPreReleaseVersions(ctx context.Context, appID string, opt
 
 	path := fmt.Sprintf("/v1/apps/%s/preReleaseVersions", appID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appPreReleaseVersions: %w", err)
 		}
 		path = query.nextURL
@@ -289,7 +289,7 @@ func (c *Client) GetAppSearchKeywords(ctx context.Context, appID string, opts ..
 
 	path := fmt.Sprintf("/v1/apps/%s/searchKeywords", appID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("searchKeywords: %w", err)
 		}
 		path = query.nextURL
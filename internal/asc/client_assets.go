@@ -292,6 +292,11 @@ func (c *Client) DeleteAppScreenshot(ctx context.Context, screenshotID string) e
 	return err
 }
 
+// ReplaceAppScreenshotsForSet sets the screenshots of a set, in display order.
+func (c *Client) ReplaceAppScreenshotsForSet(ctx context.Context, setID string, screenshotIDs []string) error {
+	return c.replaceAssetSetOrder(ctx, fmt.Sprintf("/v1/appScreenshotSets/%s/relationships/appScreenshots", setID), ResourceTypeAppScreenshots, screenshotIDs)
+}
+
 // GetAppPreviewSets retrieves preview sets for a localization.
 func (c *Client) GetAppPreviewSets(ctx context.Context, localizationID string) (*AppPreviewSetsResponse, error) {
 	path := fmt.Sprintf("/v1/appStoreVersionLocalizations/%s/appPreviewSets", localizationID)
@@ -474,3 +479,25 @@ func (c *Client) DeleteAppPreview(ctx context.Context, previewID string) error {
 	_, err := c.do(ctx, "DELETE", path, nil)
 	return err
 }
+
+// ReplaceAppPreviewsForSet sets the previews of a set, in display order.
+func (c *Client) ReplaceAppPreviewsForSet(ctx context.Context, setID string, previewIDs []string) error {
+	return c.replaceAssetSetOrder(ctx, fmt.Sprintf("/v1/appPreviewSets/%s/relationships/appPreviews", setID), ResourceTypeAppPreviews, previewIDs)
+}
+
+func (c *Client) replaceAssetSetOrder(ctx context.Context, path string, resourceType ResourceType, ids []string) error {
+	payload := RelationshipRequest{
+		Data: make([]RelationshipData, 0, len(ids)),
+	}
+	for _, id := range ids {
+		payload.Data = append(payload.Data, RelationshipData{Type: resourceType, ID: id})
+	}
+
+	body, err := BuildRequestBody(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.do(ctx, "PATCH", path, body)
+	return err
+}
@@ -16,7 +16,7 @@ func (c *Client) GetBackgroundAssets(ctx context.Context, appID string, opts ...
 
 	path := fmt.Sprintf("/v1/apps/%s/backgroundAssets", strings.TrimSpace(appID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("backgroundAssets: %w", err)
 		}
 		path = query.nextURL
@@ -146,7 +146,7 @@ func (c *Client) GetBackgroundAssetVersions(ctx context.Context, backgroundAsset
 
 	path := fmt.Sprintf("/v1/backgroundAssets/%s/versions", strings.TrimSpace(backgroundAssetID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("backgroundAssetVersions: %w", err)
 		}
 		path = query.nextURL
@@ -236,7 +236,7 @@ func (c *Client) GetBackgroundAssetUploadFiles(ctx context.Context, versionID st
 
 	path := fmt.Sprintf("/v1/backgroundAssetVersions/%s/backgroundAssetUploadFiles", strings.TrimSpace(versionID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("backgroundAssetUploadFiles: %w", err)
 		}
 		path = query.nextURL
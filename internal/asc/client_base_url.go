@@ -0,0 +1,97 @@
+package asc
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+var baseURLOverride struct {
+	mu  sync.RWMutex
+	val *string
+}
+
+// SetBaseURLOverride sets an explicit API base URL override.
+// When set, it takes precedence over env/config. When unset (nil), behavior falls back to env/config.
+func SetBaseURLOverride(value *string) {
+	baseURLOverride.mu.Lock()
+	defer baseURLOverride.mu.Unlock()
+	baseURLOverride.val = value
+}
+
+// ResolveBaseURL returns the App Store Connect API base URL.
+// Precedence: explicit override > ASC_BASE_URL > config base_url > BaseURL.
+func ResolveBaseURL() string {
+	baseURLOverride.mu.RLock()
+	override := baseURLOverride.val
+	baseURLOverride.mu.RUnlock()
+	if override == nil {
+		return ResolveBaseURLWithOverride("")
+	}
+	return ResolveBaseURLWithOverride(*override)
+}
+
+// ResolveBaseURLWithOverride returns the base URL for an explicit override
+// such as --base-url without recording it; an empty override falls back to
+// ASC_BASE_URL, config base_url and BaseURL.
+func ResolveBaseURLWithOverride(override string) string {
+	if strings.TrimSpace(override) != "" {
+		return normalizeBaseURL(override)
+	}
+	if value, ok := envValue("ASC_BASE_URL"); ok && value != "" {
+		return normalizeBaseURL(value)
+	}
+	if cfg := loadConfig(); cfg != nil && strings.TrimSpace(cfg.BaseURL) != "" {
+		return normalizeBaseURL(cfg.BaseURL)
+	}
+	return BaseURL
+}
+
+// ValidateBaseURL checks that a base URL is safe to send credentials to.
+// HTTPS is required unless the host is a loopback address (local mock servers).
+func ValidateBaseURL(raw string) error {
+	parsed, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return fmt.Errorf("invalid base URL: %w", err)
+	}
+	if parsed.Host == "" {
+		return fmt.Errorf("invalid base URL %q: host is required", raw)
+	}
+	if parsed.User != nil || parsed.RawQuery != "" || parsed.Fragment != "" {
+		return fmt.Errorf("invalid base URL %q: must not include credentials, query, or fragment", raw)
+	}
+	switch parsed.Scheme {
+	case "https":
+		return nil
+	case "http":
+		if IsLoopbackHost(parsed.Hostname()) {
+			return nil
+		}
+		return fmt.Errorf("invalid base URL %q: http is only allowed for localhost", raw)
+	default:
+		return fmt.Errorf("invalid base URL %q: scheme must be https", raw)
+	}
+}
+
+func normalizeBaseURL(value string) string {
+	return strings.TrimRight(strings.TrimSpace(value), "/")
+}
+
+// IsLoopbackHost reports whether host is localhost or a loopback IP.
+func IsLoopbackHost(host string) bool {
+	if strings.EqualFold(host, "localhost") {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// resolveBaseURL returns the client's base URL, falling back to the default.
+func (c *Client) resolveBaseURL() string {
+	if c.baseURL != "" {
+		return c.baseURL
+	}
+	return BaseURL
+}
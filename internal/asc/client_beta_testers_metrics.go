@@ -23,7 +23,7 @@ func (c *Client) GetBetaTesterUsagesMetrics(ctx context.Context, testerID string
 
 	path := fmt.Sprintf("/v1/betaTesters/%s/metrics/betaTesterUsages", testerID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("betaTesterUsages: %w", err)
 		}
 		path = query.nextURL
@@ -141,7 +141,7 @@ func (c *Client) GetBuildBundleFileSizes(ctx context.Context, buildBundleID stri
 
 	path := fmt.Sprintf("/v1/buildBundles/%s/buildBundleFileSizes", buildBundleID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("build bundle file sizes: %w", err)
 		}
 		path = query.nextURL
@@ -203,7 +203,7 @@ func (c *Client) GetBuildBundleBetaAppClipInvocations(ctx context.Context, build
 
 	path := fmt.Sprintf("/v1/buildBundles/%s/betaAppClipInvocations", buildBundleID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("build bundle app clip invocations: %w", err)
 		}
 		path = query.nextURL
@@ -20,7 +20,7 @@ func (c *Client) GetBuildBetaUsagesMetrics(ctx context.Context, buildID string,
 
 	path := fmt.Sprintf("/v1/builds/%s/metrics/betaBuildUsages", buildID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("betaBuildUsages: %w", err)
 		}
 		path = query.nextURL
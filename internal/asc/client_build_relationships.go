@@ -148,7 +148,7 @@ func (c *Client) getBuildLinkages(ctx context.Context, buildID, relationship str
 
 	path := fmt.Sprintf("/v1/builds/%s/relationships/%s", buildID, relationship)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("buildRelationships: %w", err)
 		}
 		path = query.nextURL
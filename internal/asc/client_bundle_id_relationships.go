@@ -33,7 +33,7 @@ func (c *Client) GetBundleIDProfiles(ctx context.Context, bundleID string, opts
 
 	path := fmt.Sprintf("/v1/bundleIds/%s/profiles", strings.TrimSpace(bundleID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("bundleIdProfiles: %w", err)
 		}
 		path = query.nextURL
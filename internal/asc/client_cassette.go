@@ -0,0 +1,305 @@
+package asc
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// CassetteMode selects whether API traffic is recorded or replayed.
+type CassetteMode string
+
+const (
+	// CassetteRecord writes every API interaction to the cassette directory.
+	CassetteRecord CassetteMode = "record"
+	// CassetteReplay serves API interactions from the cassette directory without network access.
+	CassetteReplay CassetteMode = "replay"
+)
+
+// CassetteOptions configures HTTP record/replay.
+type CassetteOptions struct {
+	Mode CassetteMode
+	Dir  string
+}
+
+var cassetteOverride struct {
+	mu  sync.RWMutex
+	val *CassetteOptions
+}
+
+// SetCassetteOverride sets explicit record/replay options.
+// When set, it takes precedence over env. When unset (nil), behavior falls back to env.
+func SetCassetteOverride(opts *CassetteOptions) {
+	cassetteOverride.mu.Lock()
+	defer cassetteOverride.mu.Unlock()
+	cassetteOverride.val = opts
+}
+
+// ResolveCassetteOptions returns the active record/replay options, or nil when disabled.
+// Precedence: explicit override > ASC_RECORD_DIR / ASC_REPLAY_DIR.
+func ResolveCassetteOptions() (*CassetteOptions, error) {
+	cassetteOverride.mu.RLock()
+	override := cassetteOverride.val
+	cassetteOverride.mu.RUnlock()
+	if override != nil && strings.TrimSpace(override.Dir) != "" {
+		opts := *override
+		opts.Dir = strings.TrimSpace(opts.Dir)
+		return &opts, nil
+	}
+
+	recordDir, _ := envValue("ASC_RECORD_DIR")
+	replayDir, _ := envValue("ASC_REPLAY_DIR")
+	switch {
+	case recordDir != "" && replayDir != "":
+		return nil, fmt.Errorf("ASC_RECORD_DIR and ASC_REPLAY_DIR are mutually exclusive")
+	case recordDir != "":
+		return &CassetteOptions{Mode: CassetteRecord, Dir: recordDir}, nil
+	case replayDir != "":
+		return &CassetteOptions{Mode: CassetteReplay, Dir: replayDir}, nil
+	}
+	return nil, nil
+}
+
+// cassette records or replays API interactions as JSON files keyed by
+// method, path, query and request body hash.
+type cassette struct {
+	mode CassetteMode
+	dir  string
+
+	mu   sync.Mutex
+	seen map[string]int
+}
+
+type cassetteInteraction struct {
+	Request  cassetteRequest  `json:"request"`
+	Response cassetteResponse `json:"response"`
+}
+
+type cassetteRequest struct {
+	Method     string      `json:"method"`
+	Path       string      `json:"path"`
+	Query      string      `json:"query,omitempty"`
+	BodySHA256 string      `json:"bodySha256,omitempty"`
+	Headers    http.Header `json:"headers,omitempty"`
+}
+
+type cassetteResponse struct {
+	StatusCode int             `json:"statusCode"`
+	Headers    http.Header     `json:"headers,omitempty"`
+	Body       json.RawMessage `json:"body,omitempty"`
+	BodyBase64 string          `json:"bodyBase64,omitempty"`
+}
+
+var privateKeyBlockPattern = regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----[\s\S]*?-----END [A-Z ]*PRIVATE KEY-----`)
+
+func newCassette(opts CassetteOptions) (*cassette, error) {
+	dir := strings.TrimSpace(opts.Dir)
+	if dir == "" {
+		return nil, fmt.Errorf("cassette directory is required")
+	}
+	switch opts.Mode {
+	case CassetteRecord:
+		if err := os.MkdirAll(dir, 0o700); err != nil {
+			return nil, fmt.Errorf("failed to create cassette directory: %w", err)
+		}
+	case CassetteReplay:
+		info, err := os.Stat(dir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open cassette directory: %w", err)
+		}
+		if !info.IsDir() {
+			return nil, fmt.Errorf("cassette path %q is not a directory", dir)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported cassette mode %q", opts.Mode)
+	}
+	return &cassette{mode: opts.Mode, dir: dir, seen: make(map[string]int)}, nil
+}
+
+// send performs an HTTP request, recording or replaying it when a cassette is active.
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	if c.cassette == nil {
+		return c.httpClient.Do(req)
+	}
+	return c.cassette.roundTrip(c.httpClient, req)
+}
+
+func (cs *cassette) roundTrip(client *http.Client, req *http.Request) (*http.Response, error) {
+	var bodyBytes []byte
+	if req.Body != nil {
+		var err error
+		bodyBytes, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read request body: %w", err)
+		}
+		req.Body = io.NopCloser(bytes.NewReader(bodyBytes))
+	}
+
+	key := cassetteKey(req, bodyBytes)
+	occurrence := cs.next(key)
+
+	if cs.mode == CassetteReplay {
+		return cs.replay(req, key, occurrence)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	if err := cs.record(req, bodyBytes, resp, respBody, key, occurrence); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (cs *cassette) next(key string) int {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	cs.seen[key]++
+	return cs.seen[key]
+}
+
+func (cs *cassette) record(req *http.Request, reqBody []byte, resp *http.Response, respBody []byte, key string, occurrence int) error {
+	interaction := cassetteInteraction{
+		Request: cassetteRequest{
+			Method:  req.Method,
+			Path:    req.URL.Path,
+			Query:   redactedQuery(req),
+			Headers: redactHeaders(req.Header),
+		},
+		Response: cassetteResponse{
+			StatusCode: resp.StatusCode,
+			Headers:    redactHeaders(resp.Header),
+		},
+	}
+	if len(reqBody) > 0 {
+		interaction.Request.BodySHA256 = sha256Hex(reqBody)
+	}
+
+	redactedBody := privateKeyBlockPattern.ReplaceAll(respBody, []byte("[REDACTED]"))
+	if len(redactedBody) > 0 {
+		if json.Valid(redactedBody) {
+			interaction.Response.Body = json.RawMessage(redactedBody)
+		} else {
+			interaction.Response.BodyBase64 = base64.StdEncoding.EncodeToString(redactedBody)
+		}
+	}
+
+	data, err := json.MarshalIndent(interaction, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode cassette interaction: %w", err)
+	}
+	path := filepath.Join(cs.dir, cassetteFileName(req.Method, req.URL.Path, key, occurrence))
+	if err := os.WriteFile(path, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("failed to write cassette interaction: %w", err)
+	}
+	return nil
+}
+
+func (cs *cassette) replay(req *http.Request, key string, occurrence int) (*http.Response, error) {
+	// Repeated requests (polling, retries) replay the last recorded occurrence
+	// once the recorded sequence is exhausted.
+	for n := occurrence; n >= 1; n-- {
+		path := filepath.Join(cs.dir, cassetteFileName(req.Method, req.URL.Path, key, n))
+		data, err := os.ReadFile(path)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read cassette interaction: %w", err)
+		}
+
+		var interaction cassetteInteraction
+		if err := json.Unmarshal(data, &interaction); err != nil {
+			return nil, fmt.Errorf("failed to parse cassette interaction %s: %w", filepath.Base(path), err)
+		}
+		body := []byte(interaction.Response.Body)
+		if interaction.Response.BodyBase64 != "" {
+			body, err = base64.StdEncoding.DecodeString(interaction.Response.BodyBase64)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode cassette interaction %s: %w", filepath.Base(path), err)
+			}
+		}
+		header := interaction.Response.Headers
+		if header == nil {
+			header = http.Header{}
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(bytes.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("replay: no recorded interaction for %s %s in %s", req.Method, sanitizeURLForLog(req.URL.String()), cs.dir)
+}
+
+// cassetteKey identifies an interaction independently of host and credentials.
+func cassetteKey(req *http.Request, body []byte) string {
+	parts := []string{
+		req.Method,
+		req.URL.Path,
+		req.URL.Query().Encode(),
+		sha256Hex(body),
+	}
+	return sha256Hex([]byte(strings.Join(parts, "\n")))
+}
+
+func cassetteFileName(method, path, key string, occurrence int) string {
+	slug := strings.Trim(strings.NewReplacer("/", "_", ".", "_").Replace(path), "_")
+	if len(slug) > 80 {
+		slug = slug[:80]
+	}
+	return fmt.Sprintf("%s_%s_%s_%03d.json", strings.ToUpper(method), slug, key[:16], occurrence)
+}
+
+func redactedQuery(req *http.Request) string {
+	if req.URL.RawQuery == "" {
+		return ""
+	}
+	sanitized := sanitizeURLForLog(req.URL.String())
+	if idx := strings.Index(sanitized, "?"); idx >= 0 {
+		return sanitized[idx+1:]
+	}
+	return ""
+}
+
+func redactHeaders(header http.Header) http.Header {
+	if len(header) == 0 {
+		return nil
+	}
+	redacted := make(http.Header, len(header))
+	for name, values := range header {
+		switch http.CanonicalHeaderKey(name) {
+		case "Authorization":
+			redacted.Set(name, sanitizeAuthHeader(header.Get(name)))
+		case "Cookie", "Set-Cookie", "Content-Length":
+			continue
+		default:
+			redacted[name] = append([]string(nil), values...)
+		}
+	}
+	return redacted
+}
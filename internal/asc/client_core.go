@@ -19,7 +19,8 @@ import (
 )
 
 const (
-	// BaseURL is the App Store Connect API base URL
+	// BaseURL is the default App Store Connect API base URL.
+	// Use ResolveBaseURL to honor --base-url, ASC_BASE_URL and config overrides.
 	BaseURL = "https://api.appstoreconnect.apple.com"
 	// DefaultTimeout is the default request timeout
 	DefaultTimeout = 30 * time.Second
@@ -428,10 +429,16 @@ type Client struct {
 	keyID         string
 	issuerID      string
 	privateKey    *ecdsa.PrivateKey
-	notaryBaseURL string // override for testing; empty uses NotaryBaseURL constant
+	baseURL       string    // empty uses BaseURL constant
+	notaryBaseURL string    // override for testing; empty uses NotaryBaseURL constant
+	cassette      *cassette // non-nil when recording or replaying API traffic
+	tokens        tokenCache
+	scope         []string           // scope claim of request tokens; nil grants full key access
+	rateLimit     *rateLimitGovernor // nil disables pacing
 }
 
-// NewClient creates a new ASC client
+// NewClient creates a new ASC client.
+// Pass an empty issuerID for Individual API keys.
 func NewClient(keyID, issuerID, privateKeyPath string) (*Client, error) {
 	if err := auth.ValidateKeyFile(privateKeyPath); err != nil {
 		return nil, fmt.Errorf("invalid private key: %w", err)
@@ -442,6 +449,28 @@ func NewClient(keyID, issuerID, privateKeyPath string) (*Client, error) {
 		return nil, fmt.Errorf("failed to load private key: %w", err)
 	}
 
+	baseURL := ResolveBaseURL()
+	if err := ValidateBaseURL(baseURL); err != nil {
+		return nil, err
+	}
+
+	scope, err := ResolveTokenScope()
+	if err != nil {
+		return nil, err
+	}
+
+	cassetteOpts, err := ResolveCassetteOptions()
+	if err != nil {
+		return nil, err
+	}
+	var tape *cassette
+	if cassetteOpts != nil {
+		tape, err = newCassette(*cassetteOpts)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &Client{
 		httpClient: &http.Client{
 			Timeout: ResolveTimeout(),
@@ -449,5 +478,39 @@ func NewClient(keyID, issuerID, privateKeyPath string) (*Client, error) {
 		keyID:      keyID,
 		issuerID:   issuerID,
 		privateKey: key,
+		baseURL:    baseURL,
+		cassette:   tape,
+		scope:      scope,
+		rateLimit:  rateLimitGovernorFor(baseURL),
+	}, nil
+}
+
+// NewReplayClient creates a client without credentials that serves every
+// request from the active replay cassette. Recordings never contain the
+// signed token, so replay does not need a key to match them.
+func NewReplayClient() (*Client, error) {
+	cassetteOpts, err := ResolveCassetteOptions()
+	if err != nil {
+		return nil, err
+	}
+	if cassetteOpts == nil || cassetteOpts.Mode != CassetteReplay {
+		return nil, fmt.Errorf("a client without credentials requires replay mode")
+	}
+	tape, err := newCassette(*cassetteOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	baseURL := ResolveBaseURL()
+	if err := ValidateBaseURL(baseURL); err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		httpClient: &http.Client{
+			Timeout: ResolveTimeout(),
+		},
+		baseURL:  baseURL,
+		cassette: tape,
 	}, nil
 }
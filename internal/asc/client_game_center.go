@@ -34,7 +34,7 @@ func (c *Client) GetGameCenterAchievements(ctx context.Context, gcDetailID strin
 
 	path := fmt.Sprintf("/v1/gameCenterDetails/%s/gameCenterAchievements", strings.TrimSpace(gcDetailID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-achievements: %w", err)
 		}
 		path = query.nextURL
@@ -167,7 +167,7 @@ func (c *Client) GetGameCenterLeaderboards(ctx context.Context, gcDetailID strin
 
 	path := fmt.Sprintf("/v1/gameCenterDetails/%s/gameCenterLeaderboards", strings.TrimSpace(gcDetailID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-leaderboards: %w", err)
 		}
 		path = query.nextURL
@@ -300,7 +300,7 @@ func (c *Client) GetGameCenterLeaderboardSets(ctx context.Context, gcDetailID st
 
 	path := fmt.Sprintf("/v1/gameCenterDetails/%s/gameCenterLeaderboardSets", strings.TrimSpace(gcDetailID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-leaderboard-sets: %w", err)
 		}
 		path = query.nextURL
@@ -433,7 +433,7 @@ func (c *Client) GetGameCenterLeaderboardLocalizations(ctx context.Context, lead
 
 	path := fmt.Sprintf("/v1/gameCenterLeaderboards/%s/localizations", strings.TrimSpace(leaderboardID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-leaderboard-localizations: %w", err)
 		}
 		path = query.nextURL
@@ -591,7 +591,7 @@ func (c *Client) GetGameCenterLeaderboardReleases(ctx context.Context, leaderboa
 
 	path := fmt.Sprintf("/v1/gameCenterLeaderboards/%s/releases", strings.TrimSpace(leaderboardID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-leaderboard-releases: %w", err)
 		}
 		path = query.nextURL
@@ -668,7 +668,7 @@ func (c *Client) GetGameCenterAchievementReleases(ctx context.Context, achieveme
 
 	path := fmt.Sprintf("/v1/gameCenterAchievements/%s/releases", strings.TrimSpace(achievementID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-achievement-releases: %w", err)
 		}
 		path = query.nextURL
@@ -745,7 +745,7 @@ func (c *Client) GetGameCenterLeaderboardSetMembers(ctx context.Context, setID s
 
 	path := fmt.Sprintf("/v1/gameCenterLeaderboardSets/%s/gameCenterLeaderboards", strings.TrimSpace(setID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-leaderboard-set-members: %w", err)
 		}
 		path = query.nextURL
@@ -799,7 +799,7 @@ func (c *Client) GetGameCenterLeaderboardSetReleases(ctx context.Context, setID
 
 	path := fmt.Sprintf("/v1/gameCenterLeaderboardSets/%s/releases", strings.TrimSpace(setID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-leaderboard-set-releases: %w", err)
 		}
 		path = query.nextURL
@@ -876,7 +876,7 @@ func (c *Client) GetGameCenterLeaderboardSetLocalizations(ctx context.Context, s
 
 	path := fmt.Sprintf("/v1/gameCenterLeaderboardSets/%s/localizations", strings.TrimSpace(setID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-leaderboard-set-localizations: %w", err)
 		}
 		path = query.nextURL
@@ -1009,7 +1009,7 @@ func (c *Client) GetGameCenterAchievementLocalizations(ctx context.Context, achi
 
 	path := fmt.Sprintf("/v1/gameCenterAchievements/%s/localizations", strings.TrimSpace(achievementID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-achievement-localizations: %w", err)
 		}
 		path = query.nextURL
@@ -18,7 +18,7 @@ func (c *Client) GetGameCenterActivities(ctx context.Context, gcDetailID string,
 
 	path := fmt.Sprintf("/v1/gameCenterDetails/%s/gameCenterActivities", strings.TrimSpace(gcDetailID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-activities: %w", err)
 		}
 		path = query.nextURL
@@ -213,7 +213,7 @@ func (c *Client) GetGameCenterActivityVersions(ctx context.Context, activityID s
 
 	path := fmt.Sprintf("/v1/gameCenterActivities/%s/versions", strings.TrimSpace(activityID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-activity-versions: %w", err)
 		}
 		path = query.nextURL
@@ -338,7 +338,7 @@ func (c *Client) GetGameCenterActivityLocalizations(ctx context.Context, version
 
 	path := fmt.Sprintf("/v1/gameCenterActivityVersions/%s/localizations", strings.TrimSpace(versionID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-activity-localizations: %w", err)
 		}
 		path = query.nextURL
@@ -642,7 +642,7 @@ func (c *Client) GetGameCenterActivityVersionReleases(ctx context.Context, gcDet
 
 	path := fmt.Sprintf("/v1/gameCenterDetails/%s/activityReleases", strings.TrimSpace(gcDetailID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-activity-releases: %w", err)
 		}
 		path = query.nextURL
@@ -17,7 +17,7 @@ func (c *Client) GetGameCenterDetails(ctx context.Context, opts ...GCDetailsOpti
 
 	path := "/v1/gameCenterDetails"
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-details: %w", err)
 		}
 		path = query.nextURL
@@ -84,7 +84,7 @@ func (c *Client) GetGameCenterGroupGameCenterDetails(ctx context.Context, groupI
 
 	path := fmt.Sprintf("/v1/gameCenterGroups/%s/gameCenterDetails", groupID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-group-details: %w", err)
 		}
 		path = query.nextURL
@@ -114,7 +114,7 @@ func (c *Client) GetGameCenterDetailsAchievementReleases(ctx context.Context, gc
 
 	path := fmt.Sprintf("/v1/gameCenterDetails/%s/achievementReleases", strings.TrimSpace(gcDetailID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-details-achievement-releases: %w", err)
 		}
 		path = query.nextURL
@@ -144,7 +144,7 @@ func (c *Client) GetGameCenterDetailsLeaderboardReleases(ctx context.Context, gc
 
 	path := fmt.Sprintf("/v1/gameCenterDetails/%s/leaderboardReleases", strings.TrimSpace(gcDetailID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-details-leaderboard-releases: %w", err)
 		}
 		path = query.nextURL
@@ -174,7 +174,7 @@ func (c *Client) GetGameCenterDetailsLeaderboardSetReleases(ctx context.Context,
 
 	path := fmt.Sprintf("/v1/gameCenterDetails/%s/leaderboardSetReleases", strings.TrimSpace(gcDetailID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-details-leaderboard-set-releases: %w", err)
 		}
 		path = query.nextURL
@@ -204,7 +204,7 @@ func (c *Client) GetGameCenterDetailsAchievementsV2(ctx context.Context, gcDetai
 
 	path := fmt.Sprintf("/v1/gameCenterDetails/%s/gameCenterAchievementsV2", strings.TrimSpace(gcDetailID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-details-achievements-v2: %w", err)
 		}
 		path = query.nextURL
@@ -234,7 +234,7 @@ func (c *Client) GetGameCenterDetailsLeaderboardsV2(ctx context.Context, gcDetai
 
 	path := fmt.Sprintf("/v1/gameCenterDetails/%s/gameCenterLeaderboardsV2", strings.TrimSpace(gcDetailID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-details-leaderboards-v2: %w", err)
 		}
 		path = query.nextURL
@@ -264,7 +264,7 @@ func (c *Client) GetGameCenterDetailsLeaderboardSetsV2(ctx context.Context, gcDe
 
 	path := fmt.Sprintf("/v1/gameCenterDetails/%s/gameCenterLeaderboardSetsV2", strings.TrimSpace(gcDetailID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-details-leaderboard-sets-v2: %w", err)
 		}
 		path = query.nextURL
@@ -294,7 +294,7 @@ func (c *Client) GetGameCenterDetailsClassicMatchmakingRequests(ctx context.Cont
 
 	path := fmt.Sprintf("/v1/gameCenterDetails/%s/metrics/classicMatchmakingRequests", strings.TrimSpace(gcDetailID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-details-classic-matchmaking-requests: %w", err)
 		}
 		path = query.nextURL
@@ -324,7 +324,7 @@ func (c *Client) GetGameCenterDetailsRuleBasedMatchmakingRequests(ctx context.Co
 
 	path := fmt.Sprintf("/v1/gameCenterDetails/%s/metrics/ruleBasedMatchmakingRequests", strings.TrimSpace(gcDetailID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-details-rule-based-matchmaking-requests: %w", err)
 		}
 		path = query.nextURL
@@ -22,7 +22,7 @@ func (c *Client) GetAppGameCenterEnabledVersions(ctx context.Context, appID stri
 
 	path := fmt.Sprintf("/v1/apps/%s/gameCenterEnabledVersions", appID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-enabled-versions: %w", err)
 		}
 		path = query.nextURL
@@ -57,7 +57,7 @@ func (c *Client) GetGameCenterEnabledVersionCompatibleVersions(ctx context.Conte
 
 	path := fmt.Sprintf("/v1/gameCenterEnabledVersions/%s/compatibleVersions", enabledVersionID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-enabled-versions-compatibility: %w", err)
 		}
 		path = query.nextURL
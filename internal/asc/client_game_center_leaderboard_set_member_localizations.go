@@ -17,7 +17,7 @@ func (c *Client) GetGameCenterLeaderboardSetMemberLocalizations(ctx context.Cont
 
 	path := "/v1/gameCenterLeaderboardSetMemberLocalizations"
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-leaderboard-set-member-localizations: %w", err)
 		}
 		path = query.nextURL
@@ -21,7 +21,7 @@ func (c *Client) GetGameCenterAchievementsV2(ctx context.Context, gcDetailID, gr
 		path = fmt.Sprintf("/v1/gameCenterGroups/%s/gameCenterAchievementsV2", strings.TrimSpace(groupID))
 	}
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-achievements-v2: %w", err)
 		}
 		path = query.nextURL
@@ -51,7 +51,7 @@ func (c *Client) GetGameCenterAchievementVersions(ctx context.Context, achieveme
 
 	path := fmt.Sprintf("/v2/gameCenterAchievements/%s/versions", strings.TrimSpace(achievementID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-achievement-versions: %w", err)
 		}
 		path = query.nextURL
@@ -131,7 +131,7 @@ func (c *Client) GetGameCenterAchievementVersionLocalizations(ctx context.Contex
 
 	path := fmt.Sprintf("/v2/gameCenterAchievementVersions/%s/localizations", strings.TrimSpace(versionID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-achievement-version-localizations: %w", err)
 		}
 		path = query.nextURL
@@ -407,7 +407,7 @@ func (c *Client) GetGameCenterLeaderboardsV2(ctx context.Context, gcDetailID, gr
 		path = fmt.Sprintf("/v1/gameCenterGroups/%s/gameCenterLeaderboardsV2", strings.TrimSpace(groupID))
 	}
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-leaderboards-v2: %w", err)
 		}
 		path = query.nextURL
@@ -437,7 +437,7 @@ func (c *Client) GetGameCenterLeaderboardVersions(ctx context.Context, leaderboa
 
 	path := fmt.Sprintf("/v2/gameCenterLeaderboards/%s/versions", strings.TrimSpace(leaderboardID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-leaderboard-versions: %w", err)
 		}
 		path = query.nextURL
@@ -517,7 +517,7 @@ func (c *Client) GetGameCenterLeaderboardVersionLocalizations(ctx context.Contex
 
 	path := fmt.Sprintf("/v2/gameCenterLeaderboardVersions/%s/localizations", strings.TrimSpace(versionID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-leaderboard-version-localizations: %w", err)
 		}
 		path = query.nextURL
@@ -793,7 +793,7 @@ func (c *Client) GetGameCenterLeaderboardSetsV2(ctx context.Context, gcDetailID,
 		path = fmt.Sprintf("/v1/gameCenterGroups/%s/gameCenterLeaderboardSetsV2", strings.TrimSpace(groupID))
 	}
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-leaderboard-sets-v2: %w", err)
 		}
 		path = query.nextURL
@@ -928,7 +928,7 @@ func (c *Client) GetGameCenterLeaderboardSetMembersV2(ctx context.Context, setID
 
 	path := fmt.Sprintf("/v2/gameCenterLeaderboardSets/%s/gameCenterLeaderboards", strings.TrimSpace(setID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-leaderboard-set-members-v2: %w", err)
 		}
 		path = query.nextURL
@@ -982,7 +982,7 @@ func (c *Client) GetGameCenterLeaderboardSetVersions(ctx context.Context, setID
 
 	path := fmt.Sprintf("/v2/gameCenterLeaderboardSets/%s/versions", strings.TrimSpace(setID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-leaderboard-set-versions: %w", err)
 		}
 		path = query.nextURL
@@ -1062,7 +1062,7 @@ func (c *Client) GetGameCenterLeaderboardSetVersionLocalizations(ctx context.Con
 
 	path := fmt.Sprintf("/v2/gameCenterLeaderboardSetVersions/%s/localizations", strings.TrimSpace(versionID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-leaderboard-set-version-localizations: %w", err)
 		}
 		path = query.nextURL
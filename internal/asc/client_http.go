@@ -3,7 +3,6 @@ package asc
 import (
 	"bytes"
 	"context"
-	"crypto/ecdsa"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -13,8 +12,6 @@ import (
 	"strconv"
 	"strings"
 	"time"
-
-	"github.com/golang-jwt/jwt/v5"
 )
 
 // newRequest creates a new HTTP request with JWT authentication
@@ -27,7 +24,7 @@ func (c *Client) newRequest(ctx context.Context, method, path string, body io.Re
 
 	url := path
 	if !strings.HasPrefix(path, "http://") && !strings.HasPrefix(path, "https://") {
-		url = BaseURL + path
+		url = c.resolveBaseURL() + path
 	}
 	req, err := http.NewRequestWithContext(ctx, method, url, body)
 	if err != nil {
@@ -41,33 +38,6 @@ func (c *Client) newRequest(ctx context.Context, method, path string, body io.Re
 	return req, nil
 }
 
-// generateJWT generates a JWT for ASC API authentication
-func (c *Client) generateJWT() (string, error) {
-	return GenerateJWT(c.keyID, c.issuerID, c.privateKey)
-}
-
-// GenerateJWT generates a JWT for ASC API authentication.
-func GenerateJWT(keyID, issuerID string, privateKey *ecdsa.PrivateKey) (string, error) {
-	now := time.Now()
-	claims := jwt.RegisteredClaims{
-		Issuer:    issuerID,
-		Audience:  jwt.ClaimStrings{"appstoreconnect-v1"},
-		IssuedAt:  jwt.NewNumericDate(now),
-		ExpiresAt: jwt.NewNumericDate(now.Add(tokenLifetime)),
-	}
-
-	token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
-	token.Header["kid"] = keyID
-
-	// Sign with the private key
-	signedToken, err := token.SignedString(privateKey)
-	if err != nil {
-		return "", fmt.Errorf("failed to sign token: %w", err)
-	}
-
-	return signedToken, nil
-}
-
 // do performs an HTTP request and returns the response.
 // GET/HEAD requests use retry logic for rate limiting by default.
 func (c *Client) do(ctx context.Context, method, path string, body io.Reader) ([]byte, error) {
@@ -105,6 +75,10 @@ func (c *Client) doOnce(ctx context.Context, method, path string, body io.Reader
 		return nil, err
 	}
 
+	if err := c.rateLimiter().wait(ctx, c.keyID); err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+
 	if debugSettings.verboseHTTP {
 		debugLogger.Info("→ HTTP Request",
 			"method", method,
@@ -114,7 +88,7 @@ func (c *Client) doOnce(ctx context.Context, method, path string, body io.Reader
 		)
 	}
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.send(req)
 	elapsed := time.Since(start)
 
 	if err != nil {
@@ -128,13 +102,19 @@ func (c *Client) doOnce(ctx context.Context, method, path string, body io.Reader
 	}
 	defer resp.Body.Close()
 
+	budget, hasBudget := c.rateLimiter().observe(c.keyID, resp.Header.Get(rateLimitHeader))
+
 	if debugSettings.verboseHTTP {
-		debugLogger.Info("← HTTP Response",
+		attrs := []any{
 			"status", resp.StatusCode,
 			"elapsed", elapsed.String(),
 			"content-type", resp.Header.Get("Content-Type"),
 			"content-length", resp.Header.Get("Content-Length"),
-		)
+		}
+		if hasBudget {
+			attrs = append(attrs, "rate-limit-remaining", fmt.Sprintf("%d/%d", budget.Remaining, budget.Limit))
+		}
+		debugLogger.Info("← HTTP Response", attrs...)
 	}
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
@@ -258,8 +238,8 @@ func parseRetryAfterHeader(value string) time.Duration {
 }
 
 // validateNextURL validates that a pagination URL is safe to use.
-// It ensures the URL is on the same host as BaseURL and uses HTTPS.
-func validateNextURL(nextURL string) error {
+// It ensures the URL is on the same host and scheme as the client's base URL.
+func (c *Client) validateNextURL(nextURL string) error {
 	if nextURL == "" {
 		return nil
 	}
@@ -275,18 +255,18 @@ func validateNextURL(nextURL string) error {
 		return fmt.Errorf("invalid pagination URL: %w", err)
 	}
 
-	baseURL, err := url.Parse(BaseURL)
+	baseURL, err := url.Parse(c.resolveBaseURL())
 	if err != nil {
 		return fmt.Errorf("invalid base URL: %w", err)
 	}
 
-	// Allow URLs on the same host as BaseURL
+	// Allow URLs on the same host as the base URL
 	if parsedURL.Host != baseURL.Host {
 		return fmt.Errorf("rejected pagination URL from untrusted host %q (expected %q)", parsedURL.Host, baseURL.Host)
 	}
 
-	// Require HTTPS for authentication endpoints
-	if parsedURL.Scheme != "https" {
+	// Require HTTPS for authentication endpoints (http only for a loopback base URL)
+	if parsedURL.Scheme != "https" && !(parsedURL.Scheme == baseURL.Scheme && IsLoopbackHost(baseURL.Hostname())) {
 		return fmt.Errorf("rejected pagination URL with insecure scheme %q (expected https)", parsedURL.Scheme)
 	}
 
@@ -434,7 +414,7 @@ func (c *Client) doStream(ctx context.Context, method, path string, body io.Read
 		req.Header.Set("Accept", accept)
 	}
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.send(req)
 	if err != nil {
 		return nil, fmt.Errorf("request failed: %w", err)
 	}
@@ -458,7 +438,7 @@ func (c *Client) doStreamNoAuth(ctx context.Context, method, rawURL, accept stri
 		req.Header.Set("Accept", accept)
 	}
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.send(req)
 	if err != nil {
 		return nil, fmt.Errorf("request failed: %w", err)
 	}
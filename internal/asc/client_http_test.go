@@ -1622,7 +1622,7 @@ func TestCreateBetaGroup_SendsRequest(t *testing.T) {
 		assertAuthorized(t, req)
 	}, response)
 
-	if _, err := client.CreateBetaGroup(context.Background(), "app-1", "Beta"); err != nil {
+	if _, err := client.CreateBetaGroup(context.Background(), "app-1", "Beta", false); err != nil {
 		t.Fatalf("CreateBetaGroup() error: %v", err)
 	}
 }
@@ -3727,6 +3727,44 @@ func TestDeleteAppScreenshot(t *testing.T) {
 	}
 }
 
+func TestReplaceAppScreenshotsForSet(t *testing.T) {
+	response := jsonResponse(http.StatusNoContent, "")
+	client := newTestClient(t, func(req *http.Request) {
+		if req.Method != http.MethodPatch {
+			t.Fatalf("expected PATCH, got %s", req.Method)
+		}
+		if req.URL.Path != "/v1/appScreenshotSets/SET_123/relationships/appScreenshots" {
+			t.Fatalf("unexpected path %s", req.URL.Path)
+		}
+		var payload RelationshipRequest
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		if len(payload.Data) != 2 || payload.Data[0].ID != "B" || payload.Data[1].ID != "A" || payload.Data[0].Type != ResourceTypeAppScreenshots {
+			t.Fatalf("unexpected payload: %+v", payload)
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	if err := client.ReplaceAppScreenshotsForSet(context.Background(), "SET_123", []string{"B", "A"}); err != nil {
+		t.Fatalf("ReplaceAppScreenshotsForSet() error: %v", err)
+	}
+}
+
+func TestReplaceAppPreviewsForSet(t *testing.T) {
+	response := jsonResponse(http.StatusNoContent, "")
+	client := newTestClient(t, func(req *http.Request) {
+		if req.Method != http.MethodPatch || req.URL.Path != "/v1/appPreviewSets/SET_123/relationships/appPreviews" {
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	if err := client.ReplaceAppPreviewsForSet(context.Background(), "SET_123", []string{"A"}); err != nil {
+		t.Fatalf("ReplaceAppPreviewsForSet() error: %v", err)
+	}
+}
+
 func TestGetAppPreviewSets(t *testing.T) {
 	response := jsonResponse(http.StatusOK, `{"data":[{"type":"appPreviewSets","id":"SET_123","attributes":{"previewType":"IPHONE_65"}}]}`)
 	client := newTestClient(t, func(req *http.Request) {
@@ -17,7 +17,7 @@ func (c *Client) GetInAppPurchasesV2(ctx context.Context, appID string, opts ...
 
 	path := fmt.Sprintf("/v1/apps/%s/inAppPurchasesV2", strings.TrimSpace(appID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("in-app-purchases: %w", err)
 		}
 		path = query.nextURL
@@ -47,7 +47,7 @@ func (c *Client) GetInAppPurchases(ctx context.Context, appID string, opts ...IA
 
 	path := fmt.Sprintf("/v1/apps/%s/inAppPurchases", strings.TrimSpace(appID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("in-app-purchases: %w", err)
 		}
 		path = query.nextURL
@@ -180,7 +180,7 @@ func (c *Client) GetInAppPurchaseLocalizations(ctx context.Context, iapID string
 
 	path := fmt.Sprintf("/v2/inAppPurchases/%s/inAppPurchaseLocalizations", strings.TrimSpace(iapID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("in-app-purchase-localizations: %w", err)
 		}
 		path = query.nextURL
@@ -121,7 +121,7 @@ func (c *Client) GetInAppPurchaseImages(ctx context.Context, iapID string, opts
 
 	path := fmt.Sprintf("/v2/inAppPurchases/%s/images", iapID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("in-app-purchase-images: %w", err)
 		}
 		path = query.nextURL
@@ -451,7 +451,7 @@ func (c *Client) GetInAppPurchaseAvailabilityAvailableTerritories(ctx context.Co
 
 	path := fmt.Sprintf("/v1/inAppPurchaseAvailabilities/%s/availableTerritories", availabilityID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("in-app-purchase-availability-territories: %w", err)
 		}
 		path = query.nextURL
@@ -584,7 +584,7 @@ func (c *Client) GetInAppPurchasePricePoints(ctx context.Context, iapID string,
 
 	path := fmt.Sprintf("/v2/inAppPurchases/%s/pricePoints", iapID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("in-app-purchase-price-points: %w", err)
 		}
 		path = query.nextURL
@@ -740,7 +740,7 @@ func (c *Client) GetInAppPurchasePriceScheduleManualPrices(ctx context.Context,
 
 	path := fmt.Sprintf("/v1/inAppPurchasePriceSchedules/%s/manualPrices", scheduleID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("in-app-purchase-manual-prices: %w", err)
 		}
 		path = query.nextURL
@@ -775,7 +775,7 @@ func (c *Client) GetInAppPurchasePriceScheduleAutomaticPrices(ctx context.Contex
 
 	path := fmt.Sprintf("/v1/inAppPurchasePriceSchedules/%s/automaticPrices", scheduleID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("in-app-purchase-automatic-prices: %w", err)
 		}
 		path = query.nextURL
@@ -900,7 +900,7 @@ func (c *Client) GetInAppPurchaseOfferCodes(ctx context.Context, iapID string, o
 
 	path := fmt.Sprintf("/v2/inAppPurchases/%s/offerCodes", iapID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("in-app-purchase-offer-codes: %w", err)
 		}
 		path = query.nextURL
@@ -935,7 +935,7 @@ func (c *Client) GetInAppPurchaseOfferCodeCustomCodes(ctx context.Context, offer
 
 	path := fmt.Sprintf("/v1/inAppPurchaseOfferCodes/%s/customCodes", offerCodeID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("in-app-purchase-offer-code-custom-codes: %w", err)
 		}
 		path = query.nextURL
@@ -991,7 +991,7 @@ func (c *Client) GetInAppPurchaseOfferCodeOneTimeUseCodes(ctx context.Context, o
 
 	path := fmt.Sprintf("/v1/inAppPurchaseOfferCodes/%s/oneTimeUseCodes", offerCodeID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("in-app-purchase-offer-code-one-time-use-codes: %w", err)
 		}
 		path = query.nextURL
@@ -1047,7 +1047,7 @@ func (c *Client) GetInAppPurchaseOfferCodePrices(ctx context.Context, offerCodeI
 
 	path := fmt.Sprintf("/v1/inAppPurchaseOfferCodes/%s/prices", offerCodeID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("in-app-purchase-offer-code-prices: %w", err)
 		}
 		path = query.nextURL
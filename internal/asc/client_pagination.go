@@ -62,6 +62,9 @@ func PaginateAll(ctx context.Context, firstPage PaginatedResponse, fetchNext Pag
 		// Fetch next page
 		nextPage, err := fetchNext(ctx, links.Next)
 		if err != nil {
+			if ctx.Err() != nil {
+				err = &PartialResultError{Result: result, Err: err}
+			}
 			return result, fmt.Errorf("page %d: %w", page, err)
 		}
 
@@ -313,6 +313,17 @@ func TestPaginateAll_ContextCancelled(t *testing.T) {
 	if err == nil {
 		t.Fatal("expected error for cancelled context, got nil")
 	}
+	var partial *PartialResultError
+	if !errors.As(err, &partial) {
+		t.Fatalf("expected PartialResultError, got %v", err)
+	}
+	apps, ok := partial.Result.(*AppsResponse)
+	if !ok || len(apps.Data) != 1 || apps.Data[0].ID != "app-1" {
+		t.Fatalf("expected the first page as partial result, got %#v", partial.Result)
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled in chain, got %v", err)
+	}
 }
 
 func TestPaginateAll_LinkagesResponse(t *testing.T) {
@@ -42,7 +42,7 @@ func (c *Client) GetPreReleaseVersionBuilds(ctx context.Context, versionID strin
 
 	path := fmt.Sprintf("/v1/preReleaseVersions/%s/builds", versionID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("preReleaseVersionBuilds: %w", err)
 		}
 		path = query.nextURL
@@ -48,7 +48,7 @@ func (c *Client) GetPreReleaseVersionBuildsRelationships(ctx context.Context, ve
 
 	path := fmt.Sprintf("/v1/preReleaseVersions/%s/relationships/builds", versionID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("preReleaseVersionBuilds: %w", err)
 		}
 		path = query.nextURL
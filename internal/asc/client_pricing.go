@@ -20,7 +20,7 @@ func (c *Client) GetTerritories(ctx context.Context, opts ...TerritoriesOption)
 	path := "/v1/territories"
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("territories: %w", err)
 		}
 		path = query.nextURL
@@ -52,7 +52,7 @@ func (c *Client) GetAppPricePoints(ctx context.Context, appID string, opts ...Pr
 	path := fmt.Sprintf("/v1/apps/%s/appPricePoints", appID)
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appPricePoints: %w", err)
 		}
 		path = query.nextURL
@@ -337,7 +337,7 @@ func (c *Client) GetTerritoryAvailabilities(ctx context.Context, availabilityID
 	path := fmt.Sprintf("/v2/appAvailabilities/%s/territoryAvailabilities", availabilityID)
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("territoryAvailabilities: %w", err)
 		}
 		path = query.nextURL
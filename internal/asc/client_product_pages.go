@@ -195,7 +195,7 @@ func (c *Client) GetAppCustomProductPages(ctx context.Context, appID string, opt
 	}
 	path := fmt.Sprintf("/v1/apps/%s/appCustomProductPages", appID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appCustomProductPages: %w", err)
 		}
 		path = query.nextURL
@@ -337,7 +337,7 @@ func (c *Client) GetAppCustomProductPageVersions(ctx context.Context, pageID str
 	}
 	path := fmt.Sprintf("/v1/appCustomProductPages/%s/appCustomProductPageVersions", pageID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appCustomProductPageVersions: %w", err)
 		}
 		path = query.nextURL
@@ -466,7 +466,7 @@ func (c *Client) GetAppCustomProductPageLocalizations(ctx context.Context, versi
 	}
 	path := fmt.Sprintf("/v1/appCustomProductPageVersions/%s/appCustomProductPageLocalizations", versionID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appCustomProductPageLocalizations: %w", err)
 		}
 		path = query.nextURL
@@ -691,7 +691,7 @@ func (c *Client) GetAppCustomProductPageLocalizationPreviewSets(ctx context.Cont
 	}
 	path := fmt.Sprintf("/v1/appCustomProductPageLocalizations/%s/appPreviewSets", localizationID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appPreviewSets: %w", err)
 		}
 		path = query.nextURL
@@ -724,7 +724,7 @@ func (c *Client) GetAppCustomProductPageLocalizationScreenshotSets(ctx context.C
 	}
 	path := fmt.Sprintf("/v1/appCustomProductPageLocalizations/%s/appScreenshotSets", localizationID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appScreenshotSets: %w", err)
 		}
 		path = query.nextURL
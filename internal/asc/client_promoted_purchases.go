@@ -18,7 +18,7 @@ func (c *Client) GetAppPromotedPurchases(ctx context.Context, appID string, opts
 	appID = strings.TrimSpace(appID)
 	path := fmt.Sprintf("/v1/apps/%s/promotedPurchases", appID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("promotedPurchases: %w", err)
 		}
 		path = query.nextURL
@@ -49,7 +49,7 @@ func (c *Client) GetAppPromotedPurchasesRelationships(ctx context.Context, appID
 	appID = strings.TrimSpace(appID)
 	path := fmt.Sprintf("/v1/apps/%s/relationships/promotedPurchases", appID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("promotedPurchasesRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -0,0 +1,102 @@
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// RawResponse is the untyped body of a request made with Client.Raw.
+type RawResponse struct {
+	Body json.RawMessage
+}
+
+// MarshalJSON returns the response body as-is. Non-JSON bodies are encoded
+// as a JSON string so output stays valid JSON.
+func (r *RawResponse) MarshalJSON() ([]byte, error) {
+	if r == nil || len(r.Body) == 0 {
+		return []byte("null"), nil
+	}
+	if !json.Valid(r.Body) {
+		return json.Marshal(string(r.Body))
+	}
+	return r.Body, nil
+}
+
+// Raw performs an arbitrary API request using the client's authentication,
+// retry and debug logging. path may be relative to the base URL or absolute.
+func (c *Client) Raw(ctx context.Context, method, path string, body io.Reader) (*RawResponse, error) {
+	data, err := c.do(ctx, strings.ToUpper(method), path, body)
+	if err != nil {
+		return nil, err
+	}
+	return &RawResponse{Body: data}, nil
+}
+
+// NextURL returns links.next from the response, if any.
+func (r *RawResponse) NextURL() string {
+	if r == nil {
+		return ""
+	}
+	var doc struct {
+		Links Links `json:"links"`
+	}
+	if err := json.Unmarshal(r.Body, &doc); err != nil {
+		return ""
+	}
+	return doc.Links.Next
+}
+
+// AppendPage merges the data and included arrays of page into r. Meta is taken
+// from page and links are cleared, matching typed --paginate output.
+func (r *RawResponse) AppendPage(page *RawResponse) error {
+	if page == nil || len(page.Body) == 0 {
+		return nil
+	}
+	var current, next map[string]json.RawMessage
+	if err := json.Unmarshal(r.Body, &current); err != nil {
+		return fmt.Errorf("parse response: %w", err)
+	}
+	if err := json.Unmarshal(page.Body, &next); err != nil {
+		return fmt.Errorf("parse page: %w", err)
+	}
+
+	for _, key := range []string{"data", "included"} {
+		merged, err := appendRawArrays(current[key], next[key])
+		if err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+		if merged != nil {
+			current[key] = merged
+		}
+	}
+	delete(current, "links")
+	if meta, ok := next["meta"]; ok {
+		current["meta"] = meta
+	}
+
+	body, err := json.Marshal(current)
+	if err != nil {
+		return err
+	}
+	r.Body = body
+	return nil
+}
+
+func appendRawArrays(left, right json.RawMessage) (json.RawMessage, error) {
+	if len(right) == 0 {
+		return nil, nil
+	}
+	var items, more []json.RawMessage
+	if len(left) > 0 {
+		if err := json.Unmarshal(left, &items); err != nil {
+			return nil, fmt.Errorf("cannot paginate a non-array response")
+		}
+	}
+	if err := json.Unmarshal(right, &more); err != nil {
+		return nil, fmt.Errorf("cannot paginate a non-array response")
+	}
+	return json.Marshal(append(items, more...))
+}
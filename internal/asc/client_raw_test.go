@@ -0,0 +1,54 @@
+package asc
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestRawResponse_MarshalNonJSONBody(t *testing.T) {
+	data, err := json.Marshal(&RawResponse{Body: []byte("plain text")})
+	if err != nil {
+		t.Fatalf("Marshal() error: %v", err)
+	}
+	if string(data) != `"plain text"` {
+		t.Fatalf("expected JSON string, got %s", data)
+	}
+}
+
+func TestRawResponse_AppendPage(t *testing.T) {
+	first := &RawResponse{Body: []byte(`{"data":[{"id":"1"}],"included":[{"id":"a"}],"links":{"next":"/v1/apps?cursor=2"}}`)}
+	second := &RawResponse{Body: []byte(`{"data":[{"id":"2"}],"links":{"self":"/v1/apps?cursor=2"}}`)}
+
+	if err := first.AppendPage(second); err != nil {
+		t.Fatalf("AppendPage() error: %v", err)
+	}
+	if first.NextURL() != "" {
+		t.Fatalf("expected links to be cleared, got next %q", first.NextURL())
+	}
+	body := string(first.Body)
+	if strings.Contains(body, `"links"`) {
+		t.Fatalf("expected links to be removed, got %s", body)
+	}
+	if !strings.Contains(body, `"data":[{"id":"1"},{"id":"2"}]`) || !strings.Contains(body, `"included":[{"id":"a"}]`) {
+		t.Fatalf("unexpected merged body: %s", body)
+	}
+
+	single := &RawResponse{Body: []byte(`{"data":{"id":"1"}}`)}
+	if err := single.AppendPage(second); err == nil {
+		t.Fatal("expected error merging a non-array data page")
+	}
+}
+
+func TestRawResponseRows_NonResourceDocument(t *testing.T) {
+	headers, rows, err := rawResponseRows(&RawResponse{Body: []byte(`{"status":"ok","count":2}`)})
+	if err != nil {
+		t.Fatalf("rawResponseRows() error: %v", err)
+	}
+	if len(headers) != 2 || headers[0] != "Field" {
+		t.Fatalf("unexpected headers: %v", headers)
+	}
+	if len(rows) != 2 || rows[0][0] != "count" || rows[0][1] != "2" || rows[1][1] != "ok" {
+		t.Fatalf("unexpected rows: %v", rows)
+	}
+}
@@ -17,7 +17,7 @@ func (c *Client) GetBundleIDs(ctx context.Context, opts ...BundleIDsOption) (*Bu
 	path := "/v1/bundleIds"
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("bundleIds: %w", err)
 		}
 		path = query.nextURL
@@ -130,7 +130,7 @@ func (c *Client) GetBundleIDCapabilities(ctx context.Context, bundleID string, o
 	path := fmt.Sprintf("/v1/bundleIds/%s/bundleIdCapabilities", bundleID)
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("bundleIdCapabilities: %w", err)
 		}
 		path = query.nextURL
@@ -205,7 +205,7 @@ func (c *Client) GetCertificates(ctx context.Context, opts ...CertificatesOption
 	path := "/v1/certificates"
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("certificates: %w", err)
 		}
 		path = query.nextURL
@@ -355,7 +355,7 @@ func (c *Client) GetProfiles(ctx context.Context, opts ...ProfilesOption) (*Prof
 	path := "/v1/profiles"
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("profiles: %w", err)
 		}
 		path = query.nextURL
@@ -429,7 +429,7 @@ func (c *Client) GetProfileCertificates(ctx context.Context, profileID string, o
 	path := fmt.Sprintf("/v1/profiles/%s/certificates", profileID)
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("profileCertificates: %w", err)
 		}
 		path = query.nextURL
@@ -461,7 +461,7 @@ func (c *Client) GetProfileDevices(ctx context.Context, profileID string, opts .
 	path := fmt.Sprintf("/v1/profiles/%s/devices", profileID)
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("profileDevices: %w", err)
 		}
 		path = query.nextURL
@@ -510,7 +510,7 @@ func (c *Client) GetProfileCertificatesRelationships(ctx context.Context, profil
 	path := fmt.Sprintf("/v1/profiles/%s/relationships/certificates", profileID)
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("profileCertificatesRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -542,7 +542,7 @@ func (c *Client) GetProfileDevicesRelationships(ctx context.Context, profileID s
 	path := fmt.Sprintf("/v1/profiles/%s/relationships/devices", profileID)
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("profileDevicesRelationships: %w", err)
 		}
 		path = query.nextURL
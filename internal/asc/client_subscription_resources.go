@@ -17,7 +17,7 @@ func (c *Client) GetSubscriptionLocalizations(ctx context.Context, subscriptionI
 
 	path := fmt.Sprintf("/v1/subscriptions/%s/subscriptionLocalizations", strings.TrimSpace(subscriptionID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("subscriptionLocalizations: %w", err)
 		}
 		path = query.nextURL
@@ -136,7 +136,7 @@ func (c *Client) GetSubscriptionImages(ctx context.Context, subscriptionID strin
 
 	path := fmt.Sprintf("/v1/subscriptions/%s/images", strings.TrimSpace(subscriptionID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("subscriptionImages: %w", err)
 		}
 		path = query.nextURL
@@ -261,7 +261,7 @@ func (c *Client) GetSubscriptionIntroductoryOffers(ctx context.Context, subscrip
 
 	path := fmt.Sprintf("/v1/subscriptions/%s/introductoryOffers", strings.TrimSpace(subscriptionID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("subscriptionIntroductoryOffers: %w", err)
 		}
 		path = query.nextURL
@@ -397,7 +397,7 @@ func (c *Client) GetSubscriptionPromotionalOffers(ctx context.Context, subscript
 
 	path := fmt.Sprintf("/v1/subscriptions/%s/promotionalOffers", strings.TrimSpace(subscriptionID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("subscriptionPromotionalOffers: %w", err)
 		}
 		path = query.nextURL
@@ -543,7 +543,7 @@ func (c *Client) GetSubscriptionPromotionalOfferPrices(ctx context.Context, offe
 
 	path := fmt.Sprintf("/v1/subscriptionPromotionalOffers/%s/prices", strings.TrimSpace(offerID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("subscriptionPromotionalOfferPrices: %w", err)
 		}
 		path = query.nextURL
@@ -572,7 +572,7 @@ func (c *Client) GetSubscriptionOfferCodes(ctx context.Context, subscriptionID s
 
 	path := fmt.Sprintf("/v1/subscriptions/%s/offerCodes", strings.TrimSpace(subscriptionID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("subscriptionOfferCodes: %w", err)
 		}
 		path = query.nextURL
@@ -724,7 +724,7 @@ func (c *Client) GetSubscriptionOfferCodeCustomCodes(ctx context.Context, offerC
 
 	path := fmt.Sprintf("/v1/subscriptionOfferCodes/%s/customCodes", strings.TrimSpace(offerCodeID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("subscriptionOfferCodeCustomCodes: %w", err)
 		}
 		path = query.nextURL
@@ -753,7 +753,7 @@ func (c *Client) GetSubscriptionOfferCodePrices(ctx context.Context, offerCodeID
 
 	path := fmt.Sprintf("/v1/subscriptionOfferCodes/%s/prices", strings.TrimSpace(offerCodeID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("subscriptionOfferCodePrices: %w", err)
 		}
 		path = query.nextURL
@@ -782,7 +782,7 @@ func (c *Client) GetSubscriptionPrices(ctx context.Context, subscriptionID strin
 
 	path := fmt.Sprintf("/v1/subscriptions/%s/prices", strings.TrimSpace(subscriptionID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("subscriptionPrices: %w", err)
 		}
 		path = query.nextURL
@@ -811,7 +811,7 @@ func (c *Client) GetSubscriptionPricePoints(ctx context.Context, subscriptionID
 
 	path := fmt.Sprintf("/v1/subscriptions/%s/pricePoints", strings.TrimSpace(subscriptionID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("subscriptionPricePoints: %w", err)
 		}
 		path = query.nextURL
@@ -1042,7 +1042,7 @@ func (c *Client) GetSubscriptionGroupLocalizations(ctx context.Context, groupID
 
 	path := fmt.Sprintf("/v1/subscriptionGroups/%s/subscriptionGroupLocalizations", strings.TrimSpace(groupID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("subscriptionGroupLocalizations: %w", err)
 		}
 		path = query.nextURL
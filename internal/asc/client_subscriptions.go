@@ -17,7 +17,7 @@ func (c *Client) GetSubscriptionGroups(ctx context.Context, appID string, opts .
 
 	path := fmt.Sprintf("/v1/apps/%s/subscriptionGroups", strings.TrimSpace(appID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("subscriptionGroups: %w", err)
 		}
 		path = query.nextURL
@@ -134,7 +134,7 @@ func (c *Client) GetSubscriptions(ctx context.Context, groupID string, opts ...S
 
 	path := fmt.Sprintf("/v1/subscriptionGroups/%s/subscriptions", strings.TrimSpace(groupID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("subscriptions: %w", err)
 		}
 		path = query.nextURL
@@ -421,7 +421,7 @@ func (c *Client) GetSubscriptionAvailabilityAvailableTerritories(ctx context.Con
 
 	path := fmt.Sprintf("/v1/subscriptionAvailabilities/%s/availableTerritories", availabilityID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("subscription-availability-territories: %w", err)
 		}
 		path = query.nextURL
@@ -45,7 +45,7 @@ func (c *Client) getBetaGroupLinkages(ctx context.Context, groupID, relationship
 
 	path := fmt.Sprintf("/v1/betaGroups/%s/relationships/%s", groupID, relationship)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("betaGroupRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -79,7 +79,7 @@ func (c *Client) getBetaTesterLinkages(ctx context.Context, testerID, relationsh
 
 	path := fmt.Sprintf("/v1/betaTesters/%s/relationships/%s", testerID, relationship)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("betaTesterRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -16,7 +16,7 @@ func (c *Client) GetBetaAppReviewDetails(ctx context.Context, appID string, opts
 
 	path := "/v1/betaAppReviewDetails"
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("betaAppReviewDetails: %w", err)
 		}
 		path = query.nextURL
@@ -121,7 +121,7 @@ func (c *Client) GetBetaAppReviewSubmissions(ctx context.Context, opts ...BetaAp
 
 	path := "/v1/betaAppReviewSubmissions"
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("betaAppReviewSubmissions: %w", err)
 		}
 		path = query.nextURL
@@ -232,7 +232,7 @@ func (c *Client) GetBuildBetaDetails(ctx context.Context, opts ...BuildBetaDetai
 
 	path := "/v1/buildBetaDetails"
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("buildBetaDetails: %w", err)
 		}
 		path = query.nextURL
@@ -337,7 +337,7 @@ func (c *Client) GetBetaRecruitmentCriterionOptions(ctx context.Context, opts ..
 
 	path := "/v1/betaRecruitmentCriterionOptions"
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("betaRecruitmentCriterionOptions: %w", err)
 		}
 		path = query.nextURL
@@ -0,0 +1,157 @@
+package asc
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// tokenRefreshMargin is how long before expiry a cached token is replaced,
+// so requests never go out with a token that expires in flight.
+const tokenRefreshMargin = time.Minute
+
+// tokenCache holds the most recently signed JWT for a client.
+type tokenCache struct {
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+}
+
+// ascClaims are the JWT claims for App Store Connect API authentication.
+type ascClaims struct {
+	jwt.RegisteredClaims
+	Scope []string `json:"scope,omitempty"`
+}
+
+// individualKeySubject is the "sub" claim required for Individual API keys.
+const individualKeySubject = "user"
+
+// replayToken stands in for a signed JWT on clients that only replay
+// recorded traffic.
+const replayToken = "replay"
+
+// GenerateJWT generates a JWT for ASC API authentication.
+// An empty issuerID signs an Individual API key token (sub "user", no issuer).
+func GenerateJWT(keyID, issuerID string, privateKey *ecdsa.PrivateKey) (string, error) {
+	return GenerateScopedJWT(keyID, issuerID, privateKey, nil)
+}
+
+// GenerateScopedJWT generates a JWT limited to the given scope entries
+// (for example "GET /v1/apps"). A nil or empty scope grants full key access.
+func GenerateScopedJWT(keyID, issuerID string, privateKey *ecdsa.PrivateKey, scope []string) (string, error) {
+	token, _, err := signJWT(keyID, issuerID, privateKey, scope, time.Now())
+	return token, err
+}
+
+// ResolveTokenScope returns the scope entries from ASC_TOKEN_SCOPE, separated
+// by semicolons because query strings in an entry may contain commas. Clients
+// sign every request token with this scope.
+func ResolveTokenScope() ([]string, error) {
+	value, ok := envValue("ASC_TOKEN_SCOPE")
+	if !ok || strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+	var scope []string
+	for _, entry := range strings.Split(value, ";") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			scope = append(scope, entry)
+		}
+	}
+	if err := ValidateTokenScope(scope); err != nil {
+		return nil, fmt.Errorf("ASC_TOKEN_SCOPE: %w", err)
+	}
+	return scope, nil
+}
+
+// ValidateTokenScope checks that each scope entry is "METHOD /path".
+func ValidateTokenScope(scope []string) error {
+	for _, entry := range scope {
+		method, path, ok := strings.Cut(strings.TrimSpace(entry), " ")
+		if !ok {
+			return fmt.Errorf("invalid scope %q: expected \"METHOD /path\"", entry)
+		}
+		switch method {
+		case "GET", "POST", "PATCH", "PUT", "DELETE":
+		default:
+			return fmt.Errorf("invalid scope %q: unsupported method %q", entry, method)
+		}
+		if !strings.HasPrefix(strings.TrimSpace(path), "/") {
+			return fmt.Errorf("invalid scope %q: path must start with /", entry)
+		}
+	}
+	return nil
+}
+
+func signJWT(keyID, issuerID string, privateKey *ecdsa.PrivateKey, scope []string, now time.Time) (string, time.Time, error) {
+	if privateKey == nil {
+		return "", time.Time{}, fmt.Errorf("a private key is required to sign tokens")
+	}
+	expiresAt := now.Add(tokenLifetime)
+	claims := ascClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    issuerID,
+			Audience:  jwt.ClaimStrings{"appstoreconnect-v1"},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+		Scope: scope,
+	}
+	if strings.TrimSpace(issuerID) == "" {
+		claims.Issuer = ""
+		claims.Subject = individualKeySubject
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
+	token.Header["kid"] = keyID
+
+	// Sign with the private key
+	signedToken, err := token.SignedString(privateKey)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to sign token: %w", err)
+	}
+
+	return signedToken, expiresAt, nil
+}
+
+// generateJWT returns a cached JWT for ASC API authentication, signing a new
+// one when none is cached or the cached token is about to expire.
+func (c *Client) generateJWT() (string, error) {
+	c.tokens.mu.Lock()
+	defer c.tokens.mu.Unlock()
+
+	if c.replayWithoutKey() {
+		return replayToken, nil
+	}
+
+	now := time.Now()
+	if c.tokens.token != "" && now.Add(tokenRefreshMargin).Before(c.tokens.expiresAt) {
+		return c.tokens.token, nil
+	}
+
+	token, expiresAt, err := signJWT(c.keyID, c.issuerID, c.privateKey, c.scope, now)
+	if err != nil {
+		return "", err
+	}
+	c.tokens.token = token
+	c.tokens.expiresAt = expiresAt
+	return token, nil
+}
+
+// replayWithoutKey reports whether the client only replays recorded traffic
+// and has no key to sign with; its tokens are never sent anywhere.
+func (c *Client) replayWithoutKey() bool {
+	return c.privateKey == nil && c.cassette != nil && c.cassette.mode == CassetteReplay
+}
+
+// ScopedToken signs a fresh JWT with the client's key, limited to scope.
+// The token is not cached and is intended for handing to other tools.
+func (c *Client) ScopedToken(scope []string) (string, time.Time, error) {
+	if err := ValidateTokenScope(scope); err != nil {
+		return "", time.Time{}, err
+	}
+	return signJWT(c.keyID, c.issuerID, c.privateKey, scope, time.Now())
+}
@@ -0,0 +1,186 @@
+package asc
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func newTokenTestClient(t *testing.T) *Client {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey() error: %v", err)
+	}
+	return &Client{keyID: "KEY123", issuerID: "ISS456", privateKey: key}
+}
+
+func TestClientGenerateJWT_ReusesCachedToken(t *testing.T) {
+	client := newTokenTestClient(t)
+
+	first, err := client.generateJWT()
+	if err != nil {
+		t.Fatalf("generateJWT() error: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	tokens := make([]string, 8)
+	for i := range tokens {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			tokens[i], _ = client.generateJWT()
+		}(i)
+	}
+	wg.Wait()
+
+	for i, token := range tokens {
+		if token != first {
+			t.Fatalf("token %d: expected cached token to be reused", i)
+		}
+	}
+}
+
+func TestClientGenerateJWT_RefreshesBeforeExpiry(t *testing.T) {
+	client := newTokenTestClient(t)
+
+	first, err := client.generateJWT()
+	if err != nil {
+		t.Fatalf("generateJWT() error: %v", err)
+	}
+	client.tokens.expiresAt = time.Now().Add(tokenRefreshMargin / 2)
+
+	second, err := client.generateJWT()
+	if err != nil {
+		t.Fatalf("generateJWT() error: %v", err)
+	}
+	if second == first {
+		t.Fatal("expected a new token when the cached one is about to expire")
+	}
+	if !client.tokens.expiresAt.After(time.Now().Add(tokenLifetime - time.Minute)) {
+		t.Fatalf("expected refreshed expiry, got %v", client.tokens.expiresAt)
+	}
+}
+
+func TestClientScopedToken_IncludesScopeClaim(t *testing.T) {
+	client := newTokenTestClient(t)
+	scope := []string{"GET /v1/apps", "GET /v1/builds?filter[app]=123"}
+
+	token, expiresAt, err := client.ScopedToken(scope)
+	if err != nil {
+		t.Fatalf("ScopedToken() error: %v", err)
+	}
+	if time.Until(expiresAt) <= 0 {
+		t.Fatalf("expected future expiry, got %v", expiresAt)
+	}
+
+	claims := &ascClaims{}
+	parsed, err := jwt.ParseWithClaims(token, claims, func(*jwt.Token) (interface{}, error) {
+		return &client.privateKey.PublicKey, nil
+	})
+	if err != nil || !parsed.Valid {
+		t.Fatalf("ParseWithClaims() error: %v", err)
+	}
+	if len(claims.Scope) != 2 || claims.Scope[0] != scope[0] || claims.Scope[1] != scope[1] {
+		t.Fatalf("unexpected scope claim: %v", claims.Scope)
+	}
+	if claims.Issuer != "ISS456" {
+		t.Fatalf("expected issuer ISS456, got %q", claims.Issuer)
+	}
+	if client.tokens.token != "" {
+		t.Fatal("expected scoped token not to be cached")
+	}
+}
+
+func TestClientGenerateJWT_UsesClientScope(t *testing.T) {
+	client := newTokenTestClient(t)
+	client.scope = []string{"GET /v1/apps"}
+
+	token, err := client.generateJWT()
+	if err != nil {
+		t.Fatalf("generateJWT() error: %v", err)
+	}
+	claims := &ascClaims{}
+	if _, err := jwt.ParseWithClaims(token, claims, func(*jwt.Token) (interface{}, error) {
+		return &client.privateKey.PublicKey, nil
+	}); err != nil {
+		t.Fatalf("ParseWithClaims() error: %v", err)
+	}
+	if len(claims.Scope) != 1 || claims.Scope[0] != "GET /v1/apps" {
+		t.Fatalf("expected request token to carry the client scope, got %v", claims.Scope)
+	}
+}
+
+func TestResolveTokenScope(t *testing.T) {
+	t.Setenv("ASC_TOKEN_SCOPE", "GET /v1/apps; GET /v1/builds?filter[platform]=IOS,MAC_OS;")
+	scope, err := ResolveTokenScope()
+	if err != nil {
+		t.Fatalf("ResolveTokenScope() error: %v", err)
+	}
+	if len(scope) != 2 || scope[1] != "GET /v1/builds?filter[platform]=IOS,MAC_OS" {
+		t.Fatalf("unexpected scope: %v", scope)
+	}
+
+	t.Setenv("ASC_TOKEN_SCOPE", "")
+	if scope, err := ResolveTokenScope(); err != nil || scope != nil {
+		t.Fatalf("expected no scope, got %v (err %v)", scope, err)
+	}
+
+	t.Setenv("ASC_TOKEN_SCOPE", "/v1/apps")
+	if _, err := ResolveTokenScope(); err == nil {
+		t.Fatal("expected error for an entry without a method")
+	}
+}
+
+func TestGenerateJWT_OmitsScopeClaim(t *testing.T) {
+	client := newTokenTestClient(t)
+	token, err := GenerateJWT(client.keyID, client.issuerID, client.privateKey)
+	if err != nil {
+		t.Fatalf("GenerateJWT() error: %v", err)
+	}
+	claims := jwt.MapClaims{}
+	if _, err := jwt.ParseWithClaims(token, claims, func(*jwt.Token) (interface{}, error) {
+		return &client.privateKey.PublicKey, nil
+	}); err != nil {
+		t.Fatalf("ParseWithClaims() error: %v", err)
+	}
+	if _, ok := claims["scope"]; ok {
+		t.Fatalf("expected no scope claim, got %v", claims["scope"])
+	}
+}
+
+func TestValidateTokenScope(t *testing.T) {
+	if err := ValidateTokenScope([]string{"GET /v1/apps", "PATCH /v1/apps/1"}); err != nil {
+		t.Fatalf("ValidateTokenScope() error: %v", err)
+	}
+	for _, invalid := range []string{"/v1/apps", "FETCH /v1/apps", "GET v1/apps"} {
+		if err := ValidateTokenScope([]string{invalid}); err == nil {
+			t.Fatalf("expected error for %q", invalid)
+		}
+	}
+}
+
+func TestGenerateJWT_IndividualKeyUsesUserSubject(t *testing.T) {
+	client := newTokenTestClient(t)
+	token, err := GenerateJWT(client.keyID, "", client.privateKey)
+	if err != nil {
+		t.Fatalf("GenerateJWT() error: %v", err)
+	}
+	claims := jwt.MapClaims{}
+	if _, err := jwt.ParseWithClaims(token, claims, func(*jwt.Token) (interface{}, error) {
+		return &client.privateKey.PublicKey, nil
+	}); err != nil {
+		t.Fatalf("ParseWithClaims() error: %v", err)
+	}
+	if claims["sub"] != "user" {
+		t.Fatalf("expected sub user, got %v", claims["sub"])
+	}
+	if _, ok := claims["iss"]; ok {
+		t.Fatalf("expected no iss claim, got %v", claims["iss"])
+	}
+}
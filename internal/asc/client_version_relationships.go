@@ -120,7 +120,7 @@ func (c *Client) GetAppStoreVersionExperimentsRelationships(ctx context.Context,
 
 	path := fmt.Sprintf("/v1/appStoreVersions/%s/relationships/appStoreVersionExperiments", versionID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appStoreVersionExperimentsRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -155,7 +155,7 @@ func (c *Client) GetAppStoreVersionExperimentsV2Relationships(ctx context.Contex
 
 	path := fmt.Sprintf("/v1/appStoreVersions/%s/relationships/appStoreVersionExperimentsV2", versionID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appStoreVersionExperimentsV2Relationships: %w", err)
 		}
 		path = query.nextURL
@@ -211,7 +211,7 @@ func (c *Client) GetAppStoreVersionCustomerReviewsRelationships(ctx context.Cont
 
 	path := fmt.Sprintf("/v1/appStoreVersions/%s/relationships/customerReviews", versionID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("customerReviewsRelationships: %w", err)
 		}
 		path = query.nextURL
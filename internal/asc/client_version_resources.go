@@ -43,7 +43,7 @@ func (c *Client) GetAppStoreVersionExperimentsV2ForVersion(ctx context.Context,
 
 	path := fmt.Sprintf("/v1/appStoreVersions/%s/appStoreVersionExperimentsV2", versionID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appStoreVersionExperimentsV2: %w", err)
 		}
 		path = query.nextURL
@@ -78,7 +78,7 @@ func (c *Client) GetAppStoreVersionCustomerReviews(ctx context.Context, versionI
 
 	path := fmt.Sprintf("/v1/appStoreVersions/%s/customerReviews", versionID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("customerReviews: %w", err)
 		}
 		path = query.nextURL
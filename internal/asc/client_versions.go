@@ -14,6 +14,7 @@ type AppStoreVersionAttributes struct {
 	AppStoreState   string   `json:"appStoreState,omitempty"`
 	AppVersionState string   `json:"appVersionState,omitempty"`
 	CreatedDate     string   `json:"createdDate,omitempty"`
+	Copyright       string   `json:"copyright,omitempty"`
 }
 
 // AppStoreVersionCreateAttributes describes app store version create payload attributes.
@@ -148,7 +149,7 @@ func (c *Client) GetAppStoreVersions(ctx context.Context, appID string, opts ...
 	path := fmt.Sprintf("/v1/apps/%s/appStoreVersions", appID)
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appStoreVersions: %w", err)
 		}
 		path = query.nextURL
@@ -180,7 +181,7 @@ func (c *Client) GetPreReleaseVersions(ctx context.Context, appID string, opts .
 	path := "/v1/preReleaseVersions"
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("preReleaseVersions: %w", err)
 		}
 		path = query.nextURL
@@ -17,7 +17,7 @@ func (c *Client) GetSubscriptionWinBackOffers(ctx context.Context, subscriptionI
 
 	path := fmt.Sprintf("/v1/subscriptions/%s/winBackOffers", strings.TrimSpace(subscriptionID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("winBackOffers: %w", err)
 		}
 		path = query.nextURL
@@ -47,7 +47,7 @@ func (c *Client) GetSubscriptionWinBackOffersRelationships(ctx context.Context,
 
 	path := fmt.Sprintf("/v1/subscriptions/%s/relationships/winBackOffers", strings.TrimSpace(subscriptionID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("winBackOffers relationships: %w", err)
 		}
 		path = query.nextURL
@@ -149,7 +149,7 @@ func (c *Client) GetWinBackOfferPrices(ctx context.Context, offerID string, opts
 
 	path := fmt.Sprintf("/v1/winBackOffers/%s/prices", strings.TrimSpace(offerID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("winBackOfferPrices: %w", err)
 		}
 		path = query.nextURL
@@ -179,7 +179,7 @@ func (c *Client) GetWinBackOfferPricesRelationships(ctx context.Context, offerID
 
 	path := fmt.Sprintf("/v1/winBackOffers/%s/relationships/prices", strings.TrimSpace(offerID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("winBackOfferPrices relationships: %w", err)
 		}
 		path = query.nextURL
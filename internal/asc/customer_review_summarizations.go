@@ -47,7 +47,7 @@ func (c *Client) GetCustomerReviewSummarizations(ctx context.Context, appID stri
 
 	path := fmt.Sprintf("/v1/apps/%s/customerReviewSummarizations", appID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("customerReviewSummarizations: %w", err)
 		}
 		path = query.nextURL
@@ -20,12 +20,13 @@ const (
 type DeviceClass string
 
 const (
-	DeviceClassAppleWatch DeviceClass = "APPLE_WATCH"
-	DeviceClassIPad       DeviceClass = "IPAD"
-	DeviceClassIPhone     DeviceClass = "IPHONE"
-	DeviceClassIPod       DeviceClass = "IPOD"
-	DeviceClassAppleTV    DeviceClass = "APPLE_TV"
-	DeviceClassMac        DeviceClass = "MAC"
+	DeviceClassAppleWatch     DeviceClass = "APPLE_WATCH"
+	DeviceClassIPad           DeviceClass = "IPAD"
+	DeviceClassIPhone         DeviceClass = "IPHONE"
+	DeviceClassIPod           DeviceClass = "IPOD"
+	DeviceClassAppleTV        DeviceClass = "APPLE_TV"
+	DeviceClassAppleVisionPro DeviceClass = "APPLE_VISION_PRO"
+	DeviceClassMac            DeviceClass = "MAC"
 )
 
 // DeviceAttributes describes an App Store Connect device.
@@ -1,11 +1,107 @@
 package asc
 
+import "fmt"
+
 // DeviceLocalUDIDResult represents CLI output for local device UDID lookup.
 type DeviceLocalUDIDResult struct {
 	UDID     string `json:"udid"`
 	Platform string `json:"platform"`
 }
 
+// DeviceImportResult represents CLI output for bulk device registration.
+type DeviceImportResult struct {
+	File       string                `json:"file"`
+	DryRun     bool                  `json:"dryRun,omitempty"`
+	Registered int                   `json:"registered"`
+	Enabled    int                   `json:"enabled"`
+	Skipped    int                   `json:"skipped"`
+	Failed     int                   `json:"failed"`
+	Devices    []DeviceImportItem    `json:"devices"`
+	Capacity   []DeviceCapacity      `json:"capacity"`
+	Profiles   []DeviceImportProfile `json:"profiles,omitempty"`
+}
+
+// DeviceImportItem is the outcome for a device of an import file.
+type DeviceImportItem struct {
+	Line     int    `json:"line"`
+	Name     string `json:"name"`
+	UDID     string `json:"udid"`
+	Platform string `json:"platform"`
+	Status   string `json:"status"`
+	DeviceID string `json:"deviceId,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+// DeviceCapacity is the device-slot usage of a device class. Planned counts
+// the registrations of a dry run; Remaining already subtracts them.
+type DeviceCapacity struct {
+	DeviceClass string `json:"deviceClass"`
+	Registered  int    `json:"registered"`
+	Planned     int    `json:"planned,omitempty"`
+	Limit       int    `json:"limit"`
+	Remaining   int    `json:"remaining"`
+}
+
+// DeviceImportProfile is the outcome for a regenerated profile.
+type DeviceImportProfile struct {
+	Name        string `json:"name"`
+	ProfileType string `json:"profileType"`
+	OldID       string `json:"oldId"`
+	NewID       string `json:"newId,omitempty"`
+	Devices     int    `json:"devices"`
+	Status      string `json:"status"`
+	Error       string `json:"error,omitempty"`
+}
+
+func deviceImportDeviceRows(result *DeviceImportResult) ([]string, [][]string) {
+	headers := []string{"Line", "Name", "UDID", "Platform", "Status", "Device ID", "Error"}
+	rows := make([][]string, 0, len(result.Devices))
+	for _, item := range result.Devices {
+		rows = append(rows, []string{
+			fmt.Sprintf("%d", item.Line),
+			compactWhitespace(item.Name),
+			item.UDID,
+			item.Platform,
+			item.Status,
+			item.DeviceID,
+			compactWhitespace(item.Error),
+		})
+	}
+	return headers, rows
+}
+
+func deviceImportCapacityRows(result *DeviceImportResult) ([]string, [][]string) {
+	headers := []string{"Device Class", "Registered", "Planned", "Limit", "Remaining"}
+	rows := make([][]string, 0, len(result.Capacity))
+	for _, item := range result.Capacity {
+		rows = append(rows, []string{
+			item.DeviceClass,
+			fmt.Sprintf("%d", item.Registered),
+			fmt.Sprintf("%d", item.Planned),
+			fmt.Sprintf("%d", item.Limit),
+			fmt.Sprintf("%d", item.Remaining),
+		})
+	}
+	return headers, rows
+}
+
+func deviceImportProfileRows(result *DeviceImportResult) ([]string, [][]string) {
+	headers := []string{"Profile", "Type", "Old ID", "New ID", "Devices", "Status", "Error"}
+	rows := make([][]string, 0, len(result.Profiles))
+	for _, item := range result.Profiles {
+		rows = append(rows, []string{
+			compactWhitespace(item.Name),
+			item.ProfileType,
+			item.OldID,
+			item.NewID,
+			fmt.Sprintf("%d", item.Devices),
+			item.Status,
+			compactWhitespace(item.Error),
+		})
+	}
+	return headers, rows
+}
+
 func deviceLocalUDIDRows(result *DeviceLocalUDIDResult) ([]string, [][]string) {
 	headers := []string{"UDID", "Platform"}
 	rows := [][]string{{result.UDID, result.Platform}}
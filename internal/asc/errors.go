@@ -15,6 +15,21 @@ var (
 	ErrRepeatedPaginationURL = errors.New("detected repeated pagination URL")
 )
 
+// PartialResultError reports a pagination run that stopped early because its
+// context was cancelled. Result holds the pages collected before that.
+type PartialResultError struct {
+	Result interface{}
+	Err    error
+}
+
+func (e *PartialResultError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *PartialResultError) Unwrap() error {
+	return e.Err
+}
+
 // APIError represents a parsed App Store Connect error response.
 type APIError struct {
 	Code       string
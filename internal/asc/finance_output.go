@@ -1,6 +1,9 @@
 package asc
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 // FinanceReportResult represents CLI output for finance report downloads.
 type FinanceReportResult struct {
@@ -43,3 +46,110 @@ func financeRegionsRows(result *FinanceRegionsResult) ([]string, [][]string) {
 	}
 	return headers, rows
 }
+
+// FinanceSummaryRow is an aggregate of finance report lines for one group.
+type FinanceSummaryRow struct {
+	Month       string `json:"month,omitempty"`
+	SKU         string `json:"sku,omitempty"`
+	Title       string `json:"title,omitempty"`
+	Territory   string `json:"territory,omitempty"`
+	Currency    string `json:"currency,omitempty"`
+	ProductType string `json:"productType,omitempty"`
+	Units       int    `json:"units"`
+	// Proceeds is in Currency and is only set when grouping by currency.
+	Proceeds *float64 `json:"proceeds,omitempty"`
+	// ConvertedProceeds is in the summary's target currency.
+	ConvertedProceeds *float64 `json:"convertedProceeds,omitempty"`
+}
+
+// FinanceSummaryResult represents CLI output for finance summarize.
+type FinanceSummaryResult struct {
+	VendorNumber      string              `json:"vendorNumber,omitempty"`
+	ReportType        string              `json:"reportType,omitempty"`
+	From              string              `json:"from,omitempty"`
+	To                string              `json:"to,omitempty"`
+	Regions           []string            `json:"regions,omitempty"`
+	Files             []string            `json:"files,omitempty"`
+	Reports           int                 `json:"reports"`
+	Missing           []string            `json:"missing,omitempty"`
+	GroupBy           []string            `json:"groupBy"`
+	Currency          string              `json:"currency,omitempty"`
+	Units             int                 `json:"units"`
+	ConvertedProceeds *float64            `json:"convertedProceeds,omitempty"`
+	Rows              []FinanceSummaryRow `json:"rows"`
+}
+
+func financeSummaryRows(result *FinanceSummaryResult) ([]string, [][]string) {
+	headers := make([]string, 0, len(result.GroupBy)+3)
+	for _, key := range result.GroupBy {
+		headers = append(headers, financeSummaryHeader(key))
+	}
+	headers = append(headers, "Units")
+	showProceeds := false
+	for _, row := range result.Rows {
+		if row.Proceeds != nil {
+			showProceeds = true
+			break
+		}
+	}
+	if showProceeds {
+		headers = append(headers, "Proceeds")
+	}
+	if result.Currency != "" {
+		headers = append(headers, "Proceeds ("+result.Currency+")")
+	}
+
+	rows := make([][]string, 0, len(result.Rows))
+	for _, row := range result.Rows {
+		values := make([]string, 0, len(headers))
+		for _, key := range result.GroupBy {
+			values = append(values, financeSummaryKey(row, key))
+		}
+		values = append(values, fmt.Sprintf("%d", row.Units))
+		if showProceeds {
+			values = append(values, formatFinanceAmount(row.Proceeds))
+		}
+		if result.Currency != "" {
+			values = append(values, formatFinanceAmount(row.ConvertedProceeds))
+		}
+		rows = append(rows, values)
+	}
+	return headers, rows
+}
+
+func financeSummaryHeader(key string) string {
+	switch key {
+	case "sku":
+		return "SKU"
+	case "product-type":
+		return "Product Type"
+	default:
+		return strings.ToUpper(key[:1]) + key[1:]
+	}
+}
+
+func financeSummaryKey(row FinanceSummaryRow, key string) string {
+	switch key {
+	case "month":
+		return row.Month
+	case "sku":
+		return row.SKU
+	case "title":
+		return row.Title
+	case "territory":
+		return row.Territory
+	case "currency":
+		return row.Currency
+	case "product-type":
+		return row.ProductType
+	default:
+		return ""
+	}
+}
+
+func formatFinanceAmount(value *float64) string {
+	if value == nil {
+		return ""
+	}
+	return fmt.Sprintf("%.2f", *value)
+}
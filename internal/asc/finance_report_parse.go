@@ -0,0 +1,265 @@
+package asc
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// FinanceReportLine is a sales or return row of a FINANCIAL or FINANCE_DETAIL report.
+type FinanceReportLine struct {
+	StartDate             string  `json:"startDate,omitempty"`
+	EndDate               string  `json:"endDate,omitempty"`
+	TransactionDate       string  `json:"transactionDate,omitempty"`
+	SettlementDate        string  `json:"settlementDate,omitempty"`
+	SKU                   string  `json:"sku"`
+	Title                 string  `json:"title,omitempty"`
+	AppleIdentifier       string  `json:"appleIdentifier,omitempty"`
+	ProductTypeIdentifier string  `json:"productTypeIdentifier,omitempty"`
+	CountryOfSale         string  `json:"countryOfSale"`
+	Quantity              int     `json:"quantity"`
+	PartnerShare          float64 `json:"partnerShare"`
+	ExtendedPartnerShare  float64 `json:"extendedPartnerShare"`
+	PartnerShareCurrency  string  `json:"partnerShareCurrency"`
+	CustomerPrice         float64 `json:"customerPrice"`
+	CustomerCurrency      string  `json:"customerCurrency,omitempty"`
+	SaleOrReturn          string  `json:"saleOrReturn,omitempty"`
+}
+
+// FinanceReportTotals are the Total_Rows, Total_Amount and Total_Units
+// trailer of one section of a FINANCIAL report. Reports that cover several
+// currencies repeat the header, lines and trailer once per currency.
+type FinanceReportTotals struct {
+	Currency string  `json:"currency,omitempty"`
+	Rows     int     `json:"rows"`
+	Amount   float64 `json:"amount"`
+	Units    int     `json:"units"`
+
+	// lines is the number of lines parsed in the section.
+	lines int
+}
+
+// FinanceExchangeRate is a row of the per-region summary of consolidated
+// reports. Rate converts Currency into BankCurrency.
+type FinanceExchangeRate struct {
+	Region       string  `json:"region"`
+	Currency     string  `json:"currency"`
+	Earned       float64 `json:"earned"`
+	Rate         float64 `json:"rate"`
+	Proceeds     float64 `json:"proceeds"`
+	BankCurrency string  `json:"bankCurrency"`
+}
+
+// FinanceReport is a parsed finance report.
+type FinanceReport struct {
+	Lines         []FinanceReportLine   `json:"lines"`
+	Totals        []FinanceReportTotals `json:"totals,omitempty"`
+	ExchangeRates []FinanceExchangeRate `json:"exchangeRates,omitempty"`
+}
+
+var financeRegionCurrencyRegex = regexp.MustCompile(`^(.*?)\s*\(([A-Z]{3})\)\s*$`)
+
+// ParseFinanceReport parses a FINANCIAL or FINANCE_DETAIL report, gzip
+// compressed (as downloaded) or not. Columns are matched by header name, so
+// both layouts and column reordering are supported.
+func ParseFinanceReport(reader io.Reader) (*FinanceReport, error) {
+	buffered := bufio.NewReader(reader)
+	if magic, err := buffered.Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(buffered)
+		if err != nil {
+			return nil, err
+		}
+		defer gz.Close()
+		buffered = bufio.NewReader(gz)
+	}
+
+	tsvReader := csv.NewReader(buffered)
+	tsvReader.Comma = '\t'
+	tsvReader.FieldsPerRecord = -1
+	tsvReader.LazyQuotes = true
+
+	report := &FinanceReport{}
+	var lineColumns, rateColumns map[string]int
+	// sectionStart is the index of the first line of the current section, and
+	// sectionTotals the index of its totals in report.Totals (-1 before its
+	// trailer).
+	sectionStart, sectionTotals := 0, -1
+	for {
+		record, err := tsvReader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		first := strings.TrimSpace(record[0])
+		if isBlankReportRecord(record) {
+			lineColumns = nil
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(first, "Total_"):
+			if sectionTotals < 0 {
+				totals := FinanceReportTotals{lines: len(report.Lines) - sectionStart}
+				if totals.lines > 0 {
+					totals.Currency = report.Lines[len(report.Lines)-1].PartnerShareCurrency
+				}
+				report.Totals = append(report.Totals, totals)
+				sectionTotals = len(report.Totals) - 1
+			}
+			if err := parseFinanceTotal(&report.Totals[sectionTotals], first, record); err != nil {
+				return nil, err
+			}
+			lineColumns = nil
+		case isFinanceLineHeader(record):
+			lineColumns, rateColumns = reportColumns(record), nil
+			sectionStart, sectionTotals = len(report.Lines), -1
+		case isFinanceRateHeader(record):
+			lineColumns, rateColumns = nil, reportColumns(record)
+		case lineColumns != nil:
+			line, err := parseFinanceLine(record, lineColumns)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", len(report.Lines)+1, err)
+			}
+			report.Lines = append(report.Lines, line)
+		case rateColumns != nil:
+			if rate, ok := parseFinanceRate(record, rateColumns); ok {
+				report.ExchangeRates = append(report.ExchangeRates, rate)
+			}
+		}
+	}
+
+	for i, totals := range report.Totals {
+		if totals.Rows != totals.lines {
+			return nil, fmt.Errorf("section %d lists %d rows in Total_Rows but has %d", i+1, totals.Rows, totals.lines)
+		}
+	}
+	return report, nil
+}
+
+func isBlankReportRecord(record []string) bool {
+	for _, field := range record {
+		if strings.TrimSpace(field) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func reportColumns(record []string) map[string]int {
+	columns := make(map[string]int, len(record))
+	for i, name := range record {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	return columns
+}
+
+func isFinanceLineHeader(record []string) bool {
+	columns := reportColumns(record)
+	_, hasShare := columns["extended partner share"]
+	_, hasCountry := columns["country of sale"]
+	return hasShare && hasCountry
+}
+
+func isFinanceRateHeader(record []string) bool {
+	_, ok := reportColumns(record)["exchange rate"]
+	return ok
+}
+
+// reportField returns the value of the first of names present in columns.
+func reportField(record []string, columns map[string]int, names ...string) string {
+	for _, name := range names {
+		if index, ok := columns[name]; ok && index < len(record) {
+			return strings.TrimSpace(record[index])
+		}
+	}
+	return ""
+}
+
+func parseReportAmount(value string) (float64, error) {
+	value = strings.ReplaceAll(strings.TrimSpace(value), ",", "")
+	if value == "" {
+		return 0, nil
+	}
+	return strconv.ParseFloat(value, 64)
+}
+
+func parseFinanceLine(record []string, columns map[string]int) (FinanceReportLine, error) {
+	line := FinanceReportLine{
+		StartDate:             reportField(record, columns, "start date"),
+		EndDate:               reportField(record, columns, "end date"),
+		TransactionDate:       reportField(record, columns, "transaction date"),
+		SettlementDate:        reportField(record, columns, "settlement date"),
+		SKU:                   reportField(record, columns, "vendor identifier", "sku"),
+		Title:                 reportField(record, columns, "title"),
+		AppleIdentifier:       reportField(record, columns, "apple identifier"),
+		ProductTypeIdentifier: reportField(record, columns, "product type identifier"),
+		CountryOfSale:         reportField(record, columns, "country of sale"),
+		PartnerShareCurrency:  reportField(record, columns, "partner share currency"),
+		CustomerCurrency:      reportField(record, columns, "customer currency"),
+		SaleOrReturn:          reportField(record, columns, "sales or return", "sale or return"),
+	}
+
+	quantity, err := parseReportAmount(reportField(record, columns, "quantity"))
+	if err != nil {
+		return line, fmt.Errorf("invalid quantity: %w", err)
+	}
+	line.Quantity = int(quantity)
+	if line.PartnerShare, err = parseReportAmount(reportField(record, columns, "partner share")); err != nil {
+		return line, fmt.Errorf("invalid partner share: %w", err)
+	}
+	if line.ExtendedPartnerShare, err = parseReportAmount(reportField(record, columns, "extended partner share")); err != nil {
+		return line, fmt.Errorf("invalid extended partner share: %w", err)
+	}
+	if line.CustomerPrice, err = parseReportAmount(reportField(record, columns, "customer price")); err != nil {
+		return line, fmt.Errorf("invalid customer price: %w", err)
+	}
+	return line, nil
+}
+
+func parseFinanceTotal(totals *FinanceReportTotals, key string, record []string) error {
+	if len(record) < 2 {
+		return nil
+	}
+	value, err := parseReportAmount(record[1])
+	if err != nil {
+		return fmt.Errorf("invalid %s: %w", key, err)
+	}
+	switch key {
+	case "Total_Rows":
+		totals.Rows = int(value)
+	case "Total_Amount":
+		totals.Amount = value
+	case "Total_Units":
+		totals.Units = int(value)
+	}
+	return nil
+}
+
+// parseFinanceRate parses an exchange-rate row; total and note rows without
+// a currency or rate are skipped.
+func parseFinanceRate(record []string, columns map[string]int) (FinanceExchangeRate, bool) {
+	region := strings.TrimSpace(record[0])
+	rate := FinanceExchangeRate{
+		Region:       region,
+		Currency:     reportField(record, columns, "partner share currency", "currency"),
+		BankCurrency: reportField(record, columns, "bank account currency"),
+	}
+	if match := financeRegionCurrencyRegex.FindStringSubmatch(region); match != nil {
+		rate.Region, rate.Currency = match[1], match[2]
+	}
+	value, err := parseReportAmount(reportField(record, columns, "exchange rate"))
+	if err != nil || value <= 0 || rate.Currency == "" {
+		return FinanceExchangeRate{}, false
+	}
+	rate.Rate = value
+	rate.Earned, _ = parseReportAmount(reportField(record, columns, "earned"))
+	rate.Proceeds, _ = parseReportAmount(reportField(record, columns, "proceeds"))
+	return rate, true
+}
@@ -1,10 +1,14 @@
 package asc
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"errors"
 	"net/http"
 	"net/url"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -90,3 +94,121 @@ func TestDownloadFinanceReport_ErrorResponse(t *testing.T) {
 		t.Fatalf("expected forbidden error, got %v", err)
 	}
 }
+
+const testConsolidatedFinanceReport = "Start Date\tEnd Date\tUPC\tISRC/ISBN\tVendor Identifier\tQuantity\tPartner Share\tExtended Partner Share\tPartner Share Currency\tSales or Return\tApple Identifier\tArtist/Show/Developer/Author\tTitle\tLabel/Studio/Network/Developer/Publisher\tGrid\tProduct Type Identifier\tISAN/Other Identifier\tCountry Of Sale\tPre-order Flag\tPromo Code\tCustomer Price\tCustomer Currency\n" +
+	"10/01/2025\t11/01/2025\t\t\tcom.example.pro\t3\t6.99\t20.97\tUSD\tS\t123\tExample\tExample Pro\t\t\tIA1\t\tUS\t\t\t9.99\tUSD\n" +
+	"10/01/2025\t11/01/2025\t\t\tcom.example.pro\t-1\t5.50\t-5.50\tEUR\tR\t123\tExample\tExample Pro\t\t\tIA1\t\tDE\t\t\t7.99\tEUR\n" +
+	"\n" +
+	"Country Or Region (Currency)\tBeginning Balance\tEarned\tPre-Tax Subtotal\tInput Tax\tAdjustments\tWithholding Tax\tTotal Owed\tExchange Rate\tProceeds\tBank Account Currency\n" +
+	"Americas (USD)\t0\t20.97\t20.97\t0\t0\t0\t20.97\t1.000000\t20.97\tUSD\n" +
+	"Euro-Zone (EUR)\t0\t-5.50\t-5.50\t0\t0\t0\t-5.50\t1.080000\t-5.94\tUSD\n" +
+	"\t\t\t\t\t\t\t\t\t15.03\tUSD\n"
+
+func TestParseFinanceReport_Consolidated(t *testing.T) {
+	var compressed bytes.Buffer
+	writer := gzip.NewWriter(&compressed)
+	if _, err := writer.Write([]byte(testConsolidatedFinanceReport)); err != nil {
+		t.Fatalf("gzip write error: %v", err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("gzip close error: %v", err)
+	}
+
+	report, err := ParseFinanceReport(&compressed)
+	if err != nil {
+		t.Fatalf("ParseFinanceReport() error: %v", err)
+	}
+	if len(report.Lines) != 2 {
+		t.Fatalf("expected 2 lines, got %d", len(report.Lines))
+	}
+	line := report.Lines[1]
+	if line.SKU != "com.example.pro" || line.CountryOfSale != "DE" || line.Quantity != -1 || line.ExtendedPartnerShare != -5.5 || line.PartnerShareCurrency != "EUR" || line.SaleOrReturn != "R" {
+		t.Fatalf("unexpected line: %+v", line)
+	}
+	if len(report.ExchangeRates) != 2 {
+		t.Fatalf("expected 2 exchange rates, got %+v", report.ExchangeRates)
+	}
+	rate := report.ExchangeRates[1]
+	if rate.Region != "Euro-Zone" || rate.Currency != "EUR" || rate.Rate != 1.08 || rate.BankCurrency != "USD" {
+		t.Fatalf("unexpected exchange rate: %+v", rate)
+	}
+}
+
+func TestParseFinanceReport_DetailLayoutAndTotals(t *testing.T) {
+	report, err := ParseFinanceReport(strings.NewReader(
+		"Transaction Date\tSettlement Date\tApple Identifier\tSKU\tTitle\tDeveloper Name\tProduct Type Identifier\tCountry of Sale\tQuantity\tPartner Share\tExtended Partner Share\tPartner Share Currency\tCustomer Price\tCustomer Currency\tSale or Return\n" +
+			"12/03/2025\t12/31/2025\t123\tcom.example.app\tExample\tDev\t1F\tJP\t2\t1,000\t2,000\tJPY\t1,500\tJPY\tS\n" +
+			"Total_Rows\t1\n" +
+			"Total_Amount\t2000\n" +
+			"Total_Units\t2\n",
+	))
+	if err != nil {
+		t.Fatalf("ParseFinanceReport() error: %v", err)
+	}
+	if len(report.Lines) != 1 || report.Lines[0].SKU != "com.example.app" || report.Lines[0].ExtendedPartnerShare != 2000 || report.Lines[0].SettlementDate != "12/31/2025" {
+		t.Fatalf("unexpected lines: %+v", report.Lines)
+	}
+	if len(report.Totals) != 1 || report.Totals[0].Currency != "JPY" || report.Totals[0].Rows != 1 || report.Totals[0].Amount != 2000 || report.Totals[0].Units != 2 {
+		t.Fatalf("unexpected totals: %+v", report.Totals)
+	}
+}
+
+func TestParseFinanceReport_TotalsPerSection(t *testing.T) {
+	header := "Vendor Identifier\tQuantity\tExtended Partner Share\tPartner Share Currency\tCountry Of Sale\n"
+	report, err := ParseFinanceReport(strings.NewReader(
+		header +
+			"com.example.app\t1\t0.70\tUSD\tUS\n" +
+			"com.example.pro\t2\t1.40\tUSD\tUS\n" +
+			"Total_Rows\t2\n" +
+			"Total_Amount\t2.10\n" +
+			"Total_Units\t3\n" +
+			"\n" +
+			header +
+			"com.example.app\t1\t0.65\tEUR\tDE\n" +
+			"Total_Rows\t1\n" +
+			"Total_Amount\t0.65\n" +
+			"Total_Units\t1\n",
+	))
+	if err != nil {
+		t.Fatalf("ParseFinanceReport() error: %v", err)
+	}
+	if len(report.Lines) != 3 {
+		t.Fatalf("expected 3 lines, got %d", len(report.Lines))
+	}
+	want := []FinanceReportTotals{
+		{Currency: "USD", Rows: 2, Amount: 2.10, Units: 3, lines: 2},
+		{Currency: "EUR", Rows: 1, Amount: 0.65, Units: 1, lines: 1},
+	}
+	if !reflect.DeepEqual(report.Totals, want) {
+		t.Fatalf("unexpected totals: %+v", report.Totals)
+	}
+}
+
+func TestParseFinanceReport_ValidatesEachSection(t *testing.T) {
+	header := "Vendor Identifier\tQuantity\tExtended Partner Share\tPartner Share Currency\tCountry Of Sale\n"
+	// The row counts add up across the file but not per section.
+	_, err := ParseFinanceReport(strings.NewReader(
+		header +
+			"com.example.app\t1\t0.70\tUSD\tUS\n" +
+			"Total_Rows\t2\n" +
+			"\n" +
+			header +
+			"com.example.app\t1\t0.65\tEUR\tDE\n" +
+			"com.example.pro\t1\t0.65\tEUR\tDE\n" +
+			"Total_Rows\t1\n",
+	))
+	if err == nil || !strings.Contains(err.Error(), "section 1 lists 2 rows in Total_Rows but has 1") {
+		t.Fatalf("expected per-section Total_Rows mismatch error, got %v", err)
+	}
+}
+
+func TestParseFinanceReport_RejectsTruncatedReport(t *testing.T) {
+	_, err := ParseFinanceReport(strings.NewReader(
+		"Vendor Identifier\tQuantity\tExtended Partner Share\tPartner Share Currency\tCountry Of Sale\n" +
+			"com.example.app\t1\t0.70\tUSD\tUS\n" +
+			"Total_Rows\t2\n",
+	))
+	if err == nil || !strings.Contains(err.Error(), "Total_Rows") {
+		t.Fatalf("expected Total_Rows mismatch error, got %v", err)
+	}
+}
@@ -135,6 +135,10 @@ func GenerateNotaryJWT(keyID, issuerID string, privateKey interface{}) (string,
 		"exp":   jwt.NewNumericDate(now.Add(tokenLifetime)),
 		"scope": []string{"/notary/v2"},
 	}
+	if strings.TrimSpace(issuerID) == "" {
+		delete(claims, "iss")
+		claims["sub"] = individualKeySubject
+	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
 	token.Header["kid"] = keyID
@@ -162,9 +166,13 @@ func (c *Client) resolveNotaryBaseURL() string {
 
 // newNotaryRequest creates a new HTTP request targeting the Notary API.
 func (c *Client) newNotaryRequest(ctx context.Context, method, path string, body io.Reader) (*http.Request, error) {
-	token, err := GenerateNotaryJWT(c.keyID, c.issuerID, c.privateKey)
-	if err != nil {
-		return nil, fmt.Errorf("failed to generate notary JWT: %w", err)
+	token := replayToken
+	if !c.replayWithoutKey() {
+		var err error
+		token, err = GenerateNotaryJWT(c.keyID, c.issuerID, c.privateKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate notary JWT: %w", err)
+		}
 	}
 
 	url := path
@@ -190,7 +198,7 @@ func (c *Client) doNotary(ctx context.Context, method, path string, body io.Read
 		return nil, err
 	}
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.send(req)
 	if err != nil {
 		return nil, fmt.Errorf("notary request failed: %w", err)
 	}
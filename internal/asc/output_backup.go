@@ -0,0 +1,90 @@
+package asc
+
+import "fmt"
+
+// AppBackupResourceCount is the number of items of one resource in a snapshot.
+type AppBackupResourceCount struct {
+	Resource string `json:"resource"`
+	File     string `json:"file"`
+	Count    int    `json:"count"`
+}
+
+// AppBackupResult represents CLI output for an app snapshot backup.
+type AppBackupResult struct {
+	Dir           string                   `json:"dir"`
+	AppID         string                   `json:"appId"`
+	BundleID      string                   `json:"bundleId,omitempty"`
+	FormatVersion int                      `json:"formatVersion"`
+	CreatedAt     string                   `json:"createdAt"`
+	Resources     []AppBackupResourceCount `json:"resources"`
+}
+
+// AppRestoreItem is one resource a restore created, updated, or skipped.
+type AppRestoreItem struct {
+	Resource string `json:"resource"`
+	Key      string `json:"key"`
+	Action   string `json:"action"`
+	ID       string `json:"id,omitempty"`
+	Count    int    `json:"count,omitempty"`
+	Message  string `json:"message,omitempty"`
+}
+
+// AppRestoreResult represents CLI output for restoring an app snapshot.
+type AppRestoreResult struct {
+	Dir         string           `json:"dir"`
+	SourceAppID string           `json:"sourceAppId"`
+	TargetAppID string           `json:"targetAppId"`
+	DryRun      bool             `json:"dryRun"`
+	Items       []AppRestoreItem `json:"items"`
+	Created     int              `json:"created"`
+	Updated     int              `json:"updated"`
+	Skipped     int              `json:"skipped"`
+}
+
+func appBackupSummaryRows(result *AppBackupResult) ([]string, [][]string) {
+	headers := []string{"Dir", "App ID", "Bundle ID", "Format Version", "Created At"}
+	rows := [][]string{{
+		result.Dir,
+		result.AppID,
+		result.BundleID,
+		fmt.Sprintf("%d", result.FormatVersion),
+		result.CreatedAt,
+	}}
+	return headers, rows
+}
+
+func appBackupResourceRows(resources []AppBackupResourceCount) ([]string, [][]string) {
+	headers := []string{"Resource", "File", "Count"}
+	rows := make([][]string, 0, len(resources))
+	for _, resource := range resources {
+		rows = append(rows, []string{resource.Resource, resource.File, fmt.Sprintf("%d", resource.Count)})
+	}
+	return headers, rows
+}
+
+func appRestoreSummaryRows(result *AppRestoreResult) ([]string, [][]string) {
+	headers := []string{"Dir", "Source App ID", "Target App ID", "Dry Run", "Created", "Updated", "Skipped"}
+	rows := [][]string{{
+		result.Dir,
+		result.SourceAppID,
+		result.TargetAppID,
+		fmt.Sprintf("%t", result.DryRun),
+		fmt.Sprintf("%d", result.Created),
+		fmt.Sprintf("%d", result.Updated),
+		fmt.Sprintf("%d", result.Skipped),
+	}}
+	return headers, rows
+}
+
+func appRestoreItemRows(items []AppRestoreItem) ([]string, [][]string) {
+	headers := []string{"Action", "Resource", "Key", "ID", "Count", "Message"}
+	rows := make([][]string, 0, len(items))
+	for _, item := range items {
+		count := ""
+		if item.Count > 0 {
+			count = fmt.Sprintf("%d", item.Count)
+		}
+		rows = append(rows, []string{item.Action, item.Resource, item.Key, item.ID, count, compactWhitespace(item.Message)})
+	}
+	return headers, rows
+}
@@ -0,0 +1,136 @@
+package asc
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// PrintCSV prints data as comma-separated values using the table rows.
+func PrintCSV(data interface{}) error {
+	return printDelimited(data, ',')
+}
+
+// PrintTSV prints data as tab-separated values using the table rows.
+func PrintTSV(data interface{}) error {
+	return printDelimited(data, '\t')
+}
+
+// printDelimited renders registered types through their rows function.
+// Multi-table types emit one header block per table, separated by a blank line.
+func printDelimited(data interface{}, comma rune) error {
+	var writeErr error
+	blocks := 0
+	render := func(headers []string, rows [][]string) {
+		if writeErr != nil {
+			return
+		}
+		if blocks > 0 {
+			if _, err := io.WriteString(os.Stdout, "\n"); err != nil {
+				writeErr = err
+				return
+			}
+		}
+		blocks++
+		writeErr = writeDelimited(os.Stdout, comma, headers, rows)
+	}
+	if err := renderByRegistry(data, render); err != nil {
+		return err
+	}
+	return writeErr
+}
+
+func writeDelimited(w io.Writer, comma rune, headers []string, rows [][]string) error {
+	writer := csv.NewWriter(w)
+	writer.Comma = comma
+	if err := writer.Write(headers); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		if comma == '\t' {
+			row = sanitizeTSVRow(row)
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// sanitizeTSVRow replaces tabs and newlines so each record stays on one line.
+func sanitizeTSVRow(row []string) []string {
+	clean := make([]string, len(row))
+	replacer := strings.NewReplacer("\t", " ", "\r\n", " ", "\n", " ", "\r", " ")
+	for i, cell := range row {
+		clean[i] = replacer.Replace(cell)
+	}
+	return clean
+}
+
+// PrintYAML prints data as YAML. Values go through their JSON encoding first,
+// so field names and key order match the JSON output.
+func PrintYAML(data interface{}) error {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("yaml: %w", err)
+	}
+	var node yaml.Node
+	if err := yaml.Unmarshal(encoded, &node); err != nil {
+		return fmt.Errorf("yaml: %w", err)
+	}
+	resetYAMLStyle(&node)
+
+	enc := yaml.NewEncoder(os.Stdout)
+	enc.SetIndent(2)
+	if err := enc.Encode(&node); err != nil {
+		return fmt.Errorf("yaml: %w", err)
+	}
+	return enc.Close()
+}
+
+// resetYAMLStyle drops the flow and quoting styles inherited from JSON so the
+// encoder emits block YAML, quoting only where required.
+func resetYAMLStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetYAMLStyle(child)
+	}
+}
+
+// PrintNDJSON prints one JSON value per line: each element of a "data" array,
+// the "data" object of a single-resource response, or the whole value.
+func PrintNDJSON(data interface{}) error {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("ndjson: %w", err)
+	}
+
+	var items []json.RawMessage
+	var doc map[string]json.RawMessage
+	switch {
+	case json.Unmarshal(encoded, &items) == nil:
+	case json.Unmarshal(encoded, &doc) == nil && len(doc["data"]) > 0 && string(doc["data"]) != "null":
+		if err := json.Unmarshal(doc["data"], &items); err != nil {
+			items = []json.RawMessage{doc["data"]}
+		}
+	default:
+		items = []json.RawMessage{encoded}
+	}
+
+	var buf bytes.Buffer
+	for _, item := range items {
+		if err := json.Compact(&buf, item); err != nil {
+			return fmt.Errorf("ndjson: %w", err)
+		}
+		buf.WriteByte('\n')
+	}
+	_, err = os.Stdout.Write(buf.Bytes())
+	return err
+}
@@ -0,0 +1,81 @@
+package asc
+
+import (
+	"strings"
+	"testing"
+)
+
+func formatsTestApps() *AppsResponse {
+	return &AppsResponse{
+		Data: []Resource[AppAttributes]{
+			{Type: ResourceTypeApps, ID: "1", Attributes: AppAttributes{Name: "Demo, \"Pro\"", BundleID: "com.example.demo", SKU: "SKU1"}},
+			{Type: ResourceTypeApps, ID: "2", Attributes: AppAttributes{Name: "Tab\tName", BundleID: "com.example.two", SKU: "SKU2"}},
+		},
+	}
+}
+
+func TestPrintCSV_UsesTableRows(t *testing.T) {
+	output := captureStdout(t, func() error {
+		return PrintCSV(formatsTestApps())
+	})
+
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected header plus 2 rows, got %q", output)
+	}
+	if !strings.Contains(lines[0], "Name") || !strings.Contains(lines[0], ",") {
+		t.Fatalf("expected CSV header, got %q", lines[0])
+	}
+	if !strings.Contains(lines[1], `"Demo, ""Pro"""`) {
+		t.Fatalf("expected quoted CSV cell, got %q", lines[1])
+	}
+}
+
+func TestPrintTSV_KeepsRecordsOnOneLine(t *testing.T) {
+	output := captureStdout(t, func() error {
+		return PrintTSV(formatsTestApps())
+	})
+
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected header plus 2 rows, got %q", output)
+	}
+	header := strings.Split(lines[0], "\t")
+	fields := strings.Split(lines[2], "\t")
+	if len(fields) != len(header) {
+		t.Fatalf("expected %d fields, got %d in %q", len(header), len(fields), lines[2])
+	}
+}
+
+func TestPrintYAML_UsesJSONFieldNames(t *testing.T) {
+	output := captureStdout(t, func() error {
+		return PrintYAML(formatsTestApps())
+	})
+
+	for _, want := range []string{"data:", "  - type: apps", `id: "1"`, "bundleId: com.example.demo"} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("expected %q in YAML output, got:\n%s", want, output)
+		}
+	}
+}
+
+func TestPrintNDJSON_OneResourcePerLine(t *testing.T) {
+	output := captureStdout(t, func() error {
+		return PrintNDJSON(formatsTestApps())
+	})
+
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %q", output)
+	}
+	if !strings.HasPrefix(lines[0], `{"type":"apps","id":"1"`) || !strings.HasPrefix(lines[1], `{"type":"apps","id":"2"`) {
+		t.Fatalf("unexpected NDJSON output: %q", output)
+	}
+
+	single := captureStdout(t, func() error {
+		return PrintNDJSON(&AppResponse{Data: formatsTestApps().Data[0]})
+	})
+	if strings.Count(single, "\n") != 1 || !strings.HasPrefix(single, `{"type":"apps","id":"1"`) {
+		t.Fatalf("expected single resource line, got %q", single)
+	}
+}
@@ -0,0 +1,180 @@
+package asc
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MetadataLintFinding is a single rule violation found by metadata lint.
+type MetadataLintFinding struct {
+	Rule     string `json:"rule"`
+	Severity string `json:"severity"`
+	Locale   string `json:"locale,omitempty"`
+	Field    string `json:"field,omitempty"`
+	Message  string `json:"message"`
+}
+
+// MetadataLintResult represents CLI output for metadata lint.
+type MetadataLintResult struct {
+	Source        string                `json:"source"`
+	PrimaryLocale string                `json:"primaryLocale,omitempty"`
+	Locales       []string              `json:"locales"`
+	Rules         []string              `json:"rules"`
+	Findings      []MetadataLintFinding `json:"findings"`
+	Errors        int                   `json:"errors"`
+	Warnings      int                   `json:"warnings"`
+	Valid         bool                  `json:"valid"`
+}
+
+func metadataLintSummaryRows(result *MetadataLintResult) ([]string, [][]string) {
+	headers := []string{"Source", "Locales", "Errors", "Warnings", "Valid"}
+	rows := [][]string{{
+		result.Source,
+		fmt.Sprintf("%d", len(result.Locales)),
+		fmt.Sprintf("%d", result.Errors),
+		fmt.Sprintf("%d", result.Warnings),
+		fmt.Sprintf("%t", result.Valid),
+	}}
+	return headers, rows
+}
+
+func metadataLintFindingRows(findings []MetadataLintFinding) ([]string, [][]string) {
+	headers := []string{"Severity", "Rule", "Locale", "Field", "Message"}
+	rows := make([][]string, 0, len(findings))
+	for _, finding := range findings {
+		rows = append(rows, []string{finding.Severity, finding.Rule, finding.Locale, finding.Field, finding.Message})
+	}
+	return headers, rows
+}
+
+// MetadataPlanChange is a single field that differs between a metadata spec
+// and live App Store Connect state.
+type MetadataPlanChange struct {
+	Resource string `json:"resource"`
+	Locale   string `json:"locale,omitempty"`
+	Field    string `json:"field"`
+	Action   string `json:"action"`
+	Current  string `json:"current,omitempty"`
+	Desired  string `json:"desired"`
+}
+
+// MetadataPlanOperation is one API request needed to apply a plan.
+type MetadataPlanOperation struct {
+	Method   string `json:"method"`
+	Resource string `json:"resource"`
+	Locale   string `json:"locale,omitempty"`
+	ID       string `json:"id,omitempty"`
+	Fields   int    `json:"fields"`
+}
+
+// MetadataPlanResult represents CLI output for metadata plan and apply.
+type MetadataPlanResult struct {
+	Dir        string                  `json:"dir"`
+	VersionID  string                  `json:"versionId,omitempty"`
+	AppInfoID  string                  `json:"appInfoId,omitempty"`
+	Changes    []MetadataPlanChange    `json:"changes"`
+	Operations []MetadataPlanOperation `json:"operations"`
+	Creates    int                     `json:"creates"`
+	Updates    int                     `json:"updates"`
+	Drift      bool                    `json:"drift"`
+	Applied    bool                    `json:"applied"`
+}
+
+func metadataPlanSummaryRows(result *MetadataPlanResult) ([]string, [][]string) {
+	headers := []string{"Dir", "Version ID", "App Info ID", "Plan", "Applied"}
+	rows := [][]string{{
+		result.Dir,
+		result.VersionID,
+		result.AppInfoID,
+		fmt.Sprintf("%d to create, %d to update", result.Creates, result.Updates),
+		fmt.Sprintf("%t", result.Applied),
+	}}
+	return headers, rows
+}
+
+func metadataPlanChangeRows(changes []MetadataPlanChange) ([]string, [][]string) {
+	headers := []string{"Action", "Resource", "Locale", "Field", "Current", "Desired"}
+	rows := make([][]string, 0, len(changes))
+	for _, change := range changes {
+		symbol := "~"
+		if change.Action == "create" {
+			symbol = "+"
+		}
+		rows = append(rows, []string{
+			symbol + " " + change.Action,
+			change.Resource,
+			change.Locale,
+			change.Field,
+			compactWhitespace(change.Current),
+			compactWhitespace(change.Desired),
+		})
+	}
+	return headers, rows
+}
+
+func metadataPlanOperationRows(operations []MetadataPlanOperation) ([]string, [][]string) {
+	headers := []string{"Method", "Resource", "Locale", "ID", "Fields"}
+	rows := make([][]string, 0, len(operations))
+	for _, op := range operations {
+		rows = append(rows, []string{op.Method, op.Resource, op.Locale, op.ID, fmt.Sprintf("%d", op.Fields)})
+	}
+	return headers, rows
+}
+
+// MetadataDiffChange is a single localized field that differs between two
+// metadata sources.
+type MetadataDiffChange struct {
+	Locale string `json:"locale"`
+	Field  string `json:"field"`
+	Change string `json:"change"`
+	From   string `json:"from,omitempty"`
+	To     string `json:"to,omitempty"`
+}
+
+// MetadataDiffResult represents CLI output for diff.
+type MetadataDiffResult struct {
+	From      string               `json:"from"`
+	To        string               `json:"to"`
+	Scopes    []string             `json:"scopes"`
+	Changes   []MetadataDiffChange `json:"changes"`
+	Added     int                  `json:"added"`
+	Removed   int                  `json:"removed"`
+	Changed   int                  `json:"changed"`
+	Identical bool                 `json:"identical"`
+}
+
+func metadataDiffSummaryRows(result *MetadataDiffResult) ([]string, [][]string) {
+	headers := []string{"From", "To", "Scopes", "Added", "Removed", "Changed", "Identical"}
+	rows := [][]string{{
+		result.From,
+		result.To,
+		strings.Join(result.Scopes, ", "),
+		fmt.Sprintf("%d", result.Added),
+		fmt.Sprintf("%d", result.Removed),
+		fmt.Sprintf("%d", result.Changed),
+		fmt.Sprintf("%t", result.Identical),
+	}}
+	return headers, rows
+}
+
+func metadataDiffChangeRows(changes []MetadataDiffChange) ([]string, [][]string) {
+	headers := []string{"Change", "Locale", "Field", "From", "To"}
+	rows := make([][]string, 0, len(changes))
+	for _, change := range changes {
+		symbol := "~"
+		switch change.Change {
+		case "added":
+			symbol = "+"
+		case "removed":
+			symbol = "-"
+		}
+		rows = append(rows, []string{
+			symbol + " " + change.Change,
+			change.Locale,
+			change.Field,
+			compactWhitespace(change.From),
+			compactWhitespace(change.To),
+		})
+	}
+	return headers, rows
+}
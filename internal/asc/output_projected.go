@@ -0,0 +1,106 @@
+package asc
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+)
+
+// ProjectedOutput is a generic JSON value produced by --fields or --query.
+// Columns, when set, are the dotted field paths to render in table output.
+type ProjectedOutput struct {
+	Value   interface{}
+	Columns []string
+}
+
+// MarshalJSON encodes the projected value as-is.
+func (p ProjectedOutput) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.Value)
+}
+
+// projectedOutputRows renders one row per resource (the elements of "data",
+// or of a top-level array), using the requested field paths as columns.
+func projectedOutputRows(out *ProjectedOutput) ([]string, [][]string) {
+	var items []interface{}
+	switch value := out.Value.(type) {
+	case []interface{}:
+		items = value
+	case map[string]interface{}:
+		data, ok := value["data"]
+		switch {
+		case !ok:
+			items = []interface{}{value}
+		case data == nil:
+			items = nil
+		default:
+			if list, isList := data.([]interface{}); isList {
+				items = list
+			} else {
+				items = []interface{}{data}
+			}
+		}
+	default:
+		return []string{"Value"}, [][]string{{projectedCellValue(value)}}
+	}
+
+	columns := out.Columns
+	if len(columns) == 0 {
+		seen := make(map[string]struct{})
+		for _, item := range items {
+			obj, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			for key := range obj {
+				if _, exists := seen[key]; !exists {
+					seen[key] = struct{}{}
+					columns = append(columns, key)
+				}
+			}
+		}
+		sort.Strings(columns)
+	}
+	if len(columns) == 0 {
+		rows := make([][]string, 0, len(items))
+		for _, item := range items {
+			rows = append(rows, []string{projectedCellValue(item)})
+		}
+		return []string{"Value"}, rows
+	}
+
+	rows := make([][]string, 0, len(items))
+	for _, item := range items {
+		row := make([]string, len(columns))
+		for i, column := range columns {
+			row[i] = projectedCellValue(lookupFieldPath(item, column))
+		}
+		rows = append(rows, row)
+	}
+	return columns, rows
+}
+
+// lookupFieldPath resolves a dotted path such as "attributes.version".
+func lookupFieldPath(value interface{}, path string) interface{} {
+	for _, part := range strings.Split(path, ".") {
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = obj[part]
+	}
+	return value
+}
+
+func projectedCellValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return compactWhitespace(v)
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return ""
+	}
+	return compactWhitespace(string(encoded))
+}
@@ -0,0 +1,62 @@
+package asc
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestProjectedOutputRows(t *testing.T) {
+	tests := []struct {
+		name        string
+		output      ProjectedOutput
+		wantHeaders []string
+		wantRows    [][]string
+	}{
+		{
+			name: "document with field columns",
+			output: ProjectedOutput{
+				Value: map[string]interface{}{
+					"data": []interface{}{
+						map[string]interface{}{"id": "1", "attributes": map[string]interface{}{"version": "10"}},
+						map[string]interface{}{"id": "2"},
+					},
+				},
+				Columns: []string{"id", "attributes.version"},
+			},
+			wantHeaders: []string{"id", "attributes.version"},
+			wantRows:    [][]string{{"1", "10"}, {"2", ""}},
+		},
+		{
+			name: "array of objects uses sorted keys",
+			output: ProjectedOutput{Value: []interface{}{
+				map[string]interface{}{"version": "10", "id": "1", "count": float64(3)},
+			}},
+			wantHeaders: []string{"count", "id", "version"},
+			wantRows:    [][]string{{"3", "1", "10"}},
+		},
+		{
+			name:        "array of scalars",
+			output:      ProjectedOutput{Value: []interface{}{"a", true}},
+			wantHeaders: []string{"Value"},
+			wantRows:    [][]string{{"a"}, {"true"}},
+		},
+		{
+			name:        "scalar",
+			output:      ProjectedOutput{Value: float64(2)},
+			wantHeaders: []string{"Value"},
+			wantRows:    [][]string{{"2"}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			headers, rows := projectedOutputRows(&test.output)
+			if !reflect.DeepEqual(headers, test.wantHeaders) {
+				t.Fatalf("headers = %v, want %v", headers, test.wantHeaders)
+			}
+			if !reflect.DeepEqual(rows, test.wantRows) {
+				t.Fatalf("rows = %v, want %v", rows, test.wantRows)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package asc
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// rawResponseRows renders JSON:API resources as Type/ID/attribute columns,
+// and any other JSON object as Field/Value rows.
+func rawResponseRows(resp *RawResponse) ([]string, [][]string, error) {
+	if resp == nil || len(resp.Body) == 0 {
+		return []string{"Field", "Value"}, nil, nil
+	}
+	var doc map[string]json.RawMessage
+	if err := json.Unmarshal(resp.Body, &doc); err != nil {
+		return []string{"Value"}, [][]string{{rawCellValue(resp.Body)}}, nil
+	}
+
+	data, ok := doc["data"]
+	if !ok {
+		keys := make([]string, 0, len(doc))
+		for key := range doc {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		rows := make([][]string, 0, len(keys))
+		for _, key := range keys {
+			rows = append(rows, []string{key, rawCellValue(doc[key])})
+		}
+		return []string{"Field", "Value"}, rows, nil
+	}
+
+	type rawResource struct {
+		Type       string                     `json:"type"`
+		ID         string                     `json:"id"`
+		Attributes map[string]json.RawMessage `json:"attributes"`
+	}
+	var resources []rawResource
+	if err := json.Unmarshal(data, &resources); err != nil {
+		var single rawResource
+		if err := json.Unmarshal(data, &single); err != nil {
+			return nil, nil, fmt.Errorf("parse data: %w", err)
+		}
+		resources = []rawResource{single}
+	}
+
+	seen := make(map[string]struct{})
+	var attributes []string
+	for _, resource := range resources {
+		for key := range resource.Attributes {
+			if _, ok := seen[key]; !ok {
+				seen[key] = struct{}{}
+				attributes = append(attributes, key)
+			}
+		}
+	}
+	sort.Strings(attributes)
+
+	headers := append([]string{"Type", "ID"}, attributes...)
+	rows := make([][]string, 0, len(resources))
+	for _, resource := range resources {
+		row := []string{resource.Type, resource.ID}
+		for _, key := range attributes {
+			row = append(row, rawCellValue(resource.Attributes[key]))
+		}
+		rows = append(rows, row)
+	}
+	return headers, rows, nil
+}
+
+func rawCellValue(value json.RawMessage) string {
+	if len(value) == 0 || string(value) == "null" {
+		return ""
+	}
+	var text string
+	if err := json.Unmarshal(value, &text); err == nil {
+		return compactWhitespace(text)
+	}
+	return compactWhitespace(string(value))
+}
@@ -376,6 +376,17 @@ func init() {
 	})
 	registerRows(devicesRows)
 	registerRows(deviceLocalUDIDRows)
+	registerDirect(func(v *DeviceImportResult, render func([]string, [][]string)) error {
+		h, r := deviceImportDeviceRows(v)
+		render(h, r)
+		ch, cr := deviceImportCapacityRows(v)
+		render(ch, cr)
+		if len(v.Profiles) > 0 {
+			ph, pr := deviceImportProfileRows(v)
+			render(ph, pr)
+		}
+		return nil
+	})
 	registerRows(func(v *DeviceResponse) ([]string, [][]string) {
 		return devicesRows(&DevicesResponse{Data: []Resource[DeviceAttributes]{v.Data}})
 	})
@@ -385,6 +396,8 @@ func init() {
 	})
 	registerRows(userDeleteResultRows)
 	registerRows(userInvitationRevokeResultRows)
+	registerRows(userAccessAuditRows)
+	registerRows(usersSyncRows)
 	registerRows(betaAppReviewDetailsRows)
 	registerRows(func(v *BetaAppReviewDetailResponse) ([]string, [][]string) {
 		return betaAppReviewDetailsRows(&BetaAppReviewDetailsResponse{Data: []Resource[BetaAppReviewDetailAttributes]{v.Data}})
@@ -459,6 +472,80 @@ func init() {
 		}
 		return nil
 	})
+	registerDirect(func(v *AssetValidationResult, render func([]string, [][]string)) error {
+		h, r := assetValidationSummaryRows(v)
+		render(h, r)
+		if len(v.Issues) > 0 {
+			ih, ir := assetValidationIssueRows(v.Issues)
+			render(ih, ir)
+		}
+		return nil
+	})
+	registerDirect(func(v *MetadataLintResult, render func([]string, [][]string)) error {
+		h, r := metadataLintSummaryRows(v)
+		render(h, r)
+		if len(v.Findings) > 0 {
+			fh, fr := metadataLintFindingRows(v.Findings)
+			render(fh, fr)
+		}
+		return nil
+	})
+	registerDirect(func(v *AppStoreVersionCopyResult, render func([]string, [][]string)) error {
+		if v.Version != nil {
+			vh, vr := appStoreVersionDetailRows(v.Version)
+			render(vh, vr)
+		}
+		h, r := appStoreVersionCopySummaryRows(v)
+		render(h, r)
+		if len(v.Items) > 0 {
+			ih, ir := appStoreVersionCopyItemRows(v.Items)
+			render(ih, ir)
+		}
+		return nil
+	})
+	registerDirect(func(v *MetadataPlanResult, render func([]string, [][]string)) error {
+		h, r := metadataPlanSummaryRows(v)
+		render(h, r)
+		if len(v.Changes) > 0 {
+			ch, cr := metadataPlanChangeRows(v.Changes)
+			render(ch, cr)
+			oh, or := metadataPlanOperationRows(v.Operations)
+			render(oh, or)
+		}
+		return nil
+	})
+	registerDirect(func(v *MetadataDiffResult, render func([]string, [][]string)) error {
+		h, r := metadataDiffSummaryRows(v)
+		render(h, r)
+		if len(v.Changes) > 0 {
+			ch, cr := metadataDiffChangeRows(v.Changes)
+			render(ch, cr)
+		}
+		return nil
+	})
+	registerDirect(func(v *ReleaseNotesResult, render func([]string, [][]string)) error {
+		h, r := releaseNotesSummaryRows(v)
+		render(h, r)
+		lh, lr := releaseNotesLocaleRows(v.Locales)
+		render(lh, lr)
+		return nil
+	})
+	registerDirect(func(v *AppBackupResult, render func([]string, [][]string)) error {
+		h, r := appBackupSummaryRows(v)
+		render(h, r)
+		rh, rr := appBackupResourceRows(v.Resources)
+		render(rh, rr)
+		return nil
+	})
+	registerDirect(func(v *AppRestoreResult, render func([]string, [][]string)) error {
+		h, r := appRestoreSummaryRows(v)
+		render(h, r)
+		if len(v.Items) > 0 {
+			ih, ir := appRestoreItemRows(v.Items)
+			render(ih, ir)
+		}
+		return nil
+	})
 	registerRows(appClipAdvancedExperienceImageUploadResultRows)
 	registerRows(appClipHeaderImageUploadResultRows)
 	registerRows(assetDeleteResultRows)
@@ -472,8 +559,11 @@ func init() {
 	registerRows(testFlightPublishResultRows)
 	registerRows(appStorePublishResultRows)
 	registerRows(salesReportResultRows)
+	registerRows(salesSyncResultRows)
+	registerRows(salesQueryResultRows)
 	registerRows(financeReportResultRows)
 	registerRows(financeRegionsRows)
+	registerRows(financeSummaryRows)
 	registerRows(analyticsReportRequestResultRows)
 	registerRows(analyticsReportRequestDeleteResultRows)
 	registerRows(analyticsReportRequestsRows)
@@ -481,6 +571,7 @@ func init() {
 		return analyticsReportRequestsRows(&AnalyticsReportRequestsResponse{Data: []AnalyticsReportRequestResource{v.Data}, Links: v.Links})
 	})
 	registerRows(analyticsReportDownloadResultRows)
+	registerRows(analyticsFetchResultRows)
 	registerRows(analyticsReportGetResultRows)
 	registerRows(analyticsReportsRows)
 	registerRows(func(v *AnalyticsReportResponse) ([]string, [][]string) {
@@ -813,4 +904,6 @@ func init() {
 	registerRows(notarySubmissionStatusRows)
 	registerRows(notarySubmissionsListRows)
 	registerRows(notarySubmissionLogsRows)
+	registerRowsErr(rawResponseRows)
+	registerRows(projectedOutputRows)
 }
@@ -0,0 +1,43 @@
+package asc
+
+import "fmt"
+
+// ReleaseNotesLocale is the release note text rendered for one locale.
+type ReleaseNotesLocale struct {
+	Locale         string `json:"locale"`
+	Template       string `json:"template"`
+	Notes          string `json:"notes"`
+	Action         string `json:"action,omitempty"`
+	LocalizationID string `json:"localizationId,omitempty"`
+}
+
+// ReleaseNotesResult represents CLI output for release-notes generate.
+type ReleaseNotesResult struct {
+	From    string               `json:"from"`
+	To      string               `json:"to"`
+	Commits int                  `json:"commits"`
+	Target  string               `json:"target,omitempty"`
+	DryRun  bool                 `json:"dryRun,omitempty"`
+	Locales []ReleaseNotesLocale `json:"locales"`
+}
+
+func releaseNotesSummaryRows(result *ReleaseNotesResult) ([]string, [][]string) {
+	headers := []string{"From", "To", "Commits", "Target", "Dry Run"}
+	rows := [][]string{{
+		result.From,
+		result.To,
+		fmt.Sprintf("%d", result.Commits),
+		result.Target,
+		fmt.Sprintf("%t", result.DryRun),
+	}}
+	return headers, rows
+}
+
+func releaseNotesLocaleRows(locales []ReleaseNotesLocale) ([]string, [][]string) {
+	headers := []string{"Locale", "Template", "Action", "Localization ID", "Notes"}
+	rows := make([][]string, 0, len(locales))
+	for _, locale := range locales {
+		rows = append(rows, []string{locale.Locale, locale.Template, locale.Action, locale.LocalizationID, compactWhitespace(locale.Notes)})
+	}
+	return headers, rows
+}
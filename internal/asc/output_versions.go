@@ -43,6 +43,28 @@ type AppStoreVersionDetailResult struct {
 	SubmissionID  string `json:"submissionId,omitempty"`
 }
 
+// AppStoreVersionCopyItem reports one resource handled by a version copy.
+type AppStoreVersionCopyItem struct {
+	Resource string `json:"resource"`
+	Locale   string `json:"locale,omitempty"`
+	Type     string `json:"type,omitempty"`
+	Action   string `json:"action"`
+	SourceID string `json:"sourceId,omitempty"`
+	TargetID string `json:"targetId,omitempty"`
+	Count    int    `json:"count,omitempty"`
+	Message  string `json:"message,omitempty"`
+}
+
+// AppStoreVersionCopyResult represents CLI output for copying version metadata.
+type AppStoreVersionCopyResult struct {
+	SourceVersionID string                       `json:"sourceVersionId"`
+	TargetVersionID string                       `json:"targetVersionId"`
+	Version         *AppStoreVersionDetailResult `json:"version,omitempty"`
+	Items           []AppStoreVersionCopyItem    `json:"items"`
+	Copied          int                          `json:"copied"`
+	Skipped         int                          `json:"skipped"`
+}
+
 // AppStoreVersionAttachBuildResult represents CLI output for build attachment.
 type AppStoreVersionAttachBuildResult struct {
 	VersionID string `json:"versionId"`
@@ -130,6 +152,39 @@ func appStoreVersionDetailRows(result *AppStoreVersionDetailResult) ([]string, [
 	return headers, rows
 }
 
+func appStoreVersionCopySummaryRows(result *AppStoreVersionCopyResult) ([]string, [][]string) {
+	headers := []string{"Source Version ID", "Target Version ID", "Copied", "Skipped"}
+	rows := [][]string{{
+		result.SourceVersionID,
+		result.TargetVersionID,
+		fmt.Sprintf("%d", result.Copied),
+		fmt.Sprintf("%d", result.Skipped),
+	}}
+	return headers, rows
+}
+
+func appStoreVersionCopyItemRows(items []AppStoreVersionCopyItem) ([]string, [][]string) {
+	headers := []string{"Resource", "Locale", "Type", "Action", "Source ID", "Target ID", "Count", "Message"}
+	rows := make([][]string, 0, len(items))
+	for _, item := range items {
+		count := ""
+		if item.Count > 0 {
+			count = fmt.Sprintf("%d", item.Count)
+		}
+		rows = append(rows, []string{
+			item.Resource,
+			item.Locale,
+			item.Type,
+			item.Action,
+			item.SourceID,
+			item.TargetID,
+			count,
+			compactWhitespace(item.Message),
+		})
+	}
+	return headers, rows
+}
+
 func appStoreVersionPhasedReleaseRows(resp *AppStoreVersionPhasedReleaseResponse) ([]string, [][]string) {
 	headers := []string{"Phased Release ID", "State", "Start Date", "Current Day", "Total Pause Duration"}
 	attrs := resp.Data.Attributes
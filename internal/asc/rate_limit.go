@@ -0,0 +1,307 @@
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/config"
+)
+
+const (
+	// rateLimitHeader reports the hourly request budget for the API key,
+	// e.g. "user-hour-lim:3600;user-hour-rem:3598;".
+	rateLimitHeader = "X-Rate-Limit"
+	// rateLimitWindow is the rolling window the budget applies to.
+	rateLimitWindow = time.Hour
+	// rateLimitStateFile is the shared budget state file under ~/.asc.
+	rateLimitStateFile = "rate_limit.json"
+	// rateLimitSyncInterval bounds how often the state file is re-read.
+	rateLimitSyncInterval = time.Second
+	// rateLimitPersistInterval bounds how often a healthy budget is written
+	// to the state file; budgets in the reserve are written on every change.
+	rateLimitPersistInterval = 5 * time.Second
+	// rateLimitMinReserve is the smallest remaining budget kept in reserve.
+	rateLimitMinReserve = 10
+)
+
+// RateLimitBudget is the last known request budget for an API key.
+type RateLimitBudget struct {
+	Limit         int       `json:"limit"`
+	Remaining     int       `json:"remaining"`
+	UpdatedAt     time.Time `json:"updated_at"`
+	NextAllowedAt time.Time `json:"next_allowed_at,omitempty"`
+}
+
+// reserve is the remaining budget below which requests are paced.
+func (b RateLimitBudget) reserve() int {
+	reserve := b.Limit / 20
+	if reserve < rateLimitMinReserve {
+		reserve = rateLimitMinReserve
+	}
+	return reserve
+}
+
+// rateLimitGovernor paces requests per API key once the remaining hourly
+// budget runs low. State is shared by all clients in the process and, through
+// a small state file, by concurrent asc processes.
+type rateLimitGovernor struct {
+	mu          sync.Mutex
+	budgets     map[string]*RateLimitBudget
+	lastSync    time.Time
+	lastPersist time.Time
+	statePath   func() (string, error)
+	now         func() time.Time
+}
+
+var defaultRateLimitGovernor = newRateLimitGovernor(defaultRateLimitStatePath)
+
+func newRateLimitGovernor(statePath func() (string, error)) *rateLimitGovernor {
+	return &rateLimitGovernor{
+		budgets:   make(map[string]*RateLimitBudget),
+		statePath: statePath,
+		now:       time.Now,
+	}
+}
+
+func defaultRateLimitStatePath() (string, error) {
+	path, err := config.GlobalPath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(filepath.Dir(path), rateLimitStateFile), nil
+}
+
+// parseRateLimitHeader parses an X-Rate-Limit header value.
+func parseRateLimitHeader(value string) (limit, remaining int, ok bool) {
+	limit, remaining = -1, -1
+	for _, part := range strings.Split(value, ";") {
+		name, raw, found := strings.Cut(strings.TrimSpace(part), ":")
+		if !found {
+			continue
+		}
+		parsed, err := strconv.Atoi(strings.TrimSpace(raw))
+		if err != nil || parsed < 0 {
+			continue
+		}
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "user-hour-lim":
+			limit = parsed
+		case "user-hour-rem":
+			remaining = parsed
+		}
+	}
+	if limit <= 0 || remaining < 0 {
+		return 0, 0, false
+	}
+	return limit, remaining, true
+}
+
+// wait blocks until the governor allows another request for keyID.
+func (g *rateLimitGovernor) wait(ctx context.Context, keyID string) error {
+	if g == nil {
+		return nil
+	}
+	delay := g.reserve(keyID)
+	if delay <= 0 {
+		return nil
+	}
+	if resolveDebugSettings().enabled {
+		debugLogger.Info("⏸ Rate limit pacing", "delay", delay.String())
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// reserve consumes one request from the budget and returns how long the
+// caller must wait before sending it.
+func (g *rateLimitGovernor) reserve(keyID string) time.Duration {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	now := g.now()
+	g.syncLocked(now)
+
+	budget := g.budgets[keyID]
+	if budget == nil || now.Sub(budget.UpdatedAt) > rateLimitWindow {
+		return 0
+	}
+	if budget.Remaining > budget.reserve() {
+		budget.Remaining--
+		return 0
+	}
+
+	// Low on budget: space requests at the rate the rolling window refills.
+	interval := rateLimitWindow / time.Duration(budget.Limit)
+	start := now
+	if budget.NextAllowedAt.After(start) {
+		start = budget.NextAllowedAt
+	}
+	budget.NextAllowedAt = start.Add(interval)
+	if budget.Remaining > 0 {
+		budget.Remaining--
+	}
+	g.persistLocked()
+	return start.Sub(now)
+}
+
+// observe records the budget reported by a response.
+func (g *rateLimitGovernor) observe(keyID, header string) (RateLimitBudget, bool) {
+	if g == nil {
+		return RateLimitBudget{}, false
+	}
+	limit, remaining, ok := parseRateLimitHeader(header)
+	if !ok {
+		return RateLimitBudget{}, false
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	budget := g.budgets[keyID]
+	if budget == nil {
+		budget = &RateLimitBudget{}
+		g.budgets[keyID] = budget
+	}
+	budget.Limit = limit
+	budget.Remaining = remaining
+	budget.UpdatedAt = g.now()
+	// Other processes only need an up-to-date budget once it nears the
+	// reserve, so healthy budgets are written at most every few seconds.
+	if budget.Remaining <= budget.reserve() || budget.UpdatedAt.Sub(g.lastPersist) >= rateLimitPersistInterval {
+		g.persistLocked()
+	}
+	return *budget, true
+}
+
+// syncLocked merges newer budgets written by other processes.
+func (g *rateLimitGovernor) syncLocked(now time.Time) {
+	if now.Sub(g.lastSync) < rateLimitSyncInterval {
+		return
+	}
+	g.lastSync = now
+
+	stored := g.readStateLocked()
+	for keyID, candidate := range stored {
+		if candidate == nil {
+			continue
+		}
+		current := g.budgets[keyID]
+		if current == nil {
+			copied := *candidate
+			g.budgets[keyID] = &copied
+			continue
+		}
+		if candidate.UpdatedAt.After(current.UpdatedAt) {
+			current.Limit = candidate.Limit
+			current.Remaining = candidate.Remaining
+			current.UpdatedAt = candidate.UpdatedAt
+		}
+		if candidate.NextAllowedAt.After(current.NextAllowedAt) {
+			current.NextAllowedAt = candidate.NextAllowedAt
+		}
+	}
+}
+
+func (g *rateLimitGovernor) readStateLocked() map[string]*RateLimitBudget {
+	if g.statePath == nil {
+		return nil
+	}
+	path, err := g.statePath()
+	if err != nil {
+		return nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	var stored map[string]*RateLimitBudget
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return nil
+	}
+	return stored
+}
+
+// persistLocked writes the budgets to the state file. Failures are ignored;
+// the governor is advisory and the server still enforces the limit.
+func (g *rateLimitGovernor) persistLocked() {
+	if g.statePath == nil {
+		return
+	}
+	path, err := g.statePath()
+	if err != nil {
+		return
+	}
+	g.lastPersist = g.now()
+
+	merged := g.readStateLocked()
+	if merged == nil {
+		merged = make(map[string]*RateLimitBudget, len(g.budgets))
+	}
+	cutoff := g.now().Add(-rateLimitWindow)
+	for keyID, budget := range merged {
+		if budget == nil || budget.UpdatedAt.Before(cutoff) {
+			delete(merged, keyID)
+		}
+	}
+	for keyID, budget := range g.budgets {
+		existing := merged[keyID]
+		if existing == nil || !existing.UpdatedAt.After(budget.UpdatedAt) {
+			copied := *budget
+			merged[keyID] = &copied
+		}
+	}
+
+	data, err := json.Marshal(merged)
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), rateLimitStateFile+".*")
+	if err != nil {
+		return
+	}
+	tmpPath := tmp.Name()
+	_, writeErr := tmp.Write(data)
+	closeErr := tmp.Close()
+	if writeErr != nil || closeErr != nil {
+		_ = os.Remove(tmpPath)
+		return
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		_ = os.Remove(tmpPath)
+	}
+}
+
+// rateLimitGovernorFor returns the governor for a client of baseURL. Only
+// clients of the App Store Connect API share the process governor and its
+// state file; a base URL override (a mock server, proxy or test server) gets
+// its own in-memory governor so its budgets never reach ~/.asc.
+func rateLimitGovernorFor(baseURL string) *rateLimitGovernor {
+	if baseURL == BaseURL {
+		return defaultRateLimitGovernor
+	}
+	return newRateLimitGovernor(nil)
+}
+
+// rateLimiter returns the governor used by the client, or nil when the
+// client has none or replays recorded traffic (no requests reach the server).
+func (c *Client) rateLimiter() *rateLimitGovernor {
+	if c.cassette != nil && c.cassette.mode == CassetteReplay {
+		return nil
+	}
+	return c.rateLimit
+}
@@ -0,0 +1,196 @@
+package asc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func newTestRateLimitGovernor(t *testing.T, path string, now time.Time) *rateLimitGovernor {
+	t.Helper()
+	g := newRateLimitGovernor(func() (string, error) { return path, nil })
+	g.now = func() time.Time { return now }
+	return g
+}
+
+func TestParseRateLimitHeader(t *testing.T) {
+	limit, remaining, ok := parseRateLimitHeader("user-hour-lim:3600;user-hour-rem:3598;")
+	if !ok || limit != 3600 || remaining != 3598 {
+		t.Fatalf("unexpected parse result: %d %d %t", limit, remaining, ok)
+	}
+
+	for _, value := range []string{"", "user-hour-lim:3600", "user-hour-rem:10", "user-hour-lim:x;user-hour-rem:1"} {
+		if _, _, ok := parseRateLimitHeader(value); ok {
+			t.Fatalf("expected %q to be rejected", value)
+		}
+	}
+}
+
+func TestRateLimitGovernor_NoPacingWithHealthyBudget(t *testing.T) {
+	now := time.Now()
+	g := newTestRateLimitGovernor(t, filepath.Join(t.TempDir(), "rate_limit.json"), now)
+
+	if _, ok := g.observe("KEY", "user-hour-lim:3600;user-hour-rem:3000;"); !ok {
+		t.Fatal("expected header to be observed")
+	}
+	for i := 0; i < 5; i++ {
+		if delay := g.reserve("KEY"); delay != 0 {
+			t.Fatalf("expected no delay, got %v", delay)
+		}
+	}
+	if got := g.budgets["KEY"].Remaining; got != 2995 {
+		t.Fatalf("expected local budget to be consumed, got %d", got)
+	}
+}
+
+func TestRateLimitGovernor_PacesWhenBudgetLow(t *testing.T) {
+	now := time.Now()
+	g := newTestRateLimitGovernor(t, filepath.Join(t.TempDir(), "rate_limit.json"), now)
+	g.observe("KEY", "user-hour-lim:3600;user-hour-rem:5;")
+
+	want := []time.Duration{0, time.Second, 2 * time.Second}
+	for i, expected := range want {
+		if delay := g.reserve("KEY"); delay != expected {
+			t.Fatalf("reserve %d: expected %v, got %v", i, expected, delay)
+		}
+	}
+
+	if delay := g.reserve("OTHER"); delay != 0 {
+		t.Fatalf("expected unknown key to be unpaced, got %v", delay)
+	}
+}
+
+func TestRateLimitGovernor_SharesStateAcrossProcesses(t *testing.T) {
+	now := time.Now()
+	path := filepath.Join(t.TempDir(), "rate_limit.json")
+
+	first := newTestRateLimitGovernor(t, path, now)
+	first.observe("KEY", "user-hour-lim:3600;user-hour-rem:1;")
+	if delay := first.reserve("KEY"); delay != 0 {
+		t.Fatalf("expected first request to proceed, got %v", delay)
+	}
+
+	second := newTestRateLimitGovernor(t, path, now)
+	if delay := second.reserve("KEY"); delay != time.Second {
+		t.Fatalf("expected second process to wait 1s, got %v", delay)
+	}
+}
+
+func TestRateLimitGovernor_ThrottlesStateWrites(t *testing.T) {
+	now := time.Now()
+	path := filepath.Join(t.TempDir(), "rate_limit.json")
+	g := newTestRateLimitGovernor(t, path, now)
+
+	stored := func() int {
+		t.Helper()
+		budget := newTestRateLimitGovernor(t, path, now).readStateLocked()["KEY"]
+		if budget == nil {
+			t.Fatal("expected a stored budget")
+		}
+		return budget.Remaining
+	}
+
+	g.observe("KEY", "user-hour-lim:3600;user-hour-rem:3000;")
+	g.observe("KEY", "user-hour-lim:3600;user-hour-rem:2999;")
+	if got := stored(); got != 3000 {
+		t.Fatalf("expected a healthy budget to be written once per interval, got %d", got)
+	}
+
+	g.now = func() time.Time { return now.Add(rateLimitPersistInterval) }
+	g.observe("KEY", "user-hour-lim:3600;user-hour-rem:2998;")
+	if got := stored(); got != 2998 {
+		t.Fatalf("expected the budget to be written after the interval, got %d", got)
+	}
+
+	g.observe("KEY", "user-hour-lim:3600;user-hour-rem:100;")
+	if got := stored(); got != 100 {
+		t.Fatalf("expected a budget in the reserve to be written immediately, got %d", got)
+	}
+}
+
+func TestRateLimitGovernor_IgnoresStaleBudget(t *testing.T) {
+	now := time.Now()
+	g := newTestRateLimitGovernor(t, filepath.Join(t.TempDir(), "rate_limit.json"), now.Add(-2*time.Hour))
+	g.observe("KEY", "user-hour-lim:3600;user-hour-rem:0;")
+
+	g.now = func() time.Time { return now }
+	if delay := g.reserve("KEY"); delay != 0 {
+		t.Fatalf("expected stale budget to be ignored, got %v", delay)
+	}
+}
+
+func TestClientDo_ObservesRateLimitHeader(t *testing.T) {
+	response := jsonResponse(http.StatusOK, `{"data":[]}`)
+	response.Header.Set("X-Rate-Limit", "user-hour-lim:3600;user-hour-rem:42;")
+	client := newTestClient(t, nil, response)
+	client.rateLimit = newRateLimitGovernor(nil)
+
+	if _, err := client.GetApps(context.Background()); err != nil {
+		t.Fatalf("GetApps() error: %v", err)
+	}
+	budget := client.rateLimit.budgets[client.keyID]
+	if budget == nil || budget.Limit != 3600 || budget.Remaining != 42 {
+		t.Fatalf("unexpected budget: %+v", budget)
+	}
+}
+
+func TestNewClient_KeepsRateLimitOfBaseURLOverridesInMemory(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "config.json"))
+	SetBaseURLOverride(nil)
+	t.Cleanup(func() { SetBaseURLOverride(nil) })
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Rate-Limit", "user-hour-lim:3600;user-hour-rem:5;")
+		_, _ = w.Write([]byte(`{"data":[]}`))
+	}))
+	defer server.Close()
+	t.Setenv("ASC_BASE_URL", server.URL)
+
+	client, err := NewClient("KEY123", "ISS456", writeRateLimitTestKey(t))
+	if err != nil {
+		t.Fatalf("NewClient() error: %v", err)
+	}
+	if client.rateLimit == nil || client.rateLimit == defaultRateLimitGovernor || client.rateLimit.statePath != nil {
+		t.Fatalf("expected an in-memory governor for a base URL override, got %+v", client.rateLimit)
+	}
+	if _, err := client.GetApps(context.Background()); err != nil {
+		t.Fatalf("GetApps() error: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(home, ".asc", rateLimitStateFile)); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("expected no rate limit state under HOME, got %v", err)
+	}
+
+	if rateLimitGovernorFor(BaseURL) != defaultRateLimitGovernor {
+		t.Fatal("expected the App Store Connect API to use the shared governor")
+	}
+}
+
+func writeRateLimitTestKey(t *testing.T) string {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey() error: %v", err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("MarshalPKCS8PrivateKey() error: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "AuthKey.p8")
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+	return path
+}
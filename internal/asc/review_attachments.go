@@ -84,7 +84,7 @@ func (c *Client) GetAppStoreReviewAttachmentsForReviewDetail(ctx context.Context
 
 	path := fmt.Sprintf("/v1/appStoreReviewDetails/%s/appStoreReviewAttachments", reviewDetailID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("app-store-review-attachments: %w", err)
 		}
 		path = query.nextURL
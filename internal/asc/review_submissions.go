@@ -118,7 +118,7 @@ func (c *Client) GetReviewSubmissions(ctx context.Context, appID string, opts ..
 
 	var path string
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("reviewSubmissions: %w", err)
 		}
 		path = query.nextURL
@@ -176,7 +176,7 @@ func (c *Client) GetReviewSubmissionItemsRelationships(ctx context.Context, subm
 
 	var path string
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("reviewSubmissionItemsRelationships: %w", err)
 		}
 		path = query.nextURL
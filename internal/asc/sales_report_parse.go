@@ -0,0 +1,117 @@
+package asc
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// SalesReportLine is a row of a SALES summary report.
+type SalesReportLine struct {
+	BeginDate             string  `json:"beginDate"`
+	EndDate               string  `json:"endDate"`
+	SKU                   string  `json:"sku"`
+	Title                 string  `json:"title,omitempty"`
+	AppleIdentifier       string  `json:"appleIdentifier,omitempty"`
+	ParentIdentifier      string  `json:"parentIdentifier,omitempty"`
+	ProductTypeIdentifier string  `json:"productTypeIdentifier,omitempty"`
+	CountryCode           string  `json:"countryCode"`
+	Device                string  `json:"device,omitempty"`
+	Units                 int     `json:"units"`
+	DeveloperProceeds     float64 `json:"developerProceeds"`
+	ProceedsCurrency      string  `json:"proceedsCurrency"`
+	CustomerPrice         float64 `json:"customerPrice"`
+	CustomerCurrency      string  `json:"customerCurrency,omitempty"`
+}
+
+// ParseSalesReport parses a SALES summary report, gzip compressed (as
+// downloaded) or not. Columns are matched by header name, so both report
+// versions are supported. Developer Proceeds is per unit, as in the report.
+func ParseSalesReport(reader io.Reader) ([]SalesReportLine, error) {
+	buffered := bufio.NewReader(reader)
+	if magic, err := buffered.Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(buffered)
+		if err != nil {
+			return nil, err
+		}
+		defer gz.Close()
+		buffered = bufio.NewReader(gz)
+	}
+
+	tsvReader := csv.NewReader(buffered)
+	tsvReader.Comma = '\t'
+	tsvReader.FieldsPerRecord = -1
+	tsvReader.LazyQuotes = true
+
+	var columns map[string]int
+	var lines []SalesReportLine
+	for {
+		record, err := tsvReader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if isBlankReportRecord(record) {
+			continue
+		}
+		if columns == nil {
+			columns = reportColumns(record)
+			if _, ok := columns["units"]; !ok {
+				return nil, fmt.Errorf("not a sales report: missing Units column")
+			}
+			continue
+		}
+
+		line := SalesReportLine{
+			BeginDate:             reportField(record, columns, "begin date"),
+			EndDate:               reportField(record, columns, "end date"),
+			SKU:                   reportField(record, columns, "sku"),
+			Title:                 reportField(record, columns, "title"),
+			AppleIdentifier:       reportField(record, columns, "apple identifier"),
+			ParentIdentifier:      reportField(record, columns, "parent identifier"),
+			ProductTypeIdentifier: reportField(record, columns, "product type identifier"),
+			CountryCode:           reportField(record, columns, "country code"),
+			Device:                reportField(record, columns, "device"),
+			ProceedsCurrency:      reportField(record, columns, "currency of proceeds"),
+			CustomerCurrency:      reportField(record, columns, "customer currency"),
+		}
+		units, err := parseReportAmount(reportField(record, columns, "units"))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid units: %w", len(lines)+1, err)
+		}
+		line.Units = int(units)
+		if line.DeveloperProceeds, err = parseReportAmount(reportField(record, columns, "developer proceeds")); err != nil {
+			return nil, fmt.Errorf("line %d: invalid developer proceeds: %w", len(lines)+1, err)
+		}
+		if line.CustomerPrice, err = parseReportAmount(reportField(record, columns, "customer price")); err != nil {
+			return nil, fmt.Errorf("line %d: invalid customer price: %w", len(lines)+1, err)
+		}
+		lines = append(lines, line)
+	}
+	return lines, nil
+}
+
+// IsSalesReportNotAvailable reports whether err is Apple's response for a
+// sales report that has not been generated yet, as opposed to a date
+// without sales.
+func IsSalesReportNotAvailable(err error) bool {
+	if !IsNotFound(err) {
+		return false
+	}
+	message := strings.ToLower(err.Error())
+	return strings.Contains(message, "not available yet") || strings.Contains(message, "not yet available")
+}
+
+// IsSalesReportNoSales reports whether err is Apple's response for a report
+// date without any sales.
+func IsSalesReportNoSales(err error) bool {
+	if !IsNotFound(err) {
+		return false
+	}
+	return strings.Contains(strings.ToLower(err.Error()), "no sales")
+}
@@ -94,3 +94,37 @@ type UserInvitationRevokeResult struct {
 	ID      string `json:"id"`
 	Revoked bool   `json:"revoked"`
 }
+
+// UserAccessAuditResult represents CLI output for a team access audit.
+type UserAccessAuditResult struct {
+	AppID   string            `json:"appId,omitempty"`
+	Entries []UserAccessEntry `json:"entries"`
+}
+
+// UserAccessEntry is one person's access to one app. AppID is "ALL" for
+// people who can see every app.
+type UserAccessEntry struct {
+	ID      string   `json:"id"`
+	Email   string   `json:"email"`
+	Name    string   `json:"name,omitempty"`
+	Status  string   `json:"status"`
+	Roles   []string `json:"roles"`
+	AppID   string   `json:"appId"`
+	AppName string   `json:"appName,omitempty"`
+}
+
+// UsersSyncResult represents CLI output for a team membership sync.
+type UsersSyncResult struct {
+	File    string            `json:"file"`
+	DryRun  bool              `json:"dryRun"`
+	Changes []UsersSyncChange `json:"changes"`
+}
+
+// UsersSyncChange is one planned or applied change of a team sync.
+type UsersSyncChange struct {
+	Action string   `json:"action"`
+	Email  string   `json:"email"`
+	ID     string   `json:"id,omitempty"`
+	Fields []string `json:"fields,omitempty"`
+	Status string   `json:"status"`
+}
@@ -72,3 +72,34 @@ func userInvitationRevokeResultRows(result *UserInvitationRevokeResult) ([]strin
 	rows := [][]string{{result.ID, fmt.Sprintf("%t", result.Revoked)}}
 	return headers, rows
 }
+
+func userAccessAuditRows(result *UserAccessAuditResult) ([]string, [][]string) {
+	headers := []string{"Email", "Name", "Status", "Roles", "App ID", "App"}
+	rows := make([][]string, 0, len(result.Entries))
+	for _, entry := range result.Entries {
+		rows = append(rows, []string{
+			compactWhitespace(entry.Email),
+			compactWhitespace(entry.Name),
+			entry.Status,
+			compactWhitespace(strings.Join(entry.Roles, ",")),
+			entry.AppID,
+			compactWhitespace(entry.AppName),
+		})
+	}
+	return headers, rows
+}
+
+func usersSyncRows(result *UsersSyncResult) ([]string, [][]string) {
+	headers := []string{"Action", "Email", "ID", "Details", "Status"}
+	rows := make([][]string, 0, len(result.Changes))
+	for _, change := range result.Changes {
+		rows = append(rows, []string{
+			change.Action,
+			compactWhitespace(change.Email),
+			change.ID,
+			compactWhitespace(strings.Join(change.Fields, "; ")),
+			change.Status,
+		})
+	}
+	return headers, rows
+}
@@ -748,7 +748,7 @@ func (c *Client) GetCiProducts(ctx context.Context, opts ...CiProductsOption) (*
 
 	path := "/v1/ciProducts"
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("ciProducts: %w", err)
 		}
 		path = query.nextURL
@@ -810,7 +810,7 @@ func (c *Client) GetCiProductBuildRuns(ctx context.Context, productID string, op
 
 	path := fmt.Sprintf("/v1/ciProducts/%s/buildRuns", productID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("ciProductBuildRuns: %w", err)
 		}
 		path = query.nextURL
@@ -840,7 +840,7 @@ func (c *Client) GetCiProductPrimaryRepositories(ctx context.Context, productID
 
 	path := fmt.Sprintf("/v1/ciProducts/%s/primaryRepositories", productID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("ciProductPrimaryRepositories: %w", err)
 		}
 		path = query.nextURL
@@ -870,7 +870,7 @@ func (c *Client) GetCiProductAdditionalRepositories(ctx context.Context, product
 
 	path := fmt.Sprintf("/v1/ciProducts/%s/additionalRepositories", productID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("ciProductAdditionalRepositories: %w", err)
 		}
 		path = query.nextURL
@@ -908,7 +908,7 @@ func (c *Client) GetCiWorkflows(ctx context.Context, productID string, opts ...C
 
 	path := fmt.Sprintf("/v1/ciProducts/%s/workflows", productID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("ciWorkflows: %w", err)
 		}
 		path = query.nextURL
@@ -1017,7 +1017,7 @@ func (c *Client) GetScmProviders(ctx context.Context, opts ...ScmProvidersOption
 
 	path := "/v1/scmProviders"
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("scmProviders: %w", err)
 		}
 		path = query.nextURL
@@ -1063,7 +1063,7 @@ func (c *Client) GetScmProviderRepositories(ctx context.Context, providerID stri
 
 	path := fmt.Sprintf("/v1/scmProviders/%s/repositories", strings.TrimSpace(providerID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("scmProviderRepositories: %w", err)
 		}
 		path = query.nextURL
@@ -1093,7 +1093,7 @@ func (c *Client) GetScmRepositories(ctx context.Context, opts ...ScmRepositories
 
 	path := "/v1/scmRepositories"
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("scmRepositories: %w", err)
 		}
 		path = query.nextURL
@@ -1157,7 +1157,7 @@ func (c *Client) GetScmGitReferences(ctx context.Context, repositoryID string, o
 
 	path := fmt.Sprintf("/v1/scmRepositories/%s/gitReferences", repositoryID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("scmGitReferences: %w", err)
 		}
 		path = query.nextURL
@@ -1188,7 +1188,7 @@ func (c *Client) GetScmRepositoryGitReferencesRelationships(ctx context.Context,
 
 	path := fmt.Sprintf("/v1/scmRepositories/%s/relationships/gitReferences", repositoryID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("scmRepositoryGitReferencesRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -1218,7 +1218,7 @@ func (c *Client) GetScmRepositoryPullRequests(ctx context.Context, repositoryID
 
 	path := fmt.Sprintf("/v1/scmRepositories/%s/pullRequests", strings.TrimSpace(repositoryID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("scmRepositoryPullRequests: %w", err)
 		}
 		path = query.nextURL
@@ -1249,7 +1249,7 @@ func (c *Client) GetScmRepositoryPullRequestsRelationships(ctx context.Context,
 
 	path := fmt.Sprintf("/v1/scmRepositories/%s/relationships/pullRequests", repositoryID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("scmRepositoryPullRequestsRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -1295,7 +1295,7 @@ func (c *Client) GetCiMacOsVersions(ctx context.Context, opts ...CiMacOsVersions
 
 	path := "/v1/ciMacOsVersions"
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("ciMacOsVersions: %w", err)
 		}
 		path = query.nextURL
@@ -1341,7 +1341,7 @@ func (c *Client) GetCiMacOsVersionXcodeVersions(ctx context.Context, macOsVersio
 
 	path := fmt.Sprintf("/v1/ciMacOsVersions/%s/xcodeVersions", macOsVersionID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("ciMacOsVersionXcodeVersions: %w", err)
 		}
 		path = query.nextURL
@@ -1371,7 +1371,7 @@ func (c *Client) GetCiXcodeVersions(ctx context.Context, opts ...CiXcodeVersions
 
 	path := "/v1/ciXcodeVersions"
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("ciXcodeVersions: %w", err)
 		}
 		path = query.nextURL
@@ -1417,7 +1417,7 @@ func (c *Client) GetCiXcodeVersionMacOsVersions(ctx context.Context, xcodeVersio
 
 	path := fmt.Sprintf("/v1/ciXcodeVersions/%s/macOsVersions", xcodeVersionID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("ciXcodeVersionMacOsVersions: %w", err)
 		}
 		path = query.nextURL
@@ -1447,7 +1447,7 @@ func (c *Client) GetCiBuildRuns(ctx context.Context, workflowID string, opts ...
 
 	path := fmt.Sprintf("/v1/ciWorkflows/%s/buildRuns", workflowID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("ciBuildRuns: %w", err)
 		}
 		path = query.nextURL
@@ -1493,7 +1493,7 @@ func (c *Client) GetCiBuildRunBuilds(ctx context.Context, buildRunID string, opt
 
 	path := fmt.Sprintf("/v1/ciBuildRuns/%s/builds", buildRunID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("ciBuildRunBuilds: %w", err)
 		}
 		path = query.nextURL
@@ -2239,7 +2239,7 @@ func (c *Client) GetCiBuildActions(ctx context.Context, buildRunID string, opts
 
 	path := fmt.Sprintf("/v1/ciBuildRuns/%s/actions", buildRunID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("ciBuildActions: %w", err)
 		}
 		path = query.nextURL
@@ -2301,7 +2301,7 @@ func (c *Client) GetCiBuildActionArtifacts(ctx context.Context, buildActionID st
 
 	path := fmt.Sprintf("/v1/ciBuildActions/%s/artifacts", buildActionID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("ciArtifacts: %w", err)
 		}
 		path = query.nextURL
@@ -2347,7 +2347,7 @@ func (c *Client) GetCiBuildActionTestResults(ctx context.Context, buildActionID
 
 	path := fmt.Sprintf("/v1/ciBuildActions/%s/testResults", buildActionID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("ciTestResults: %w", err)
 		}
 		path = query.nextURL
@@ -2393,7 +2393,7 @@ func (c *Client) GetCiBuildActionIssues(ctx context.Context, buildActionID strin
 
 	path := fmt.Sprintf("/v1/ciBuildActions/%s/issues", buildActionID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("ciIssues: %w", err)
 		}
 		path = query.nextURL
@@ -182,9 +182,20 @@ func inspectProfiles() DoctorSection {
 				source = fmt.Sprintf("%s: %s", cred.Source, cred.SourcePath)
 			}
 			message := fmt.Sprintf("%s - complete (%s)", cred.Name, source)
+			if config.IsIndividualKeyType(cred.KeyType) {
+				message = fmt.Sprintf("%s - complete, individual key (%s)", cred.Name, source)
+			}
 			if cred.IsDefault {
 				message += " [default]"
 			}
+			if config.IsIndividualKeyType(cred.KeyType) && strings.TrimSpace(cred.IssuerID) != "" {
+				checks = append(checks, DoctorCheck{
+					Status:         DoctorFail,
+					Message:        fmt.Sprintf("%s - individual key has an issuer ID", cred.Name),
+					Recommendation: fmt.Sprintf("Re-run auth login for %q with --key-type individual and no --issuer-id", cred.Name),
+				})
+				continue
+			}
 			checks = append(checks, DoctorCheck{
 				Status:  DoctorOK,
 				Message: message,
@@ -209,9 +220,13 @@ func inspectProfiles() DoctorSection {
 		}
 		seen[name]++
 		if !isCompleteConfigCredential(cred) {
+			missing := "missing key ID, issuer ID, or private key path"
+			if config.IsIndividualKeyType(cred.KeyType) {
+				missing = "missing key ID or private key path"
+			}
 			checks = append(checks, DoctorCheck{
 				Status:         DoctorWarn,
-				Message:        fmt.Sprintf("%s - incomplete (missing key ID, issuer ID, or private key path)", name),
+				Message:        fmt.Sprintf("%s - incomplete (%s)", name, missing),
 				Recommendation: fmt.Sprintf("Re-run auth login for %q", name),
 			})
 		}
@@ -341,6 +356,7 @@ func inspectEnvironment() DoctorSection {
 	envVars := []string{
 		"ASC_KEY_ID",
 		"ASC_ISSUER_ID",
+		"ASC_KEY_TYPE",
 		"ASC_PRIVATE_KEY_PATH",
 		"ASC_PRIVATE_KEY",
 		"ASC_PRIVATE_KEY_B64",
@@ -351,7 +367,7 @@ func inspectEnvironment() DoctorSection {
 	for _, name := range envVars {
 		if value := strings.TrimSpace(os.Getenv(name)); value != "" {
 			message := fmt.Sprintf("%s is set", name)
-			if name == "ASC_KEY_ID" || name == "ASC_ISSUER_ID" || name == "ASC_PROFILE" {
+			if name == "ASC_KEY_ID" || name == "ASC_ISSUER_ID" || name == "ASC_KEY_TYPE" || name == "ASC_PROFILE" {
 				message = fmt.Sprintf("%s is set (%s)", name, value)
 			}
 			checks = append(checks, DoctorCheck{
@@ -366,15 +382,35 @@ func inspectEnvironment() DoctorSection {
 	hasKeyPath := strings.TrimSpace(os.Getenv("ASC_PRIVATE_KEY_PATH")) != "" ||
 		strings.TrimSpace(os.Getenv("ASC_PRIVATE_KEY")) != "" ||
 		strings.TrimSpace(os.Getenv("ASC_PRIVATE_KEY_B64")) != ""
+	keyType, keyTypeErr := config.NormalizeKeyType(os.Getenv("ASC_KEY_TYPE"))
+	if keyTypeErr != nil {
+		checks = append(checks, DoctorCheck{
+			Status:         DoctorFail,
+			Message:        fmt.Sprintf("ASC_KEY_TYPE is invalid: %v", keyTypeErr),
+			Recommendation: "Set ASC_KEY_TYPE to team or individual",
+		})
+	}
+	individual := keyType == config.KeyTypeIndividual
 	envProvided := keyID != "" || issuerID != "" || hasKeyPath
-	envComplete := keyID != "" && issuerID != "" && hasKeyPath
+	envComplete := keyID != "" && hasKeyPath && (issuerID != "" || individual)
 	if envProvided && !envComplete {
+		message := "Environment credentials are incomplete (set ASC_KEY_ID, ASC_ISSUER_ID, and a private key)"
+		if individual {
+			message = "Environment credentials are incomplete (set ASC_KEY_ID and a private key)"
+		}
 		checks = append(checks, DoctorCheck{
 			Status:         DoctorWarn,
-			Message:        "Environment credentials are incomplete (set ASC_KEY_ID, ASC_ISSUER_ID, and a private key)",
+			Message:        message,
 			Recommendation: "Set missing ASC_* variables or clear partial values",
 		})
 	}
+	if individual && issuerID != "" {
+		checks = append(checks, DoctorCheck{
+			Status:         DoctorFail,
+			Message:        "ASC_ISSUER_ID is set but ASC_KEY_TYPE is individual",
+			Recommendation: "Unset ASC_ISSUER_ID when using an individual API key",
+		})
+	}
 
 	if envProvided {
 		defaultCreds, err := GetDefaultCredentials()
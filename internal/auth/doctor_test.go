@@ -107,3 +107,44 @@ func sectionHasStatus(section DoctorSection, status DoctorStatus, contains strin
 	}
 	return false
 }
+
+func TestDoctorProfilesIndividualKeys(t *testing.T) {
+	t.Setenv("ASC_BYPASS_KEYCHAIN", "1")
+
+	tempDir := t.TempDir()
+	keyPath := filepath.Join(tempDir, "AuthKey.p8")
+	writeECDSAPEM(t, keyPath, 0o600, true)
+
+	cfg := &config.Config{
+		DefaultKeyName: "personal",
+		Keys: []config.Credential{
+			{
+				Name:           "personal",
+				KeyID:          "KEY123",
+				PrivateKeyPath: keyPath,
+				KeyType:        config.KeyTypeIndividual,
+			},
+			{
+				Name:           "stray-issuer",
+				KeyID:          "KEY456",
+				IssuerID:       "ISS456",
+				PrivateKeyPath: keyPath,
+				KeyType:        config.KeyTypeIndividual,
+			},
+		},
+	}
+	configPath := filepath.Join(tempDir, "config.json")
+	if err := config.SaveAt(configPath, cfg); err != nil {
+		t.Fatalf("save config error: %v", err)
+	}
+	t.Setenv("ASC_CONFIG_PATH", configPath)
+
+	report := Doctor(DoctorOptions{})
+	section := findDoctorSection(t, report, "Profiles")
+	if !sectionHasStatus(section, DoctorOK, "personal - complete, individual key") {
+		t.Fatalf("expected individual key to be complete, got %#v", section.Checks)
+	}
+	if !sectionHasStatus(section, DoctorFail, "stray-issuer - individual key has an issuer ID") {
+		t.Fatalf("expected issuer failure for individual key, got %#v", section.Checks)
+	}
+}
@@ -30,6 +30,7 @@ type Credential struct {
 	KeyID          string `json:"key_id"`
 	IssuerID       string `json:"issuer_id"`
 	PrivateKeyPath string `json:"private_key_path"`
+	KeyType        string `json:"key_type,omitempty"`
 	IsDefault      bool   `json:"is_default"`
 	Source         string `json:"source,omitempty"`
 	SourcePath     string `json:"source_path,omitempty"`
@@ -59,6 +60,7 @@ type credentialPayload struct {
 	KeyID          string `json:"key_id"`
 	IssuerID       string `json:"issuer_id"`
 	PrivateKeyPath string `json:"private_key_path"`
+	KeyType        string `json:"key_type,omitempty"`
 }
 
 func keyringConfig(keychainName string) keyring.Config {
@@ -204,11 +206,13 @@ func LoadPrivateKey(path string) (*ecdsa.PrivateKey, error) {
 }
 
 // StoreCredentials stores credentials in the keychain when available.
-func StoreCredentials(name, keyID, issuerID, keyPath string) error {
+// keyType is config.KeyTypeTeam (or empty) or config.KeyTypeIndividual.
+func StoreCredentials(name, keyID, issuerID, keyPath, keyType string) error {
 	payload := credentialPayload{
 		KeyID:          keyID,
 		IssuerID:       issuerID,
 		PrivateKeyPath: keyPath,
+		KeyType:        storedKeyType(keyType),
 	}
 
 	if err := storeInKeychain(name, payload); err == nil {
@@ -226,11 +230,12 @@ func StoreCredentials(name, keyID, issuerID, keyPath string) error {
 }
 
 // StoreCredentialsConfig stores credentials in the config file only.
-func StoreCredentialsConfig(name, keyID, issuerID, keyPath string) error {
+func StoreCredentialsConfig(name, keyID, issuerID, keyPath, keyType string) error {
 	payload := credentialPayload{
 		KeyID:          keyID,
 		IssuerID:       issuerID,
 		PrivateKeyPath: keyPath,
+		KeyType:        storedKeyType(keyType),
 	}
 	path, err := config.GlobalPath()
 	if err != nil {
@@ -240,15 +245,25 @@ func StoreCredentialsConfig(name, keyID, issuerID, keyPath string) error {
 }
 
 // StoreCredentialsConfigAt stores credentials in the specified config file.
-func StoreCredentialsConfigAt(name, keyID, issuerID, keyPath, configPath string) error {
+func StoreCredentialsConfigAt(name, keyID, issuerID, keyPath, keyType, configPath string) error {
 	payload := credentialPayload{
 		KeyID:          keyID,
 		IssuerID:       issuerID,
 		PrivateKeyPath: keyPath,
+		KeyType:        storedKeyType(keyType),
 	}
 	return storeInConfigAt(name, payload, configPath)
 }
 
+// storedKeyType returns the key type as persisted. Team keys are stored
+// without a key type so existing entries keep their shape.
+func storedKeyType(keyType string) string {
+	if config.IsIndividualKeyType(keyType) {
+		return config.KeyTypeIndividual
+	}
+	return ""
+}
+
 // clearConfigCredentials clears credentials from the config file.
 // This is called after successfully migrating to keychain storage.
 func clearConfigCredentials() error {
@@ -279,6 +294,7 @@ func clearConfigCredentialsAt(path string) error {
 	cfg.KeyID = ""
 	cfg.IssuerID = ""
 	cfg.PrivateKeyPath = ""
+	cfg.KeyType = ""
 	cfg.DefaultKeyName = ""
 	cfg.Keys = nil
 	return config.SaveAt(path, cfg)
@@ -511,6 +527,7 @@ func selectCredential(profile string, credentials []Credential) (*config.Config,
 					KeyID:          cred.KeyID,
 					IssuerID:       cred.IssuerID,
 					PrivateKeyPath: cred.PrivateKeyPath,
+					KeyType:        cred.KeyType,
 					DefaultKeyName: cred.Name,
 				}, true, nil
 			}
@@ -523,6 +540,7 @@ func selectCredential(profile string, credentials []Credential) (*config.Config,
 			KeyID:          cred.KeyID,
 			IssuerID:       cred.IssuerID,
 			PrivateKeyPath: cred.PrivateKeyPath,
+			KeyType:        cred.KeyType,
 			DefaultKeyName: cred.Name,
 		}, true, nil
 	}
@@ -660,6 +678,7 @@ func listFromKeyring(kr keyring.Keyring) ([]Credential, error) {
 			KeyID:          payload.KeyID,
 			IssuerID:       payload.IssuerID,
 			PrivateKeyPath: payload.PrivateKeyPath,
+			KeyType:        payload.KeyType,
 			IsDefault:      name == defaultName,
 			Source:         "keychain",
 		})
@@ -674,6 +693,7 @@ func migrateLegacyCredentials(credentials []Credential) {
 			KeyID:          cred.KeyID,
 			IssuerID:       cred.IssuerID,
 			PrivateKeyPath: cred.PrivateKeyPath,
+			KeyType:        cred.KeyType,
 		}
 		if err := storeInKeychain(cred.Name, payload); err != nil {
 			continue
@@ -771,6 +791,7 @@ func storeInConfigAt(name string, payload credentialPayload, configPath string)
 			cfg.Keys[i].KeyID = payload.KeyID
 			cfg.Keys[i].IssuerID = payload.IssuerID
 			cfg.Keys[i].PrivateKeyPath = payload.PrivateKeyPath
+			cfg.Keys[i].KeyType = payload.KeyType
 			updated = true
 			break
 		}
@@ -781,12 +802,14 @@ func storeInConfigAt(name string, payload credentialPayload, configPath string)
 			KeyID:          payload.KeyID,
 			IssuerID:       payload.IssuerID,
 			PrivateKeyPath: payload.PrivateKeyPath,
+			KeyType:        payload.KeyType,
 		})
 	}
 
 	cfg.KeyID = payload.KeyID
 	cfg.IssuerID = payload.IssuerID
 	cfg.PrivateKeyPath = payload.PrivateKeyPath
+	cfg.KeyType = payload.KeyType
 	cfg.DefaultKeyName = name
 	return config.SaveAt(configPath, cfg)
 }
@@ -816,16 +839,20 @@ func hasAnyCredentials(cfg *config.Config) bool {
 }
 
 func isCompleteConfigCredential(cred config.Credential) bool {
-	return strings.TrimSpace(cred.KeyID) != "" &&
-		strings.TrimSpace(cred.IssuerID) != "" &&
-		strings.TrimSpace(cred.PrivateKeyPath) != ""
+	return isCompleteCredential(cred.KeyID, cred.IssuerID, cred.PrivateKeyPath, cred.KeyType)
 }
 
 func hasLegacyCredentials(cfg *config.Config) bool {
-	return cfg != nil &&
-		strings.TrimSpace(cfg.KeyID) != "" &&
-		strings.TrimSpace(cfg.IssuerID) != "" &&
-		strings.TrimSpace(cfg.PrivateKeyPath) != ""
+	return cfg != nil && isCompleteCredential(cfg.KeyID, cfg.IssuerID, cfg.PrivateKeyPath, cfg.KeyType)
+}
+
+// isCompleteCredential reports whether a credential has every field its key
+// type needs. Individual keys have no issuer ID.
+func isCompleteCredential(keyID, issuerID, keyPath, keyType string) bool {
+	if strings.TrimSpace(keyID) == "" || strings.TrimSpace(keyPath) == "" {
+		return false
+	}
+	return config.IsIndividualKeyType(keyType) || strings.TrimSpace(issuerID) != ""
 }
 
 func configCredentialList(cfg *config.Config) []config.Credential {
@@ -858,6 +885,7 @@ func configCredentialList(cfg *config.Config) []config.Credential {
 				KeyID:          cfg.KeyID,
 				IssuerID:       cfg.IssuerID,
 				PrivateKeyPath: cfg.PrivateKeyPath,
+				KeyType:        cfg.KeyType,
 			})
 		}
 	}
@@ -892,6 +920,7 @@ func findConfigCredential(cfg *config.Config, name string) (config.Credential, b
 			KeyID:          cfg.KeyID,
 			IssuerID:       cfg.IssuerID,
 			PrivateKeyPath: cfg.PrivateKeyPath,
+			KeyType:        cfg.KeyType,
 		}
 		return cred, true, isCompleteConfigCredential(cred)
 	}
@@ -904,6 +933,7 @@ func applyConfigCredential(cfg *config.Config, cred config.Credential) *config.C
 			KeyID:          cred.KeyID,
 			IssuerID:       cred.IssuerID,
 			PrivateKeyPath: cred.PrivateKeyPath,
+			KeyType:        cred.KeyType,
 			DefaultKeyName: strings.TrimSpace(cred.Name),
 		}
 	}
@@ -911,6 +941,7 @@ func applyConfigCredential(cfg *config.Config, cred config.Credential) *config.C
 	copied.KeyID = cred.KeyID
 	copied.IssuerID = cred.IssuerID
 	copied.PrivateKeyPath = cred.PrivateKeyPath
+	copied.KeyType = cred.KeyType
 	if strings.TrimSpace(cred.Name) != "" {
 		copied.DefaultKeyName = strings.TrimSpace(cred.Name)
 	}
@@ -1015,6 +1046,7 @@ func listFromConfig() ([]Credential, error) {
 			KeyID:          cred.KeyID,
 			IssuerID:       cred.IssuerID,
 			PrivateKeyPath: cred.PrivateKeyPath,
+			KeyType:        cred.KeyType,
 			IsDefault:      cred.Name == defaultName,
 			Source:         "config",
 			SourcePath:     path,
@@ -1051,6 +1083,7 @@ func saveDefaultName(name string) error {
 				cfg.KeyID = cred.KeyID
 				cfg.IssuerID = cred.IssuerID
 				cfg.PrivateKeyPath = cred.PrivateKeyPath
+				cfg.KeyType = cred.KeyType
 				return config.Save(cfg)
 			}
 		}
@@ -1059,6 +1092,7 @@ func saveDefaultName(name string) error {
 		cfg.KeyID = ""
 		cfg.IssuerID = ""
 		cfg.PrivateKeyPath = ""
+		cfg.KeyType = ""
 	}
 	return config.Save(cfg)
 }
@@ -1099,6 +1133,7 @@ func removeFromConfig(name string) error {
 		cfg.KeyID = ""
 		cfg.IssuerID = ""
 		cfg.PrivateKeyPath = ""
+		cfg.KeyType = ""
 		cfg.DefaultKeyName = ""
 		cfg.Keys = nil
 		return config.Save(cfg)
@@ -1121,6 +1156,7 @@ func removeFromConfig(name string) error {
 		cfg.KeyID = ""
 		cfg.IssuerID = ""
 		cfg.PrivateKeyPath = ""
+		cfg.KeyType = ""
 		cfg.DefaultKeyName = ""
 		removed = true
 	}
@@ -679,7 +679,7 @@ func TestLoadPrivateKeySEC1(t *testing.T) {
 func TestStoreAndListCredentials(t *testing.T) {
 	withArrayKeyring(t)
 
-	if err := StoreCredentials("my-key", "KEY123", "ISS456", "/tmp/AuthKey.p8"); err != nil {
+	if err := StoreCredentials("my-key", "KEY123", "ISS456", "/tmp/AuthKey.p8", config.KeyTypeTeam); err != nil {
 		t.Fatalf("StoreCredentials() error: %v", err)
 	}
 
@@ -701,7 +701,7 @@ func TestStoreAndListCredentials(t *testing.T) {
 func TestRemoveAllCredentials(t *testing.T) {
 	withArrayKeyring(t)
 
-	if err := StoreCredentials("my-key", "KEY123", "ISS456", "/tmp/AuthKey.p8"); err != nil {
+	if err := StoreCredentials("my-key", "KEY123", "ISS456", "/tmp/AuthKey.p8", config.KeyTypeTeam); err != nil {
 		t.Fatalf("StoreCredentials() error: %v", err)
 	}
 
@@ -732,7 +732,7 @@ func TestStoreCredentialsFallbackToConfig(t *testing.T) {
 		keyringOpener = previous
 	})
 
-	if err := StoreCredentials("test-fallback", "KEY123", "ISS456", "/tmp/AuthKey.p8"); err != nil {
+	if err := StoreCredentials("test-fallback", "KEY123", "ISS456", "/tmp/AuthKey.p8", config.KeyTypeTeam); err != nil {
 		t.Fatalf("StoreCredentials() error: %v", err)
 	}
 
@@ -25,7 +25,8 @@ Examples:
   asc analytics get --request-id "REQUEST_ID"
   asc analytics reports get --report-id "REPORT_ID"
   asc analytics instances relationships --instance-id "INSTANCE_ID"
-  asc analytics download --request-id "REQUEST_ID" --instance-id "INSTANCE_ID"`,
+  asc analytics download --request-id "REQUEST_ID" --instance-id "INSTANCE_ID"
+  asc analytics fetch --app "APP_ID" --category APP_USAGE --report "App Sessions" --granularity DAILY --since 30d`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
@@ -37,6 +38,7 @@ Examples:
 			AnalyticsInstancesCommand(),
 			AnalyticsSegmentsCommand(),
 			AnalyticsDownloadCommand(),
+			AnalyticsFetchCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
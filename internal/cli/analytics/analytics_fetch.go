@@ -0,0 +1,390 @@
+package analytics
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// defaultAnalyticsFetchWaitTimeout bounds --wait when neither --timeout nor
+// ASC_TIMEOUT is set; first instances of a new request can take two days.
+const defaultAnalyticsFetchWaitTimeout = 48 * time.Hour
+
+var analyticsSinceDaysPattern = regexp.MustCompile(`^(\d+)d$`)
+
+// AnalyticsFetchCommand requests, waits for, downloads and merges an analytics report.
+func AnalyticsFetchCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("fetch", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	category := fs.String("category", "", "Report category (e.g. APP_USAGE, APP_STORE_ENGAGEMENT)")
+	reportName := fs.String("report", "", "Report name (e.g. \"App Sessions\")")
+	granularity := fs.String("granularity", "DAILY", "Instance granularity: DAILY, WEEKLY, MONTHLY")
+	since := fs.String("since", "30d", "Start of the window: a number of days (e.g. 30d) or YYYY-MM-DD")
+	date := fs.String("date", "", "Fetch a single report date (YYYY-MM-DD) instead of --since")
+	out := fs.String("out", "", "Merged CSV path (default: analytics_{report}_{granularity}.csv)")
+	wait := fs.Bool("wait", false, "Wait until report instances are available")
+	pollInterval := fs.Duration("poll-interval", time.Minute, "Poll interval when waiting")
+	timeout := fs.Duration("timeout", 0, "Timeout for the whole fetch (e.g. 2h; default: ASC_TIMEOUT, or 48h with --wait)")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "fetch",
+		ShortUsage: "asc analytics fetch --app APP_ID --report NAME [flags]",
+		ShortHelp:  "Request, download and merge an analytics report.",
+		LongHelp: `Request, download and merge an analytics report.
+
+Reuses the app's ONGOING report request, or creates one, then downloads every
+segment of the matching report instances and merges them into a single CSV.
+The header is the union of all segment headers in first-seen order and
+duplicate rows are removed.
+
+Apple takes a day or two to generate the first instances of a new request;
+use --wait to poll until they are available.
+
+Examples:
+  asc analytics fetch --app "APP_ID" --category APP_USAGE --report "App Sessions" --granularity DAILY --since 30d
+  asc analytics fetch --app "APP_ID" --report "App Store Discovery and Engagement Detailed" --since 2024-01-01 --out engagement.csv
+  asc analytics fetch --app "APP_ID" --report "App Sessions" --date 2024-01-20
+  asc analytics fetch --app "APP_ID" --report "App Sessions" --wait --poll-interval 10m --timeout 48h`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
+				return flag.ErrHelp
+			}
+			name := strings.TrimSpace(*reportName)
+			if name == "" {
+				fmt.Fprintln(os.Stderr, "Error: --report is required")
+				return flag.ErrHelp
+			}
+			normalizedGranularity := strings.ToUpper(strings.TrimSpace(*granularity))
+			switch normalizedGranularity {
+			case "DAILY", "WEEKLY", "MONTHLY":
+			default:
+				return fmt.Errorf("analytics fetch: --granularity must be DAILY, WEEKLY, or MONTHLY")
+			}
+			if *timeout < 0 {
+				return fmt.Errorf("analytics fetch: --timeout must be greater than or equal to 0")
+			}
+			if *wait && *pollInterval <= 0 {
+				return fmt.Errorf("analytics fetch: --poll-interval must be greater than 0")
+			}
+
+			visited := map[string]bool{}
+			fs.Visit(func(f *flag.Flag) {
+				visited[f.Name] = true
+			})
+
+			now := time.Now().UTC()
+			from, to := "", now.Format("2006-01-02")
+			if strings.TrimSpace(*date) != "" {
+				if visited["since"] {
+					return fmt.Errorf("analytics fetch: --date and --since are mutually exclusive")
+				}
+				normalized, err := normalizeAnalyticsDateFilter(*date)
+				if err != nil {
+					return fmt.Errorf("analytics fetch: %w", err)
+				}
+				from, to = normalized, normalized
+			} else {
+				parsed, err := parseAnalyticsSince(*since, now)
+				if err != nil {
+					return fmt.Errorf("analytics fetch: %w", err)
+				}
+				from = parsed
+			}
+
+			outPath := strings.TrimSpace(*out)
+			if outPath == "" {
+				outPath = fmt.Sprintf("analytics_%s_%s.csv", analyticsReportSlug(name), strings.ToLower(normalizedGranularity))
+			}
+			if _, err := os.Lstat(outPath); err == nil {
+				return fmt.Errorf("analytics fetch: output file already exists: %s", outPath)
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("analytics fetch: %w", err)
+			}
+
+			var requestCtx context.Context
+			var cancel context.CancelFunc
+			switch {
+			case *timeout > 0:
+				requestCtx, cancel = shared.ContextWithTimeoutDuration(ctx, *timeout)
+			case *wait:
+				requestCtx, cancel = shared.ContextWithTimeoutDuration(ctx, asc.ResolveTimeoutWithDefault(defaultAnalyticsFetchWaitTimeout))
+			default:
+				requestCtx, cancel = shared.ContextWithTimeout(ctx)
+			}
+			defer cancel()
+
+			request, created, err := ensureOngoingAnalyticsRequest(requestCtx, client, resolvedAppID)
+			if err != nil {
+				return fmt.Errorf("analytics fetch: %w", err)
+			}
+
+			result := &asc.AnalyticsFetchResult{
+				AppID:          resolvedAppID,
+				RequestID:      request,
+				RequestCreated: created,
+				ReportName:     name,
+				Category:       strings.ToUpper(strings.TrimSpace(*category)),
+				Granularity:    normalizedGranularity,
+				From:           from,
+				To:             to,
+				FilePath:       outPath,
+			}
+
+			var ticker *time.Ticker
+			if *wait {
+				ticker = time.NewTicker(*pollInterval)
+				defer ticker.Stop()
+			}
+
+			var instances []asc.Resource[asc.AnalyticsReportInstanceAttributes]
+			for {
+				missing, found, err := findAnalyticsReport(requestCtx, client, request, name, result.Category)
+				if err != nil {
+					return fmt.Errorf("analytics fetch: %w", err)
+				}
+				if found != nil {
+					result.ReportID = found.ID
+					result.ReportName = found.Attributes.Name
+					result.Category = found.Attributes.Category
+					instances, err = matchingAnalyticsInstances(requestCtx, client, found.ID, normalizedGranularity, from, to)
+					if err != nil {
+						return fmt.Errorf("analytics fetch: failed to fetch instances: %w", err)
+					}
+					if len(instances) > 0 {
+						break
+					}
+				}
+
+				pending := fmt.Sprintf("no %s instances of %q between %s and %s yet", normalizedGranularity, name, from, to)
+				if found == nil {
+					pending = missing
+				}
+				if !*wait {
+					return fmt.Errorf("analytics fetch: %s (request %s; use --wait to poll)", pending, request)
+				}
+				fmt.Fprintf(os.Stderr, "Waiting: %s\n", pending)
+				select {
+				case <-requestCtx.Done():
+					if errors.Is(requestCtx.Err(), context.Canceled) {
+						return fmt.Errorf("analytics fetch: canceled waiting for report instances (%s)", pending)
+					}
+					return fmt.Errorf("analytics fetch: timed out waiting for report instances (%s)", pending)
+				case <-ticker.C:
+				}
+			}
+
+			table := newAnalyticsTable()
+			for _, instance := range instances {
+				segments, err := fetchAnalyticsReportSegments(requestCtx, client, instance.ID)
+				if err != nil {
+					return fmt.Errorf("analytics fetch: failed to fetch segments: %w", err)
+				}
+				for _, segment := range segments {
+					if err := addAnalyticsSegment(requestCtx, client, table, segment); err != nil {
+						return fmt.Errorf("analytics fetch: segment %s of instance %s: %w", segment.ID, instance.ID, err)
+					}
+					result.Segments++
+				}
+			}
+			if result.Segments == 0 {
+				return fmt.Errorf("analytics fetch: no segments available for %d matching instances", len(instances))
+			}
+
+			var buffer bytes.Buffer
+			if err := table.write(&buffer); err != nil {
+				return fmt.Errorf("analytics fetch: %w", err)
+			}
+			size, err := shared.WriteStreamToFile(outPath, &buffer)
+			if err != nil {
+				return fmt.Errorf("analytics fetch: failed to write %s: %w", outPath, err)
+			}
+
+			result.Instances = len(instances)
+			result.Rows = len(table.rows)
+			result.DuplicatesRemoved = table.duplicates
+			result.FileSize = size
+			return shared.PrintOutput(result, *output, *pretty)
+		},
+	}
+}
+
+// parseAnalyticsSince resolves --since to a YYYY-MM-DD date. "30d" means the
+// 30 days up to and including today.
+func parseAnalyticsSince(value string, now time.Time) (string, error) {
+	trimmed := strings.ToLower(strings.TrimSpace(value))
+	if match := analyticsSinceDaysPattern.FindStringSubmatch(trimmed); match != nil {
+		days, err := strconv.Atoi(match[1])
+		if err != nil || days < 1 {
+			return "", fmt.Errorf("--since must be at least 1d")
+		}
+		return now.AddDate(0, 0, -(days - 1)).Format("2006-01-02"), nil
+	}
+	parsed, err := time.Parse("2006-01-02", trimmed)
+	if err != nil {
+		return "", fmt.Errorf("--since must be a number of days (e.g. 30d) or YYYY-MM-DD")
+	}
+	if parsed.After(now) {
+		return "", fmt.Errorf("--since must not be in the future")
+	}
+	return parsed.Format("2006-01-02"), nil
+}
+
+func analyticsReportSlug(name string) string {
+	var builder strings.Builder
+	lastUnderscore := true
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			builder.WriteRune(r)
+			lastUnderscore = false
+		} else if !lastUnderscore {
+			builder.WriteRune('_')
+			lastUnderscore = true
+		}
+	}
+	return strings.TrimSuffix(builder.String(), "_")
+}
+
+// ensureOngoingAnalyticsRequest returns the app's active ONGOING request,
+// creating one when the app has none. Requests Apple stopped for inactivity
+// no longer produce instances and are skipped.
+func ensureOngoingAnalyticsRequest(ctx context.Context, client *asc.Client, appID string) (string, bool, error) {
+	resp, err := client.GetAnalyticsReportRequests(ctx, appID, asc.WithAnalyticsReportRequestsLimit(analyticsMaxLimit))
+	if err != nil {
+		return "", false, fmt.Errorf("failed to fetch requests: %w", err)
+	}
+	paginated, err := asc.PaginateAll(ctx, resp, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetAnalyticsReportRequests(ctx, appID, asc.WithAnalyticsReportRequestsNextURL(nextURL))
+	})
+	if err != nil {
+		return "", false, fmt.Errorf("failed to fetch requests: %w", err)
+	}
+	requests, ok := paginated.(*asc.AnalyticsReportRequestsResponse)
+	if !ok {
+		return "", false, fmt.Errorf("unexpected requests response type %T", paginated)
+	}
+	for _, request := range requests.Data {
+		attrs := request.Attributes
+		if attrs.AccessType != asc.AnalyticsAccessTypeOngoing {
+			continue
+		}
+		if attrs.StoppedDueToInactivity != nil && *attrs.StoppedDueToInactivity {
+			continue
+		}
+		return request.ID, false, nil
+	}
+
+	created, err := client.CreateAnalyticsReportRequest(ctx, appID, asc.AnalyticsAccessTypeOngoing)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to create request: %w", err)
+	}
+	return created.Data.ID, true, nil
+}
+
+// findAnalyticsReport looks up a report of the request by name (case
+// insensitive) and optionally category. When it is missing, the returned
+// message explains why.
+func findAnalyticsReport(ctx context.Context, client *asc.Client, requestID, name, category string) (string, *asc.Resource[asc.AnalyticsReportAttributes], error) {
+	reports, _, err := fetchAnalyticsReports(ctx, client, requestID, 0, "", true)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to fetch reports: %w", err)
+	}
+	if len(reports) == 0 {
+		return fmt.Sprintf("request %s has no reports yet", requestID), nil, nil
+	}
+	for i := range reports {
+		attrs := reports[i].Attributes
+		if !strings.EqualFold(strings.TrimSpace(attrs.Name), name) {
+			continue
+		}
+		if category != "" && !strings.EqualFold(attrs.Category, category) {
+			continue
+		}
+		return "", &reports[i], nil
+	}
+	if category != "" {
+		return "", nil, fmt.Errorf("report %q not found in category %s", name, category)
+	}
+	return "", nil, fmt.Errorf("report %q not found", name)
+}
+
+// matchingAnalyticsInstances returns the report's instances of a granularity
+// dated within [from, to], oldest first.
+func matchingAnalyticsInstances(ctx context.Context, client *asc.Client, reportID, granularity, from, to string) ([]asc.Resource[asc.AnalyticsReportInstanceAttributes], error) {
+	instances, err := fetchAnalyticsReportInstances(ctx, client, reportID)
+	if err != nil {
+		return nil, err
+	}
+	var matched []asc.Resource[asc.AnalyticsReportInstanceAttributes]
+	for _, instance := range instances {
+		attrs := instance.Attributes
+		if !strings.EqualFold(attrs.Granularity, granularity) {
+			continue
+		}
+		if !(from == to && matchAnalyticsInstanceDate(attrs, from)) && !analyticsInstanceOverlaps(attrs, granularity, from, to) {
+			continue
+		}
+		matched = append(matched, instance)
+	}
+	sort.SliceStable(matched, func(i, j int) bool {
+		return matched[i].Attributes.ReportDate < matched[j].Attributes.ReportDate
+	})
+	return matched, nil
+}
+
+// analyticsInstanceOverlaps reports whether any day of the instance's period
+// falls within [from, to]. Weekly and monthly instances are dated by the first
+// day of their period, so a range starting mid-period still includes them.
+func analyticsInstanceOverlaps(attrs asc.AnalyticsReportInstanceAttributes, granularity, from, to string) bool {
+	reportDate := attrs.ReportDate
+	if len(reportDate) > len("2006-01-02") {
+		reportDate = reportDate[:len("2006-01-02")]
+	}
+	start, err := time.Parse("2006-01-02", reportDate)
+	if err != nil {
+		return false
+	}
+	end := start
+	switch strings.ToUpper(granularity) {
+	case "WEEKLY":
+		end = start.AddDate(0, 0, 6)
+	case "MONTHLY":
+		end = start.AddDate(0, 1, -1)
+	}
+	return end.Format("2006-01-02") >= from && start.Format("2006-01-02") <= to
+}
+
+func addAnalyticsSegment(ctx context.Context, client *asc.Client, table *analyticsTable, segment asc.Resource[asc.AnalyticsReportSegmentAttributes]) error {
+	downloadURL := strings.TrimSpace(segment.Attributes.URL)
+	if downloadURL == "" {
+		return fmt.Errorf("segment download URL is empty")
+	}
+	download, err := client.DownloadAnalyticsReport(ctx, downloadURL)
+	if err != nil {
+		return err
+	}
+	defer download.Body.Close()
+	return table.add(download.Body)
+}
@@ -0,0 +1,101 @@
+package analytics
+
+import (
+	"bytes"
+	"compress/gzip"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestAnalyticsTableMergesHeadersAndRemovesDuplicates(t *testing.T) {
+	table := newAnalyticsTable()
+
+	var compressed bytes.Buffer
+	writer := gzip.NewWriter(&compressed)
+	writer.Write([]byte("\ufeffDate,Territory,Sessions\n2024-01-20,US,5\n2024-01-20,DE,3\n"))
+	writer.Close()
+	if err := table.add(&compressed); err != nil {
+		t.Fatalf("add gzip segment: %v", err)
+	}
+	// Reordered columns, a duplicate row and a new column.
+	if err := table.add(strings.NewReader("Sessions,Date,Territory,Device\n3,2024-01-20,DE,\n4,2024-01-21,US,iPhone\n")); err != nil {
+		t.Fatalf("add plain segment: %v", err)
+	}
+	if err := table.add(strings.NewReader("")); err != nil {
+		t.Fatalf("add empty segment: %v", err)
+	}
+
+	var out bytes.Buffer
+	if err := table.write(&out); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	want := "Date,Territory,Sessions,Device\n2024-01-20,US,5,\n2024-01-20,DE,3,\n2024-01-21,US,4,iPhone\n"
+	if out.String() != want {
+		t.Fatalf("unexpected merge:\n%q\nwant\n%q", out.String(), want)
+	}
+	if table.duplicates != 1 {
+		t.Fatalf("expected 1 duplicate, got %d", table.duplicates)
+	}
+}
+
+func TestParseAnalyticsSince(t *testing.T) {
+	now := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{value: "1d", want: "2024-03-10"},
+		{value: "30D", want: "2024-02-10"},
+		{value: "2024-01-01", want: "2024-01-01"},
+		{value: "0d", wantErr: true},
+		{value: "2024-04-01", wantErr: true},
+		{value: "yesterday", wantErr: true},
+	}
+	for _, test := range tests {
+		got, err := parseAnalyticsSince(test.value, now)
+		if test.wantErr {
+			if err == nil {
+				t.Fatalf("parseAnalyticsSince(%q) expected error, got %q", test.value, got)
+			}
+			continue
+		}
+		if err != nil || got != test.want {
+			t.Fatalf("parseAnalyticsSince(%q) = %q, %v; want %q", test.value, got, err, test.want)
+		}
+	}
+}
+
+func TestAnalyticsInstanceOverlaps(t *testing.T) {
+	tests := []struct {
+		granularity string
+		reportDate  string
+		from, to    string
+		want        bool
+	}{
+		{"DAILY", "2024-01-20", "2024-01-20", "2024-01-31", true},
+		{"DAILY", "2024-01-19", "2024-01-20", "2024-01-31", false},
+		{"WEEKLY", "2024-01-15", "2024-01-20", "2024-01-31", true},
+		{"WEEKLY", "2024-01-08", "2024-01-20", "2024-01-31", false},
+		{"MONTHLY", "2024-01-01", "2024-01-20", "2024-02-10", true},
+		{"MONTHLY", "2024-01-01T00:00:00Z", "2024-01-15", "2024-01-15", true},
+		{"MONTHLY", "2023-12-01", "2024-01-01", "2024-01-31", false},
+		{"MONTHLY", "2024-02-01", "2024-01-01", "2024-01-31", false},
+		{"DAILY", "not-a-date", "2024-01-01", "2024-01-31", false},
+	}
+	for _, test := range tests {
+		attrs := asc.AnalyticsReportInstanceAttributes{Granularity: test.granularity, ReportDate: test.reportDate}
+		if got := analyticsInstanceOverlaps(attrs, test.granularity, test.from, test.to); got != test.want {
+			t.Fatalf("analyticsInstanceOverlaps(%s %s, %s..%s) = %t, want %t", test.granularity, test.reportDate, test.from, test.to, got, test.want)
+		}
+	}
+}
+
+func TestAnalyticsReportSlug(t *testing.T) {
+	if got := analyticsReportSlug("App Store Discovery & Engagement (Detailed)"); got != "app_store_discovery_engagement_detailed" {
+		t.Fatalf("unexpected slug %q", got)
+	}
+}
@@ -0,0 +1,129 @@
+package analytics
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// analyticsTable merges analytics report segments into one table. The
+// header is the first segment's columns followed by columns that only later
+// segments have; identical rows are kept once.
+type analyticsTable struct {
+	delimiter  rune
+	header     []string
+	columns    map[string]int
+	rows       [][]string
+	seen       map[string]bool
+	duplicates int
+}
+
+func newAnalyticsTable() *analyticsTable {
+	return &analyticsTable{columns: make(map[string]int), seen: make(map[string]bool)}
+}
+
+// add reads a segment, gzip compressed or not, tab or comma separated.
+func (t *analyticsTable) add(reader io.Reader) error {
+	buffered := bufio.NewReader(reader)
+	if magic, err := buffered.Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(buffered)
+		if err != nil {
+			return err
+		}
+		defer gz.Close()
+		buffered = bufio.NewReader(gz)
+	}
+
+	firstLine, err := buffered.Peek(4096)
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		return err
+	}
+	if len(strings.TrimSpace(string(firstLine))) == 0 {
+		return nil
+	}
+	delimiter := ','
+	if line, _, _ := strings.Cut(string(firstLine), "\n"); strings.Contains(line, "\t") {
+		delimiter = '\t'
+	}
+	if t.delimiter == 0 {
+		t.delimiter = delimiter
+	}
+
+	csvReader := csv.NewReader(buffered)
+	csvReader.Comma = delimiter
+	csvReader.FieldsPerRecord = -1
+	csvReader.LazyQuotes = true
+
+	header, err := csvReader.Read()
+	if err != nil {
+		return fmt.Errorf("read header: %w", err)
+	}
+	positions := make([]int, len(header))
+	for i, name := range header {
+		name = strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))
+		position, ok := t.columns[name]
+		if !ok {
+			position = len(t.header)
+			t.header = append(t.header, name)
+			t.columns[name] = position
+		}
+		positions[i] = position
+	}
+
+	for {
+		record, err := csvReader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if len(record) == 1 && strings.TrimSpace(record[0]) == "" {
+			continue
+		}
+		row := make([]string, len(t.header))
+		for i, value := range record {
+			if i < len(positions) {
+				row[positions[i]] = value
+			}
+		}
+		// Trailing empty values are dropped from the key so rows read before
+		// a later segment added columns still match.
+		trimmed := row
+		for len(trimmed) > 0 && trimmed[len(trimmed)-1] == "" {
+			trimmed = trimmed[:len(trimmed)-1]
+		}
+		key := strings.Join(trimmed, "\x1f")
+		if t.seen[key] {
+			t.duplicates++
+			continue
+		}
+		t.seen[key] = true
+		t.rows = append(t.rows, row)
+	}
+}
+
+// write writes the merged table using the first segment's delimiter.
+func (t *analyticsTable) write(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if t.delimiter != 0 {
+		writer.Comma = t.delimiter
+	}
+	if err := writer.Write(t.header); err != nil {
+		return err
+	}
+	for _, row := range t.rows {
+		// Rows read before a later segment added columns are shorter.
+		for len(row) < len(t.header) {
+			row = append(row, "")
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
@@ -0,0 +1,328 @@
+package analytics
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// salesStoreManifestFile records which vendor and report version a sales
+// store holds.
+const salesStoreManifestFile = "store.json"
+
+// salesStoreColumns is the normalized CSV layout of stored reports. Proceeds
+// are extended (units × developer proceeds) so rows can be summed directly.
+var salesStoreColumns = []string{
+	"date", "sku", "title", "apple_identifier", "parent_identifier", "product_type",
+	"country", "device", "units", "proceeds", "proceeds_currency", "customer_price", "customer_currency",
+}
+
+type salesStoreManifest struct {
+	VendorNumber string                 `json:"vendorNumber"`
+	Version      asc.SalesReportVersion `json:"version,omitempty"`
+}
+
+// salesStoreRow is a normalized report line as stored on disk.
+type salesStoreRow struct {
+	Date             string
+	SKU              string
+	Title            string
+	AppleIdentifier  string
+	ParentIdentifier string
+	ProductType      string
+	Country          string
+	Device           string
+	Units            int
+	Proceeds         float64
+	ProceedsCurrency string
+	CustomerPrice    float64
+	CustomerCurrency string
+}
+
+// salesStore is a directory of normalized sales reports laid out as
+// <dir>/<FREQUENCY>/<report date>.csv. A file without rows marks a date
+// without sales, so every present file counts as synced.
+type salesStore struct {
+	dir string
+}
+
+// openSalesStore opens or creates a store, refusing stores of another vendor
+// or report version. Stores written before the version was recorded hold 1_0
+// reports.
+func openSalesStore(dir, vendorNumber string, version asc.SalesReportVersion) (*salesStore, error) {
+	manifestPath := filepath.Join(dir, salesStoreManifestFile)
+	data, err := os.ReadFile(manifestPath)
+	switch {
+	case err == nil:
+		var manifest salesStoreManifest
+		if err := json.Unmarshal(data, &manifest); err != nil {
+			return nil, fmt.Errorf("parse %s: %w", manifestPath, err)
+		}
+		if manifest.VendorNumber != vendorNumber {
+			return nil, fmt.Errorf("store %s belongs to vendor %s, not %s", dir, manifest.VendorNumber, vendorNumber)
+		}
+		if manifest.Version == "" {
+			manifest.Version = asc.SalesReportVersion1_0
+		}
+		if manifest.Version != version {
+			return nil, fmt.Errorf("store %s holds version %s reports, not %s", dir, manifest.Version, version)
+		}
+	case errors.Is(err, os.ErrNotExist):
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, err
+		}
+		data, err := json.MarshalIndent(salesStoreManifest{VendorNumber: vendorNumber, Version: version}, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		if err := os.WriteFile(manifestPath, append(data, '\n'), 0o644); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, err
+	}
+	return &salesStore{dir: dir}, nil
+}
+
+// existingSalesStore opens a store for reading.
+func existingSalesStore(dir string) (*salesStore, error) {
+	if _, err := os.Stat(filepath.Join(dir, salesStoreManifestFile)); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("%s not found in %s (run 'asc analytics sales sync' first)", salesStoreManifestFile, dir)
+		}
+		return nil, err
+	}
+	return &salesStore{dir: dir}, nil
+}
+
+func (s *salesStore) path(frequency asc.SalesReportFrequency, date string) string {
+	return filepath.Join(s.dir, string(frequency), date+".csv")
+}
+
+func (s *salesStore) has(frequency asc.SalesReportFrequency, date string) bool {
+	_, err := os.Stat(s.path(frequency, date))
+	return err == nil
+}
+
+// write stores a report's lines, replacing the file atomically so an
+// interrupted sync never leaves a partial date behind.
+func (s *salesStore) write(frequency asc.SalesReportFrequency, date string, lines []asc.SalesReportLine) error {
+	path := s.path(frequency, date)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+date+"-*.csv")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	writer := csv.NewWriter(tmp)
+	if err := writer.Write(salesStoreColumns); err != nil {
+		tmp.Close()
+		return err
+	}
+	for _, line := range lines {
+		record := []string{
+			date,
+			line.SKU,
+			line.Title,
+			line.AppleIdentifier,
+			line.ParentIdentifier,
+			line.ProductTypeIdentifier,
+			line.CountryCode,
+			line.Device,
+			strconv.Itoa(line.Units),
+			formatStoreAmount(float64(line.Units) * line.DeveloperProceeds),
+			line.ProceedsCurrency,
+			formatStoreAmount(line.CustomerPrice),
+			line.CustomerCurrency,
+		}
+		if err := writer.Write(record); err != nil {
+			tmp.Close()
+			return err
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// dates returns the stored report dates of a frequency within [from, to];
+// empty bounds are open.
+func (s *salesStore) dates(frequency asc.SalesReportFrequency, from, to string) ([]string, error) {
+	entries, err := os.ReadDir(filepath.Join(s.dir, string(frequency)))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var dates []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || strings.HasPrefix(name, ".") || filepath.Ext(name) != ".csv" {
+			continue
+		}
+		date := strings.TrimSuffix(name, ".csv")
+		if (from != "" && date < from) || (to != "" && date > to) {
+			continue
+		}
+		dates = append(dates, date)
+	}
+	sort.Strings(dates)
+	return dates, nil
+}
+
+// read returns the rows stored for a report date.
+func (s *salesStore) read(frequency asc.SalesReportFrequency, date string) ([]salesStoreRow, error) {
+	path := s.path(frequency, date)
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[name] = i
+	}
+	field := func(record []string, name string) string {
+		if index, ok := columns[name]; ok && index < len(record) {
+			return record[index]
+		}
+		return ""
+	}
+
+	var rows []salesStoreRow
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		row := salesStoreRow{
+			Date:             field(record, "date"),
+			SKU:              field(record, "sku"),
+			Title:            field(record, "title"),
+			AppleIdentifier:  field(record, "apple_identifier"),
+			ParentIdentifier: field(record, "parent_identifier"),
+			ProductType:      field(record, "product_type"),
+			Country:          field(record, "country"),
+			Device:           field(record, "device"),
+			ProceedsCurrency: field(record, "proceeds_currency"),
+			CustomerCurrency: field(record, "customer_currency"),
+		}
+		if row.Units, err = strconv.Atoi(field(record, "units")); err != nil {
+			return nil, fmt.Errorf("%s: invalid units: %w", path, err)
+		}
+		if row.Proceeds, err = strconv.ParseFloat(field(record, "proceeds"), 64); err != nil {
+			return nil, fmt.Errorf("%s: invalid proceeds: %w", path, err)
+		}
+		if row.CustomerPrice, err = strconv.ParseFloat(field(record, "customer_price"), 64); err != nil {
+			return nil, fmt.Errorf("%s: invalid customer price: %w", path, err)
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func formatStoreAmount(value float64) string {
+	return strconv.FormatFloat(math.Round(value*10000)/10000, 'f', -1, 64)
+}
+
+// salesReportDateLayout returns the report date layout of a frequency.
+func salesReportDateLayout(frequency asc.SalesReportFrequency) string {
+	switch frequency {
+	case asc.SalesReportFrequencyMonthly:
+		return "2006-01"
+	case asc.SalesReportFrequencyYearly:
+		return "2006"
+	default:
+		return "2006-01-02"
+	}
+}
+
+// salesReportDates returns the report dates of a frequency between from and
+// to inclusive. Weekly reports are dated by the Sunday that ends the week.
+func salesReportDates(frequency asc.SalesReportFrequency, from, to string) ([]string, error) {
+	layout := salesReportDateLayout(frequency)
+	start, err := time.Parse(layout, from)
+	if err != nil {
+		return nil, err
+	}
+	end, err := time.Parse(layout, to)
+	if err != nil {
+		return nil, err
+	}
+	if end.Before(start) {
+		return nil, fmt.Errorf("--to must not be before --from")
+	}
+
+	next := func(t time.Time) time.Time { return t.AddDate(0, 0, 1) }
+	switch frequency {
+	case asc.SalesReportFrequencyWeekly:
+		for start.Weekday() != time.Sunday {
+			start = start.AddDate(0, 0, 1)
+		}
+		next = func(t time.Time) time.Time { return t.AddDate(0, 0, 7) }
+	case asc.SalesReportFrequencyMonthly:
+		next = func(t time.Time) time.Time { return t.AddDate(0, 1, 0) }
+	case asc.SalesReportFrequencyYearly:
+		next = func(t time.Time) time.Time { return t.AddDate(1, 0, 0) }
+	}
+
+	var dates []string
+	for current := start; !current.After(end); current = next(current) {
+		dates = append(dates, current.Format(layout))
+	}
+	return dates, nil
+}
+
+// lastAvailableSalesReportDate returns the most recent report date of a
+// frequency that Apple can have generated at now: yesterday for daily
+// reports, the last Sunday before today for weekly reports, and the previous
+// month or year otherwise.
+func lastAvailableSalesReportDate(frequency asc.SalesReportFrequency, now time.Time) string {
+	now = now.UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	layout := salesReportDateLayout(frequency)
+	switch frequency {
+	case asc.SalesReportFrequencyWeekly:
+		last := today.AddDate(0, 0, -1)
+		for last.Weekday() != time.Sunday {
+			last = last.AddDate(0, 0, -1)
+		}
+		return last.Format(layout)
+	case asc.SalesReportFrequencyMonthly:
+		return time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, -1, 0).Format(layout)
+	case asc.SalesReportFrequencyYearly:
+		return time.Date(today.Year()-1, 1, 1, 0, 0, 0, 0, time.UTC).Format(layout)
+	default:
+		return today.AddDate(0, 0, -1).Format(layout)
+	}
+}
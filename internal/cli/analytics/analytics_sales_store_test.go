@@ -0,0 +1,133 @@
+package analytics
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestSalesReportDates(t *testing.T) {
+	tests := []struct {
+		name      string
+		frequency asc.SalesReportFrequency
+		from      string
+		to        string
+		want      []string
+	}{
+		{name: "daily", frequency: asc.SalesReportFrequencyDaily, from: "2024-02-28", to: "2024-03-01", want: []string{"2024-02-28", "2024-02-29", "2024-03-01"}},
+		{name: "weekly sundays", frequency: asc.SalesReportFrequencyWeekly, from: "2024-01-02", to: "2024-01-21", want: []string{"2024-01-07", "2024-01-14", "2024-01-21"}},
+		{name: "monthly", frequency: asc.SalesReportFrequencyMonthly, from: "2024-11", to: "2025-01", want: []string{"2024-11", "2024-12", "2025-01"}},
+		{name: "yearly", frequency: asc.SalesReportFrequencyYearly, from: "2023", to: "2024", want: []string{"2023", "2024"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := salesReportDates(test.frequency, test.from, test.to)
+			if err != nil {
+				t.Fatalf("salesReportDates() error: %v", err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Fatalf("expected %v, got %v", test.want, got)
+			}
+		})
+	}
+	if _, err := salesReportDates(asc.SalesReportFrequencyDaily, "2024-01-02", "2024-01-01"); err == nil {
+		t.Fatal("expected error when --to is before --from")
+	}
+}
+
+func TestLastAvailableSalesReportDate(t *testing.T) {
+	now := time.Date(2024, 3, 13, 15, 0, 0, 0, time.UTC) // a Wednesday
+	tests := []struct {
+		frequency asc.SalesReportFrequency
+		want      string
+	}{
+		{frequency: asc.SalesReportFrequencyDaily, want: "2024-03-12"},
+		{frequency: asc.SalesReportFrequencyWeekly, want: "2024-03-10"},
+		{frequency: asc.SalesReportFrequencyMonthly, want: "2024-02"},
+		{frequency: asc.SalesReportFrequencyYearly, want: "2023"},
+	}
+	for _, test := range tests {
+		if got := lastAvailableSalesReportDate(test.frequency, now); got != test.want {
+			t.Fatalf("%s: expected %s, got %s", test.frequency, test.want, got)
+		}
+	}
+	sunday := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	if got := lastAvailableSalesReportDate(asc.SalesReportFrequencyWeekly, sunday); got != "2024-03-03" {
+		t.Fatalf("expected the week ending today to be unavailable, got %s", got)
+	}
+}
+
+func TestSalesStoreRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	store, err := openSalesStore(dir, "12345678", asc.SalesReportVersion1_0)
+	if err != nil {
+		t.Fatalf("openSalesStore() error: %v", err)
+	}
+	lines := []asc.SalesReportLine{
+		{SKU: "pro", Title: "Pro, \"Deluxe\"", CountryCode: "US", Device: "iPhone", Units: 3, DeveloperProceeds: 0.7, ProceedsCurrency: "USD", CustomerPrice: 0.99},
+	}
+	if err := store.write(asc.SalesReportFrequencyDaily, "2024-01-20", lines); err != nil {
+		t.Fatalf("write() error: %v", err)
+	}
+	if err := store.write(asc.SalesReportFrequencyDaily, "2024-01-21", nil); err != nil {
+		t.Fatalf("write() error: %v", err)
+	}
+
+	if !store.has(asc.SalesReportFrequencyDaily, "2024-01-21") {
+		t.Fatal("expected empty date to count as stored")
+	}
+	dates, err := store.dates(asc.SalesReportFrequencyDaily, "2024-01-20", "")
+	if err != nil || !reflect.DeepEqual(dates, []string{"2024-01-20", "2024-01-21"}) {
+		t.Fatalf("unexpected dates %v (err %v)", dates, err)
+	}
+	rows, err := store.read(asc.SalesReportFrequencyDaily, "2024-01-20")
+	if err != nil {
+		t.Fatalf("read() error: %v", err)
+	}
+	want := salesStoreRow{Date: "2024-01-20", SKU: "pro", Title: "Pro, \"Deluxe\"", Country: "US", Device: "iPhone", Units: 3, Proceeds: 2.1, ProceedsCurrency: "USD", CustomerPrice: 0.99}
+	if len(rows) != 1 || !reflect.DeepEqual(rows[0], want) {
+		t.Fatalf("unexpected rows: %+v", rows)
+	}
+
+	if _, err := openSalesStore(dir, "87654321", asc.SalesReportVersion1_0); err == nil {
+		t.Fatal("expected error opening another vendor's store")
+	}
+	if _, err := openSalesStore(dir, "12345678", asc.SalesReportVersion1_1); err == nil {
+		t.Fatal("expected error opening a store of another report version")
+	}
+}
+
+func TestOpenSalesStore_UnversionedManifestHoldsVersion1_0(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, salesStoreManifestFile), []byte(`{"vendorNumber": "12345678"}`), 0o644); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+	if _, err := openSalesStore(dir, "12345678", asc.SalesReportVersion1_0); err != nil {
+		t.Fatalf("openSalesStore() error: %v", err)
+	}
+	if _, err := openSalesStore(dir, "12345678", asc.SalesReportVersion1_1); err == nil {
+		t.Fatal("expected error syncing 1_1 reports into a 1_0 store")
+	}
+}
+
+func TestAggregateSalesRows(t *testing.T) {
+	rows := []salesStoreRow{
+		{Date: "2024-01-20", SKU: "pro", Country: "US", Units: 3, Proceeds: 2.1, ProceedsCurrency: "USD"},
+		{Date: "2024-01-21", SKU: "pro", Country: "US", Units: 1, Proceeds: 0.7, ProceedsCurrency: "USD"},
+		{Date: "2024-01-21", SKU: "pro", Country: "DE", Units: 2, Proceeds: 1.2, ProceedsCurrency: "EUR"},
+		{Date: "2024-01-21", SKU: "lite", Country: "US", Units: 5, Proceeds: 0, ProceedsCurrency: "USD"},
+	}
+	got := aggregateSalesRows(rows, []string{"sku"})
+	want := []asc.SalesQueryRow{
+		{SKU: "lite", Currency: "USD", Units: 5},
+		{SKU: "pro", Currency: "EUR", Units: 2, Proceeds: 1.2},
+		{SKU: "pro", Currency: "USD", Units: 4, Proceeds: 2.8},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %+v, got %+v", want, got)
+	}
+}
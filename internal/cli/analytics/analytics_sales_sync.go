@@ -0,0 +1,372 @@
+package analytics
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// salesQueryGroupKeys are the accepted --group-by keys of sales query.
+var salesQueryGroupKeys = []string{"date", "sku", "title", "country", "device", "product-type"}
+
+// AnalyticsSalesSyncCommand downloads a range of sales reports into a local store.
+func AnalyticsSalesSyncCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("sync", flag.ExitOnError)
+
+	vendor := fs.String("vendor", "", "Vendor number (or ASC_VENDOR_NUMBER/ASC_ANALYTICS_VENDOR_NUMBER env)")
+	store := fs.String("store", "", "Store directory (required)")
+	frequency := fs.String("frequency", string(asc.SalesReportFrequencyDaily), "Frequency: DAILY, WEEKLY, MONTHLY, YEARLY")
+	from := fs.String("from", "", "First report date: daily/weekly YYYY-MM-DD, monthly YYYY-MM, yearly YYYY (required)")
+	to := fs.String("to", "", "Last report date, same format as --from (default: --from)")
+	version := fs.String("version", "1_0", "Report format version: 1_0 (default), 1_1")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "sync",
+		ShortUsage: "asc analytics sales sync --store DIR --from DATE [--to DATE] [flags]",
+		ShortHelp:  "Download missing SALES summary reports into a local store.",
+		LongHelp: `Download missing SALES summary reports into a local store.
+
+Each report date is stored as normalized CSV in <store>/<FREQUENCY>/<date>.csv.
+A store holds one vendor and one report --version; syncing another version
+into it is refused, so use a separate store per version.
+Dates already in the store are skipped, so re-running a sync only downloads
+what is missing. The range stops at the last report Apple can have generated
+(yesterday for daily reports). Dates without sales are stored as empty files,
+except the most recent one. Reports Apple has not generated yet are listed as
+pending and picked up by the next sync.
+
+Weekly reports are dated by the Sunday that ends the week.
+
+Examples:
+  asc analytics sales sync --vendor "12345678" --store ./sales --from 2025-01-01 --to 2025-12-31
+  asc analytics sales sync --vendor "12345678" --store ./sales --frequency MONTHLY --from 2025-01 --to 2025-12
+  asc analytics sales query --store ./sales --group-by sku,country --output table`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			vendorNumber := shared.ResolveVendorNumber(*vendor)
+			if vendorNumber == "" {
+				fmt.Fprintln(os.Stderr, "Error: --vendor is required (or set ASC_VENDOR_NUMBER/ASC_ANALYTICS_VENDOR_NUMBER)")
+				return flag.ErrHelp
+			}
+			storeDir := strings.TrimSpace(*store)
+			if storeDir == "" {
+				fmt.Fprintln(os.Stderr, "Error: --store is required")
+				return flag.ErrHelp
+			}
+			if strings.TrimSpace(*from) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --from is required")
+				return flag.ErrHelp
+			}
+
+			freq, err := normalizeSalesReportFrequency(*frequency)
+			if err != nil {
+				return fmt.Errorf("analytics sales sync: %w", err)
+			}
+			fromDate, err := normalizeReportDate(*from, freq)
+			if err != nil {
+				return fmt.Errorf("analytics sales sync: %s", strings.Replace(err.Error(), "--date", "--from", 1))
+			}
+			toDate := fromDate
+			if strings.TrimSpace(*to) != "" {
+				if toDate, err = normalizeReportDate(*to, freq); err != nil {
+					return fmt.Errorf("analytics sales sync: %s", strings.Replace(err.Error(), "--date", "--to", 1))
+				}
+			}
+			reportVersion, err := normalizeSalesReportVersion(*version)
+			if err != nil {
+				return fmt.Errorf("analytics sales sync: %w", err)
+			}
+			lastAvailable := lastAvailableSalesReportDate(freq, time.Now())
+			if fromDate > lastAvailable {
+				return fmt.Errorf("analytics sales sync: --from %s is after the last available report date %s", fromDate, lastAvailable)
+			}
+			if toDate > lastAvailable {
+				toDate = lastAvailable
+			}
+			dates, err := salesReportDates(freq, fromDate, toDate)
+			if err != nil {
+				return fmt.Errorf("analytics sales sync: %w", err)
+			}
+
+			salesStore, err := openSalesStore(storeDir, vendorNumber, reportVersion)
+			if err != nil {
+				return fmt.Errorf("analytics sales sync: %w", err)
+			}
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("analytics sales sync: %w", err)
+			}
+
+			result := &asc.SalesSyncResult{
+				Store:        storeDir,
+				VendorNumber: vendorNumber,
+				Frequency:    string(freq),
+				From:         fromDate,
+				To:           toDate,
+			}
+			for _, date := range dates {
+				if salesStore.has(freq, date) {
+					result.Skipped++
+					continue
+				}
+				lines, err := downloadSalesReport(ctx, client, asc.SalesReportParams{
+					VendorNumber:  vendorNumber,
+					ReportType:    asc.SalesReportTypeSales,
+					ReportSubType: asc.SalesReportSubTypeSummary,
+					Frequency:     freq,
+					ReportDate:    date,
+					Version:       reportVersion,
+				})
+				switch {
+				case asc.IsSalesReportNoSales(err) && date < lastAvailable:
+					lines = nil
+					result.Empty++
+				case asc.IsNotFound(err):
+					// Not generated yet, or too recent to be sure it stays
+					// empty: leave the date out of the store so the next
+					// sync asks again.
+					result.Pending = append(result.Pending, date)
+					continue
+				case err != nil:
+					return fmt.Errorf("analytics sales sync: %s: %w", date, err)
+				default:
+					result.Downloaded++
+					result.Rows += len(lines)
+				}
+				if err := salesStore.write(freq, date, lines); err != nil {
+					return fmt.Errorf("analytics sales sync: %s: %w", date, err)
+				}
+			}
+
+			return shared.PrintOutput(result, *output, *pretty)
+		},
+	}
+}
+
+func downloadSalesReport(ctx context.Context, client *asc.Client, params asc.SalesReportParams) ([]asc.SalesReportLine, error) {
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+
+	download, err := client.GetSalesReport(requestCtx, params)
+	if err != nil {
+		return nil, err
+	}
+	defer download.Body.Close()
+	return asc.ParseSalesReport(download.Body)
+}
+
+// AnalyticsSalesQueryCommand aggregates reports from a local sales store.
+func AnalyticsSalesQueryCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("query", flag.ExitOnError)
+
+	store := fs.String("store", "", "Store directory (required)")
+	frequency := fs.String("frequency", string(asc.SalesReportFrequencyDaily), "Frequency: DAILY, WEEKLY, MONTHLY, YEARLY")
+	from := fs.String("from", "", "First report date to include, same format as sync")
+	to := fs.String("to", "", "Last report date to include, same format as sync")
+	groupBy := fs.String("group-by", "sku", "Group by: "+strings.Join(salesQueryGroupKeys, ", "))
+	skus := fs.String("sku", "", "Only include these SKUs, comma-separated")
+	countries := fs.String("country", "", "Only include these country codes, comma-separated")
+	devices := fs.String("device", "", "Only include these devices, comma-separated (e.g. iPhone,iPad)")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "query",
+		ShortUsage: "asc analytics sales query --store DIR [flags]",
+		ShortHelp:  "Aggregate units and proceeds from a local sales store.",
+		LongHelp: `Aggregate units and proceeds from a local sales store.
+
+Reads reports written by 'asc analytics sales sync' without calling the API.
+Proceeds are summed per proceeds currency, so each group has one row per
+currency.
+
+Examples:
+  asc analytics sales query --store ./sales --output table
+  asc analytics sales query --store ./sales --from 2025-07-01 --to 2025-09-30 --group-by date,country --output csv
+  asc analytics sales query --store ./sales --group-by device --sku "com.example.pro" --country US,CA --output markdown
+  asc analytics sales query --store ./sales --frequency MONTHLY --group-by date,sku`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			storeDir := strings.TrimSpace(*store)
+			if storeDir == "" {
+				fmt.Fprintln(os.Stderr, "Error: --store is required")
+				return flag.ErrHelp
+			}
+			groups, err := parseSalesQueryGroupBy(*groupBy)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				return flag.ErrHelp
+			}
+
+			freq, err := normalizeSalesReportFrequency(*frequency)
+			if err != nil {
+				return fmt.Errorf("analytics sales query: %w", err)
+			}
+			fromDate, toDate := "", ""
+			if strings.TrimSpace(*from) != "" {
+				if fromDate, err = normalizeReportDate(*from, freq); err != nil {
+					return fmt.Errorf("analytics sales query: %s", strings.Replace(err.Error(), "--date", "--from", 1))
+				}
+			}
+			if strings.TrimSpace(*to) != "" {
+				if toDate, err = normalizeReportDate(*to, freq); err != nil {
+					return fmt.Errorf("analytics sales query: %s", strings.Replace(err.Error(), "--date", "--to", 1))
+				}
+			}
+
+			salesStore, err := existingSalesStore(storeDir)
+			if err != nil {
+				return fmt.Errorf("analytics sales query: %w", err)
+			}
+			dates, err := salesStore.dates(freq, fromDate, toDate)
+			if err != nil {
+				return fmt.Errorf("analytics sales query: %w", err)
+			}
+
+			filter := salesQueryFilter{
+				skus:      stringSet(shared.SplitCSV(*skus), false),
+				countries: stringSet(shared.SplitCSV(*countries), true),
+				devices:   stringSet(shared.SplitCSV(*devices), true),
+			}
+			var rows []salesStoreRow
+			for _, date := range dates {
+				stored, err := salesStore.read(freq, date)
+				if err != nil {
+					return fmt.Errorf("analytics sales query: %w", err)
+				}
+				for _, row := range stored {
+					if filter.matches(row) {
+						rows = append(rows, row)
+					}
+				}
+			}
+
+			result := &asc.SalesQueryResult{
+				Store:     storeDir,
+				Frequency: string(freq),
+				From:      fromDate,
+				To:        toDate,
+				Reports:   len(dates),
+				GroupBy:   groups,
+				Rows:      aggregateSalesRows(rows, groups),
+			}
+			return shared.PrintOutput(result, *output, *pretty)
+		},
+	}
+}
+
+func parseSalesQueryGroupBy(value string) ([]string, error) {
+	groups := shared.SplitCSV(strings.ToLower(value))
+	if len(groups) == 0 {
+		return nil, fmt.Errorf("--group-by is required")
+	}
+	seen := make(map[string]bool, len(groups))
+	for _, group := range groups {
+		valid := false
+		for _, key := range salesQueryGroupKeys {
+			if group == key {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return nil, fmt.Errorf("--group-by must be one of: %s", strings.Join(salesQueryGroupKeys, ", "))
+		}
+		if seen[group] {
+			return nil, fmt.Errorf("--group-by lists %s twice", group)
+		}
+		seen[group] = true
+	}
+	return groups, nil
+}
+
+// stringSet builds a lookup set; a nil set matches everything.
+func stringSet(values []string, fold bool) map[string]bool {
+	if len(values) == 0 {
+		return nil
+	}
+	set := make(map[string]bool, len(values))
+	for _, value := range values {
+		if fold {
+			value = strings.ToUpper(value)
+		}
+		set[value] = true
+	}
+	return set
+}
+
+type salesQueryFilter struct {
+	skus      map[string]bool
+	countries map[string]bool
+	devices   map[string]bool
+}
+
+func (f salesQueryFilter) matches(row salesStoreRow) bool {
+	if f.skus != nil && !f.skus[row.SKU] {
+		return false
+	}
+	if f.countries != nil && !f.countries[strings.ToUpper(row.Country)] {
+		return false
+	}
+	if f.devices != nil && !f.devices[strings.ToUpper(row.Device)] {
+		return false
+	}
+	return true
+}
+
+// aggregateSalesRows sums units and proceeds per group and proceeds currency.
+func aggregateSalesRows(rows []salesStoreRow, groups []string) []asc.SalesQueryRow {
+	byKey := make(map[string]*asc.SalesQueryRow)
+	var keys []string
+	for _, row := range rows {
+		aggregate := asc.SalesQueryRow{Currency: row.ProceedsCurrency}
+		for _, group := range groups {
+			switch group {
+			case "date":
+				aggregate.Date = row.Date
+			case "sku":
+				aggregate.SKU = row.SKU
+			case "title":
+				aggregate.Title = row.Title
+			case "country":
+				aggregate.Country = row.Country
+			case "device":
+				aggregate.Device = row.Device
+			case "product-type":
+				aggregate.ProductType = row.ProductType
+			}
+		}
+		key := strings.Join([]string{aggregate.Date, aggregate.SKU, aggregate.Title, aggregate.Country, aggregate.Device, aggregate.ProductType, aggregate.Currency}, "\x1f")
+		existing, ok := byKey[key]
+		if !ok {
+			existing = &aggregate
+			byKey[key] = existing
+			keys = append(keys, key)
+		}
+		existing.Units += row.Units
+		existing.Proceeds += row.Proceeds
+	}
+
+	sort.Strings(keys)
+	result := make([]asc.SalesQueryRow, 0, len(keys))
+	for _, key := range keys {
+		row := byKey[key]
+		row.Proceeds = math.Round(row.Proceeds*100) / 100
+		result = append(result, *row)
+	}
+	return result
+}
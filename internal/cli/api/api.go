@@ -0,0 +1,362 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// field is a single key=value pair from --raw-field or --field.
+type field struct {
+	key   string
+	value interface{}
+}
+
+// fieldList collects repeated field flags. Typed lists convert true, false,
+// null and numbers to their JSON types; raw lists keep every value a string.
+type fieldList struct {
+	fields *[]field
+	typed  bool
+}
+
+func (f fieldList) String() string {
+	if f.fields == nil {
+		return ""
+	}
+	parts := make([]string, 0, len(*f.fields))
+	for _, item := range *f.fields {
+		parts = append(parts, fmt.Sprintf("%s=%v", item.key, item.value))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func (f fieldList) Set(value string) error {
+	key, raw, ok := strings.Cut(value, "=")
+	key = strings.TrimSpace(key)
+	if !ok || key == "" {
+		return fmt.Errorf("expected key=value, got %q", value)
+	}
+	var parsed interface{} = raw
+	if f.typed {
+		parsed = typedFieldValue(raw)
+	}
+	*f.fields = append(*f.fields, field{key: key, value: parsed})
+	return nil
+}
+
+func typedFieldValue(raw string) interface{} {
+	switch raw {
+	case "true":
+		return true
+	case "false":
+		return false
+	case "null":
+		return nil
+	}
+	if n, err := strconv.ParseInt(raw, 10, 64); err == nil {
+		return n
+	}
+	if f, err := strconv.ParseFloat(raw, 64); err == nil {
+		return f
+	}
+	return raw
+}
+
+// APICommand returns the api command for raw App Store Connect requests.
+func APICommand() *ffcli.Command {
+	fs := flag.NewFlagSet("api", flag.ExitOnError)
+
+	var fields []field
+	raw := fieldList{fields: &fields}
+	typed := fieldList{fields: &fields, typed: true}
+	fs.Var(raw, "f", "Add a string field key=value (repeatable)")
+	fs.Var(raw, "raw-field", "Add a string field key=value (repeatable)")
+	fs.Var(typed, "F", "Add a typed field key=value; true, false, null and numbers are converted (repeatable)")
+	fs.Var(typed, "field", "Add a typed field key=value; true, false, null and numbers are converted (repeatable)")
+	input := fs.String("input", "", "Path to a JSON request body file (use - for stdin)")
+	paginate := fs.Bool("paginate", false, "Follow links.next and merge all pages (GET only)")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "api",
+		ShortUsage: "asc api <METHOD> <path> [flags]",
+		ShortHelp:  "Make an authenticated request to any App Store Connect API endpoint.",
+		LongHelp: `Make an authenticated request to any App Store Connect API endpoint.
+
+The request uses the active credentials, retry policy and --api-debug logging.
+path is relative to the API base URL (for example /v1/apps) or a full
+App Store Connect URL, such as a links.next value from an earlier response.
+
+For GET and DELETE, fields are added to the query string as-is
+(filter[bundleId]=com.example.app). For POST, PATCH and PUT, fields build the
+JSON body; brackets nest objects (data[attributes][name]=Example) and a
+trailing [] appends to an array. Use --input for bodies fields can't express.
+
+Examples:
+  asc api GET /v1/apps
+  asc api GET /v1/apps -f "filter[bundleId]=com.example.app" --output table
+  asc api GET /v1/apps/APP_ID/appStoreVersions --paginate
+  asc api PATCH /v1/apps/APP_ID -f data[type]=apps -f data[id]=APP_ID -f data[attributes][primaryLocale]=en-US
+  asc api POST /v1/betaGroups --input body.json
+  asc api DELETE /v1/betaTesters/TESTER_ID`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) < 2 {
+				fmt.Fprintln(os.Stderr, "Error: METHOD and path are required")
+				return flag.ErrHelp
+			}
+			// Flags may follow the positional arguments.
+			if len(args) > 2 {
+				if err := fs.Parse(args[2:]); err != nil {
+					return err
+				}
+				if fs.NArg() > 0 {
+					fmt.Fprintf(os.Stderr, "Error: unexpected argument %q\n", fs.Arg(0))
+					return flag.ErrHelp
+				}
+			}
+
+			method := strings.ToUpper(strings.TrimSpace(args[0]))
+			switch method {
+			case "GET", "POST", "PATCH", "PUT", "DELETE":
+			default:
+				fmt.Fprintf(os.Stderr, "Error: unsupported method %q (use GET, POST, PATCH, PUT or DELETE)\n", args[0])
+				return flag.ErrHelp
+			}
+
+			path := strings.TrimSpace(args[1])
+			if strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://") {
+				if err := shared.ValidateNextURL(path); err != nil {
+					fmt.Fprintln(os.Stderr, "Error: path must be an App Store Connect URL")
+					return flag.ErrHelp
+				}
+			} else if !strings.HasPrefix(path, "/") {
+				fmt.Fprintln(os.Stderr, "Error: path must start with / (for example /v1/apps)")
+				return flag.ErrHelp
+			}
+
+			bodyMethod := method == "POST" || method == "PATCH" || method == "PUT"
+			inputValue := strings.TrimSpace(*input)
+			if inputValue != "" && !bodyMethod {
+				fmt.Fprintf(os.Stderr, "Error: --input is not supported with %s\n", method)
+				return flag.ErrHelp
+			}
+			if inputValue != "" && len(fields) > 0 {
+				fmt.Fprintln(os.Stderr, "Error: --input and fields are mutually exclusive")
+				return flag.ErrHelp
+			}
+			if *paginate && method != "GET" {
+				fmt.Fprintln(os.Stderr, "Error: --paginate is only supported with GET")
+				return flag.ErrHelp
+			}
+
+			var body io.Reader
+			switch {
+			case inputValue != "":
+				data, err := readInput(inputValue)
+				if err != nil {
+					return fmt.Errorf("api: %w", err)
+				}
+				body = bytes.NewReader(data)
+			case bodyMethod && len(fields) > 0:
+				payload, err := buildBody(fields)
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+					return flag.ErrHelp
+				}
+				body, err = asc.BuildRequestBody(payload)
+				if err != nil {
+					return fmt.Errorf("api: %w", err)
+				}
+			case len(fields) > 0:
+				withQuery, err := addQuery(path, fields)
+				if err != nil {
+					return fmt.Errorf("api: %w", err)
+				}
+				path = withQuery
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("api: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			resp, err := client.Raw(requestCtx, method, path, body)
+			if err != nil {
+				return fmt.Errorf("api: %w", err)
+			}
+
+			if *paginate {
+				if err := paginateAll(requestCtx, client, resp); err != nil {
+					return fmt.Errorf("api: %w", err)
+				}
+			}
+
+			if len(resp.Body) == 0 {
+				return nil
+			}
+			return shared.PrintOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// paginateAll follows links.next from first, merging each page into it.
+func paginateAll(ctx context.Context, client *asc.Client, first *asc.RawResponse) error {
+	seen := make(map[string]struct{})
+	page := 1
+	for next := first.NextURL(); next != ""; {
+		if _, ok := seen[next]; ok {
+			return fmt.Errorf("page %d: %w", page+1, asc.ErrRepeatedPaginationURL)
+		}
+		seen[next] = struct{}{}
+		page++
+
+		if err := shared.ValidateNextURL(next); err != nil {
+			return fmt.Errorf("page %d: %w", page, err)
+		}
+		resp, err := client.Raw(ctx, "GET", next, nil)
+		if err != nil {
+			if ctx.Err() != nil {
+				err = &asc.PartialResultError{Result: first, Err: err}
+			}
+			return fmt.Errorf("page %d: %w", page, err)
+		}
+		if err := first.AppendPage(resp); err != nil {
+			return fmt.Errorf("page %d: %w", page, err)
+		}
+		next = resp.NextURL()
+	}
+	return nil
+}
+
+func readInput(path string) ([]byte, error) {
+	var (
+		data []byte
+		err  error
+	)
+	if path == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read --input: %w", err)
+	}
+	if !json.Valid(data) {
+		return nil, errors.New("--input must contain valid JSON")
+	}
+	return data, nil
+}
+
+// addQuery appends fields to the query string of path, keeping keys as-is.
+func addQuery(path string, fields []field) (string, error) {
+	parsed, err := url.Parse(path)
+	if err != nil {
+		return "", fmt.Errorf("invalid path: %w", err)
+	}
+	query := parsed.Query()
+	for _, item := range fields {
+		value := ""
+		if item.value != nil {
+			value = fmt.Sprint(item.value)
+		}
+		query.Add(item.key, value)
+	}
+	parsed.RawQuery = query.Encode()
+	return parsed.String(), nil
+}
+
+// buildBody builds a JSON object from fields, nesting bracketed keys.
+func buildBody(fields []field) (map[string]interface{}, error) {
+	body := make(map[string]interface{})
+	for _, item := range fields {
+		segments, err := splitFieldKey(item.key)
+		if err != nil {
+			return nil, err
+		}
+		if err := setField(body, segments, item.value, item.key); err != nil {
+			return nil, err
+		}
+	}
+	return body, nil
+}
+
+// splitFieldKey splits "data[attributes][name]" into its segments.
+func splitFieldKey(key string) ([]string, error) {
+	head, rest, _ := strings.Cut(key, "[")
+	if head == "" {
+		return nil, fmt.Errorf("invalid field key %q", key)
+	}
+	segments := []string{head}
+	if rest == "" {
+		return segments, nil
+	}
+	rest = "[" + rest
+	for rest != "" {
+		if !strings.HasPrefix(rest, "[") {
+			return nil, fmt.Errorf("invalid field key %q", key)
+		}
+		end := strings.Index(rest, "]")
+		if end < 0 {
+			return nil, fmt.Errorf("invalid field key %q", key)
+		}
+		segments = append(segments, rest[1:end])
+		rest = rest[end+1:]
+	}
+	for _, segment := range segments[:len(segments)-1] {
+		if segment == "" {
+			return nil, fmt.Errorf("invalid field key %q: [] is only allowed at the end", key)
+		}
+	}
+	return segments, nil
+}
+
+func setField(node map[string]interface{}, segments []string, value interface{}, key string) error {
+	name := segments[0]
+	if len(segments) == 1 {
+		node[name] = value
+		return nil
+	}
+	if len(segments) == 2 && segments[1] == "" {
+		existing, ok := node[name]
+		if !ok {
+			node[name] = []interface{}{value}
+			return nil
+		}
+		list, ok := existing.([]interface{})
+		if !ok {
+			return fmt.Errorf("field %q conflicts with an earlier field", key)
+		}
+		node[name] = append(list, value)
+		return nil
+	}
+
+	child, ok := node[name]
+	if !ok {
+		child = make(map[string]interface{})
+		node[name] = child
+	}
+	childMap, ok := child.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("field %q conflicts with an earlier field", key)
+	}
+	return setField(childMap, segments[1:], value, key)
+}
@@ -21,12 +21,14 @@ func AssetsCommand() *ffcli.Command {
 Examples:
   asc assets screenshots list --version-localization "LOC_ID"
   asc assets screenshots upload --version-localization "LOC_ID" --path "./screenshots" --device-type "IPHONE_65"
-  asc assets previews upload --version-localization "LOC_ID" --path "./previews" --device-type "IPHONE_65"`,
+  asc assets previews upload --version-localization "LOC_ID" --path "./previews" --device-type "IPHONE_65"
+  asc assets validate --path "./screenshots"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			AssetsScreenshotsCommand(),
 			AssetsPreviewsCommand(),
+			AssetsValidateCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
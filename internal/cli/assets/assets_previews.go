@@ -4,9 +4,7 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"mime"
 	"os"
-	"path/filepath"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -47,7 +45,7 @@ func AssetsPreviewsListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("list", flag.ExitOnError)
 
 	localizationID := fs.String("version-localization", "", "App Store version localization ID")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -108,7 +106,10 @@ func AssetsPreviewsUploadCommand() *ffcli.Command {
 	localizationID := fs.String("version-localization", "", "App Store version localization ID")
 	path := fs.String("path", "", "Path to preview file or directory")
 	deviceType := fs.String("device-type", "", "Device type (e.g., IPHONE_65)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	sync := fs.Bool("sync", false, "Upload only new or changed files (by MD5 checksum) and reorder the set to match local file order")
+	prune := fs.Bool("prune", false, "With --sync, delete previews in the set that have no matching local file")
+	skipValidation := fs.Bool("skip-validation", false, "Skip the local format, size and duration checks before upload")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -117,9 +118,22 @@ func AssetsPreviewsUploadCommand() *ffcli.Command {
 		ShortHelp:  "Upload previews for a localization.",
 		LongHelp: `Upload previews for a localization.
 
+With --sync, files whose MD5 checksum matches an existing preview in the set
+are skipped, new or changed files are uploaded, and the set is reordered to
+follow local file name order. Add --prune to delete previews that no longer
+have a local file.
+
+Before uploading, each file is checked locally (see "asc assets validate"): the
+movie must be MP4 or QuickTime, match the pixel size of the preview type, run
+15 to 30 seconds and stay under 500 MB, and the set must stay within its limit
+of 3 previews, counting previews already in the set. Any error stops the
+upload before anything changes; pass --skip-validation to skip the file checks.
+
 Examples:
   asc assets previews upload --version-localization "LOC_ID" --path "./previews" --device-type "IPHONE_65"
-  asc assets previews upload --version-localization "LOC_ID" --path "./previews/preview.mov" --device-type "IPHONE_65"`,
+  asc assets previews upload --version-localization "LOC_ID" --path "./previews/preview.mov" --device-type "IPHONE_65"
+  asc assets previews upload --version-localization "LOC_ID" --path "./previews" --device-type "IPHONE_65" --sync
+  asc assets previews upload --version-localization "LOC_ID" --path "./previews" --device-type "IPHONE_65" --sync --prune`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -138,37 +152,67 @@ Examples:
 				fmt.Fprintln(os.Stderr, "Error: --device-type is required")
 				return flag.ErrHelp
 			}
+			if *prune && !*sync {
+				fmt.Fprintln(os.Stderr, "Error: --prune requires --sync")
+				return flag.ErrHelp
+			}
 
-			previewType, err := normalizePreviewType(deviceValue)
+			previewType, err := shared.NormalizePreviewType(deviceValue)
 			if err != nil {
 				return fmt.Errorf("assets previews upload: %w", err)
 			}
 
-			files, err := collectAssetFiles(pathValue)
+			files, err := shared.CollectAssetFiles(pathValue)
 			if err != nil {
 				return fmt.Errorf("assets previews upload: %w", err)
 			}
 
+			if !*skipValidation {
+				report := shared.ValidatePreviewFiles(pathValue, files, previewType)
+				for _, issue := range report.Issues {
+					label := "Warning"
+					if issue.Severity == asc.AssetIssueError {
+						label = "Error"
+					}
+					fmt.Fprintf(os.Stderr, "%s: %s: %s\n", label, issue.Path, issue.Message)
+				}
+				if report.Errors > 0 {
+					return fmt.Errorf("assets previews upload: local validation found %d error(s); fix the files or pass --skip-validation", report.Errors)
+				}
+			}
+
 			client, err := shared.GetASCClient()
 			if err != nil {
 				return fmt.Errorf("assets previews upload: %w", err)
 			}
 
-			requestCtx, cancel := contextWithAssetUploadTimeout(ctx)
+			requestCtx, cancel := shared.ContextWithAssetUploadTimeout(ctx)
 			defer cancel()
 
-			set, err := ensurePreviewSet(requestCtx, client, locID, previewType)
+			set, err := shared.EnsurePreviewSet(requestCtx, client, locID, previewType)
 			if err != nil {
 				return fmt.Errorf("assets previews upload: %w", err)
 			}
 
-			results := make([]asc.AssetUploadResultItem, 0, len(files))
-			for _, filePath := range files {
-				item, err := uploadPreviewAsset(requestCtx, client, set.ID, filePath)
+			var results []asc.AssetUploadResultItem
+			reordered := false
+			if *sync {
+				results, reordered, err = shared.SyncPreviewSet(requestCtx, client, set.ID, files, *prune)
 				if err != nil {
 					return fmt.Errorf("assets previews upload: %w", err)
 				}
-				results = append(results, item)
+			} else {
+				if err := shared.CheckPreviewSetCapacity(requestCtx, client, set.ID, len(files)); err != nil {
+					return fmt.Errorf("assets previews upload: %w", err)
+				}
+				results = make([]asc.AssetUploadResultItem, 0, len(files))
+				for _, filePath := range files {
+					item, err := shared.UploadPreviewAsset(requestCtx, client, set.ID, filePath)
+					if err != nil {
+						return fmt.Errorf("assets previews upload: %w", err)
+					}
+					results = append(results, item)
+				}
 			}
 
 			result := asc.AppPreviewUploadResult{
@@ -176,6 +220,7 @@ Examples:
 				SetID:                 set.ID,
 				PreviewType:           set.Attributes.PreviewType,
 				Results:               results,
+				Reordered:             reordered,
 			}
 
 			return shared.PrintOutput(&result, *output, *pretty)
@@ -189,7 +234,7 @@ func AssetsPreviewsDeleteCommand() *ffcli.Command {
 
 	id := fs.String("id", "", "Preview ID")
 	confirm := fs.Bool("confirm", false, "Confirm deletion")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -234,112 +279,3 @@ Examples:
 		},
 	}
 }
-
-func normalizePreviewType(input string) (string, error) {
-	value := strings.ToUpper(strings.TrimSpace(input))
-	if value == "" {
-		return "", fmt.Errorf("device type is required")
-	}
-	value = strings.TrimPrefix(value, "APP_")
-	if !asc.IsValidPreviewType(value) {
-		return "", fmt.Errorf("unsupported preview type %q", value)
-	}
-	return value, nil
-}
-
-func ensurePreviewSet(ctx context.Context, client *asc.Client, localizationID, previewType string) (asc.Resource[asc.AppPreviewSetAttributes], error) {
-	resp, err := client.GetAppPreviewSets(ctx, localizationID)
-	if err != nil {
-		return asc.Resource[asc.AppPreviewSetAttributes]{}, err
-	}
-	for _, set := range resp.Data {
-		if strings.EqualFold(set.Attributes.PreviewType, previewType) {
-			return set, nil
-		}
-	}
-	created, err := client.CreateAppPreviewSet(ctx, localizationID, previewType)
-	if err != nil {
-		return asc.Resource[asc.AppPreviewSetAttributes]{}, err
-	}
-	return created.Data, nil
-}
-
-func uploadPreviewAsset(ctx context.Context, client *asc.Client, setID, filePath string) (asc.AssetUploadResultItem, error) {
-	if err := asc.ValidateImageFile(filePath); err != nil {
-		return asc.AssetUploadResultItem{}, err
-	}
-
-	mimeType, err := detectPreviewMimeType(filePath)
-	if err != nil {
-		return asc.AssetUploadResultItem{}, err
-	}
-
-	file, err := shared.OpenExistingNoFollow(filePath)
-	if err != nil {
-		return asc.AssetUploadResultItem{}, err
-	}
-	defer file.Close()
-
-	info, err := file.Stat()
-	if err != nil {
-		return asc.AssetUploadResultItem{}, err
-	}
-
-	checksum, err := asc.ComputeChecksumFromReader(file, asc.ChecksumAlgorithmMD5)
-	if err != nil {
-		return asc.AssetUploadResultItem{}, err
-	}
-
-	created, err := client.CreateAppPreview(ctx, setID, info.Name(), info.Size(), mimeType)
-	if err != nil {
-		return asc.AssetUploadResultItem{}, err
-	}
-	if len(created.Data.Attributes.UploadOperations) == 0 {
-		return asc.AssetUploadResultItem{}, fmt.Errorf("no upload operations returned for %q", info.Name())
-	}
-
-	if err := asc.UploadAssetFromFile(ctx, file, info.Size(), created.Data.Attributes.UploadOperations); err != nil {
-		return asc.AssetUploadResultItem{}, err
-	}
-
-	if _, err := client.UpdateAppPreview(ctx, created.Data.ID, true, checksum.Hash); err != nil {
-		return asc.AssetUploadResultItem{}, err
-	}
-
-	state, err := waitForPreviewDelivery(ctx, client, created.Data.ID)
-	if err != nil {
-		return asc.AssetUploadResultItem{}, err
-	}
-
-	return asc.AssetUploadResultItem{
-		FileName: info.Name(),
-		FilePath: filePath,
-		AssetID:  created.Data.ID,
-		State:    state,
-	}, nil
-}
-
-func detectPreviewMimeType(path string) (string, error) {
-	ext := strings.ToLower(filepath.Ext(path))
-	if ext == "" {
-		return "", fmt.Errorf("preview file %q is missing an extension", path)
-	}
-	mimeType := mime.TypeByExtension(ext)
-	if mimeType == "" {
-		return "", fmt.Errorf("unsupported preview file extension %q", ext)
-	}
-	if idx := strings.Index(mimeType, ";"); idx > 0 {
-		mimeType = mimeType[:idx]
-	}
-	return mimeType, nil
-}
-
-func waitForPreviewDelivery(ctx context.Context, client *asc.Client, previewID string) (string, error) {
-	return waitForAssetDeliveryState(ctx, previewID, func(ctx context.Context) (*asc.AssetDeliveryState, error) {
-		resp, err := client.GetAppPreview(ctx, previewID)
-		if err != nil {
-			return nil, err
-		}
-		return resp.Data.Attributes.AssetDeliveryState, nil
-	})
-}
@@ -45,7 +45,7 @@ func AssetsScreenshotsListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("list", flag.ExitOnError)
 
 	localizationID := fs.String("version-localization", "", "App Store version localization ID")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -106,7 +106,10 @@ func AssetsScreenshotsUploadCommand() *ffcli.Command {
 	localizationID := fs.String("version-localization", "", "App Store version localization ID")
 	path := fs.String("path", "", "Path to screenshot file or directory")
 	deviceType := fs.String("device-type", "", "Device type (e.g., IPHONE_65)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	sync := fs.Bool("sync", false, "Upload only new or changed files (by MD5 checksum) and reorder the set to match local file order")
+	prune := fs.Bool("prune", false, "With --sync, delete screenshots in the set that have no matching local file")
+	skipValidation := fs.Bool("skip-validation", false, "Skip the local size, alpha and color space checks before upload")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -115,9 +118,22 @@ func AssetsScreenshotsUploadCommand() *ffcli.Command {
 		ShortHelp:  "Upload screenshots for a localization.",
 		LongHelp: `Upload screenshots for a localization.
 
+With --sync, files whose MD5 checksum matches an existing screenshot in the set
+are skipped, new or changed files are uploaded, and the set is reordered to
+follow local file name order. Add --prune to delete screenshots that no longer
+have a local file.
+
+Before uploading, each file is checked locally (see "asc assets validate"): pixel
+size for the display type, alpha channel and color space, and the per-set limit.
+Any error stops the upload before a request is made; pass --skip-validation to
+upload anyway. Screenshots already in the set count toward the limit, so an
+upload that would overfill the set fails before anything changes.
+
 Examples:
   asc assets screenshots upload --version-localization "LOC_ID" --path "./screenshots" --device-type "IPHONE_65"
-  asc assets screenshots upload --version-localization "LOC_ID" --path "./screenshots/en-US.png" --device-type "IPHONE_65"`,
+  asc assets screenshots upload --version-localization "LOC_ID" --path "./screenshots/en-US.png" --device-type "IPHONE_65"
+  asc assets screenshots upload --version-localization "LOC_ID" --path "./screenshots" --device-type "IPHONE_65" --sync
+  asc assets screenshots upload --version-localization "LOC_ID" --path "./screenshots" --device-type "IPHONE_65" --sync --prune`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -136,37 +152,67 @@ Examples:
 				fmt.Fprintln(os.Stderr, "Error: --device-type is required")
 				return flag.ErrHelp
 			}
+			if *prune && !*sync {
+				fmt.Fprintln(os.Stderr, "Error: --prune requires --sync")
+				return flag.ErrHelp
+			}
 
-			displayType, err := normalizeScreenshotDisplayType(deviceValue)
+			displayType, err := shared.NormalizeScreenshotDisplayType(deviceValue)
 			if err != nil {
 				return fmt.Errorf("assets screenshots upload: %w", err)
 			}
 
-			files, err := collectAssetFiles(pathValue)
+			files, err := shared.CollectAssetFiles(pathValue)
 			if err != nil {
 				return fmt.Errorf("assets screenshots upload: %w", err)
 			}
 
+			if !*skipValidation {
+				report := shared.ValidateScreenshotFiles(pathValue, files, displayType)
+				for _, issue := range report.Issues {
+					label := "Warning"
+					if issue.Severity == asc.AssetIssueError {
+						label = "Error"
+					}
+					fmt.Fprintf(os.Stderr, "%s: %s: %s\n", label, issue.Path, issue.Message)
+				}
+				if report.Errors > 0 {
+					return fmt.Errorf("assets screenshots upload: local validation found %d error(s); fix the files or pass --skip-validation", report.Errors)
+				}
+			}
+
 			client, err := shared.GetASCClient()
 			if err != nil {
 				return fmt.Errorf("assets screenshots upload: %w", err)
 			}
 
-			requestCtx, cancel := contextWithAssetUploadTimeout(ctx)
+			requestCtx, cancel := shared.ContextWithAssetUploadTimeout(ctx)
 			defer cancel()
 
-			set, err := ensureScreenshotSet(requestCtx, client, locID, displayType)
+			set, err := shared.EnsureScreenshotSet(requestCtx, client, locID, displayType)
 			if err != nil {
 				return fmt.Errorf("assets screenshots upload: %w", err)
 			}
 
-			results := make([]asc.AssetUploadResultItem, 0, len(files))
-			for _, filePath := range files {
-				item, err := uploadScreenshotAsset(requestCtx, client, set.ID, filePath)
+			var results []asc.AssetUploadResultItem
+			reordered := false
+			if *sync {
+				results, reordered, err = shared.SyncScreenshotSet(requestCtx, client, set.ID, files, *prune)
 				if err != nil {
 					return fmt.Errorf("assets screenshots upload: %w", err)
 				}
-				results = append(results, item)
+			} else {
+				if err := shared.CheckScreenshotSetCapacity(requestCtx, client, set.ID, len(files)); err != nil {
+					return fmt.Errorf("assets screenshots upload: %w", err)
+				}
+				results = make([]asc.AssetUploadResultItem, 0, len(files))
+				for _, filePath := range files {
+					item, err := shared.UploadScreenshotAsset(requestCtx, client, set.ID, filePath)
+					if err != nil {
+						return fmt.Errorf("assets screenshots upload: %w", err)
+					}
+					results = append(results, item)
+				}
 			}
 
 			result := asc.AppScreenshotUploadResult{
@@ -174,6 +220,7 @@ Examples:
 				SetID:                 set.ID,
 				DisplayType:           set.Attributes.ScreenshotDisplayType,
 				Results:               results,
+				Reordered:             reordered,
 			}
 
 			return shared.PrintOutput(&result, *output, *pretty)
@@ -187,7 +234,7 @@ func AssetsScreenshotsDeleteCommand() *ffcli.Command {
 
 	id := fs.String("id", "", "Screenshot ID")
 	confirm := fs.Bool("confirm", false, "Confirm deletion")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -232,94 +279,3 @@ Examples:
 		},
 	}
 }
-
-func normalizeScreenshotDisplayType(input string) (string, error) {
-	value := strings.ToUpper(strings.TrimSpace(input))
-	if value == "" {
-		return "", fmt.Errorf("device type is required")
-	}
-	if !strings.HasPrefix(value, "APP_") {
-		value = "APP_" + value
-	}
-	if !asc.IsValidScreenshotDisplayType(value) {
-		return "", fmt.Errorf("unsupported screenshot display type %q", value)
-	}
-	return value, nil
-}
-
-func ensureScreenshotSet(ctx context.Context, client *asc.Client, localizationID, displayType string) (asc.Resource[asc.AppScreenshotSetAttributes], error) {
-	resp, err := client.GetAppScreenshotSets(ctx, localizationID)
-	if err != nil {
-		return asc.Resource[asc.AppScreenshotSetAttributes]{}, err
-	}
-	for _, set := range resp.Data {
-		if strings.EqualFold(set.Attributes.ScreenshotDisplayType, displayType) {
-			return set, nil
-		}
-	}
-	created, err := client.CreateAppScreenshotSet(ctx, localizationID, displayType)
-	if err != nil {
-		return asc.Resource[asc.AppScreenshotSetAttributes]{}, err
-	}
-	return created.Data, nil
-}
-
-func uploadScreenshotAsset(ctx context.Context, client *asc.Client, setID, filePath string) (asc.AssetUploadResultItem, error) {
-	if err := asc.ValidateImageFile(filePath); err != nil {
-		return asc.AssetUploadResultItem{}, err
-	}
-
-	file, err := shared.OpenExistingNoFollow(filePath)
-	if err != nil {
-		return asc.AssetUploadResultItem{}, err
-	}
-	defer file.Close()
-
-	info, err := file.Stat()
-	if err != nil {
-		return asc.AssetUploadResultItem{}, err
-	}
-
-	checksum, err := asc.ComputeChecksumFromReader(file, asc.ChecksumAlgorithmMD5)
-	if err != nil {
-		return asc.AssetUploadResultItem{}, err
-	}
-
-	created, err := client.CreateAppScreenshot(ctx, setID, info.Name(), info.Size())
-	if err != nil {
-		return asc.AssetUploadResultItem{}, err
-	}
-	if len(created.Data.Attributes.UploadOperations) == 0 {
-		return asc.AssetUploadResultItem{}, fmt.Errorf("no upload operations returned for %q", info.Name())
-	}
-
-	if err := asc.UploadAssetFromFile(ctx, file, info.Size(), created.Data.Attributes.UploadOperations); err != nil {
-		return asc.AssetUploadResultItem{}, err
-	}
-
-	if _, err := client.UpdateAppScreenshot(ctx, created.Data.ID, true, checksum.Hash); err != nil {
-		return asc.AssetUploadResultItem{}, err
-	}
-
-	state, err := waitForScreenshotDelivery(ctx, client, created.Data.ID)
-	if err != nil {
-		return asc.AssetUploadResultItem{}, err
-	}
-
-	return asc.AssetUploadResultItem{
-		FileName: info.Name(),
-		FilePath: filePath,
-		AssetID:  created.Data.ID,
-		State:    state,
-	}, nil
-}
-
-func waitForScreenshotDelivery(ctx context.Context, client *asc.Client, screenshotID string) (string, error) {
-	return waitForAssetDeliveryState(ctx, screenshotID, func(ctx context.Context) (*asc.AssetDeliveryState, error) {
-		resp, err := client.GetAppScreenshot(ctx, screenshotID)
-		if err != nil {
-			return nil, err
-		}
-		return resp.Data.Attributes.AssetDeliveryState, nil
-	})
-}
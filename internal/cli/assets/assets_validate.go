@@ -0,0 +1,80 @@
+package assets
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// AssetsValidateCommand returns the local asset validation subcommand.
+func AssetsValidateCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("validate", flag.ExitOnError)
+
+	path := fs.String("path", "", "Path to an asset file or directory (walked recursively)")
+	deviceType := fs.String("device-type", "", "Device type for all files (e.g., IPHONE_65); inferred from file names or pixel sizes when omitted")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "validate",
+		ShortUsage: "asc assets validate --path \"./screenshots\" [flags]",
+		ShortHelp:  "Validate local screenshots and previews before upload.",
+		LongHelp: `Validate local screenshots and previews before upload.
+
+Reads PNG and JPEG headers and checks each screenshot's pixel size against the
+sizes accepted for its display type, and flags alpha channels, transparency and
+unsupported color spaces. Previews must be MP4 or QuickTime movies with a
+pixel size accepted for their preview type, a duration of 15 to 30 seconds and
+at most 500 MB. Files in the same directory with the same display
+type form a set, which is checked against the per-set limits (10 screenshots,
+3 previews). No network requests are made.
+
+The display type comes from --device-type, else a file name prefix such as
+APP_IPHONE_65_01.png, else the pixel size. All problems are reported in one
+pass; the command exits non-zero when any error is found.
+
+Examples:
+  asc assets validate --path "./screenshots"
+  asc assets validate --path "./screenshots/en-US" --device-type "IPHONE_65"
+  asc assets validate --path "./fastlane/screenshots" --output table`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			pathValue := strings.TrimSpace(*path)
+			if pathValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --path is required")
+				return flag.ErrHelp
+			}
+
+			var screenshotType, previewType string
+			if deviceValue := strings.TrimSpace(*deviceType); deviceValue != "" {
+				var screenshotErr, previewErr error
+				screenshotType, screenshotErr = shared.NormalizeScreenshotDisplayType(deviceValue)
+				previewType, previewErr = shared.NormalizePreviewType(deviceValue)
+				if screenshotErr != nil && previewErr != nil {
+					fmt.Fprintf(os.Stderr, "Error: unsupported --device-type %q\n", deviceValue)
+					return flag.ErrHelp
+				}
+			}
+
+			result, err := shared.ValidateAssets(pathValue, screenshotType, previewType)
+			if err != nil {
+				return fmt.Errorf("assets validate: %w", err)
+			}
+
+			if err := shared.PrintOutput(result, *output, *pretty); err != nil {
+				return err
+			}
+			if result.Errors > 0 {
+				return shared.NewReportedError(fmt.Errorf("assets validate: found %d error(s)", result.Errors))
+			}
+			return nil
+		},
+	}
+}
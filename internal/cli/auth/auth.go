@@ -54,6 +54,7 @@ Use --strict-auth or ASC_STRICT_AUTH=1 to fail when sources are mixed.`,
 			AuthLogoutCommand(),
 			AuthDoctorCommand(),
 			AuthStatusCommand(),
+			AuthTokenCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			if len(args) == 0 {
@@ -129,7 +130,7 @@ Examples:
 				Created:    true,
 				Config:     template,
 			}
-			return asc.PrintJSON(result)
+			return shared.PrintOutput(result, "json", false)
 		},
 	}
 }
@@ -247,10 +248,14 @@ func validateStoredCredential(ctx context.Context, cred authsvc.Credential) erro
 	if err != nil {
 		return fmt.Errorf("failed to load private key: %w", err)
 	}
-	if _, err := asc.GenerateJWT(cred.KeyID, cred.IssuerID, privateKey); err != nil {
+	issuerID := cred.IssuerID
+	if config.IsIndividualKeyType(cred.KeyType) {
+		issuerID = ""
+	}
+	if _, err := asc.GenerateJWT(cred.KeyID, issuerID, privateKey); err != nil {
 		return fmt.Errorf("failed to generate JWT: %w", err)
 	}
-	client, err := asc.NewClient(cred.KeyID, cred.IssuerID, cred.PrivateKeyPath)
+	client, err := asc.NewClient(cred.KeyID, issuerID, cred.PrivateKeyPath)
 	if err != nil {
 		return err
 	}
@@ -324,8 +329,9 @@ func AuthLoginCommand() *ffcli.Command {
 
 	name := fs.String("name", "", "Friendly name for this key")
 	keyID := fs.String("key-id", "", "App Store Connect API Key ID")
-	issuerID := fs.String("issuer-id", "", "App Store Connect Issuer ID")
+	issuerID := fs.String("issuer-id", "", "App Store Connect Issuer ID (team keys only)")
 	keyPath := fs.String("private-key", "", "Path to private key (.p8) file")
+	keyType := fs.String("key-type", config.KeyTypeTeam, "API key type: team or individual")
 	bypassKeychain := fs.Bool("bypass-keychain", false, "Store credentials in config.json instead of keychain")
 	local := fs.Bool("local", false, "When bypassing keychain, write to ./.asc/config.json")
 	network := fs.Bool("network", false, "Validate credentials with a lightweight API request")
@@ -342,11 +348,15 @@ with a local config fallback (restricted permissions). Use --bypass-keychain to
 explicitly bypass keychain and write credentials to ~/.asc/config.json instead.
 Add --local to write ./.asc/config.json for the current repo.
 
+Team keys require --issuer-id. Individual keys (--key-type individual) are
+tied to a single user and have no issuer ID.
+
 Examples:
   asc auth login --name "MyKey" --key-id "ABC123" --issuer-id "DEF456" --private-key /path/to/AuthKey.p8
   asc auth login --bypass-keychain --local --name "MyKey" --key-id "ABC123" --issuer-id "DEF456" --private-key /path/to/AuthKey.p8
   asc auth login --network --name "MyKey" --key-id "ABC123" --issuer-id "DEF456" --private-key /path/to/AuthKey.p8
   asc auth login --skip-validation --name "MyKey" --key-id "ABC123" --issuer-id "DEF456" --private-key /path/to/AuthKey.p8
+  asc auth login --key-type individual --name "Personal" --key-id "ABC123" --private-key /path/to/AuthKey.p8
 
 The private key file path is stored securely. The key content is never saved.`,
 		FlagSet:   fs,
@@ -364,7 +374,17 @@ The private key file path is stored securely. The key content is never saved.`,
 				fmt.Fprintln(os.Stderr, "Error: --key-id is required")
 				return flag.ErrHelp
 			}
-			if *issuerID == "" {
+			normalizedKeyType, err := config.NormalizeKeyType(*keyType)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: --key-type: %v\n", err)
+				return flag.ErrHelp
+			}
+			if normalizedKeyType == config.KeyTypeIndividual {
+				if *issuerID != "" {
+					fmt.Fprintln(os.Stderr, "Error: --issuer-id is not used with --key-type individual")
+					return flag.ErrHelp
+				}
+			} else if *issuerID == "" {
 				fmt.Fprintln(os.Stderr, "Error: --issuer-id is required")
 				return flag.ErrHelp
 			}
@@ -400,16 +420,16 @@ The private key file path is stored securely. The key content is never saved.`,
 					if err != nil {
 						return fmt.Errorf("auth login: %w", err)
 					}
-					if err := authsvc.StoreCredentialsConfigAt(*name, *keyID, *issuerID, *keyPath, path); err != nil {
+					if err := authsvc.StoreCredentialsConfigAt(*name, *keyID, *issuerID, *keyPath, normalizedKeyType, path); err != nil {
 						return fmt.Errorf("auth login: failed to store credentials: %w", err)
 					}
 				} else {
-					if err := authsvc.StoreCredentialsConfig(*name, *keyID, *issuerID, *keyPath); err != nil {
+					if err := authsvc.StoreCredentialsConfig(*name, *keyID, *issuerID, *keyPath, normalizedKeyType); err != nil {
 						return fmt.Errorf("auth login: failed to store credentials: %w", err)
 					}
 				}
 			} else {
-				if err := authsvc.StoreCredentials(*name, *keyID, *issuerID, *keyPath); err != nil {
+				if err := authsvc.StoreCredentials(*name, *keyID, *issuerID, *keyPath, normalizedKeyType); err != nil {
 					return fmt.Errorf("auth login: failed to store credentials: %w", err)
 				}
 			}
@@ -622,7 +642,11 @@ Examples:
 					if cred.IsDefault {
 						active = " (default)"
 					}
-					fmt.Printf("  - %s (Key ID: %s)%s (stored in %s)\n", cred.Name, cred.KeyID, active, credentialStorageLabel(cred))
+					keyType := ""
+					if config.IsIndividualKeyType(cred.KeyType) {
+						keyType = ", individual"
+					}
+					fmt.Printf("  - %s (Key ID: %s%s)%s (stored in %s)\n", cred.Name, cred.KeyID, keyType, active, credentialStorageLabel(cred))
 					if *validate {
 						if err := statusValidateCredential(ctx, cred); err != nil {
 							var permErr *permissionWarning
@@ -646,7 +670,8 @@ Examples:
 				strings.TrimSpace(os.Getenv(shared.PrivateKeyEnvVar)) != "" ||
 				strings.TrimSpace(os.Getenv(shared.PrivateKeyBase64EnvVar)) != ""
 			envProvided := envKeyID != "" || envIssuerID != "" || hasKeyEnv
-			envComplete := envKeyID != "" && envIssuerID != "" && hasKeyEnv
+			envIndividual := config.IsIndividualKeyType(os.Getenv("ASC_KEY_TYPE"))
+			envComplete := envKeyID != "" && hasKeyEnv && (envIssuerID != "" || envIndividual)
 
 			if profile != "" && envProvided {
 				fmt.Printf("Profile %q selected; environment credentials will be ignored.\n", profile)
@@ -362,6 +362,72 @@ func TestAuthLoginCommand(t *testing.T) {
 	})
 }
 
+func TestAuthLoginCommand_IndividualKey(t *testing.T) {
+	t.Run("rejects issuer id", func(t *testing.T) {
+		cmd := AuthLoginCommand()
+		if err := cmd.FlagSet.Parse([]string{
+			"--name", "personal",
+			"--key-type", "individual",
+			"--key-id", "KEY",
+			"--issuer-id", "ISS",
+			"--private-key", "/tmp/AuthKey.p8",
+		}); err != nil {
+			t.Fatalf("Parse() error: %v", err)
+		}
+		err := cmd.Exec(context.Background(), []string{})
+		if !errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("expected flag.ErrHelp, got %v", err)
+		}
+	})
+
+	t.Run("rejects unknown key type", func(t *testing.T) {
+		cmd := AuthLoginCommand()
+		if err := cmd.FlagSet.Parse([]string{
+			"--name", "personal",
+			"--key-type", "enterprise",
+			"--key-id", "KEY",
+			"--private-key", "/tmp/AuthKey.p8",
+		}); err != nil {
+			t.Fatalf("Parse() error: %v", err)
+		}
+		err := cmd.Exec(context.Background(), []string{})
+		if !errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("expected flag.ErrHelp, got %v", err)
+		}
+	})
+
+	t.Run("stores individual key without issuer", func(t *testing.T) {
+		withTempRepo(t, func(repo string) {
+			keyPath := writeTempECDSAKeyFile(t)
+			cmd := AuthLoginCommand()
+			if err := cmd.FlagSet.Parse([]string{
+				"--name", "personal",
+				"--key-type", "individual",
+				"--key-id", "KEY",
+				"--private-key", keyPath,
+				"--bypass-keychain",
+				"--local",
+			}); err != nil {
+				t.Fatalf("Parse() error: %v", err)
+			}
+			if err := cmd.Exec(context.Background(), []string{}); err != nil {
+				t.Fatalf("Exec() error: %v", err)
+			}
+
+			cfg, err := config.LoadAt(filepath.Join(repo, ".asc", "config.json"))
+			if err != nil {
+				t.Fatalf("LoadAt() error: %v", err)
+			}
+			if len(cfg.Keys) != 1 || cfg.Keys[0].KeyType != config.KeyTypeIndividual || cfg.Keys[0].IssuerID != "" {
+				t.Fatalf("unexpected stored keys: %+v", cfg.Keys)
+			}
+			if cfg.KeyType != config.KeyTypeIndividual {
+				t.Fatalf("KeyType = %q, want individual", cfg.KeyType)
+			}
+		})
+	})
+}
+
 func TestAuthSwitchCommand(t *testing.T) {
 	t.Run("missing name", func(t *testing.T) {
 		cmd := AuthSwitchCommand()
@@ -393,7 +459,7 @@ func TestAuthSwitchCommand(t *testing.T) {
 		cfgPath := filepath.Join(t.TempDir(), "config.json")
 		t.Setenv("ASC_BYPASS_KEYCHAIN", "1")
 		t.Setenv("ASC_CONFIG_PATH", cfgPath)
-		if err := authsvc.StoreCredentialsConfigAt("existing", "KEY", "ISS", "/tmp/AuthKey.p8", cfgPath); err != nil {
+		if err := authsvc.StoreCredentialsConfigAt("existing", "KEY", "ISS", "/tmp/AuthKey.p8", config.KeyTypeTeam, cfgPath); err != nil {
 			t.Fatalf("StoreCredentialsConfigAt() error: %v", err)
 		}
 
@@ -411,7 +477,7 @@ func TestAuthSwitchCommand(t *testing.T) {
 		cfgPath := filepath.Join(t.TempDir(), "config.json")
 		t.Setenv("ASC_BYPASS_KEYCHAIN", "1")
 		t.Setenv("ASC_CONFIG_PATH", cfgPath)
-		if err := authsvc.StoreCredentialsConfigAt("demo", "KEY", "ISS", "/tmp/AuthKey.p8", cfgPath); err != nil {
+		if err := authsvc.StoreCredentialsConfigAt("demo", "KEY", "ISS", "/tmp/AuthKey.p8", config.KeyTypeTeam, cfgPath); err != nil {
 			t.Fatalf("StoreCredentialsConfigAt() error: %v", err)
 		}
 
@@ -460,7 +526,7 @@ func TestAuthLogoutCommand(t *testing.T) {
 		cfgPath := filepath.Join(t.TempDir(), "config.json")
 		t.Setenv("ASC_BYPASS_KEYCHAIN", "1")
 		t.Setenv("ASC_CONFIG_PATH", cfgPath)
-		if err := authsvc.StoreCredentialsConfigAt("demo", "KEY", "ISS", "/tmp/AuthKey.p8", cfgPath); err != nil {
+		if err := authsvc.StoreCredentialsConfigAt("demo", "KEY", "ISS", "/tmp/AuthKey.p8", config.KeyTypeTeam, cfgPath); err != nil {
 			t.Fatalf("StoreCredentialsConfigAt() error: %v", err)
 		}
 
@@ -485,10 +551,10 @@ func TestAuthLogoutCommand(t *testing.T) {
 		cfgPath := filepath.Join(t.TempDir(), "config.json")
 		t.Setenv("ASC_BYPASS_KEYCHAIN", "1")
 		t.Setenv("ASC_CONFIG_PATH", cfgPath)
-		if err := authsvc.StoreCredentialsConfigAt("one", "KEY1", "ISS1", "/tmp/AuthKey1.p8", cfgPath); err != nil {
+		if err := authsvc.StoreCredentialsConfigAt("one", "KEY1", "ISS1", "/tmp/AuthKey1.p8", config.KeyTypeTeam, cfgPath); err != nil {
 			t.Fatalf("StoreCredentialsConfigAt() error: %v", err)
 		}
-		if err := authsvc.StoreCredentialsConfigAt("two", "KEY2", "ISS2", "/tmp/AuthKey2.p8", cfgPath); err != nil {
+		if err := authsvc.StoreCredentialsConfigAt("two", "KEY2", "ISS2", "/tmp/AuthKey2.p8", config.KeyTypeTeam, cfgPath); err != nil {
 			t.Fatalf("StoreCredentialsConfigAt() error: %v", err)
 		}
 
@@ -534,7 +600,7 @@ func TestAuthStatusCommand(t *testing.T) {
 		cfgPath := filepath.Join(t.TempDir(), "config.json")
 		t.Setenv("ASC_BYPASS_KEYCHAIN", "1")
 		t.Setenv("ASC_CONFIG_PATH", cfgPath)
-		if err := authsvc.StoreCredentialsConfigAt("demo", "KEY", "ISS", "/tmp/AuthKey.p8", cfgPath); err != nil {
+		if err := authsvc.StoreCredentialsConfigAt("demo", "KEY", "ISS", "/tmp/AuthKey.p8", config.KeyTypeTeam, cfgPath); err != nil {
 			t.Fatalf("StoreCredentialsConfigAt() error: %v", err)
 		}
 
@@ -557,7 +623,7 @@ func TestAuthStatusCommand(t *testing.T) {
 		cfgPath := filepath.Join(t.TempDir(), "config.json")
 		t.Setenv("ASC_BYPASS_KEYCHAIN", "1")
 		t.Setenv("ASC_CONFIG_PATH", cfgPath)
-		if err := authsvc.StoreCredentialsConfigAt("demo", "KEY", "ISS", "/tmp/AuthKey.p8", cfgPath); err != nil {
+		if err := authsvc.StoreCredentialsConfigAt("demo", "KEY", "ISS", "/tmp/AuthKey.p8", config.KeyTypeTeam, cfgPath); err != nil {
 			t.Fatalf("StoreCredentialsConfigAt() error: %v", err)
 		}
 
@@ -0,0 +1,79 @@
+package auth
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// scopeList collects repeated --scope flag values.
+type scopeList []string
+
+func (s *scopeList) String() string {
+	return strings.Join(*s, ", ")
+}
+
+func (s *scopeList) Set(value string) error {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		return fmt.Errorf("scope must not be empty")
+	}
+	*s = append(*s, trimmed)
+	return nil
+}
+
+// AuthTokenCommand returns the auth token subcommand.
+func AuthTokenCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("auth token", flag.ExitOnError)
+
+	var scope scopeList
+	fs.Var(&scope, "scope", "Limit the token to \"METHOD /path\" (repeatable)")
+
+	return &ffcli.Command{
+		Name:       "token",
+		ShortUsage: "asc auth token [--scope \"METHOD /path\" ...]",
+		ShortHelp:  "Print a signed API token for use by other tools.",
+		LongHelp: `Print a signed API token for use by other tools.
+
+The token is signed with the active credentials and expires after 10 minutes.
+Use --scope to restrict it to specific requests; App Store Connect rejects
+any request that does not match one of the scope entries.
+
+To scope the requests asc itself makes, set ASC_TOKEN_SCOPE to the entries
+separated by semicolons.
+
+Examples:
+  asc auth token
+  asc auth token --scope "GET /v1/apps"
+  asc auth token --scope "GET /v1/apps" --scope "GET /v1/builds?filter[app]=123456789"
+  curl -H "Authorization: Bearer $(asc auth token --scope 'GET /v1/apps')" https://api.appstoreconnect.apple.com/v1/apps`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if err := asc.ValidateTokenScope(scope); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: --scope: %v\n", err)
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("auth token: %w", err)
+			}
+
+			token, _, err := client.ScopedToken(scope)
+			if err != nil {
+				return fmt.Errorf("auth token: %w", err)
+			}
+
+			fmt.Fprintln(os.Stdout, token)
+			return nil
+		},
+	}
+}
@@ -0,0 +1,463 @@
+package backup
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// BackupCommand returns the backup command.
+func BackupCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("backup", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	appInfoID := fs.String("app-info", "", "App Info ID (optional override)")
+	out := fs.String("out", "", "Snapshot directory to create (must not exist or be empty)")
+	skipScreenshots := fs.Bool("skip-screenshots", false, "Do not download screenshots")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "backup",
+		ShortUsage: "asc backup --app APP_ID --out DIR [flags]",
+		ShortHelp:  "Write a point-in-time snapshot of an app's configuration.",
+		LongHelp: `Write a point-in-time snapshot of an app's configuration.
+
+The snapshot directory holds one JSON file per resource:
+  manifest.json           format version, creation time and the source app
+  app-info.json           app info localizations, categories and age rating
+  versions.json           App Store versions and their localizations
+  in-app-purchases.json   in-app purchases with localizations and prices
+  subscriptions.json      subscription groups, subscriptions, localizations and prices
+  beta-groups.json        TestFlight beta groups
+  availability.json       territory availability
+  app-events.json         in-app events and their localizations
+  screenshots/            screenshots of the latest version per platform
+
+Every version's metadata is saved; screenshots are only downloaded for the
+most recent version of each platform, which is the one "asc restore" uses.
+Review attachments, previews and builds are not included.
+
+Examples:
+  asc backup --app "APP_ID" --out ./snapshots/2026-10-18
+  asc backup --app "APP_ID" --out ./snapshot --skip-screenshots
+  asc backup --app "APP_ID" --out ./snapshot --output table`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
+				return flag.ErrHelp
+			}
+			dir := strings.TrimSpace(*out)
+			if dir == "" {
+				fmt.Fprintln(os.Stderr, "Error: --out is required")
+				return flag.ErrHelp
+			}
+			if err := prepareSnapshotDir(dir); err != nil {
+				return fmt.Errorf("backup: %w", err)
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("backup: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithAssetUploadTimeout(ctx)
+			defer cancel()
+
+			b := &backuper{client: client, dir: dir, skipScreenshots: *skipScreenshots}
+			snap, err := b.collect(requestCtx, resolvedAppID, *appInfoID)
+			if err != nil {
+				return fmt.Errorf("backup: %w", err)
+			}
+			counts, err := writeSnapshot(dir, snap)
+			if err != nil {
+				return fmt.Errorf("backup: %w", err)
+			}
+
+			result := &asc.AppBackupResult{
+				Dir:           dir,
+				AppID:         snap.Manifest.App.ID,
+				BundleID:      snap.Manifest.App.BundleID,
+				FormatVersion: snap.Manifest.FormatVersion,
+				CreatedAt:     snap.Manifest.CreatedAt,
+				Resources:     counts,
+			}
+			return shared.PrintOutput(result, *output, *pretty)
+		},
+	}
+}
+
+// prepareSnapshotDir creates dir, refusing to mix a new snapshot into a
+// directory that already has files.
+func prepareSnapshotDir(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err == nil {
+		if len(entries) > 0 {
+			return fmt.Errorf("--out directory %q is not empty", dir)
+		}
+		return nil
+	}
+	if !os.IsNotExist(err) {
+		return err
+	}
+	return os.MkdirAll(dir, 0o755)
+}
+
+type backuper struct {
+	client          *asc.Client
+	dir             string
+	skipScreenshots bool
+}
+
+func (b *backuper) collect(ctx context.Context, appID, appInfoID string) (*shared.Snapshot, error) {
+	app, err := b.client.GetApp(ctx, appID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch app: %w", err)
+	}
+	snap := &shared.Snapshot{
+		Manifest: shared.SnapshotManifest{
+			FormatVersion: shared.SnapshotFormatVersion,
+			CreatedAt:     time.Now().UTC().Format(time.RFC3339),
+			App: shared.SnapshotApp{
+				ID:            app.Data.ID,
+				Name:          app.Data.Attributes.Name,
+				BundleID:      app.Data.Attributes.BundleID,
+				SKU:           app.Data.Attributes.SKU,
+				PrimaryLocale: app.Data.Attributes.PrimaryLocale,
+			},
+		},
+	}
+
+	if snap.AppInfo, err = b.appInfo(ctx, appID, appInfoID); err != nil {
+		return nil, err
+	}
+	if snap.Versions, err = b.versions(ctx, appID); err != nil {
+		return nil, err
+	}
+	if snap.InAppPurchases, err = b.inAppPurchases(ctx, appID); err != nil {
+		return nil, err
+	}
+	if snap.Subscriptions, err = b.subscriptions(ctx, appID); err != nil {
+		return nil, err
+	}
+	if snap.BetaGroups, err = b.betaGroups(ctx, appID); err != nil {
+		return nil, err
+	}
+	if snap.Availability, err = b.availability(ctx, appID); err != nil {
+		return nil, err
+	}
+	if snap.AppEvents, err = b.appEvents(ctx, appID); err != nil {
+		return nil, err
+	}
+	return snap, nil
+}
+
+func (b *backuper) appInfo(ctx context.Context, appID, appInfoID string) (*shared.SnapshotAppInfo, error) {
+	resolvedAppInfoID, err := shared.ResolveAppInfoID(ctx, b.client, appID, appInfoID)
+	if err != nil {
+		return nil, err
+	}
+	info := &shared.SnapshotAppInfo{ID: resolvedAppInfoID}
+
+	localizations, err := shared.FetchAppInfoLocalizations(ctx, b.client, resolvedAppInfoID)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range localizations {
+		info.Localizations = append(info.Localizations, item.Attributes)
+	}
+	if info.PrimaryCategory, err = shared.FetchAppInfoCategoryID(ctx, b.client.GetAppInfoPrimaryCategory, resolvedAppInfoID); err != nil {
+		return nil, fmt.Errorf("failed to fetch primary category: %w", err)
+	}
+	if info.SecondaryCategory, err = shared.FetchAppInfoCategoryID(ctx, b.client.GetAppInfoSecondaryCategory, resolvedAppInfoID); err != nil {
+		return nil, fmt.Errorf("failed to fetch secondary category: %w", err)
+	}
+
+	ageRating, err := b.client.GetAgeRatingDeclarationForAppInfo(ctx, resolvedAppInfoID)
+	if err != nil && !asc.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to fetch age rating: %w", err)
+	}
+	if err == nil {
+		info.AgeRating = &ageRating.Data.Attributes
+	}
+	return info, nil
+}
+
+func (b *backuper) versions(ctx context.Context, appID string) ([]shared.SnapshotVersion, error) {
+	items, err := fetchAll(ctx, b.client.GetAppStoreVersions, appID, asc.WithAppStoreVersionsNextURL, asc.WithAppStoreVersionsLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch versions: %w", err)
+	}
+	versions := make([]shared.SnapshotVersion, 0, len(items))
+	// Localization IDs by locale, per version, for the screenshot download.
+	localizationIDs := make([]map[string]string, 0, len(items))
+	for _, item := range items {
+		version := shared.SnapshotVersion{
+			ID:            item.ID,
+			VersionString: item.Attributes.VersionString,
+			Platform:      string(item.Attributes.Platform),
+			State:         shared.ResolveAppStoreVersionState(item.Attributes),
+			CreatedDate:   item.Attributes.CreatedDate,
+			Copyright:     item.Attributes.Copyright,
+		}
+		localizations, err := shared.FetchVersionLocalizations(ctx, b.client, item.ID)
+		if err != nil {
+			return nil, err
+		}
+		ids := make(map[string]string, len(localizations))
+		for _, localization := range localizations {
+			version.Localizations = append(version.Localizations, shared.SnapshotVersionLocalization{
+				AppStoreVersionLocalizationAttributes: localization.Attributes,
+			})
+			ids[localization.Attributes.Locale] = localization.ID
+		}
+		versions = append(versions, version)
+		localizationIDs = append(localizationIDs, ids)
+	}
+
+	if b.skipScreenshots {
+		return versions, nil
+	}
+	for _, index := range shared.LatestVersionIndexes(versions) {
+		if err := b.screenshots(ctx, &versions[index], localizationIDs[index]); err != nil {
+			return nil, err
+		}
+	}
+	return versions, nil
+}
+
+// screenshots downloads every screenshot set of a version into the snapshot.
+func (b *backuper) screenshots(ctx context.Context, version *shared.SnapshotVersion, idsByLocale map[string]string) error {
+	versionDir := versionScreenshotDir(*version)
+	for i := range version.Localizations {
+		localization := &version.Localizations[i]
+		localizationID := idsByLocale[localization.Locale]
+		if localizationID == "" {
+			continue
+		}
+		sets, err := b.client.GetAppScreenshotSets(ctx, localizationID)
+		if err != nil {
+			return fmt.Errorf("failed to fetch screenshot sets for %s: %w", localization.Locale, err)
+		}
+		for _, set := range sets.Data {
+			displayType := set.Attributes.ScreenshotDisplayType
+			screenshots, err := b.client.GetAppScreenshots(ctx, set.ID)
+			if err != nil {
+				return fmt.Errorf("failed to fetch screenshots for set %s: %w", set.ID, err)
+			}
+			saved := shared.SnapshotScreenshotSet{DisplayType: displayType}
+			for index, screenshot := range screenshots.Data {
+				url := shared.ScreenshotDownloadURL(screenshot.Attributes)
+				if url == "" {
+					continue
+				}
+				relPath := filepath.ToSlash(filepath.Join(versionDir, localization.Locale, displayType, screenshotFileName(index, screenshot.Attributes.FileName)))
+				if err := shared.DownloadAssetFile(ctx, b.client, url, filepath.Join(b.dir, filepath.FromSlash(relPath)), false); err != nil {
+					return fmt.Errorf("failed to download screenshot %s: %w", screenshot.ID, err)
+				}
+				saved.Files = append(saved.Files, relPath)
+			}
+			if len(saved.Files) > 0 {
+				localization.Screenshots = append(localization.Screenshots, saved)
+			}
+		}
+	}
+	return nil
+}
+
+// versionScreenshotDir is screenshots/<platform>-<version>, relative to the snapshot.
+func versionScreenshotDir(version shared.SnapshotVersion) string {
+	return filepath.Join(shared.SnapshotScreenshotsDir, version.Platform+"-"+version.VersionString)
+}
+
+// screenshotFileName prefixes the original name with its position so the
+// set order survives and equal names cannot collide.
+func screenshotFileName(index int, fileName string) string {
+	base := filepath.Base(strings.ReplaceAll(strings.TrimSpace(fileName), "\\", "/"))
+	if base == "." || base == "/" || base == "" {
+		base = "screenshot.png"
+	}
+	if filepath.Ext(base) == "" {
+		base += ".png"
+	}
+	return fmt.Sprintf("%02d_%s", index+1, base)
+}
+
+func (b *backuper) inAppPurchases(ctx context.Context, appID string) ([]shared.SnapshotInAppPurchase, error) {
+	items, err := fetchAll(ctx, b.client.GetInAppPurchasesV2, appID, asc.WithIAPNextURL, asc.WithIAPLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch in-app purchases: %w", err)
+	}
+	purchases := make([]shared.SnapshotInAppPurchase, 0, len(items))
+	for _, item := range items {
+		purchase := shared.SnapshotInAppPurchase{InAppPurchaseV2Attributes: item.Attributes}
+		localizations, err := fetchAll(ctx, b.client.GetInAppPurchaseLocalizations, item.ID, asc.WithIAPLocalizationsNextURL, asc.WithIAPLocalizationsLimit(200))
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch localizations for %s: %w", item.Attributes.ProductID, err)
+		}
+		for _, localization := range localizations {
+			purchase.Localizations = append(purchase.Localizations, localization.Attributes)
+		}
+		if err := b.inAppPurchasePrices(ctx, item.ID, &purchase); err != nil {
+			return nil, fmt.Errorf("failed to fetch prices for %s: %w", item.Attributes.ProductID, err)
+		}
+		purchases = append(purchases, purchase)
+	}
+	return purchases, nil
+}
+
+// inAppPurchasePrices saves the base territory and manual prices of an IAP's
+// price schedule; Apple derives the other territories from them.
+func (b *backuper) inAppPurchasePrices(ctx context.Context, iapID string, purchase *shared.SnapshotInAppPurchase) error {
+	schedule, err := b.client.GetInAppPurchasePriceSchedule(ctx, iapID)
+	if err != nil {
+		if asc.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	territory, err := b.client.GetInAppPurchasePriceScheduleBaseTerritory(ctx, schedule.Data.ID)
+	if err != nil && !asc.IsNotFound(err) {
+		return err
+	}
+	if err == nil {
+		purchase.BaseTerritory = territory.Data.ID
+	}
+
+	prices, included, err := fetchPages(ctx, b.client.GetInAppPurchasePriceScheduleManualPrices, schedule.Data.ID, asc.WithIAPPriceSchedulePricesNextURL,
+		asc.WithIAPPriceSchedulePricesInclude([]string{"inAppPurchasePricePoint", "territory"}),
+		asc.WithIAPPriceSchedulePricesPricePointFields([]string{"customerPrice", "territory"}),
+		asc.WithIAPPriceSchedulePricesLimit(200),
+	)
+	if err != nil {
+		return err
+	}
+	purchase.Prices = snapshotPrices(prices, included, "inAppPurchasePricePoint", func(attrs asc.InAppPurchasePriceAttributes) (string, string) {
+		return attrs.StartDate, attrs.EndDate
+	})
+	return nil
+}
+
+func (b *backuper) subscriptions(ctx context.Context, appID string) ([]shared.SnapshotSubscriptionGroup, error) {
+	groups, err := fetchAll(ctx, b.client.GetSubscriptionGroups, appID, asc.WithSubscriptionGroupsNextURL, asc.WithSubscriptionGroupsLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch subscription groups: %w", err)
+	}
+	result := make([]shared.SnapshotSubscriptionGroup, 0, len(groups))
+	for _, group := range groups {
+		saved := shared.SnapshotSubscriptionGroup{ReferenceName: group.Attributes.ReferenceName}
+		localizations, err := fetchAll(ctx, b.client.GetSubscriptionGroupLocalizations, group.ID, asc.WithSubscriptionGroupLocalizationsNextURL, asc.WithSubscriptionGroupLocalizationsLimit(200))
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch localizations for group %s: %w", group.Attributes.ReferenceName, err)
+		}
+		for _, localization := range localizations {
+			saved.Localizations = append(saved.Localizations, localization.Attributes)
+		}
+
+		subscriptions, err := fetchAll(ctx, b.client.GetSubscriptions, group.ID, asc.WithSubscriptionsNextURL, asc.WithSubscriptionsLimit(200))
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch subscriptions for group %s: %w", group.Attributes.ReferenceName, err)
+		}
+		for _, subscription := range subscriptions {
+			sub := shared.SnapshotSubscription{SubscriptionAttributes: subscription.Attributes}
+			localizations, err := fetchAll(ctx, b.client.GetSubscriptionLocalizations, subscription.ID, asc.WithSubscriptionLocalizationsNextURL, asc.WithSubscriptionLocalizationsLimit(200))
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch localizations for %s: %w", subscription.Attributes.ProductID, err)
+			}
+			for _, localization := range localizations {
+				sub.Localizations = append(sub.Localizations, localization.Attributes)
+			}
+			prices, included, err := fetchPages(ctx, b.client.GetSubscriptionPrices, subscription.ID, asc.WithSubscriptionPricesNextURL,
+				asc.WithSubscriptionPricesInclude([]string{"subscriptionPricePoint", "territory"}),
+				asc.WithSubscriptionPricesPricePointFields([]string{"customerPrice"}),
+				asc.WithSubscriptionPricesLimit(200),
+			)
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch prices for %s: %w", subscription.Attributes.ProductID, err)
+			}
+			sub.Prices = snapshotPrices(prices, included, "subscriptionPricePoint", func(attrs asc.SubscriptionPriceAttributes) (string, string) {
+				return attrs.StartDate, ""
+			})
+			saved.Subscriptions = append(saved.Subscriptions, sub)
+		}
+		result = append(result, saved)
+	}
+	return result, nil
+}
+
+func (b *backuper) betaGroups(ctx context.Context, appID string) ([]asc.BetaGroupAttributes, error) {
+	items, err := fetchAll(ctx, b.client.GetBetaGroups, appID, asc.WithBetaGroupsNextURL, asc.WithBetaGroupsLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch beta groups: %w", err)
+	}
+	groups := make([]asc.BetaGroupAttributes, 0, len(items))
+	for _, item := range items {
+		groups = append(groups, item.Attributes)
+	}
+	return groups, nil
+}
+
+func (b *backuper) availability(ctx context.Context, appID string) (*shared.SnapshotAvailability, error) {
+	resp, err := b.client.GetAppAvailabilityV2(ctx, appID)
+	if err != nil {
+		if shared.IsAppAvailabilityMissing(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to fetch availability: %w", err)
+	}
+	items, err := fetchAll(ctx, b.client.GetTerritoryAvailabilities, resp.Data.ID, asc.WithTerritoryAvailabilitiesNextURL, asc.WithTerritoryAvailabilitiesLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch territory availability: %w", err)
+	}
+	ids, err := shared.MapTerritoryAvailabilityIDs(&asc.TerritoryAvailabilitiesResponse{Data: items})
+	if err != nil {
+		return nil, err
+	}
+	territories := make(map[string]string, len(ids))
+	for territory, id := range ids {
+		territories[id] = territory
+	}
+
+	availability := &shared.SnapshotAvailability{AvailableInNewTerritories: resp.Data.Attributes.AvailableInNewTerritories}
+	for _, item := range items {
+		availability.Territories = append(availability.Territories, shared.SnapshotTerritoryAvailability{
+			Territory:       territories[item.ID],
+			Available:       item.Attributes.Available,
+			ReleaseDate:     item.Attributes.ReleaseDate,
+			PreOrderEnabled: item.Attributes.PreOrderEnabled,
+		})
+	}
+	return availability, nil
+}
+
+func (b *backuper) appEvents(ctx context.Context, appID string) ([]shared.SnapshotAppEvent, error) {
+	items, err := fetchAll(ctx, b.client.GetAppEvents, appID, asc.WithAppEventsNextURL, asc.WithAppEventsLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch app events: %w", err)
+	}
+	events := make([]shared.SnapshotAppEvent, 0, len(items))
+	for _, item := range items {
+		event := shared.SnapshotAppEvent{AppEventAttributes: item.Attributes}
+		localizations, err := fetchAll(ctx, b.client.GetAppEventLocalizations, item.ID, asc.WithAppEventLocalizationsNextURL, asc.WithAppEventLocalizationsLimit(200))
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch localizations for event %s: %w", item.Attributes.ReferenceName, err)
+		}
+		for _, localization := range localizations {
+			event.Localizations = append(event.Localizations, localization.Attributes)
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
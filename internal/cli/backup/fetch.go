@@ -0,0 +1,126 @@
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// listFunc is the shape shared by the client's paginated "list children of a
+// parent" methods, e.g. client.GetBetaGroups.
+type listFunc[T any, O any] func(ctx context.Context, parentID string, opts ...O) (*asc.Response[T], error)
+
+// includedResource is one entry of a response's "included" array.
+type includedResource struct {
+	Type          string          `json:"type"`
+	ID            string          `json:"id"`
+	Attributes    json.RawMessage `json:"attributes"`
+	Relationships json.RawMessage `json:"relationships"`
+}
+
+// fetchAll returns every resource of a paginated list.
+func fetchAll[T any, O any](ctx context.Context, list listFunc[T, O], parentID string, next func(string) O, opts ...O) ([]asc.Resource[T], error) {
+	data, _, err := fetchPages(ctx, list, parentID, next, opts...)
+	return data, err
+}
+
+// fetchPages is fetchAll that also collects the included resources of every page.
+func fetchPages[T any, O any](ctx context.Context, list listFunc[T, O], parentID string, next func(string) O, opts ...O) ([]asc.Resource[T], []includedResource, error) {
+	page, err := list(ctx, parentID, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	var data []asc.Resource[T]
+	var included []includedResource
+	seen := make(map[string]struct{})
+	for {
+		data = append(data, page.Data...)
+		if len(page.Included) > 0 {
+			var items []includedResource
+			if err := json.Unmarshal(page.Included, &items); err != nil {
+				return nil, nil, fmt.Errorf("parse included resources: %w", err)
+			}
+			included = append(included, items...)
+		}
+		if page.Links.Next == "" {
+			return data, included, nil
+		}
+		if _, ok := seen[page.Links.Next]; ok {
+			return nil, nil, asc.ErrRepeatedPaginationURL
+		}
+		seen[page.Links.Next] = struct{}{}
+		if page, err = list(ctx, parentID, next(page.Links.Next)); err != nil {
+			return nil, nil, err
+		}
+	}
+}
+
+// relationshipID returns the ID linked under key in a resource's
+// relationships, or "" when the relationship has no data.
+func relationshipID(relationships json.RawMessage, key string) string {
+	if len(relationships) == 0 {
+		return ""
+	}
+	var links map[string]struct {
+		Data *asc.ResourceData `json:"data"`
+	}
+	if err := json.Unmarshal(relationships, &links); err != nil {
+		return ""
+	}
+	link, ok := links[key]
+	if !ok || link.Data == nil {
+		return ""
+	}
+	return strings.TrimSpace(link.Data.ID)
+}
+
+// snapshotPrices resolves price resources fetched with their price point and
+// territory included into territory/customer price pairs.
+func snapshotPrices[T any](prices []asc.Resource[T], included []includedResource, pricePointKey string, dates func(T) (string, string)) []shared.SnapshotPrice {
+	type pricePoint struct {
+		customerPrice string
+		territory     string
+	}
+	points := make(map[string]pricePoint)
+	for _, item := range included {
+		var attrs struct {
+			CustomerPrice string `json:"customerPrice"`
+		}
+		if err := json.Unmarshal(item.Attributes, &attrs); err != nil || attrs.CustomerPrice == "" {
+			continue
+		}
+		points[item.ID] = pricePoint{
+			customerPrice: strings.TrimSpace(attrs.CustomerPrice),
+			territory:     relationshipID(item.Relationships, "territory"),
+		}
+	}
+
+	result := make([]shared.SnapshotPrice, 0, len(prices))
+	for _, price := range prices {
+		pointID := relationshipID(price.Relationships, pricePointKey)
+		point, ok := points[pointID]
+		if !ok {
+			continue
+		}
+		territory := relationshipID(price.Relationships, "territory")
+		if territory == "" {
+			territory = point.territory
+		}
+		if territory == "" {
+			continue
+		}
+		startDate, endDate := dates(price.Attributes)
+		result = append(result, shared.SnapshotPrice{
+			Territory:     strings.ToUpper(territory),
+			CustomerPrice: point.customerPrice,
+			PricePointID:  pointID,
+			StartDate:     startDate,
+			EndDate:       endDate,
+		})
+	}
+	return result
+}
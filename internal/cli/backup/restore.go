@@ -0,0 +1,1007 @@
+package backup
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// Restore actions reported in AppRestoreItem.Action.
+const (
+	restoreActionCreate = "create"
+	restoreActionUpdate = "update"
+	restoreActionUpload = "upload"
+	restoreActionSkip   = "skip"
+)
+
+// RestoreCommand returns the restore command.
+func RestoreCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("restore", flag.ExitOnError)
+
+	dir := fs.String("dir", "", "Snapshot directory written by asc backup")
+	appID := fs.String("app", "", "Target app ID (default: the app the snapshot was taken from)")
+	appInfoID := fs.String("app-info", "", "Target App Info ID (optional override)")
+	productIDPrefix := fs.String("product-id-prefix", "", "Rewrite product IDs as OLD=NEW when restoring to a different app")
+	skipScreenshots := fs.Bool("skip-screenshots", false, "Do not upload screenshots")
+	dryRun := fs.Bool("dry-run", false, "Report what would change without writing")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "restore",
+		ShortUsage: "asc restore --dir DIR [--app APP_ID] [flags]",
+		ShortHelp:  "Recreate missing configuration from an app snapshot.",
+		LongHelp: `Recreate missing configuration from an app snapshot.
+
+Restore compares a snapshot written by "asc backup" with the target app and
+creates what is missing: app info and version localizations, the latest
+version of each platform, screenshots, in-app purchases, subscription groups
+and subscriptions with their localizations and prices, beta groups,
+availability and upcoming app events. Categories and the age rating are
+updated when they differ. Products that already exist get the localizations
+and prices they are missing, so a failed restore can be rerun. When a platform
+has no version matching the snapshot, its editable version (for example a
+fresh clone's 1.0) is renamed and restored into instead. Nothing else that
+already exists is deleted or edited, and screenshot sets that would exceed
+their limit are reported as errors, also in a dry run.
+
+The target app must already exist. It defaults to the snapshot's source app;
+ASC_APP_ID is deliberately ignored so a snapshot is never applied to another
+app by accident. Product IDs are unique across App Store Connect, so restoring
+products into a different app needs --product-id-prefix.
+
+Examples:
+  asc restore --dir ./snapshot --dry-run
+  asc restore --dir ./snapshot
+  asc restore --dir ./snapshot --app "NEW_APP_ID" --product-id-prefix "com.example.old.=com.example.new."
+  asc restore --dir ./snapshot --skip-screenshots --output table`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			snapshotDir := strings.TrimSpace(*dir)
+			if snapshotDir == "" {
+				fmt.Fprintln(os.Stderr, "Error: --dir is required")
+				return flag.ErrHelp
+			}
+			prefixFrom, prefixTo, err := parseProductIDPrefix(*productIDPrefix)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				return flag.ErrHelp
+			}
+
+			snap, err := shared.ReadSnapshot(snapshotDir)
+			if err != nil {
+				return fmt.Errorf("restore: %w", err)
+			}
+			targetAppID := strings.TrimSpace(*appID)
+			if targetAppID == "" {
+				targetAppID = snap.Manifest.App.ID
+			}
+			if targetAppID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --app is required (the snapshot has no source app ID)")
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("restore: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithAssetUploadTimeout(ctx)
+			defer cancel()
+
+			r := &restorer{
+				client:          client,
+				snap:            snap,
+				dir:             snapshotDir,
+				appID:           targetAppID,
+				dryRun:          *dryRun,
+				skipScreenshots: *skipScreenshots,
+				prefixFrom:      prefixFrom,
+				prefixTo:        prefixTo,
+				today:           time.Now().UTC().Format("2006-01-02"),
+				result: &asc.AppRestoreResult{
+					Dir:         snapshotDir,
+					SourceAppID: snap.Manifest.App.ID,
+					TargetAppID: targetAppID,
+					DryRun:      *dryRun,
+					Items:       []asc.AppRestoreItem{},
+				},
+			}
+			if err := r.run(requestCtx, *appInfoID); err != nil {
+				return fmt.Errorf("restore: %w", err)
+			}
+			return shared.PrintOutput(r.result, *output, *pretty)
+		},
+	}
+}
+
+// parseProductIDPrefix splits an OLD=NEW product ID prefix rewrite.
+func parseProductIDPrefix(value string) (string, string, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", "", nil
+	}
+	from, to, ok := strings.Cut(value, "=")
+	from, to = strings.TrimSpace(from), strings.TrimSpace(to)
+	if !ok || from == "" || to == "" {
+		return "", "", fmt.Errorf("--product-id-prefix must be OLD=NEW")
+	}
+	return from, to, nil
+}
+
+type restorer struct {
+	client          *asc.Client
+	snap            *shared.Snapshot
+	dir             string
+	appID           string
+	dryRun          bool
+	skipScreenshots bool
+	prefixFrom      string
+	prefixTo        string
+	today           string
+	result          *asc.AppRestoreResult
+}
+
+func (r *restorer) record(item asc.AppRestoreItem) {
+	r.result.Items = append(r.result.Items, item)
+	switch item.Action {
+	case restoreActionCreate, restoreActionUpload:
+		r.result.Created++
+	case restoreActionUpdate:
+		r.result.Updated++
+	case restoreActionSkip:
+		r.result.Skipped++
+	}
+}
+
+// productID applies the --product-id-prefix rewrite.
+func (r *restorer) productID(id string) string {
+	if r.prefixFrom != "" && strings.HasPrefix(id, r.prefixFrom) {
+		return r.prefixTo + strings.TrimPrefix(id, r.prefixFrom)
+	}
+	return id
+}
+
+func (r *restorer) run(ctx context.Context, appInfoID string) error {
+	if r.snap.AppInfo != nil {
+		if err := r.restoreAppInfo(ctx, appInfoID); err != nil {
+			return err
+		}
+	}
+	steps := []func(context.Context) error{
+		r.restoreVersions,
+		r.restoreInAppPurchases,
+		r.restoreSubscriptions,
+		r.restoreBetaGroups,
+		r.restoreAvailability,
+		r.restoreAppEvents,
+	}
+	for _, step := range steps {
+		if err := step(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *restorer) restoreAppInfo(ctx context.Context, appInfoID string) error {
+	info := r.snap.AppInfo
+	resolvedAppInfoID, err := shared.ResolveAppInfoID(ctx, r.client, r.appID, appInfoID)
+	if err != nil {
+		return err
+	}
+
+	localizations, err := shared.FetchAppInfoLocalizations(ctx, r.client, resolvedAppInfoID)
+	if err != nil {
+		return err
+	}
+	existing := make(map[string]struct{}, len(localizations))
+	for _, item := range localizations {
+		existing[item.Attributes.Locale] = struct{}{}
+	}
+	for _, localization := range info.Localizations {
+		if _, ok := existing[localization.Locale]; ok {
+			r.record(asc.AppRestoreItem{Resource: "app-info-localization", Key: localization.Locale, Action: restoreActionSkip, Message: "already exists"})
+			continue
+		}
+		item := asc.AppRestoreItem{Resource: "app-info-localization", Key: localization.Locale, Action: restoreActionCreate}
+		if !r.dryRun {
+			created, err := r.client.CreateAppInfoLocalization(ctx, resolvedAppInfoID, localization)
+			if err != nil {
+				return fmt.Errorf("failed to create app info localization %s: %w", localization.Locale, err)
+			}
+			item.ID = created.Data.ID
+		}
+		r.record(item)
+	}
+
+	if info.PrimaryCategory != "" {
+		primary, err := shared.FetchAppInfoCategoryID(ctx, r.client.GetAppInfoPrimaryCategory, resolvedAppInfoID)
+		if err != nil {
+			return fmt.Errorf("failed to fetch primary category: %w", err)
+		}
+		secondary, err := shared.FetchAppInfoCategoryID(ctx, r.client.GetAppInfoSecondaryCategory, resolvedAppInfoID)
+		if err != nil {
+			return fmt.Errorf("failed to fetch secondary category: %w", err)
+		}
+		key := strings.Trim(info.PrimaryCategory+"/"+info.SecondaryCategory, "/")
+		if primary == info.PrimaryCategory && secondary == info.SecondaryCategory {
+			r.record(asc.AppRestoreItem{Resource: "categories", Key: key, Action: restoreActionSkip, Message: "unchanged"})
+		} else {
+			if !r.dryRun {
+				if _, err := r.client.UpdateAppInfoCategories(ctx, resolvedAppInfoID, info.PrimaryCategory, info.SecondaryCategory); err != nil {
+					return fmt.Errorf("failed to update categories: %w", err)
+				}
+			}
+			r.record(asc.AppRestoreItem{Resource: "categories", Key: key, Action: restoreActionUpdate, ID: resolvedAppInfoID})
+		}
+	}
+
+	if info.AgeRating != nil {
+		current, err := r.client.GetAgeRatingDeclarationForAppInfo(ctx, resolvedAppInfoID)
+		switch {
+		case asc.IsNotFound(err):
+			r.record(asc.AppRestoreItem{Resource: "age-rating", Key: resolvedAppInfoID, Action: restoreActionSkip, Message: "target app info has no age rating declaration"})
+		case err != nil:
+			return fmt.Errorf("failed to fetch age rating: %w", err)
+		case reflect.DeepEqual(current.Data.Attributes, *info.AgeRating):
+			r.record(asc.AppRestoreItem{Resource: "age-rating", Key: resolvedAppInfoID, Action: restoreActionSkip, Message: "unchanged"})
+		default:
+			if !r.dryRun {
+				if _, err := r.client.UpdateAgeRatingDeclaration(ctx, current.Data.ID, *info.AgeRating); err != nil {
+					return fmt.Errorf("failed to update age rating: %w", err)
+				}
+			}
+			r.record(asc.AppRestoreItem{Resource: "age-rating", Key: resolvedAppInfoID, Action: restoreActionUpdate, ID: current.Data.ID})
+		}
+	}
+	return nil
+}
+
+// restoreVersions restores the latest snapshot version of each platform; older
+// versions are kept in the snapshot for reference only.
+func (r *restorer) restoreVersions(ctx context.Context) error {
+	if len(r.snap.Versions) == 0 {
+		return nil
+	}
+	items, err := fetchAll(ctx, r.client.GetAppStoreVersions, r.appID, asc.WithAppStoreVersionsNextURL, asc.WithAppStoreVersionsLimit(200))
+	if err != nil {
+		return fmt.Errorf("failed to fetch versions: %w", err)
+	}
+	existing := make(map[string]string, len(items))
+	editable := make(map[string]asc.Resource[asc.AppStoreVersionAttributes])
+	for _, item := range items {
+		platform := string(item.Attributes.Platform)
+		existing[platform+" "+item.Attributes.VersionString] = item.ID
+		if isEditableVersion(item.Attributes) {
+			editable[platform] = item
+		}
+	}
+
+	for _, index := range shared.LatestVersionIndexes(r.snap.Versions) {
+		version := r.snap.Versions[index]
+		key := version.Platform + " " + version.VersionString
+		versionID, ok := existing[key]
+		current, hasEditable := editable[version.Platform]
+		switch {
+		case ok:
+			r.record(asc.AppRestoreItem{Resource: "version", Key: key, Action: restoreActionSkip, ID: versionID, Message: "already exists"})
+		case hasEditable:
+			// App Store Connect allows one editable version per platform, so
+			// a cloned app restores into the version it already has.
+			versionID = current.ID
+			if !r.dryRun {
+				if _, err := r.client.UpdateAppStoreVersion(ctx, versionID, asc.AppStoreVersionUpdateAttributes{
+					VersionString: &version.VersionString,
+				}); err != nil {
+					return fmt.Errorf("failed to update version %s: %w", key, err)
+				}
+			}
+			r.record(asc.AppRestoreItem{Resource: "version", Key: key, Action: restoreActionUpdate, ID: versionID, Message: "renamed from " + current.Attributes.VersionString})
+		default:
+			if !r.dryRun {
+				created, err := r.client.CreateAppStoreVersion(ctx, r.appID, asc.AppStoreVersionCreateAttributes{
+					Platform:      asc.Platform(version.Platform),
+					VersionString: version.VersionString,
+					Copyright:     version.Copyright,
+				})
+				if err != nil {
+					return fmt.Errorf("failed to create version %s: %w", key, err)
+				}
+				versionID = created.Data.ID
+			}
+			r.record(asc.AppRestoreItem{Resource: "version", Key: key, Action: restoreActionCreate, ID: versionID})
+		}
+		if err := r.restoreVersionLocalizations(ctx, versionID, version); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// editableVersionStates are the states in which a version's metadata and
+// version string can still be changed.
+var editableVersionStates = map[string]struct{}{
+	"PREPARE_FOR_SUBMISSION": {},
+	"DEVELOPER_REJECTED":     {},
+	"REJECTED":               {},
+	"METADATA_REJECTED":      {},
+	"INVALID_BINARY":         {},
+}
+
+func isEditableVersion(attrs asc.AppStoreVersionAttributes) bool {
+	state := attrs.AppVersionState
+	if state == "" {
+		state = attrs.AppStoreState
+	}
+	_, ok := editableVersionStates[state]
+	return ok
+}
+
+func (r *restorer) restoreVersionLocalizations(ctx context.Context, versionID string, version shared.SnapshotVersion) error {
+	// A new version starts with localizations copied by App Store Connect,
+	// so list them rather than assuming none exist.
+	existing := make(map[string]string)
+	if versionID != "" {
+		localizations, err := shared.FetchVersionLocalizations(ctx, r.client, versionID)
+		if err != nil {
+			return err
+		}
+		for _, item := range localizations {
+			existing[item.Attributes.Locale] = item.ID
+		}
+	}
+
+	for _, localization := range version.Localizations {
+		key := version.Platform + " " + version.VersionString + " " + localization.Locale
+		localizationID, ok := existing[localization.Locale]
+		if ok {
+			r.record(asc.AppRestoreItem{Resource: "version-localization", Key: key, Action: restoreActionSkip, ID: localizationID, Message: "already exists"})
+		} else {
+			if !r.dryRun {
+				created, err := r.client.CreateAppStoreVersionLocalization(ctx, versionID, localization.AppStoreVersionLocalizationAttributes)
+				if err != nil {
+					return fmt.Errorf("failed to create version localization %s: %w", key, err)
+				}
+				localizationID = created.Data.ID
+			}
+			r.record(asc.AppRestoreItem{Resource: "version-localization", Key: key, Action: restoreActionCreate, ID: localizationID})
+		}
+		if r.skipScreenshots {
+			continue
+		}
+		for _, set := range localization.Screenshots {
+			if err := r.restoreScreenshotSet(ctx, localizationID, key+" "+set.DisplayType, set); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// restoreScreenshotSet uploads the snapshot's screenshots into the matching
+// set, keeping byte-identical screenshots that are already there.
+func (r *restorer) restoreScreenshotSet(ctx context.Context, localizationID, key string, set shared.SnapshotScreenshotSet) error {
+	files := make([]string, 0, len(set.Files))
+	for _, file := range set.Files {
+		files = append(files, filepath.Join(r.dir, filepath.FromSlash(file)))
+	}
+	if r.dryRun {
+		return r.planScreenshotSet(ctx, localizationID, key, set.DisplayType, files)
+	}
+
+	remote, err := shared.EnsureScreenshotSet(ctx, r.client, localizationID, set.DisplayType)
+	if err != nil {
+		return fmt.Errorf("failed to prepare screenshot set %s: %w", key, err)
+	}
+	results, _, err := shared.SyncScreenshotSet(ctx, r.client, remote.ID, files, false)
+	if err != nil {
+		return fmt.Errorf("failed to upload screenshots %s: %w", key, err)
+	}
+	uploaded := 0
+	for _, result := range results {
+		if result.Action == shared.AssetActionUploaded {
+			uploaded++
+		}
+	}
+	if uploaded == 0 {
+		r.record(asc.AppRestoreItem{Resource: "screenshots", Key: key, Action: restoreActionSkip, ID: remote.ID, Message: "already uploaded"})
+		return nil
+	}
+	r.record(asc.AppRestoreItem{Resource: "screenshots", Key: key, Action: restoreActionUpload, ID: remote.ID, Count: uploaded})
+	return nil
+}
+
+// planScreenshotSet records what restoreScreenshotSet would upload, matching
+// remote screenshots by checksum and checking the set limit the same way.
+func (r *restorer) planScreenshotSet(ctx context.Context, localizationID, key, displayType string, files []string) error {
+	var setID string
+	if localizationID != "" {
+		remote, ok, err := shared.FindScreenshotSet(ctx, r.client, localizationID, displayType)
+		if err != nil {
+			return fmt.Errorf("failed to fetch screenshot set %s: %w", key, err)
+		}
+		if ok {
+			setID = remote.ID
+		}
+	}
+	plan, err := shared.PlanScreenshotSetSync(ctx, r.client, setID, files, false)
+	if err != nil {
+		return fmt.Errorf("failed to plan screenshots %s: %w", key, err)
+	}
+	if plan.Upload == 0 {
+		r.record(asc.AppRestoreItem{Resource: "screenshots", Key: key, Action: restoreActionSkip, ID: setID, Message: "already uploaded"})
+		return nil
+	}
+	r.record(asc.AppRestoreItem{Resource: "screenshots", Key: key, Action: restoreActionUpload, ID: setID, Count: plan.Upload})
+	return nil
+}
+
+func (r *restorer) restoreInAppPurchases(ctx context.Context) error {
+	if len(r.snap.InAppPurchases) == 0 {
+		return nil
+	}
+	items, err := fetchAll(ctx, r.client.GetInAppPurchasesV2, r.appID, asc.WithIAPNextURL, asc.WithIAPLimit(200))
+	if err != nil {
+		return fmt.Errorf("failed to fetch in-app purchases: %w", err)
+	}
+	existing := make(map[string]string, len(items))
+	for _, item := range items {
+		existing[item.Attributes.ProductID] = item.ID
+	}
+
+	for _, purchase := range r.snap.InAppPurchases {
+		productID := r.productID(purchase.ProductID)
+		prices := currentPrices(purchase.Prices, r.today)
+		if id, ok := existing[productID]; ok {
+			if err := r.resumeInAppPurchase(ctx, id, productID, purchase, prices); err != nil {
+				return err
+			}
+			continue
+		}
+		item := asc.AppRestoreItem{
+			Resource: "in-app-purchase",
+			Key:      productID,
+			Action:   restoreActionCreate,
+			Message:  fmt.Sprintf("%d localization(s), %d price(s)", len(purchase.Localizations), len(prices)),
+		}
+		if r.dryRun {
+			r.record(item)
+			continue
+		}
+
+		created, err := r.client.CreateInAppPurchaseV2(ctx, r.appID, asc.InAppPurchaseV2CreateAttributes{
+			Name:              purchase.Name,
+			ProductID:         productID,
+			InAppPurchaseType: purchase.InAppPurchaseType,
+			ReviewNote:        purchase.ReviewNote,
+			FamilySharable:    purchase.FamilySharable,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create in-app purchase %s: %w", productID, err)
+		}
+		item.ID = created.Data.ID
+		if err := r.createInAppPurchaseDetails(ctx, created.Data.ID, productID, purchase.BaseTerritory, purchase.Localizations, prices); err != nil {
+			return err
+		}
+		r.record(item)
+	}
+	return nil
+}
+
+// resumeInAppPurchase adds the localizations and prices an existing in-app
+// purchase is missing, so a restore that failed after creating the product
+// can be rerun.
+func (r *restorer) resumeInAppPurchase(ctx context.Context, iapID, productID string, purchase shared.SnapshotInAppPurchase, prices []shared.SnapshotPrice) error {
+	current, err := fetchAll(ctx, r.client.GetInAppPurchaseLocalizations, iapID, asc.WithIAPLocalizationsNextURL, asc.WithIAPLocalizationsLimit(200))
+	if err != nil {
+		return fmt.Errorf("failed to fetch localizations for %s: %w", productID, err)
+	}
+	localizations := missingLocalizations(purchase.Localizations, current, func(attrs asc.InAppPurchaseLocalizationAttributes) string {
+		return attrs.Locale
+	})
+	if purchase.BaseTerritory == "" {
+		prices = nil
+	}
+	if len(prices) > 0 {
+		schedule, err := r.client.GetInAppPurchasePriceSchedule(ctx, iapID)
+		switch {
+		case asc.IsNotFound(err):
+		case err != nil:
+			return fmt.Errorf("failed to fetch price schedule for %s: %w", productID, err)
+		case schedule.Data.ID != "":
+			// A schedule without manual prices is what App Store Connect
+			// returns for a product that was never priced.
+			manual, err := r.client.GetInAppPurchasePriceScheduleManualPrices(ctx, schedule.Data.ID, asc.WithIAPPriceSchedulePricesLimit(1))
+			if err != nil {
+				return fmt.Errorf("failed to fetch prices for %s: %w", productID, err)
+			}
+			if len(manual.Data) > 0 {
+				prices = nil
+			}
+		}
+	}
+
+	if len(localizations) == 0 && len(prices) == 0 {
+		r.record(asc.AppRestoreItem{Resource: "in-app-purchase", Key: productID, Action: restoreActionSkip, ID: iapID, Message: "already exists"})
+		return nil
+	}
+	if !r.dryRun {
+		if err := r.createInAppPurchaseDetails(ctx, iapID, productID, purchase.BaseTerritory, localizations, prices); err != nil {
+			return err
+		}
+	}
+	r.record(asc.AppRestoreItem{
+		Resource: "in-app-purchase",
+		Key:      productID,
+		Action:   restoreActionUpdate,
+		ID:       iapID,
+		Message:  fmt.Sprintf("%d missing localization(s), %d price(s)", len(localizations), len(prices)),
+	})
+	return nil
+}
+
+func (r *restorer) createInAppPurchaseDetails(ctx context.Context, iapID, productID, baseTerritory string, localizations []asc.InAppPurchaseLocalizationAttributes, prices []shared.SnapshotPrice) error {
+	for _, localization := range localizations {
+		if _, err := r.client.CreateInAppPurchaseLocalization(ctx, iapID, asc.InAppPurchaseLocalizationCreateAttributes{
+			Name:        localization.Name,
+			Locale:      localization.Locale,
+			Description: localization.Description,
+		}); err != nil {
+			return fmt.Errorf("failed to create localization %s for %s: %w", localization.Locale, productID, err)
+		}
+	}
+	if err := r.createInAppPurchasePriceSchedule(ctx, iapID, baseTerritory, prices); err != nil {
+		return fmt.Errorf("failed to set prices for %s: %w", productID, err)
+	}
+	return nil
+}
+
+// missingLocalizations returns the snapshot localizations whose locale is not
+// among current.
+func missingLocalizations[T any](want []T, current []asc.Resource[T], locale func(T) string) []T {
+	have := make(map[string]struct{}, len(current))
+	for _, item := range current {
+		have[locale(item.Attributes)] = struct{}{}
+	}
+	var missing []T
+	for _, item := range want {
+		if _, ok := have[locale(item)]; !ok {
+			missing = append(missing, item)
+		}
+	}
+	return missing
+}
+
+func (r *restorer) createInAppPurchasePriceSchedule(ctx context.Context, iapID, baseTerritory string, prices []shared.SnapshotPrice) error {
+	if baseTerritory == "" || len(prices) == 0 {
+		return nil
+	}
+	attrs := asc.InAppPurchasePriceScheduleCreateAttributes{BaseTerritoryID: baseTerritory}
+	for _, price := range prices {
+		points, err := fetchAll(ctx, r.client.GetInAppPurchasePricePoints, iapID, asc.WithIAPPricePointsNextURL,
+			asc.WithIAPPricePointsTerritory(price.Territory),
+			asc.WithIAPPricePointsLimit(200),
+		)
+		if err != nil {
+			return err
+		}
+		pointID, err := findPricePoint(points, price, func(attrs asc.InAppPurchasePricePointAttributes) string {
+			return attrs.CustomerPrice
+		})
+		if err != nil {
+			return err
+		}
+		attrs.Prices = append(attrs.Prices, asc.InAppPurchasePriceSchedulePrice{PricePointID: pointID})
+	}
+	_, err := r.client.CreateInAppPurchasePriceSchedule(ctx, iapID, attrs)
+	return err
+}
+
+// findPricePoint returns the ID of the price point whose customer price
+// matches price. Price points are per product, so IDs from the snapshot
+// cannot be reused.
+func findPricePoint[T any](points []asc.Resource[T], price shared.SnapshotPrice, customerPrice func(T) string) (string, error) {
+	for _, point := range points {
+		if customerPrice(point.Attributes) == price.CustomerPrice {
+			return point.ID, nil
+		}
+	}
+	return "", fmt.Errorf("no price point for %s in %s", price.CustomerPrice, price.Territory)
+}
+
+func (r *restorer) restoreSubscriptions(ctx context.Context) error {
+	if len(r.snap.Subscriptions) == 0 {
+		return nil
+	}
+	groups, err := fetchAll(ctx, r.client.GetSubscriptionGroups, r.appID, asc.WithSubscriptionGroupsNextURL, asc.WithSubscriptionGroupsLimit(200))
+	if err != nil {
+		return fmt.Errorf("failed to fetch subscription groups: %w", err)
+	}
+	existing := make(map[string]string, len(groups))
+	for _, group := range groups {
+		existing[group.Attributes.ReferenceName] = group.ID
+	}
+
+	for _, group := range r.snap.Subscriptions {
+		groupID, ok := existing[group.ReferenceName]
+		if ok {
+			if err := r.resumeSubscriptionGroup(ctx, groupID, group); err != nil {
+				return err
+			}
+		} else {
+			item := asc.AppRestoreItem{
+				Resource: "subscription-group",
+				Key:      group.ReferenceName,
+				Action:   restoreActionCreate,
+				Message:  fmt.Sprintf("%d localization(s)", len(group.Localizations)),
+			}
+			if !r.dryRun {
+				created, err := r.client.CreateSubscriptionGroup(ctx, r.appID, asc.SubscriptionGroupCreateAttributes{ReferenceName: group.ReferenceName})
+				if err != nil {
+					return fmt.Errorf("failed to create subscription group %s: %w", group.ReferenceName, err)
+				}
+				groupID = created.Data.ID
+				item.ID = groupID
+				if err := r.createSubscriptionGroupLocalizations(ctx, groupID, group.ReferenceName, group.Localizations); err != nil {
+					return err
+				}
+			}
+			r.record(item)
+		}
+		if err := r.restoreGroupSubscriptions(ctx, groupID, group); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resumeSubscriptionGroup adds the localizations an existing subscription
+// group is missing.
+func (r *restorer) resumeSubscriptionGroup(ctx context.Context, groupID string, group shared.SnapshotSubscriptionGroup) error {
+	current, err := fetchAll(ctx, r.client.GetSubscriptionGroupLocalizations, groupID, asc.WithSubscriptionGroupLocalizationsNextURL, asc.WithSubscriptionGroupLocalizationsLimit(200))
+	if err != nil {
+		return fmt.Errorf("failed to fetch localizations for group %s: %w", group.ReferenceName, err)
+	}
+	localizations := missingLocalizations(group.Localizations, current, func(attrs asc.SubscriptionGroupLocalizationAttributes) string {
+		return attrs.Locale
+	})
+	if len(localizations) == 0 {
+		r.record(asc.AppRestoreItem{Resource: "subscription-group", Key: group.ReferenceName, Action: restoreActionSkip, ID: groupID, Message: "already exists"})
+		return nil
+	}
+	if !r.dryRun {
+		if err := r.createSubscriptionGroupLocalizations(ctx, groupID, group.ReferenceName, localizations); err != nil {
+			return err
+		}
+	}
+	r.record(asc.AppRestoreItem{
+		Resource: "subscription-group",
+		Key:      group.ReferenceName,
+		Action:   restoreActionUpdate,
+		ID:       groupID,
+		Message:  fmt.Sprintf("%d missing localization(s)", len(localizations)),
+	})
+	return nil
+}
+
+func (r *restorer) createSubscriptionGroupLocalizations(ctx context.Context, groupID, referenceName string, localizations []asc.SubscriptionGroupLocalizationAttributes) error {
+	for _, localization := range localizations {
+		if _, err := r.client.CreateSubscriptionGroupLocalization(ctx, groupID, asc.SubscriptionGroupLocalizationCreateAttributes{
+			Name:          localization.Name,
+			CustomAppName: localization.CustomAppName,
+			Locale:        localization.Locale,
+		}); err != nil {
+			return fmt.Errorf("failed to create localization %s for group %s: %w", localization.Locale, referenceName, err)
+		}
+	}
+	return nil
+}
+
+func (r *restorer) restoreGroupSubscriptions(ctx context.Context, groupID string, group shared.SnapshotSubscriptionGroup) error {
+	existing := make(map[string]string)
+	if groupID != "" {
+		items, err := fetchAll(ctx, r.client.GetSubscriptions, groupID, asc.WithSubscriptionsNextURL, asc.WithSubscriptionsLimit(200))
+		if err != nil {
+			return fmt.Errorf("failed to fetch subscriptions for group %s: %w", group.ReferenceName, err)
+		}
+		for _, item := range items {
+			existing[item.Attributes.ProductID] = item.ID
+		}
+	}
+
+	for _, subscription := range group.Subscriptions {
+		productID := r.productID(subscription.ProductID)
+		prices := currentPrices(subscription.Prices, r.today)
+		if id, ok := existing[productID]; ok {
+			if err := r.resumeSubscription(ctx, id, productID, subscription, prices); err != nil {
+				return err
+			}
+			continue
+		}
+		item := asc.AppRestoreItem{
+			Resource: "subscription",
+			Key:      productID,
+			Action:   restoreActionCreate,
+			Message:  fmt.Sprintf("%d localization(s), %d price(s)", len(subscription.Localizations), len(prices)),
+		}
+		if r.dryRun {
+			r.record(item)
+			continue
+		}
+
+		attrs := asc.SubscriptionCreateAttributes{
+			Name:               subscription.Name,
+			ProductID:          productID,
+			FamilySharable:     &subscription.FamilySharable,
+			SubscriptionPeriod: subscription.SubscriptionPeriod,
+			ReviewNote:         subscription.ReviewNote,
+		}
+		if subscription.GroupLevel > 0 {
+			attrs.GroupLevel = &subscription.GroupLevel
+		}
+		created, err := r.client.CreateSubscription(ctx, groupID, attrs)
+		if err != nil {
+			return fmt.Errorf("failed to create subscription %s: %w", productID, err)
+		}
+		item.ID = created.Data.ID
+		if err := r.createSubscriptionDetails(ctx, created.Data.ID, productID, subscription.Localizations, prices); err != nil {
+			return err
+		}
+		r.record(item)
+	}
+	return nil
+}
+
+// resumeSubscription adds the localizations and territory prices an existing
+// subscription is missing, so a restore that failed after creating the
+// product can be rerun.
+func (r *restorer) resumeSubscription(ctx context.Context, subscriptionID, productID string, subscription shared.SnapshotSubscription, prices []shared.SnapshotPrice) error {
+	current, err := fetchAll(ctx, r.client.GetSubscriptionLocalizations, subscriptionID, asc.WithSubscriptionLocalizationsNextURL, asc.WithSubscriptionLocalizationsLimit(200))
+	if err != nil {
+		return fmt.Errorf("failed to fetch localizations for %s: %w", productID, err)
+	}
+	localizations := missingLocalizations(subscription.Localizations, current, func(attrs asc.SubscriptionLocalizationAttributes) string {
+		return attrs.Locale
+	})
+	var missingPrices []shared.SnapshotPrice
+	for _, price := range prices {
+		existing, err := r.client.GetSubscriptionPrices(ctx, subscriptionID,
+			asc.WithSubscriptionPricesTerritory(price.Territory),
+			asc.WithSubscriptionPricesLimit(1),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to fetch %s prices for %s: %w", price.Territory, productID, err)
+		}
+		if len(existing.Data) == 0 {
+			missingPrices = append(missingPrices, price)
+		}
+	}
+
+	if len(localizations) == 0 && len(missingPrices) == 0 {
+		r.record(asc.AppRestoreItem{Resource: "subscription", Key: productID, Action: restoreActionSkip, ID: subscriptionID, Message: "already exists"})
+		return nil
+	}
+	if !r.dryRun {
+		if err := r.createSubscriptionDetails(ctx, subscriptionID, productID, localizations, missingPrices); err != nil {
+			return err
+		}
+	}
+	r.record(asc.AppRestoreItem{
+		Resource: "subscription",
+		Key:      productID,
+		Action:   restoreActionUpdate,
+		ID:       subscriptionID,
+		Message:  fmt.Sprintf("%d missing localization(s), %d price(s)", len(localizations), len(missingPrices)),
+	})
+	return nil
+}
+
+func (r *restorer) createSubscriptionDetails(ctx context.Context, subscriptionID, productID string, localizations []asc.SubscriptionLocalizationAttributes, prices []shared.SnapshotPrice) error {
+	for _, localization := range localizations {
+		if _, err := r.client.CreateSubscriptionLocalization(ctx, subscriptionID, asc.SubscriptionLocalizationCreateAttributes{
+			Name:        localization.Name,
+			Locale:      localization.Locale,
+			Description: localization.Description,
+		}); err != nil {
+			return fmt.Errorf("failed to create localization %s for %s: %w", localization.Locale, productID, err)
+		}
+	}
+	for _, price := range prices {
+		points, err := fetchAll(ctx, r.client.GetSubscriptionPricePoints, subscriptionID, asc.WithSubscriptionPricePointsNextURL,
+			asc.WithSubscriptionPricePointsTerritory(price.Territory),
+			asc.WithSubscriptionPricePointsLimit(200),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to fetch price points for %s: %w", productID, err)
+		}
+		pointID, err := findPricePoint(points, price, func(attrs asc.SubscriptionPricePointAttributes) string {
+			return attrs.CustomerPrice
+		})
+		if err != nil {
+			return fmt.Errorf("failed to set prices for %s: %w", productID, err)
+		}
+		if _, err := r.client.CreateSubscriptionPrice(ctx, subscriptionID, pointID, price.Territory, asc.SubscriptionPriceCreateAttributes{}); err != nil {
+			return fmt.Errorf("failed to set %s price for %s: %w", price.Territory, productID, err)
+		}
+	}
+	return nil
+}
+
+func (r *restorer) restoreBetaGroups(ctx context.Context) error {
+	if len(r.snap.BetaGroups) == 0 {
+		return nil
+	}
+	items, err := fetchAll(ctx, r.client.GetBetaGroups, r.appID, asc.WithBetaGroupsNextURL, asc.WithBetaGroupsLimit(200))
+	if err != nil {
+		return fmt.Errorf("failed to fetch beta groups: %w", err)
+	}
+	existing := make(map[string]string, len(items))
+	for _, item := range items {
+		existing[item.Attributes.Name] = item.ID
+	}
+
+	for _, group := range r.snap.BetaGroups {
+		if id, ok := existing[group.Name]; ok {
+			r.record(asc.AppRestoreItem{Resource: "beta-group", Key: group.Name, Action: restoreActionSkip, ID: id, Message: "already exists"})
+			continue
+		}
+		if group.IsInternalGroup {
+			r.record(asc.AppRestoreItem{Resource: "beta-group", Key: group.Name, Action: restoreActionSkip, Message: "internal groups are not recreated"})
+			continue
+		}
+		item := asc.AppRestoreItem{Resource: "beta-group", Key: group.Name, Action: restoreActionCreate}
+		if !r.dryRun {
+			created, err := r.client.CreateBetaGroup(ctx, r.appID, group.Name, false)
+			if err != nil {
+				return fmt.Errorf("failed to create beta group %s: %w", group.Name, err)
+			}
+			item.ID = created.Data.ID
+			if _, err := r.client.UpdateBetaGroup(ctx, created.Data.ID, asc.BetaGroupUpdateRequest{
+				Data: asc.BetaGroupUpdateData{
+					Type: asc.ResourceTypeBetaGroups,
+					ID:   created.Data.ID,
+					Attributes: &asc.BetaGroupUpdateAttributes{
+						PublicLinkEnabled:      &group.PublicLinkEnabled,
+						PublicLinkLimitEnabled: &group.PublicLinkLimitEnabled,
+						PublicLinkLimit:        group.PublicLinkLimit,
+						FeedbackEnabled:        &group.FeedbackEnabled,
+					},
+				},
+			}); err != nil {
+				return fmt.Errorf("failed to configure beta group %s: %w", group.Name, err)
+			}
+		}
+		r.record(item)
+	}
+	return nil
+}
+
+// restoreAvailability only sets availability on an app that has none;
+// changing existing availability is left to "asc pricing availability".
+func (r *restorer) restoreAvailability(ctx context.Context) error {
+	availability := r.snap.Availability
+	if availability == nil {
+		return nil
+	}
+	key := fmt.Sprintf("%d territories", len(availability.Territories))
+	current, err := r.client.GetAppAvailabilityV2(ctx, r.appID)
+	if err == nil {
+		r.record(asc.AppRestoreItem{Resource: "availability", Key: key, Action: restoreActionSkip, ID: current.Data.ID, Message: "target app already has availability"})
+		return nil
+	}
+	if !shared.IsAppAvailabilityMissing(err) {
+		return fmt.Errorf("failed to fetch availability: %w", err)
+	}
+
+	item := asc.AppRestoreItem{Resource: "availability", Key: key, Action: restoreActionCreate, Count: len(availability.Territories)}
+	if !r.dryRun {
+		attrs := asc.AppAvailabilityV2CreateAttributes{AvailableInNewTerritories: &availability.AvailableInNewTerritories}
+		for _, territory := range availability.Territories {
+			create := asc.TerritoryAvailabilityCreate{TerritoryID: territory.Territory, Available: territory.Available}
+			if territory.PreOrderEnabled {
+				preOrderEnabled := true
+				create.PreOrderEnabled = &preOrderEnabled
+				create.ReleaseDate = territory.ReleaseDate
+			}
+			attrs.TerritoryAvailabilities = append(attrs.TerritoryAvailabilities, create)
+		}
+		created, err := r.client.CreateAppAvailabilityV2(ctx, r.appID, attrs)
+		if err != nil {
+			return fmt.Errorf("failed to create availability: %w", err)
+		}
+		item.ID = created.Data.ID
+	}
+	r.record(item)
+	return nil
+}
+
+func (r *restorer) restoreAppEvents(ctx context.Context) error {
+	if len(r.snap.AppEvents) == 0 {
+		return nil
+	}
+	items, err := fetchAll(ctx, r.client.GetAppEvents, r.appID, asc.WithAppEventsNextURL, asc.WithAppEventsLimit(200))
+	if err != nil {
+		return fmt.Errorf("failed to fetch app events: %w", err)
+	}
+	existing := make(map[string]string, len(items))
+	for _, item := range items {
+		existing[item.Attributes.ReferenceName] = item.ID
+	}
+
+	for _, event := range r.snap.AppEvents {
+		if id, ok := existing[event.ReferenceName]; ok {
+			r.record(asc.AppRestoreItem{Resource: "app-event", Key: event.ReferenceName, Action: restoreActionSkip, ID: id, Message: "already exists"})
+			continue
+		}
+		if event.EventState == "PAST" || event.EventState == "ARCHIVED" {
+			r.record(asc.AppRestoreItem{Resource: "app-event", Key: event.ReferenceName, Action: restoreActionSkip, Message: "event has ended"})
+			continue
+		}
+		item := asc.AppRestoreItem{
+			Resource: "app-event",
+			Key:      event.ReferenceName,
+			Action:   restoreActionCreate,
+			Message:  fmt.Sprintf("%d localization(s)", len(event.Localizations)),
+		}
+		if !r.dryRun {
+			created, err := r.client.CreateAppEvent(ctx, r.appID, asc.AppEventCreateAttributes{
+				ReferenceName:       event.ReferenceName,
+				Badge:               event.Badge,
+				DeepLink:            event.DeepLink,
+				PurchaseRequirement: event.PurchaseRequirement,
+				PrimaryLocale:       event.PrimaryLocale,
+				Priority:            event.Priority,
+				Purpose:             event.Purpose,
+				TerritorySchedules:  event.TerritorySchedules,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create app event %s: %w", event.ReferenceName, err)
+			}
+			item.ID = created.Data.ID
+			if err := r.createAppEventLocalizations(ctx, created.Data.ID, event); err != nil {
+				return err
+			}
+		}
+		r.record(item)
+	}
+	return nil
+}
+
+// createAppEventLocalizations adds the snapshot's localizations to a new
+// event, skipping any (such as the primary locale) it already has.
+func (r *restorer) createAppEventLocalizations(ctx context.Context, eventID string, event shared.SnapshotAppEvent) error {
+	items, err := fetchAll(ctx, r.client.GetAppEventLocalizations, eventID, asc.WithAppEventLocalizationsNextURL, asc.WithAppEventLocalizationsLimit(200))
+	if err != nil {
+		return fmt.Errorf("failed to fetch localizations for event %s: %w", event.ReferenceName, err)
+	}
+	existing := make(map[string]struct{}, len(items))
+	for _, item := range items {
+		existing[item.Attributes.Locale] = struct{}{}
+	}
+	for _, localization := range event.Localizations {
+		if _, ok := existing[localization.Locale]; ok {
+			continue
+		}
+		if _, err := r.client.CreateAppEventLocalization(ctx, eventID, asc.AppEventLocalizationCreateAttributes{
+			Locale:           localization.Locale,
+			Name:             localization.Name,
+			ShortDescription: localization.ShortDescription,
+			LongDescription:  localization.LongDescription,
+		}); err != nil {
+			return fmt.Errorf("failed to create localization %s for event %s: %w", localization.Locale, event.ReferenceName, err)
+		}
+	}
+	return nil
+}
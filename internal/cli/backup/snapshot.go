@@ -0,0 +1,95 @@
+package backup
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// writeSnapshot writes every section as an indented JSON file and returns
+// per-resource counts in file order.
+func writeSnapshot(dir string, snap *shared.Snapshot) ([]asc.AppBackupResourceCount, error) {
+	appInfoCount := 0
+	if snap.AppInfo != nil {
+		appInfoCount = 1
+	}
+	availabilityCount := 0
+	if snap.Availability != nil {
+		availabilityCount = len(snap.Availability.Territories)
+	}
+	subscriptionCount := 0
+	for _, group := range snap.Subscriptions {
+		subscriptionCount += len(group.Subscriptions)
+	}
+
+	files := []struct {
+		resource string
+		name     string
+		value    any
+		count    int
+	}{
+		{"app-info", shared.SnapshotAppInfoFile, snap.AppInfo, appInfoCount},
+		{"versions", shared.SnapshotVersionsFile, snap.Versions, len(snap.Versions)},
+		{"in-app-purchases", shared.SnapshotInAppPurchasesFile, snap.InAppPurchases, len(snap.InAppPurchases)},
+		{"subscriptions", shared.SnapshotSubscriptionsFile, snap.Subscriptions, subscriptionCount},
+		{"beta-groups", shared.SnapshotBetaGroupsFile, snap.BetaGroups, len(snap.BetaGroups)},
+		{"availability", shared.SnapshotAvailabilityFile, snap.Availability, availabilityCount},
+		{"app-events", shared.SnapshotAppEventsFile, snap.AppEvents, len(snap.AppEvents)},
+	}
+	counts := make([]asc.AppBackupResourceCount, 0, len(files)+1)
+	for _, file := range files {
+		if err := writeSnapshotFile(dir, file.name, file.value); err != nil {
+			return nil, err
+		}
+		counts = append(counts, asc.AppBackupResourceCount{Resource: file.resource, File: file.name, Count: file.count})
+	}
+	screenshotCount := 0
+	for _, version := range snap.Versions {
+		for _, localization := range version.Localizations {
+			for _, set := range localization.Screenshots {
+				screenshotCount += len(set.Files)
+			}
+		}
+	}
+	counts = append(counts, asc.AppBackupResourceCount{Resource: "screenshots", File: shared.SnapshotScreenshotsDir + "/", Count: screenshotCount})
+
+	// The manifest goes last so a partial snapshot never looks complete.
+	if err := writeSnapshotFile(dir, shared.SnapshotManifestFile, snap.Manifest); err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+func writeSnapshotFile(dir, name string, value any) error {
+	data, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode %s: %w", name, err)
+	}
+	return os.WriteFile(filepath.Join(dir, name), append(data, '\n'), 0o644)
+}
+
+// currentPrices keeps, per territory, the price in effect on today (a
+// YYYY-MM-DD date): the latest start date on or before today that has not ended.
+func currentPrices(prices []shared.SnapshotPrice, today string) []shared.SnapshotPrice {
+	byTerritory := make(map[string]shared.SnapshotPrice)
+	for _, price := range prices {
+		if price.StartDate > today || (price.EndDate != "" && price.EndDate <= today) {
+			continue
+		}
+		existing, ok := byTerritory[price.Territory]
+		if !ok || price.StartDate > existing.StartDate {
+			byTerritory[price.Territory] = price
+		}
+	}
+	current := make([]shared.SnapshotPrice, 0, len(byTerritory))
+	for _, price := range byTerritory {
+		current = append(current, price)
+	}
+	sort.Slice(current, func(i, j int) bool { return current[i].Territory < current[j].Territory })
+	return current
+}
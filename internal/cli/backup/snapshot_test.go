@@ -0,0 +1,61 @@
+package backup
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+func TestCurrentPrices(t *testing.T) {
+	prices := []shared.SnapshotPrice{
+		{Territory: "USA", CustomerPrice: "0.99", StartDate: "2025-01-01", EndDate: "2026-03-01"},
+		{Territory: "USA", CustomerPrice: "1.99", StartDate: "2026-03-01"},
+		{Territory: "USA", CustomerPrice: "2.99", StartDate: "2027-01-01"},
+		{Territory: "GBR", CustomerPrice: "0.79"},
+		{Territory: "FRA", CustomerPrice: "0.89", EndDate: "2026-01-01"},
+	}
+
+	got := currentPrices(prices, "2026-10-18")
+	want := []shared.SnapshotPrice{
+		{Territory: "GBR", CustomerPrice: "0.79"},
+		{Territory: "USA", CustomerPrice: "1.99", StartDate: "2026-03-01"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("currentPrices() = %+v, want %+v", got, want)
+	}
+}
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	snap := &shared.Snapshot{
+		Manifest: shared.SnapshotManifest{FormatVersion: shared.SnapshotFormatVersion, CreatedAt: "2026-10-18T00:00:00Z", App: shared.SnapshotApp{ID: "app-1"}},
+		AppInfo:  &shared.SnapshotAppInfo{ID: "info-1", PrimaryCategory: "GAMES"},
+		Versions: []shared.SnapshotVersion{{
+			ID:            "version-1",
+			VersionString: "1.0",
+			Platform:      "IOS",
+			Localizations: []shared.SnapshotVersionLocalization{{
+				Screenshots: []shared.SnapshotScreenshotSet{{DisplayType: "APP_IPHONE_67", Files: []string{"a.png", "b.png"}}},
+			}},
+		}},
+	}
+
+	counts, err := writeSnapshot(dir, snap)
+	if err != nil {
+		t.Fatalf("writeSnapshot() error: %v", err)
+	}
+	last := counts[len(counts)-1]
+	if last.Resource != "screenshots" || last.Count != 2 {
+		t.Fatalf("unexpected screenshot count: %+v", last)
+	}
+
+	got, err := shared.ReadSnapshot(dir)
+	if err != nil {
+		t.Fatalf("ReadSnapshot() error: %v", err)
+	}
+	if got.Manifest != snap.Manifest || got.AppInfo.PrimaryCategory != "GAMES" || len(got.Versions) != 1 ||
+		got.Versions[0].Localizations[0].Screenshots[0].Files[1] != "b.png" {
+		t.Fatalf("unexpected snapshot after round trip: %+v", got)
+	}
+}
@@ -39,7 +39,7 @@ func BuildsAppEncryptionDeclarationGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("app-encryption-declaration get", flag.ExitOnError)
 
 	buildID := fs.String("id", "", "Build ID")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
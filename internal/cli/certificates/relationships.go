@@ -39,7 +39,7 @@ func CertificatesRelationshipsPassTypeIDCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("pass-type-id", flag.ExitOnError)
 
 	id := fs.String("id", "", "Certificate ID")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
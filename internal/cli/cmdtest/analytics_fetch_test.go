@@ -0,0 +1,220 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestAnalyticsFetchReusesRequestAndMergesSegments(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	outPath := filepath.Join(t.TempDir(), "sessions.csv")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	var downloads []string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.URL.Host == "example.apple.com":
+			downloads = append(downloads, req.URL.Path)
+			switch req.URL.Path {
+			case "/seg-1.csv.gz":
+				return gzipReportResponse(t, "Date\tApp Name\tSessions\n2024-01-20\tExample\t10\n2024-01-20\tExample\t10\n"), nil
+			case "/seg-2.csv.gz":
+				return gzipReportResponse(t, "Date\tApp Name\tSessions\tDevice\n2024-01-20\tExample\t10\t\n2024-01-21\tExample\t7\tiPad\n"), nil
+			}
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/apps/app-1/analyticsReportRequests":
+			return apiTestResponse(`{"data":[` +
+				`{"type":"analyticsReportRequests","id":"req-snapshot","attributes":{"accessType":"ONE_TIME_SNAPSHOT"}},` +
+				`{"type":"analyticsReportRequests","id":"req-stopped","attributes":{"accessType":"ONGOING","stoppedDueToInactivity":true}},` +
+				`{"type":"analyticsReportRequests","id":"req-ongoing","attributes":{"accessType":"ONGOING","stoppedDueToInactivity":false}}` +
+				`],"links":{}}`), nil
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/analyticsReportRequests/req-ongoing/reports":
+			return apiTestResponse(`{"data":[` +
+				`{"type":"analyticsReports","id":"report-crashes","attributes":{"name":"App Crashes","category":"APP_USAGE"}},` +
+				`{"type":"analyticsReports","id":"report-sessions","attributes":{"name":"App Sessions","category":"APP_USAGE"}}` +
+				`],"links":{}}`), nil
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/analyticsReports/report-sessions/instances":
+			return apiTestResponse(`{"data":[` +
+				`{"type":"analyticsReportInstances","id":"inst-21","attributes":{"granularity":"DAILY","reportDate":"2024-01-21"}},` +
+				`{"type":"analyticsReportInstances","id":"inst-weekly","attributes":{"granularity":"WEEKLY","reportDate":"2024-01-21"}},` +
+				`{"type":"analyticsReportInstances","id":"inst-20","attributes":{"granularity":"DAILY","reportDate":"2024-01-20"}},` +
+				`{"type":"analyticsReportInstances","id":"inst-old","attributes":{"granularity":"DAILY","reportDate":"2023-12-01"}}` +
+				`],"links":{}}`), nil
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/analyticsReportInstances/inst-20/segments":
+			return apiTestResponse(`{"data":[{"type":"analyticsReportSegments","id":"seg-1","attributes":{"url":"https://example.apple.com/seg-1.csv.gz"}}],"links":{}}`), nil
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/analyticsReportInstances/inst-21/segments":
+			return apiTestResponse(`{"data":[{"type":"analyticsReportSegments","id":"seg-2","attributes":{"url":"https://example.apple.com/seg-2.csv.gz"}}],"links":{}}`), nil
+		}
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		return nil, nil
+	})
+
+	stdout := runSalesStoreCommand(t, []string{
+		"analytics", "fetch", "--app", "app-1", "--category", "app_usage", "--report", "app sessions",
+		"--since", "2024-01-15", "--out", outPath,
+	})
+
+	var result struct {
+		RequestID         string `json:"requestId"`
+		RequestCreated    bool   `json:"requestCreated"`
+		ReportID          string `json:"reportId"`
+		ReportName        string `json:"reportName"`
+		Instances         int    `json:"instances"`
+		Segments          int    `json:"segments"`
+		Rows              int    `json:"rows"`
+		DuplicatesRemoved int    `json:"duplicatesRemoved"`
+		FilePath          string `json:"filePath"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if result.RequestID != "req-ongoing" || result.RequestCreated {
+		t.Fatalf("expected reused ONGOING request, got %+v", result)
+	}
+	if result.ReportID != "report-sessions" || result.ReportName != "App Sessions" {
+		t.Fatalf("unexpected report: %+v", result)
+	}
+	if result.Instances != 2 || result.Segments != 2 || result.Rows != 2 || result.DuplicatesRemoved != 2 {
+		t.Fatalf("unexpected counts: %+v", result)
+	}
+	if strings.Join(downloads, ",") != "/seg-1.csv.gz,/seg-2.csv.gz" {
+		t.Fatalf("expected segments in report date order, got %v", downloads)
+	}
+
+	data, err := os.ReadFile(outPath)
+	if err != nil {
+		t.Fatalf("read merged file: %v", err)
+	}
+	want := "Date\tApp Name\tSessions\tDevice\n2024-01-20\tExample\t10\t\n2024-01-21\tExample\t7\tiPad\n"
+	if string(data) != want {
+		t.Fatalf("unexpected merged file:\n%q\nwant\n%q", string(data), want)
+	}
+}
+
+func TestAnalyticsFetchCreatesRequestWhenMissing(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	created := false
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/apps/app-1/analyticsReportRequests":
+			return apiTestResponse(`{"data":[],"links":{}}`), nil
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/analyticsReportRequests":
+			body, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(body), `"accessType":"ONGOING"`) {
+				t.Fatalf("expected ONGOING request, got %s", body)
+			}
+			created = true
+			return &http.Response{
+				StatusCode: http.StatusCreated,
+				Body:       io.NopCloser(strings.NewReader(`{"data":{"type":"analyticsReportRequests","id":"req-new","attributes":{"accessType":"ONGOING"}}}`)),
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+			}, nil
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/analyticsReportRequests/req-new/reports":
+			return apiTestResponse(`{"data":[],"links":{}}`), nil
+		}
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		return nil, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	var runErr error
+	captureOutput(t, func() {
+		if err := root.Parse([]string{"analytics", "fetch", "--app", "app-1", "--report", "App Sessions", "--out", filepath.Join(t.TempDir(), "out.csv")}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if !created {
+		t.Fatal("expected a new ONGOING request to be created")
+	}
+	if runErr == nil || !strings.Contains(runErr.Error(), "request req-new has no reports yet") || !strings.Contains(runErr.Error(), "--wait") {
+		t.Fatalf("expected pending error with --wait hint, got %v", runErr)
+	}
+}
+
+func TestAnalyticsFetchValidationErrors(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "")
+	existing := filepath.Join(t.TempDir(), "existing.csv")
+	if err := os.WriteFile(existing, []byte("x"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing app",
+			args:    []string{"analytics", "fetch", "--report", "App Sessions"},
+			wantErr: "--app is required",
+		},
+		{
+			name:    "missing report",
+			args:    []string{"analytics", "fetch", "--app", "app-1"},
+			wantErr: "--report is required",
+		},
+		{
+			name:    "invalid granularity",
+			args:    []string{"analytics", "fetch", "--app", "app-1", "--report", "App Sessions", "--granularity", "HOURLY"},
+			wantErr: "--granularity must be DAILY, WEEKLY, or MONTHLY",
+		},
+		{
+			name:    "invalid since",
+			args:    []string{"analytics", "fetch", "--app", "app-1", "--report", "App Sessions", "--since", "last month"},
+			wantErr: "--since must be a number of days",
+		},
+		{
+			name:    "date and since",
+			args:    []string{"analytics", "fetch", "--app", "app-1", "--report", "App Sessions", "--since", "7d", "--date", "2024-01-20"},
+			wantErr: "--date and --since are mutually exclusive",
+		},
+		{
+			name:    "existing output",
+			args:    []string{"analytics", "fetch", "--app", "app-1", "--report", "App Sessions", "--out", existing},
+			wantErr: "output file already exists",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			var runErr error
+			_, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				runErr = root.Run(context.Background())
+			})
+			if errors.Is(runErr, flag.ErrHelp) {
+				if !strings.Contains(stderr, test.wantErr) {
+					t.Fatalf("expected stderr to contain %q, got %q", test.wantErr, stderr)
+				}
+				return
+			}
+			if runErr == nil || !strings.Contains(runErr.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, runErr)
+			}
+		})
+	}
+}
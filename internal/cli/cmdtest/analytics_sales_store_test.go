@@ -0,0 +1,168 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const salesStoreTestReport = "Provider\tSKU\tTitle\tProduct Type Identifier\tUnits\tDeveloper Proceeds\tBegin Date\tEnd Date\tCountry Code\tCurrency of Proceeds\tCustomer Price\tCustomer Currency\tDevice\n" +
+	"APPLE\tcom.example.pro\tExample Pro\tIA1\t3\t0.7\t01/20/2024\t01/20/2024\tUS\tUSD\t0.99\tUSD\tiPhone\n" +
+	"APPLE\tcom.example.pro\tExample Pro\tIA1\t2\t0.6\t01/20/2024\t01/20/2024\tDE\tEUR\t0.99\tEUR\tiPad\n" +
+	"APPLE\tcom.example.app\tExample\t1F\t4\t0\t01/20/2024\t01/20/2024\tUS\tUSD\t0\tUSD\tiPhone\n"
+
+func salesNotFoundResponse(detail string) *http.Response {
+	return &http.Response{
+		StatusCode: http.StatusNotFound,
+		Body:       io.NopCloser(strings.NewReader(`{"errors":[{"code":"NOT_FOUND","title":"The request expected results but none were found.","detail":"` + detail + `"}]}`)),
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+	}
+}
+
+func runSalesStoreCommand(t *testing.T, args []string) string {
+	t.Helper()
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse(args); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+	return stdout
+}
+
+func TestAnalyticsSalesSyncAndQuery(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	store := filepath.Join(t.TempDir(), "sales")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	var requested []string
+	available := false
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path != "/v1/salesReports" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		query := req.URL.Query()
+		if query.Get("filter[reportType]") != "SALES" || query.Get("filter[reportSubType]") != "SUMMARY" || query.Get("filter[frequency]") != "DAILY" {
+			t.Fatalf("unexpected query: %s", req.URL.RawQuery)
+		}
+		date := query.Get("filter[reportDate]")
+		requested = append(requested, date)
+		switch date {
+		case "2024-01-20":
+			return gzipReportResponse(t, salesStoreTestReport), nil
+		case "2024-01-21":
+			return salesNotFoundResponse("There were no sales for the date specified."), nil
+		case "2024-01-23":
+			return salesNotFoundResponse("The requested report could not be found."), nil
+		default:
+			if available {
+				return gzipReportResponse(t, strings.ReplaceAll(salesStoreTestReport, "01/20/2024", "01/22/2024")), nil
+			}
+			return salesNotFoundResponse("Report is not available yet. Daily reports for the Americas are available by 5 am Pacific Time."), nil
+		}
+	})
+
+	syncArgs := []string{"analytics", "sales", "sync", "--vendor", "12345678", "--store", store, "--from", "2024-01-20", "--to", "2024-01-23"}
+	var first struct {
+		Downloaded int      `json:"downloaded"`
+		Empty      int      `json:"empty"`
+		Skipped    int      `json:"skipped"`
+		Rows       int      `json:"rows"`
+		Pending    []string `json:"pending"`
+	}
+	if err := json.Unmarshal([]byte(runSalesStoreCommand(t, syncArgs)), &first); err != nil {
+		t.Fatalf("unmarshal first sync: %v", err)
+	}
+	if first.Downloaded != 1 || first.Empty != 1 || first.Rows != 3 || strings.Join(first.Pending, ",") != "2024-01-22,2024-01-23" {
+		t.Fatalf("unexpected first sync: %+v", first)
+	}
+
+	requested = nil
+	available = true
+	var second struct {
+		Downloaded int      `json:"downloaded"`
+		Skipped    int      `json:"skipped"`
+		Pending    []string `json:"pending"`
+	}
+	if err := json.Unmarshal([]byte(runSalesStoreCommand(t, syncArgs)), &second); err != nil {
+		t.Fatalf("unmarshal second sync: %v", err)
+	}
+	if strings.Join(requested, ",") != "2024-01-22,2024-01-23" {
+		t.Fatalf("expected only the pending dates to be requested, got %v", requested)
+	}
+	if second.Downloaded != 1 || second.Skipped != 2 || strings.Join(second.Pending, ",") != "2024-01-23" {
+		t.Fatalf("unexpected second sync: %+v", second)
+	}
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		t.Fatalf("query must not call the API: %s", req.URL.String())
+		return nil, nil
+	})
+	var query struct {
+		Reports int `json:"reports"`
+		Rows    []struct {
+			SKU      string  `json:"sku"`
+			Device   string  `json:"device"`
+			Currency string  `json:"currency"`
+			Units    int     `json:"units"`
+			Proceeds float64 `json:"proceeds"`
+		} `json:"rows"`
+	}
+	stdout := runSalesStoreCommand(t, []string{"analytics", "sales", "query", "--store", store, "--group-by", "sku,device", "--sku", "com.example.pro", "--from", "2024-01-21"})
+	if err := json.Unmarshal([]byte(stdout), &query); err != nil {
+		t.Fatalf("unmarshal query: %v\n%s", err, stdout)
+	}
+	if query.Reports != 2 || len(query.Rows) != 2 {
+		t.Fatalf("unexpected query result: %s", stdout)
+	}
+	if row := query.Rows[1]; row.Device != "iPhone" || row.Currency != "USD" || row.Units != 3 || row.Proceeds != 2.1 {
+		t.Fatalf("unexpected iPhone row: %+v", row)
+	}
+
+	table := runSalesStoreCommand(t, []string{"analytics", "sales", "query", "--store", store, "--group-by", "date,country", "--output", "csv"})
+	for _, want := range []string{"Date", "Country", "2024-01-20", "2024-01-22", "DE", "EUR"} {
+		if !strings.Contains(table, want) {
+			t.Fatalf("expected csv output to contain %q, got:\n%s", want, table)
+		}
+	}
+}
+
+func TestAnalyticsSalesSyncRejectsUnavailableRange(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	store := filepath.Join(t.TempDir(), "sales")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		t.Fatalf("unexpected request for an unavailable date: %s", req.URL.String())
+		return nil, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	from := time.Now().UTC().Format("2006-01-02")
+	if err := root.Parse([]string{"analytics", "sales", "sync", "--vendor", "12345678", "--store", store, "--from", from}); err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	err := root.Run(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "after the last available report date") {
+		t.Fatalf("expected unavailable range error, got %v", err)
+	}
+}
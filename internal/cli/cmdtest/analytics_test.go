@@ -76,3 +76,40 @@ func TestAnalyticsValidationErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestAnalyticsSalesStoreValidationErrors(t *testing.T) {
+	t.Setenv("ASC_VENDOR_NUMBER", "")
+	t.Setenv("ASC_ANALYTICS_VENDOR_NUMBER", "")
+	t.Setenv("ASC_CONFIG_PATH", "/nonexistent/config.json")
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{name: "sync missing vendor", args: []string{"analytics", "sales", "sync", "--store", "sales", "--from", "2024-01-01"}, wantErr: "--vendor is required"},
+		{name: "sync missing store", args: []string{"analytics", "sales", "sync", "--vendor", "12345678", "--from", "2024-01-01"}, wantErr: "--store is required"},
+		{name: "sync missing from", args: []string{"analytics", "sales", "sync", "--vendor", "12345678", "--store", "sales"}, wantErr: "--from is required"},
+		{name: "query missing store", args: []string{"analytics", "sales", "query"}, wantErr: "--store is required"},
+		{name: "query invalid group", args: []string{"analytics", "sales", "query", "--store", "sales", "--group-by", "region"}, wantErr: "--group-by must be one of"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			_, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
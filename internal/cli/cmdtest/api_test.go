@@ -0,0 +1,283 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func apiTestResponse(body string) *http.Response {
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+	}
+}
+
+func TestAPIValidationErrors(t *testing.T) {
+	bodyPath := filepath.Join(t.TempDir(), "body.json")
+	if err := os.WriteFile(bodyPath, []byte(`{"data":{}}`), 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing path",
+			args:    []string{"api", "GET"},
+			wantErr: "METHOD and path are required",
+		},
+		{
+			name:    "unsupported method",
+			args:    []string{"api", "FETCH", "/v1/apps"},
+			wantErr: "unsupported method",
+		},
+		{
+			name:    "relative path without slash",
+			args:    []string{"api", "GET", "v1/apps"},
+			wantErr: "path must start with /",
+		},
+		{
+			name:    "untrusted absolute URL",
+			args:    []string{"api", "GET", "https://example.com/v1/apps"},
+			wantErr: "path must be an App Store Connect URL",
+		},
+		{
+			name:    "paginate with POST",
+			args:    []string{"api", "POST", "/v1/apps", "--paginate"},
+			wantErr: "--paginate is only supported with GET",
+		},
+		{
+			name:    "input with GET",
+			args:    []string{"api", "GET", "/v1/apps", "--input", bodyPath},
+			wantErr: "--input is not supported with GET",
+		},
+		{
+			name:    "input with fields",
+			args:    []string{"api", "POST", "/v1/apps", "--input", bodyPath, "-f", "data[type]=apps"},
+			wantErr: "--input and fields are mutually exclusive",
+		},
+		{
+			name:    "conflicting fields",
+			args:    []string{"api", "PATCH", "/v1/apps/1", "-f", "data=x", "-f", "data[type]=apps"},
+			wantErr: "conflicts with an earlier field",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestAPIGetAddsFieldsToQuery(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/apps" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		if got := req.URL.Query().Get("filter[bundleId]"); got != "com.example.app" {
+			t.Fatalf("expected filter[bundleId] query, got %q", req.URL.RawQuery)
+		}
+		if got := req.URL.Query().Get("limit"); got != "5" {
+			t.Fatalf("expected limit=5, got %q", req.URL.RawQuery)
+		}
+		return apiTestResponse(`{"data":[{"type":"apps","id":"app-1","attributes":{"name":"Example","bundleId":"com.example.app"}}]}`), nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"api", "GET", "/v1/apps?limit=5", "-f", "filter[bundleId]=com.example.app", "--output", "table"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	for _, want := range []string{"bundleId", "name", "app-1", "Example"} {
+		if !strings.Contains(stdout, want) {
+			t.Fatalf("expected %q in table output, got %q", want, stdout)
+		}
+	}
+}
+
+func TestAPIPatchBuildsBodyFromFields(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var payload map[string]interface{}
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodPatch || req.URL.Path != "/v1/apps/app-1" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		return apiTestResponse(`{"data":{"type":"apps","id":"app-1"}}`), nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"api", "patch", "/v1/apps/app-1",
+			"-f", "data[type]=apps",
+			"-f", "data[id]=app-1",
+			"-F", "data[attributes][contentRightsDeclaration]=null",
+			"-F", "data[attributes][isOrEverWasMadeForKids]=false",
+			"-f", "data[attributes][tags][]=one",
+			"-f", "data[attributes][tags][]=two",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	data, _ := payload["data"].(map[string]interface{})
+	attributes, _ := data["attributes"].(map[string]interface{})
+	if data["type"] != "apps" || data["id"] != "app-1" {
+		t.Fatalf("unexpected data: %v", payload)
+	}
+	if value, ok := attributes["contentRightsDeclaration"]; !ok || value != nil {
+		t.Fatalf("expected null contentRightsDeclaration, got %v", attributes)
+	}
+	if attributes["isOrEverWasMadeForKids"] != false {
+		t.Fatalf("expected boolean false, got %v", attributes["isOrEverWasMadeForKids"])
+	}
+	tags, _ := attributes["tags"].([]interface{})
+	if len(tags) != 2 || tags[0] != "one" || tags[1] != "two" {
+		t.Fatalf("unexpected tags: %v", attributes["tags"])
+	}
+	if !strings.Contains(stdout, `"id":"app-1"`) {
+		t.Fatalf("expected response in stdout, got %q", stdout)
+	}
+}
+
+func TestAPIPaginateMergesPages(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	const secondURL = "https://api.appstoreconnect.apple.com/v1/apps?cursor=BQ"
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	requestCount := 0
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		requestCount++
+		switch requestCount {
+		case 1:
+			return apiTestResponse(`{"data":[{"type":"apps","id":"app-1"}],"links":{"next":"` + secondURL + `"}}`), nil
+		case 2:
+			if req.URL.String() != secondURL {
+				t.Fatalf("unexpected second request: %s", req.URL.String())
+			}
+			return apiTestResponse(`{"data":[{"type":"apps","id":"app-2"}],"links":{}}`), nil
+		default:
+			t.Fatalf("unexpected extra request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"api", "GET", "/v1/apps", "--paginate"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var merged struct {
+		Data []struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &merged); err != nil {
+		t.Fatalf("unmarshal output: %v (%q)", err, stdout)
+	}
+	if len(merged.Data) != 2 || merged.Data[0].ID != "app-1" || merged.Data[1].ID != "app-2" {
+		t.Fatalf("unexpected merged data: %+v", merged.Data)
+	}
+}
+
+func TestAPIPaginateRejectsUntrustedNextURL(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return apiTestResponse(`{"data":[],"links":{"next":"https://evil.example.com/v1/apps?cursor=AQ"}}`), nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	captureOutput(t, func() {
+		if err := root.Parse([]string{"api", "GET", "/v1/apps", "--paginate"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if runErr == nil || !strings.Contains(runErr.Error(), "--next must be an App Store Connect URL") {
+		t.Fatalf("expected untrusted next URL error, got %v", runErr)
+	}
+}
@@ -182,7 +182,7 @@ func TestLocalizationsMediaSetsOutputErrors(t *testing.T) {
 	}{
 		{
 			name: "preview sets get unsupported output",
-			args: []string{"localizations", "preview-sets", "get", "--id", "SET_ID", "--output", "yaml"},
+			args: []string{"localizations", "preview-sets", "get", "--id", "SET_ID", "--output", "xml"},
 		},
 		{
 			name: "preview sets get pretty with table",
@@ -190,7 +190,7 @@ func TestLocalizationsMediaSetsOutputErrors(t *testing.T) {
 		},
 		{
 			name: "screenshot sets get unsupported output",
-			args: []string{"localizations", "screenshot-sets", "get", "--id", "SET_ID", "--output", "yaml"},
+			args: []string{"localizations", "screenshot-sets", "get", "--id", "SET_ID", "--output", "xml"},
 		},
 		{
 			name: "screenshot sets get pretty with markdown",
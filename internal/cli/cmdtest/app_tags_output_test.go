@@ -278,8 +278,8 @@ func TestAppTagsListOutputErrors(t *testing.T) {
 	}{
 		{
 			name:    "unsupported output",
-			args:    []string{"app-tags", "list", "--app", "app-1", "--output", "yaml"},
-			wantErr: "unsupported format: yaml",
+			args:    []string{"app-tags", "list", "--app", "app-1", "--output", "xml"},
+			wantErr: "unsupported format: xml",
 		},
 		{
 			name:    "pretty with markdown",
@@ -0,0 +1,243 @@
+package cmdtest
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"flag"
+	"image"
+	"image/png"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeOpaquePNG(t *testing.T, path string, width, height int) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatalf("MkdirAll() error: %v", err)
+	}
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for i := 3; i < len(img.Pix); i += 4 {
+		img.Pix[i] = 0xff
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("Create() error: %v", err)
+	}
+	defer file.Close()
+	if err := png.Encode(file, img); err != nil {
+		t.Fatalf("png.Encode() error: %v", err)
+	}
+}
+
+func TestAssetsValidateReportsErrors(t *testing.T) {
+	dir := t.TempDir()
+	writeOpaquePNG(t, filepath.Join(dir, "en-US", "APP_IPHONE_65_01.png"), 1242, 2688)
+	writeOpaquePNG(t, filepath.Join(dir, "en-US", "APP_IPHONE_65_02.png"), 1242, 2600)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"assets", "validate", "--path", dir}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr == nil || !strings.Contains(runErr.Error(), "found 1 error(s)") {
+		t.Fatalf("expected validation error, got %v", runErr)
+	}
+
+	var result struct {
+		Valid  bool `json:"valid"`
+		Errors int  `json:"errors"`
+		Files  []struct {
+			DisplayType string `json:"displayType"`
+		} `json:"files"`
+		Issues []struct {
+			Path    string `json:"path"`
+			Message string `json:"message"`
+		} `json:"issues"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if result.Valid || result.Errors != 1 || len(result.Files) != 2 || len(result.Issues) != 1 {
+		t.Fatalf("unexpected result: %s", stdout)
+	}
+	if !strings.HasSuffix(result.Issues[0].Path, "APP_IPHONE_65_02.png") || !strings.Contains(result.Issues[0].Message, "1242x2600") {
+		t.Fatalf("unexpected issue: %+v", result.Issues[0])
+	}
+}
+
+func TestAssetsValidateValidDirectory(t *testing.T) {
+	dir := t.TempDir()
+	writeOpaquePNG(t, filepath.Join(dir, "home.png"), 2880, 1800)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"assets", "validate", "--path", dir, "--device-type", "DESKTOP"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+	if !strings.Contains(stdout, `"valid":true`) || !strings.Contains(stdout, `"displayType":"APP_DESKTOP"`) {
+		t.Fatalf("unexpected output: %s", stdout)
+	}
+}
+
+func TestAssetsValidateRejectsUnknownDeviceType(t *testing.T) {
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"assets", "validate", "--path", ".", "--device-type", "NOKIA"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("expected ErrHelp, got %v", err)
+		}
+	})
+	if !strings.Contains(stderr, `unsupported --device-type "NOKIA"`) {
+		t.Fatalf("unexpected stderr: %s", stderr)
+	}
+}
+
+func TestAssetsScreenshotsUploadPreflightStopsBeforeRequests(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	dir := t.TempDir()
+	writeOpaquePNG(t, filepath.Join(dir, "01.png"), 1290, 2796)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		return nil, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"assets", "screenshots", "upload", "--version-localization", "LOC_ID", "--path", dir, "--device-type", "IPHONE_65"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr == nil || !strings.Contains(runErr.Error(), "--skip-validation") {
+		t.Fatalf("expected preflight error, got %v", runErr)
+	}
+	if !strings.Contains(stderr, "size 1290x2796 is not accepted for APP_IPHONE_65") {
+		t.Fatalf("unexpected stderr: %s", stderr)
+	}
+}
+
+func TestAssetsPreviewsUploadPreflightStopsBeforeRequests(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "01.mov"), []byte("movie"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		return nil, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"assets", "previews", "upload", "--version-localization", "LOC_ID", "--path", dir, "--device-type", "IPHONE_65"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr == nil || !strings.Contains(runErr.Error(), "--skip-validation") {
+		t.Fatalf("expected preflight error, got %v", runErr)
+	}
+	if !strings.Contains(stderr, "cannot read video") {
+		t.Fatalf("unexpected stderr: %s", stderr)
+	}
+}
+
+func TestAssetsPreviewsUploadCountsExistingPreviews(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	dir := t.TempDir()
+	path := filepath.Join(dir, "01.mov")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appStoreVersionLocalizations/LOC_ID/appPreviewSets":
+			return apiTestResponse(`{"data":[{"type":"appPreviewSets","id":"set-1","attributes":{"previewType":"IPHONE_65"}}],"links":{}}`), nil
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appPreviewSets/set-1/appPreviews":
+			return apiTestResponse(`{"data":[{"type":"appPreviews","id":"p-1","attributes":{}},{"type":"appPreviews","id":"p-2","attributes":{}},{"type":"appPreviews","id":"p-3","attributes":{}}],"links":{}}`), nil
+		}
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		return nil, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	writeTestMovie(t, path)
+
+	var runErr error
+	captureOutput(t, func() {
+		if err := root.Parse([]string{"assets", "previews", "upload", "--version-localization", "LOC_ID", "--path", dir, "--device-type", "IPHONE_65"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr == nil || !strings.Contains(runErr.Error(), "set would hold 4 previews (3 existing, 1 new)") {
+		t.Fatalf("expected set capacity error, got %v", runErr)
+	}
+}
+
+// writeTestMovie writes a minimal 20 second 886x1920 QuickTime movie.
+func writeTestMovie(t *testing.T, path string) {
+	t.Helper()
+	atom := func(kind string, payload ...[]byte) []byte {
+		body := bytes.Join(payload, nil)
+		out := binary.BigEndian.AppendUint32(nil, uint32(8+len(body)))
+		return append(append(out, kind...), body...)
+	}
+	mvhd := make([]byte, 100)
+	binary.BigEndian.PutUint32(mvhd[12:], 600)
+	binary.BigEndian.PutUint32(mvhd[16:], 20*600)
+	tkhd := make([]byte, 84)
+	binary.BigEndian.PutUint32(tkhd[76:], 886<<16)
+	binary.BigEndian.PutUint32(tkhd[80:], 1920<<16)
+	movie := bytes.Join([][]byte{
+		atom("ftyp", []byte("qt  "), make([]byte, 4)),
+		atom("moov", atom("mvhd", mvhd), atom("trak", atom("tkhd", tkhd))),
+	}, nil)
+	if err := os.WriteFile(path, movie, 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+}
@@ -0,0 +1,63 @@
+package cmdtest
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestAuthTokenRejectsInvalidScope(t *testing.T) {
+	setupAuth(t)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"auth", "token", "--scope", "/v1/apps"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		err := root.Run(context.Background())
+		if !errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("expected ErrHelp, got %v", err)
+		}
+	})
+
+	if !strings.Contains(stderr, "Error: --scope") {
+		t.Fatalf("expected scope error, got %q", stderr)
+	}
+}
+
+func TestAuthTokenPrintsScopedToken(t *testing.T) {
+	setupAuth(t)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"auth", "token", "--scope", "GET /v1/apps", "--scope", "GET /v1/builds"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	token := strings.TrimSpace(stdout)
+	claims := jwt.MapClaims{}
+	parsed, _, err := jwt.NewParser().ParseUnverified(token, claims)
+	if err != nil {
+		t.Fatalf("ParseUnverified() error: %v", err)
+	}
+	if parsed.Header["kid"] != "TEST_KEY" {
+		t.Fatalf("expected kid TEST_KEY, got %v", parsed.Header["kid"])
+	}
+	scope, ok := claims["scope"].([]interface{})
+	if !ok || len(scope) != 2 || scope[0] != "GET /v1/apps" || scope[1] != "GET /v1/builds" {
+		t.Fatalf("unexpected scope claim: %v", claims["scope"])
+	}
+}
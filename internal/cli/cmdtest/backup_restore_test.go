@@ -0,0 +1,524 @@
+package cmdtest
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func notFoundTestResponse() *http.Response {
+	return &http.Response{
+		StatusCode: http.StatusNotFound,
+		Body:       io.NopCloser(strings.NewReader(`{"errors":[{"code":"NOT_FOUND","title":"Not Found"}]}`)),
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+	}
+}
+
+func TestBackupWritesSnapshot(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet {
+			t.Fatalf("backup must not write, got %s %s", req.Method, req.URL.Path)
+		}
+		switch req.URL.Path {
+		case "/v1/apps/app-1":
+			return apiTestResponse(`{"data":{"type":"apps","id":"app-1","attributes":{"name":"Example","bundleId":"com.example.app","primaryLocale":"en-US"}}}`), nil
+		case "/v1/appInfos/info-1/appInfoLocalizations":
+			return apiTestResponse(`{"data":[{"type":"appInfoLocalizations","id":"ail-1","attributes":{"locale":"en-US","name":"Example"}}]}`), nil
+		case "/v1/appInfos/info-1/primaryCategory":
+			return apiTestResponse(`{"data":{"type":"appCategories","id":"GAMES"}}`), nil
+		case "/v1/appInfos/info-1/secondaryCategory":
+			return apiTestResponse(`{"data":null}`), nil
+		case "/v1/appInfos/info-1/ageRatingDeclaration":
+			return apiTestResponse(`{"data":{"type":"ageRatingDeclarations","id":"age-1","attributes":{"gambling":false}}}`), nil
+		case "/v1/apps/app-1/appStoreVersions":
+			return apiTestResponse(`{"data":[{"type":"appStoreVersions","id":"version-1","attributes":{"platform":"IOS","versionString":"1.0","createdDate":"2026-01-01T00:00:00Z"}}]}`), nil
+		case "/v1/appStoreVersions/version-1/appStoreVersionLocalizations":
+			return apiTestResponse(`{"data":[{"type":"appStoreVersionLocalizations","id":"loc-1","attributes":{"locale":"en-US","description":"Hello"}}]}`), nil
+		case "/v1/apps/app-1/inAppPurchasesV2":
+			return apiTestResponse(`{"data":[{"type":"inAppPurchases","id":"iap-1","attributes":{"name":"Coins","productId":"com.example.coins","inAppPurchaseType":"CONSUMABLE"}}]}`), nil
+		case "/v2/inAppPurchases/iap-1/inAppPurchaseLocalizations":
+			return apiTestResponse(`{"data":[{"type":"inAppPurchaseLocalizations","id":"iapl-1","attributes":{"name":"Coins","locale":"en-US"}}]}`), nil
+		case "/v2/inAppPurchases/iap-1/iapPriceSchedule":
+			return apiTestResponse(`{"data":{"type":"inAppPurchasePriceSchedules","id":"iap-1"}}`), nil
+		case "/v1/inAppPurchasePriceSchedules/iap-1/baseTerritory":
+			return apiTestResponse(`{"data":{"type":"territories","id":"USA"}}`), nil
+		case "/v1/inAppPurchasePriceSchedules/iap-1/manualPrices":
+			return apiTestResponse(`{"data":[{"type":"inAppPurchasePrices","id":"price-1","attributes":{"startDate":"2026-01-01"},"relationships":{"inAppPurchasePricePoint":{"data":{"type":"inAppPurchasePricePoints","id":"pp-1"}},"territory":{"data":{"type":"territories","id":"USA"}}}}],"included":[{"type":"inAppPurchasePricePoints","id":"pp-1","attributes":{"customerPrice":"0.99"}}]}`), nil
+		case "/v1/apps/app-1/appAvailabilityV2":
+			return notFoundTestResponse(), nil
+		case "/v1/apps/app-1/subscriptionGroups", "/v1/apps/app-1/betaGroups", "/v1/apps/app-1/appEvents":
+			return apiTestResponse(`{"data":[]}`), nil
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	out := filepath.Join(t.TempDir(), "snapshot")
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"backup", "--app", "app-1", "--app-info", "info-1", "--out", out, "--skip-screenshots"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result struct {
+		AppID     string `json:"appId"`
+		Resources []struct {
+			Resource string `json:"resource"`
+			Count    int    `json:"count"`
+		} `json:"resources"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if result.AppID != "app-1" {
+		t.Fatalf("unexpected app ID: %s", stdout)
+	}
+	counts := map[string]int{}
+	for _, resource := range result.Resources {
+		counts[resource.Resource] = resource.Count
+	}
+	if counts["versions"] != 1 || counts["in-app-purchases"] != 1 || counts["availability"] != 0 {
+		t.Fatalf("unexpected resource counts: %v", counts)
+	}
+
+	for _, name := range []string{"manifest.json", "app-info.json", "versions.json", "in-app-purchases.json", "subscriptions.json", "beta-groups.json", "availability.json", "app-events.json"} {
+		if _, err := os.Stat(filepath.Join(out, name)); err != nil {
+			t.Fatalf("expected %s in snapshot: %v", name, err)
+		}
+	}
+	data, err := os.ReadFile(filepath.Join(out, "in-app-purchases.json"))
+	if err != nil {
+		t.Fatalf("ReadFile() error: %v", err)
+	}
+	var purchases []struct {
+		ProductID     string `json:"productId"`
+		BaseTerritory string `json:"baseTerritory"`
+		Prices        []struct {
+			Territory     string `json:"territory"`
+			CustomerPrice string `json:"customerPrice"`
+		} `json:"prices"`
+	}
+	if err := json.Unmarshal(data, &purchases); err != nil {
+		t.Fatalf("failed to parse in-app-purchases.json: %v", err)
+	}
+	if len(purchases) != 1 || purchases[0].BaseTerritory != "USA" || len(purchases[0].Prices) != 1 ||
+		purchases[0].Prices[0].Territory != "USA" || purchases[0].Prices[0].CustomerPrice != "0.99" {
+		t.Fatalf("unexpected in-app purchases: %s", data)
+	}
+}
+
+func TestBackupRejectsNonEmptyOutDir(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	out := t.TempDir()
+	if err := os.WriteFile(filepath.Join(out, "existing.txt"), []byte("x"), 0o644); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	captureOutput(t, func() {
+		if err := root.Parse([]string{"backup", "--app", "app-1", "--out", out}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr == nil || !strings.Contains(runErr.Error(), "is not empty") {
+		t.Fatalf("expected non-empty directory error, got %v", runErr)
+	}
+}
+
+// writeRestoreSnapshot writes a snapshot with one in-app purchase and two
+// beta groups taken from app-1.
+func writeRestoreSnapshot(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	files := map[string]string{
+		"manifest.json": `{"formatVersion":1,"createdAt":"2026-01-01T00:00:00Z","app":{"id":"app-1","name":"Example","bundleId":"com.example.app"}}`,
+		"in-app-purchases.json": `[{"name":"Coins","productId":"com.old.coins","inAppPurchaseType":"CONSUMABLE","baseTerritory":"USA",
+  "localizations":[{"name":"Coins","locale":"en-US","description":"A pile of coins"}],
+  "prices":[{"territory":"USA","customerPrice":"0.99","pricePointId":"old-pp","startDate":"2026-01-01"}]}]`,
+		"beta-groups.json": `[{"name":"Existing"},{"name":"External","publicLinkEnabled":true,"feedbackEnabled":true}]`,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatalf("WriteFile() error: %v", err)
+		}
+	}
+	return dir
+}
+
+// restoreTargetTransport serves an app-2 that only has the "Existing" beta
+// group and records every write as "METHOD path" with its body.
+func restoreTargetTransport(t *testing.T, writes map[string]string, mu *sync.Mutex) roundTripFunc {
+	return func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet {
+			body, _ := io.ReadAll(req.Body)
+			mu.Lock()
+			writes[req.Method+" "+req.URL.Path] = string(body)
+			mu.Unlock()
+			return apiTestResponse(`{"data":{"type":"resources","id":"new-1","attributes":{}}}`), nil
+		}
+		switch req.URL.Path {
+		case "/v1/apps/app-2/inAppPurchasesV2":
+			return apiTestResponse(`{"data":[]}`), nil
+		case "/v1/apps/app-2/betaGroups":
+			return apiTestResponse(`{"data":[{"type":"betaGroups","id":"group-1","attributes":{"name":"Existing"}}]}`), nil
+		case "/v2/inAppPurchases/new-1/pricePoints":
+			if req.URL.Query().Get("filter[territory]") != "USA" {
+				t.Fatalf("expected territory filter, got %s", req.URL.RawQuery)
+			}
+			return apiTestResponse(`{"data":[{"type":"inAppPurchasePricePoints","id":"pp-079","attributes":{"customerPrice":"0.79"}},{"type":"inAppPurchasePricePoints","id":"pp-099","attributes":{"customerPrice":"0.99"}}]}`), nil
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	}
+}
+
+type restoreOutput struct {
+	TargetAppID string `json:"targetAppId"`
+	DryRun      bool   `json:"dryRun"`
+	Items       []struct {
+		Resource string `json:"resource"`
+		Key      string `json:"key"`
+		Action   string `json:"action"`
+	} `json:"items"`
+	Created int `json:"created"`
+	Skipped int `json:"skipped"`
+}
+
+func TestRestoreDryRunReportsMissingResources(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	dir := writeRestoreSnapshot(t)
+
+	writes := map[string]string{}
+	var mu sync.Mutex
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = restoreTargetTransport(t, writes, &mu)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"restore", "--dir", dir, "--app", "app-2", "--product-id-prefix", "com.old.=com.new.", "--dry-run"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+	if len(writes) != 0 {
+		t.Fatalf("dry run must not write, got %v", writes)
+	}
+
+	var result restoreOutput
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if !result.DryRun || result.TargetAppID != "app-2" || result.Created != 2 || result.Skipped != 1 {
+		t.Fatalf("unexpected summary: %s", stdout)
+	}
+	actions := map[string]string{}
+	for _, item := range result.Items {
+		actions[item.Resource+" "+item.Key] = item.Action
+	}
+	want := map[string]string{
+		"in-app-purchase com.new.coins": "create",
+		"beta-group Existing":           "skip",
+		"beta-group External":           "create",
+	}
+	if len(actions) != len(want) {
+		t.Fatalf("expected %d items, got %v", len(want), actions)
+	}
+	for key, value := range want {
+		if actions[key] != value {
+			t.Fatalf("item %q = %q, want %q (all: %v)", key, actions[key], value, actions)
+		}
+	}
+}
+
+func TestRestoreCreatesMissingResources(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	dir := writeRestoreSnapshot(t)
+
+	writes := map[string]string{}
+	var mu sync.Mutex
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = restoreTargetTransport(t, writes, &mu)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	captureOutput(t, func() {
+		if err := root.Parse([]string{"restore", "--dir", dir, "--app", "app-2", "--product-id-prefix", "com.old.=com.new."}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	wantWrites := map[string]string{
+		"POST /v2/inAppPurchases":              `"productId":"com.new.coins"`,
+		"POST /v1/inAppPurchaseLocalizations":  `"description":"A pile of coins"`,
+		"POST /v1/inAppPurchasePriceSchedules": `"pp-099"`,
+		"POST /v1/betaGroups":                  `"name":"External"`,
+		"PATCH /v1/betaGroups/new-1":           `"publicLinkEnabled":true`,
+	}
+	if len(writes) != len(wantWrites) {
+		t.Fatalf("expected %d writes, got %v", len(wantWrites), writes)
+	}
+	for key, fragment := range wantWrites {
+		body, ok := writes[key]
+		if !ok {
+			t.Fatalf("missing write %q (all: %v)", key, writes)
+		}
+		if !strings.Contains(body, fragment) {
+			t.Fatalf("write %q body %s does not contain %s", key, body, fragment)
+		}
+	}
+	if strings.Contains(writes["POST /v1/inAppPurchasePriceSchedules"], "old-pp") {
+		t.Fatalf("restore must not reuse snapshot price point IDs: %s", writes["POST /v1/inAppPurchasePriceSchedules"])
+	}
+}
+
+func TestRestoreValidationErrors(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{"missing dir", []string{"restore"}, "Error: --dir is required"},
+		{"bad prefix", []string{"restore", "--dir", t.TempDir(), "--product-id-prefix", "com.old."}, "Error: --product-id-prefix must be OLD=NEW"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			var runErr error
+			_, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				runErr = root.Run(context.Background())
+			})
+			if !errors.Is(runErr, flag.ErrHelp) {
+				t.Fatalf("expected ErrHelp, got %v", runErr)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected %q in stderr, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	var runErr error
+	captureOutput(t, func() {
+		if err := root.Parse([]string{"restore", "--dir", t.TempDir()}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr == nil || !strings.Contains(runErr.Error(), "is this a snapshot directory?") {
+		t.Fatalf("expected missing manifest error, got %v", runErr)
+	}
+}
+
+func TestRestoreResumesExistingInAppPurchase(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	dir := writeRestoreSnapshot(t)
+
+	writes := map[string]string{}
+	var mu sync.Mutex
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	target := restoreTargetTransport(t, writes, &mu)
+	// The product was created by an earlier restore that failed before its
+	// localizations and prices.
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method == http.MethodGet {
+			switch req.URL.Path {
+			case "/v1/apps/app-2/inAppPurchasesV2":
+				return apiTestResponse(`{"data":[{"type":"inAppPurchases","id":"new-1","attributes":{"productId":"com.new.coins"}}]}`), nil
+			case "/v2/inAppPurchases/new-1/inAppPurchaseLocalizations":
+				return apiTestResponse(`{"data":[]}`), nil
+			case "/v2/inAppPurchases/new-1/iapPriceSchedule":
+				return notFoundTestResponse(), nil
+			}
+		}
+		return target(req)
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"restore", "--dir", dir, "--app", "app-2", "--product-id-prefix", "com.old.=com.new."}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if _, ok := writes["POST /v2/inAppPurchases"]; ok {
+		t.Fatalf("existing product must not be created again: %v", writes)
+	}
+	for _, key := range []string{"POST /v1/inAppPurchaseLocalizations", "POST /v1/inAppPurchasePriceSchedules"} {
+		if _, ok := writes[key]; !ok {
+			t.Fatalf("missing write %q (all: %v)", key, writes)
+		}
+	}
+	var result restoreOutput
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	for _, item := range result.Items {
+		if item.Resource == "in-app-purchase" && item.Action != "update" {
+			t.Fatalf("expected the existing product to be updated, got %+v", item)
+		}
+	}
+}
+
+func TestRestoreRenamesEditableVersion(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	dir := t.TempDir()
+	screenshot := []byte("screenshot bytes")
+	files := map[string]string{
+		"manifest.json": `{"formatVersion":1,"createdAt":"2026-01-01T00:00:00Z","app":{"id":"app-1","name":"Example","bundleId":"com.example.app"}}`,
+		"versions.json": `[{"id":"v-old","versionString":"2.0","platform":"IOS","localizations":[{"locale":"en-US","description":"Hello",
+  "screenshots":[{"displayType":"APP_IPHONE_65","files":["screenshots/one.png"]}]}]}]`,
+	}
+	if err := os.MkdirAll(filepath.Join(dir, "screenshots"), 0o755); err != nil {
+		t.Fatalf("MkdirAll() error: %v", err)
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatalf("WriteFile() error: %v", err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(dir, "screenshots", "one.png"), screenshot, 0o644); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+	checksum := md5.Sum(screenshot)
+
+	writes := map[string]string{}
+	var mu sync.Mutex
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet {
+			body, _ := io.ReadAll(req.Body)
+			mu.Lock()
+			writes[req.Method+" "+req.URL.Path] = string(body)
+			mu.Unlock()
+			return apiTestResponse(`{"data":{"type":"appStoreVersions","id":"v-1","attributes":{}}}`), nil
+		}
+		switch req.URL.Path {
+		case "/v1/apps/app-2/appStoreVersions":
+			return apiTestResponse(`{"data":[` +
+				`{"type":"appStoreVersions","id":"v-live","attributes":{"platform":"IOS","versionString":"0.9","appVersionState":"READY_FOR_DISTRIBUTION"}},` +
+				`{"type":"appStoreVersions","id":"v-1","attributes":{"platform":"IOS","versionString":"1.0","appVersionState":"PREPARE_FOR_SUBMISSION"}}]}`), nil
+		case "/v1/appStoreVersions/v-1/appStoreVersionLocalizations":
+			return apiTestResponse(`{"data":[{"type":"appStoreVersionLocalizations","id":"loc-1","attributes":{"locale":"en-US"}}]}`), nil
+		case "/v1/appStoreVersionLocalizations/loc-1/appScreenshotSets":
+			return apiTestResponse(`{"data":[{"type":"appScreenshotSets","id":"set-1","attributes":{"screenshotDisplayType":"APP_IPHONE_65"}}]}`), nil
+		case "/v1/appScreenshotSets/set-1/appScreenshots":
+			return apiTestResponse(`{"data":[{"type":"appScreenshots","id":"shot-1","attributes":{"fileName":"one.png","sourceFileChecksum":"` +
+				hex.EncodeToString(checksum[:]) + `","assetDeliveryState":{"state":"COMPLETE"}}}]}`), nil
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	run := func(args ...string) restoreOutput {
+		root := RootCommand("1.2.3")
+		root.FlagSet.SetOutput(io.Discard)
+		stdout, _ := captureOutput(t, func() {
+			if err := root.Parse(append([]string{"restore", "--dir", dir, "--app", "app-2"}, args...)); err != nil {
+				t.Fatalf("parse error: %v", err)
+			}
+			if err := root.Run(context.Background()); err != nil {
+				t.Fatalf("run error: %v", err)
+			}
+		})
+		var result restoreOutput
+		if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+			t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+		}
+		return result
+	}
+
+	result := run("--dry-run")
+	if len(writes) != 0 {
+		t.Fatalf("dry run must not write, got %v", writes)
+	}
+	actions := map[string]string{}
+	for _, item := range result.Items {
+		actions[item.Resource+" "+item.Key] = item.Action
+	}
+	want := map[string]string{
+		"version IOS 2.0":                         "update",
+		"version-localization IOS 2.0 en-US":      "skip",
+		"screenshots IOS 2.0 en-US APP_IPHONE_65": "skip",
+	}
+	for key, value := range want {
+		if actions[key] != value {
+			t.Fatalf("item %q = %q, want %q (all: %v)", key, actions[key], value, actions)
+		}
+	}
+
+	run("--skip-screenshots")
+	if len(writes) != 1 || !strings.Contains(writes["PATCH /v1/appStoreVersions/v-1"], `"versionString":"2.0"`) {
+		t.Fatalf("expected the editable version to be renamed, got %v", writes)
+	}
+}
@@ -0,0 +1,61 @@
+package cmdtest
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func runCassetteCommand(t *testing.T, args []string) (string, error) {
+	t.Helper()
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse(args); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	return stdout, runErr
+}
+
+func TestReplayRunsWithoutCredentials(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	dir := t.TempDir()
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return apiTestResponse(`{"data":[{"type":"apps","id":"app-1","attributes":{"name":"Recorded App"}}],"links":{}}`), nil
+	})
+	if _, err := runCassetteCommand(t, []string{"--record", dir, "apps", "list"}); err != nil {
+		t.Fatalf("record run error: %v", err)
+	}
+
+	t.Setenv("ASC_KEY_ID", "")
+	t.Setenv("ASC_ISSUER_ID", "")
+	t.Setenv("ASC_PRIVATE_KEY_PATH", "")
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		t.Fatalf("unexpected network call during replay: %s", req.URL)
+		return nil, nil
+	})
+	stdout, err := runCassetteCommand(t, []string{"--replay", dir, "apps", "list"})
+	if err != nil {
+		t.Fatalf("replay run error: %v", err)
+	}
+	if !strings.Contains(stdout, "Recorded App") {
+		t.Fatalf("expected replayed output, got %q", stdout)
+	}
+
+	if _, err := runCassetteCommand(t, []string{"apps", "list"}); err == nil {
+		t.Fatal("expected missing credentials to fail outside replay")
+	}
+}
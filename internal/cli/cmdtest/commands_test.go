@@ -2902,6 +2902,11 @@ func TestTestFlightSyncValidationErrors(t *testing.T) {
 			args:    []string{"testflight", "sync", "pull", "--app", "APP_ID", "--output", "./testflight.yaml", "--tester", "tester@example.com"},
 			wantErr: "--tester requires --include-testers",
 		},
+		{
+			name:    "testflight sync push missing input",
+			args:    []string{"testflight", "sync", "push", "--app", "APP_ID"},
+			wantErr: "--input is required",
+		},
 	}
 
 	for _, test := range tests {
@@ -3077,6 +3082,11 @@ func TestAssetsValidationErrors(t *testing.T) {
 			args:    []string{"assets", "screenshots", "upload", "--version-localization", "LOC_ID", "--path", "./screenshots"},
 			wantErr: "--device-type is required",
 		},
+		{
+			name:    "assets screenshots upload prune without sync",
+			args:    []string{"assets", "screenshots", "upload", "--version-localization", "LOC_ID", "--path", "./screenshots", "--device-type", "IPHONE_65", "--prune"},
+			wantErr: "--prune requires --sync",
+		},
 		{
 			name:    "assets screenshots delete missing id",
 			args:    []string{"assets", "screenshots", "delete"},
@@ -3107,6 +3117,11 @@ func TestAssetsValidationErrors(t *testing.T) {
 			args:    []string{"assets", "previews", "upload", "--version-localization", "LOC_ID", "--path", "./previews"},
 			wantErr: "--device-type is required",
 		},
+		{
+			name:    "assets previews upload prune without sync",
+			args:    []string{"assets", "previews", "upload", "--version-localization", "LOC_ID", "--path", "./previews", "--device-type", "IPHONE_65", "--prune"},
+			wantErr: "--prune requires --sync",
+		},
 		{
 			name:    "assets previews delete missing id",
 			args:    []string{"assets", "previews", "delete"},
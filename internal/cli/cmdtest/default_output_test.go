@@ -2,6 +2,8 @@ package cmdtest
 
 import (
 	"io"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -75,3 +77,39 @@ func TestDefaultOutputEnvOverriddenByExplicitFlag(t *testing.T) {
 		t.Fatalf("expected parsed output to be json, got %q", got)
 	}
 }
+
+func TestDefaultOutputAcceptsNewFormats(t *testing.T) {
+	for _, format := range []string{"csv", "tsv", "yaml", "ndjson"} {
+		t.Run(format, func(t *testing.T) {
+			resetDefaultOutput(t)
+			t.Setenv("ASC_DEFAULT_OUTPUT", format)
+
+			cmd := findCommand(RootCommand("1.2.3"), "categories", "list")
+			if cmd == nil {
+				t.Fatal("expected categories list command")
+			}
+			if got := cmd.FlagSet.Lookup("output").DefValue; got != format {
+				t.Fatalf("expected default output %q, got %q", format, got)
+			}
+		})
+	}
+}
+
+func TestDefaultOutputFromConfig(t *testing.T) {
+	resetDefaultOutput(t)
+	t.Setenv("ASC_DEFAULT_OUTPUT", "")
+
+	configPath := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(configPath, []byte(`{"default_output":"csv"}`), 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+	t.Setenv("ASC_CONFIG_PATH", configPath)
+
+	cmd := findCommand(RootCommand("1.2.3"), "categories", "list")
+	if cmd == nil {
+		t.Fatal("expected categories list command")
+	}
+	if got := cmd.FlagSet.Lookup("output").DefValue; got != "csv" {
+		t.Fatalf("expected default output from config to be csv, got %q", got)
+	}
+}
@@ -0,0 +1,402 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDevicesImportRegistersNewDevicesAndRegeneratesProfiles(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	devicesFile := filepath.Join(t.TempDir(), "devices.txt")
+	content := "Device ID\tDevice Name\tDevice Platform\n" +
+		"00008030-001A2D3E0E38802E\tQA iPhone 12\tios\n" +
+		"00008101-000A1B2C3D4E5F60\tQA iPhone 15\tios\n"
+	if err := os.WriteFile(devicesFile, []byte(content), 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	var created []string
+	var profileBody string
+	deleted := false
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/devices":
+			return apiTestResponse(`{"data":[{"type":"devices","id":"dev-existing","attributes":{"name":"Old","udid":"00008030-001a2d3e0e38802e","platform":"IOS","deviceClass":"IPHONE","status":"ENABLED"}}],"links":{}}`), nil
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/devices":
+			body, _ := io.ReadAll(req.Body)
+			created = append(created, string(body))
+			return &http.Response{
+				StatusCode: http.StatusCreated,
+				Body:       io.NopCloser(strings.NewReader(`{"data":{"type":"devices","id":"dev-new","attributes":{"name":"QA iPhone 15","udid":"00008101-000A1B2C3D4E5F60","platform":"IOS","deviceClass":"IPHONE"}}}`)),
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+			}, nil
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/profiles":
+			if req.URL.Query().Get("filter[profileType]") == "IOS_APP_DEVELOPMENT" {
+				return apiTestResponse(`{"data":[` +
+					`{"type":"profiles","id":"prof-old","attributes":{"name":"Team Dev","profileType":"IOS_APP_DEVELOPMENT","profileState":"ACTIVE"}},` +
+					`{"type":"profiles","id":"prof-expired","attributes":{"name":"Old Dev","profileType":"IOS_APP_DEVELOPMENT","profileState":"EXPIRED"}},` +
+					`{"type":"profiles","id":"prof-xcode","attributes":{"name":"iOS Team Provisioning Profile: *","profileType":"IOS_APP_DEVELOPMENT","profileState":"ACTIVE"}}` +
+					`],"links":{}}`), nil
+			}
+			if req.URL.Query().Get("filter[profileType]") != "" {
+				t.Fatalf("unexpected profile type filter: %s", req.URL.RawQuery)
+			}
+			return apiTestResponse(`{"data":[],"links":{}}`), nil
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/profiles/prof-old/bundleId":
+			return apiTestResponse(`{"data":{"type":"bundleIds","id":"bundle-1","attributes":{"identifier":"com.example.app"}}}`), nil
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/profiles/prof-old/certificates":
+			return apiTestResponse(`{"data":[{"type":"certificates","id":"cert-1"}],"links":{}}`), nil
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/profiles/prof-old/devices":
+			return apiTestResponse(`{"data":[{"type":"devices","id":"dev-existing"}],"links":{}}`), nil
+		case req.Method == http.MethodDelete && req.URL.Path == "/v1/profiles/prof-old":
+			if profileBody == "" {
+				t.Fatal("expected the new profile to be created before the old one is deleted")
+			}
+			deleted = true
+			return &http.Response{StatusCode: http.StatusNoContent, Body: io.NopCloser(strings.NewReader("")), Header: http.Header{}}, nil
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/profiles":
+			body, _ := io.ReadAll(req.Body)
+			profileBody = string(body)
+			return &http.Response{
+				StatusCode: http.StatusCreated,
+				Body:       io.NopCloser(strings.NewReader(`{"data":{"type":"profiles","id":"prof-new","attributes":{"name":"Team Dev","profileType":"IOS_APP_DEVELOPMENT"}}}`)),
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+			}, nil
+		}
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		return nil, nil
+	})
+
+	stdout := runSalesStoreCommand(t, []string{"devices", "import", "--file", devicesFile, "--regenerate-profiles"})
+
+	var result struct {
+		Registered int `json:"registered"`
+		Skipped    int `json:"skipped"`
+		Devices    []struct {
+			Status   string `json:"status"`
+			DeviceID string `json:"deviceId"`
+		} `json:"devices"`
+		Capacity []struct {
+			DeviceClass string `json:"deviceClass"`
+			Registered  int    `json:"registered"`
+			Remaining   int    `json:"remaining"`
+		} `json:"capacity"`
+		Profiles []struct {
+			OldID   string `json:"oldId"`
+			NewID   string `json:"newId"`
+			Devices int    `json:"devices"`
+			Status  string `json:"status"`
+		} `json:"profiles"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if result.Registered != 1 || result.Skipped != 1 || len(created) != 1 {
+		t.Fatalf("expected one registration and one skip, got %+v (created %v)", result, created)
+	}
+	if result.Devices[0].Status != "exists" || result.Devices[0].DeviceID != "dev-existing" || result.Devices[1].Status != "registered" {
+		t.Fatalf("unexpected device statuses: %+v", result.Devices)
+	}
+	if !strings.Contains(created[0], `"udid":"00008101-000A1B2C3D4E5F60"`) || !strings.Contains(created[0], `"platform":"IOS"`) {
+		t.Fatalf("unexpected create body: %s", created[0])
+	}
+	var classes []string
+	for _, capacity := range result.Capacity {
+		classes = append(classes, capacity.DeviceClass)
+	}
+	if strings.Join(classes, ",") != "APPLE_WATCH,IPAD,IPHONE" || result.Capacity[2].Registered != 2 || result.Capacity[2].Remaining != 98 || result.Capacity[1].Remaining != 100 {
+		t.Fatalf("unexpected capacity: %+v", result.Capacity)
+	}
+	if !deleted || len(result.Profiles) != 1 || result.Profiles[0].NewID != "prof-new" || result.Profiles[0].Devices != 2 || result.Profiles[0].Status != "regenerated" {
+		t.Fatalf("unexpected profiles: %+v (deleted %t)", result.Profiles, deleted)
+	}
+	for _, want := range []string{`"id":"bundle-1"`, `"id":"cert-1"`, `"id":"dev-existing"`, `"id":"dev-new"`} {
+		if !strings.Contains(profileBody, want) {
+			t.Fatalf("expected profile body to contain %s, got %s", want, profileBody)
+		}
+	}
+}
+
+func TestDevicesImportDryRunRegistersNothing(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	devicesFile := filepath.Join(t.TempDir(), "devices.csv")
+	if err := os.WriteFile(devicesFile, []byte("00008030-001A2D3E0E38802E,QA iPhone\n"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method == http.MethodGet && req.URL.Path == "/v1/devices" {
+			return apiTestResponse(`{"data":[],"links":{}}`), nil
+		}
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		return nil, nil
+	})
+
+	stdout := runSalesStoreCommand(t, []string{"devices", "import", "--file", devicesFile, "--platform", "IOS", "--dry-run"})
+	if !strings.Contains(stdout, `"status":"would-register"`) || !strings.Contains(stdout, `"deviceClass":"IPHONE","registered":0,"planned":1,"limit":100,"remaining":99`) {
+		t.Fatalf("unexpected dry-run output: %s", stdout)
+	}
+}
+
+func TestDevicesImportEnablesDisabledDevices(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	devicesFile := filepath.Join(t.TempDir(), "devices.csv")
+	if err := os.WriteFile(devicesFile, []byte("00008030-001A2D3E0E38802E,QA iPhone,IOS\n"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	var updateBody string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/devices":
+			return apiTestResponse(`{"data":[{"type":"devices","id":"dev-off","attributes":{"name":"QA iPhone","udid":"00008030-001A2D3E0E38802E","platform":"IOS","deviceClass":"IPHONE","status":"DISABLED"}}],"links":{}}`), nil
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/devices/dev-off":
+			body, _ := io.ReadAll(req.Body)
+			updateBody = string(body)
+			return apiTestResponse(`{"data":{"type":"devices","id":"dev-off","attributes":{"status":"ENABLED"}}}`), nil
+		}
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		return nil, nil
+	})
+
+	stdout := runSalesStoreCommand(t, []string{"devices", "import", "--file", devicesFile, "--enable-disabled"})
+	if !strings.Contains(updateBody, `"status":"ENABLED"`) {
+		t.Fatalf("expected device to be enabled, got body %q", updateBody)
+	}
+	if !strings.Contains(stdout, `"status":"enabled"`) || !strings.Contains(stdout, `"enabled":1`) {
+		t.Fatalf("unexpected output: %s", stdout)
+	}
+}
+
+func TestDevicesImportRefusesBatchOverDeviceLimit(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	devicesFile := filepath.Join(t.TempDir(), "devices.csv")
+	content := "00008030-001A2D3E0E38802E,QA iPhone 1,IOS\n00008030-001A2D3E0E38802F,QA iPhone 2,IOS\n"
+	if err := os.WriteFile(devicesFile, []byte(content), 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+
+	var devices []string
+	for i := 0; i < 99; i++ {
+		devices = append(devices, fmt.Sprintf(`{"type":"devices","id":"dev-%d","attributes":{"udid":"00008030-0000000000%06d","platform":"IOS","deviceClass":"IPHONE","status":"ENABLED"}}`, i, i))
+	}
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method == http.MethodGet && req.URL.Path == "/v1/devices" {
+			return apiTestResponse(`{"data":[` + strings.Join(devices, ",") + `],"links":{}}`), nil
+		}
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		return nil, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"devices", "import", "--file", devicesFile, "--dry-run"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr == nil || !strings.Contains(runErr.Error(), "2 new device(s) could use IPHONE slots, but only 1 of 100 remain") {
+		t.Fatalf("expected dry run to report the limit, got %v", runErr)
+	}
+	if !strings.Contains(stdout, `"deviceClass":"IPHONE","registered":99,"planned":2,"limit":100,"remaining":0`) {
+		t.Fatalf("unexpected dry-run output: %s", stdout)
+	}
+
+	root = RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	captureOutput(t, func() {
+		if err := root.Parse([]string{"devices", "import", "--file", devicesFile}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr == nil || !strings.Contains(runErr.Error(), "nothing was registered") {
+		t.Fatalf("expected import to stop before registering, got %v", runErr)
+	}
+}
+
+func TestDevicesImportSkipsDisabledDevicesByDefault(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	devicesFile := filepath.Join(t.TempDir(), "devices.csv")
+	if err := os.WriteFile(devicesFile, []byte("00008030-001A2D3E0E38802E,QA iPhone,IOS\n"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method == http.MethodGet && req.URL.Path == "/v1/devices" {
+			return apiTestResponse(`{"data":[{"type":"devices","id":"dev-off","attributes":{"name":"QA iPhone","udid":"00008030-001A2D3E0E38802E","platform":"IOS","deviceClass":"IPHONE","status":"DISABLED"}}],"links":{}}`), nil
+		}
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		return nil, nil
+	})
+
+	stdout := runSalesStoreCommand(t, []string{"devices", "import", "--file", devicesFile})
+	if !strings.Contains(stdout, `"status":"exists (disabled)"`) || !strings.Contains(stdout, `"enabled":0,"skipped":1`) {
+		t.Fatalf("unexpected output: %s", stdout)
+	}
+}
+
+func TestDevicesImportRecreatesProfileWhenNameIsTaken(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	devicesFile := filepath.Join(t.TempDir(), "devices.csv")
+	if err := os.WriteFile(devicesFile, []byte("00008030-001A2D3E0E38802E,QA iPhone,IOS\n"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	var calls []string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/devices":
+			return apiTestResponse(`{"data":[],"links":{}}`), nil
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/devices":
+			return &http.Response{
+				StatusCode: http.StatusCreated,
+				Body:       io.NopCloser(strings.NewReader(`{"data":{"type":"devices","id":"dev-new","attributes":{"deviceClass":"IPHONE"}}}`)),
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+			}, nil
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/profiles":
+			return apiTestResponse(`{"data":[{"type":"profiles","id":"prof-old","attributes":{"name":"Team Dev","profileType":"IOS_APP_DEVELOPMENT","profileState":"ACTIVE"}}],"links":{}}`), nil
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/profiles/prof-old/bundleId":
+			return apiTestResponse(`{"data":{"type":"bundleIds","id":"bundle-1","attributes":{"identifier":"com.example.app"}}}`), nil
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/profiles/prof-old/certificates":
+			return apiTestResponse(`{"data":[{"type":"certificates","id":"cert-1"}],"links":{}}`), nil
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/profiles/prof-old/devices":
+			return apiTestResponse(`{"data":[],"links":{}}`), nil
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/profiles":
+			calls = append(calls, "create")
+			if len(calls) == 1 {
+				return &http.Response{
+					StatusCode: http.StatusConflict,
+					Body:       io.NopCloser(strings.NewReader(`{"errors":[{"status":"409","code":"ENTITY_ERROR","title":"Name taken","detail":"Multiple profiles found with the name 'Team Dev'"}]}`)),
+					Header:     http.Header{"Content-Type": []string{"application/json"}},
+				}, nil
+			}
+			return &http.Response{
+				StatusCode: http.StatusConflict,
+				Body:       io.NopCloser(strings.NewReader(`{"errors":[{"status":"409","code":"ENTITY_ERROR","title":"Still taken"}]}`)),
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+			}, nil
+		case req.Method == http.MethodDelete && req.URL.Path == "/v1/profiles/prof-old":
+			calls = append(calls, "delete")
+			return &http.Response{StatusCode: http.StatusNoContent, Body: io.NopCloser(strings.NewReader("")), Header: http.Header{}}, nil
+		}
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		return nil, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"devices", "import", "--file", devicesFile, "--regenerate-profiles", "--bundle-id", "com.example.app"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr == nil {
+		t.Fatal("expected a reported error for the failed profile")
+	}
+	if strings.Join(calls, ",") != "create,delete,create" {
+		t.Fatalf("expected create, delete, create; got %v", calls)
+	}
+	if !strings.Contains(stdout, "could not be recreated") || !strings.Contains(stdout, "--bundle bundle-1 --certificate cert-1 --device dev-new") {
+		t.Fatalf("expected recreate details in output, got %s", stdout)
+	}
+}
+
+func TestDevicesImportValidationErrors(t *testing.T) {
+	invalidFile := filepath.Join(t.TempDir(), "invalid.csv")
+	if err := os.WriteFile(invalidFile, []byte("not-a-udid,Phone,IOS\n"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "invalid platform",
+			args:    []string{"devices", "import", "--file", invalidFile, "--platform", "WATCH_OS"},
+			wantErr: "--platform must be one of",
+		},
+		{
+			name:    "profile type without regenerate",
+			args:    []string{"devices", "import", "--file", invalidFile, "--profile-type", "IOS_APP_DEVELOPMENT"},
+			wantErr: "--profile-type requires --regenerate-profiles",
+		},
+		{
+			name:    "bundle id without regenerate",
+			args:    []string{"devices", "import", "--file", invalidFile, "--bundle-id", "com.example.app"},
+			wantErr: "--bundle-id requires --regenerate-profiles",
+		},
+		{
+			name:    "invalid udid",
+			args:    []string{"devices", "import", "--file", invalidFile},
+			wantErr: "line 1: invalid IOS UDID",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			var runErr error
+			captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				runErr = root.Run(context.Background())
+			})
+			if runErr == nil || !strings.Contains(runErr.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, runErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,214 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeDiffFastlaneDir(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	files := map[string]string{
+		"metadata/en-US/description.txt": "Line one\nLine two changed\n",
+		"metadata/en-US/name.txt":        "Example",
+		"metadata/de-DE/keywords.txt":    "foto,album",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("MkdirAll() error: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatalf("WriteFile() error: %v", err)
+		}
+	}
+	return dir
+}
+
+func diffVersionTransport(t *testing.T) roundTripFunc {
+	return func(req *http.Request) (*http.Response, error) {
+		switch req.URL.Path {
+		case "/v1/appStoreVersions/version-1/appStoreVersionLocalizations":
+			return apiTestResponse(`{"data":[{"type":"appStoreVersionLocalizations","id":"loc-en","attributes":{"locale":"en-US","description":"Line one\nLine two","keywords":"photo"}}]}`), nil
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	}
+}
+
+func TestDiffVersionAgainstFastlaneJSON(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	fastlaneDir := writeDiffFastlaneDir(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = diffVersionTransport(t)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"diff", "--from", "version:version-1", "--to", "fastlane:" + fastlaneDir}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	var reported ReportedError
+	if !errors.As(runErr, &reported) {
+		t.Fatalf("expected reported error for differences, got %v", runErr)
+	}
+
+	var result struct {
+		Scopes  []string `json:"scopes"`
+		Changes []struct {
+			Locale string `json:"locale"`
+			Field  string `json:"field"`
+			Change string `json:"change"`
+		} `json:"changes"`
+		Added     int  `json:"added"`
+		Removed   int  `json:"removed"`
+		Changed   int  `json:"changed"`
+		Identical bool `json:"identical"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if len(result.Scopes) != 1 || result.Scopes[0] != "version" {
+		t.Fatalf("expected only the version scope, got %v", result.Scopes)
+	}
+	got := make([]string, 0, len(result.Changes))
+	for _, change := range result.Changes {
+		got = append(got, change.Change+" "+change.Locale+" "+change.Field)
+	}
+	want := []string{"added de-DE keywords", "changed en-US description", "removed en-US keywords"}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Fatalf("changes = %v, want %v", got, want)
+	}
+	if result.Added != 1 || result.Removed != 1 || result.Changed != 1 || result.Identical {
+		t.Fatalf("unexpected summary: %s", stdout)
+	}
+}
+
+func TestDiffUnifiedOutput(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	fastlaneDir := writeDiffFastlaneDir(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = diffVersionTransport(t)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"diff", "--from", "version:version-1", "--to", "fastlane:" + fastlaneDir, "--locale", "en-US", "--output", "unified"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		_ = root.Run(context.Background())
+	})
+
+	want := strings.Join([]string{
+		"--- version:version-1",
+		"+++ fastlane:" + fastlaneDir,
+		"@@ en-US description @@",
+		" Line one",
+		"-Line two",
+		"+Line two changed",
+		"@@ en-US keywords @@",
+		"-photo",
+		"",
+	}, "\n")
+	if stdout != want {
+		t.Fatalf("unexpected unified diff:\n%s\nwant:\n%s", stdout, want)
+	}
+}
+
+func TestDiffIdenticalSnapshots(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"manifest.json": `{"formatVersion":1,"app":{"id":"app-1"}}`,
+		"app-info.json": `{"id":"info-1","localizations":[{"locale":"en-US","name":"Example"}]}`,
+		"versions.json": `[{"id":"v1","versionString":"1.0","platform":"IOS","createdDate":"2026-01-01","localizations":[{"locale":"en-US","description":"Old"}]},
+  {"id":"v2","versionString":"1.1","platform":"IOS","createdDate":"2026-02-01","localizations":[{"locale":"en-US","description":"Hello"}]}]`,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatalf("WriteFile() error: %v", err)
+		}
+	}
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"diff", "--from", "snapshot:" + dir, "--to", "snapshot:" + dir}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+	if !strings.Contains(stdout, `"identical":true`) || !strings.Contains(stdout, `"scopes":["version","app-info"]`) {
+		t.Fatalf("unexpected output: %s", stdout)
+	}
+}
+
+func TestDiffValidationErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{"missing to", []string{"diff", "--from", "version:1"}, "Error: --from and --to are required"},
+		{"bad platform", []string{"diff", "--from", "version:1", "--to", "version:2", "--platform", "ANDROID"}, "Error:"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			var runErr error
+			_, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				runErr = root.Run(context.Background())
+			})
+			if !errors.Is(runErr, flag.ErrHelp) {
+				t.Fatalf("expected ErrHelp, got %v", runErr)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected %q in stderr, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	var runErr error
+	captureOutput(t, func() {
+		if err := root.Parse([]string{"diff", "--from", "github:repo", "--to", "version:2"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr == nil || !strings.Contains(runErr.Error(), `unknown source kind "github"`) {
+		t.Fatalf("expected unknown source error, got %v", runErr)
+	}
+}
@@ -23,6 +23,7 @@ func TestExitCodeConstantsMatch(t *testing.T) {
 		{"Auth", 3, func() int { return cmd.ExitAuth }},
 		{"NotFound", 4, func() int { return cmd.ExitNotFound }},
 		{"Conflict", 5, func() int { return cmd.ExitConflict }},
+		{"Drift", 6, func() int { return cmd.ExitDrift }},
 	}
 
 	for _, tt := range tests {
@@ -1,11 +1,16 @@
 package cmdtest
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"encoding/json"
 	"errors"
 	"flag"
 	"io"
+	"net/http"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 )
@@ -151,3 +156,167 @@ func TestFinanceRegionsCommandOutputsJSON(t *testing.T) {
 		t.Fatal("expected region Z1 in output")
 	}
 }
+
+func gzipReportResponse(t *testing.T, report string) *http.Response {
+	t.Helper()
+	var compressed bytes.Buffer
+	writer := gzip.NewWriter(&compressed)
+	if _, err := writer.Write([]byte(report)); err != nil {
+		t.Fatalf("gzip write error: %v", err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("gzip close error: %v", err)
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(&compressed),
+		Header:     http.Header{"Content-Type": []string{"application/a-gzip"}},
+	}
+}
+
+const summarizeFinanceReport = "Start Date\tEnd Date\tVendor Identifier\tQuantity\tPartner Share\tExtended Partner Share\tPartner Share Currency\tSales or Return\tTitle\tProduct Type Identifier\tCountry Of Sale\tCustomer Price\tCustomer Currency\n" +
+	"10/01/2025\t11/01/2025\tcom.example.pro\t3\t6.99\t20.97\tUSD\tS\tExample Pro\tIA1\tUS\t9.99\tUSD\n" +
+	"10/01/2025\t11/01/2025\tcom.example.pro\t2\t5.50\t11.00\tEUR\tS\tExample Pro\tIA1\tDE\t7.99\tEUR\n" +
+	"\n" +
+	"Country Or Region (Currency)\tEarned\tTotal Owed\tExchange Rate\tProceeds\tBank Account Currency\n" +
+	"Americas (USD)\t20.97\t20.97\t1.000000\t20.97\tUSD\n" +
+	"Euro-Zone (EUR)\t11.00\t11.00\t1.100000\t12.10\tUSD\n"
+
+func TestFinanceSummarizeDownloadsRangeAndConverts(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	var requested []string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path != "/v1/financeReports" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		query := req.URL.Query()
+		if query.Get("filter[regionCode]") != "ZZ" || query.Get("filter[reportType]") != "FINANCIAL" {
+			t.Fatalf("unexpected query: %s", req.URL.RawQuery)
+		}
+		date := query.Get("filter[reportDate]")
+		requested = append(requested, date)
+		if date == "2025-11" {
+			return notFoundTestResponse(), nil
+		}
+		return gzipReportResponse(t, summarizeFinanceReport), nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"finance", "summarize", "--vendor", "12345678", "--from", "2025-10", "--to", "2025-11", "--group-by", "sku", "--currency", "USD"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if strings.Join(requested, ",") != "2025-10,2025-11" {
+		t.Fatalf("unexpected report months: %v", requested)
+	}
+	var result struct {
+		Reports           int      `json:"reports"`
+		Missing           []string `json:"missing"`
+		Units             int      `json:"units"`
+		ConvertedProceeds float64  `json:"convertedProceeds"`
+		Rows              []struct {
+			SKU               string  `json:"sku"`
+			Units             int     `json:"units"`
+			ConvertedProceeds float64 `json:"convertedProceeds"`
+		} `json:"rows"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("unmarshal output: %v\n%s", err, stdout)
+	}
+	if result.Reports != 1 || len(result.Missing) != 1 || result.Missing[0] != "2025-11 ZZ" {
+		t.Fatalf("unexpected reports/missing: %+v", result)
+	}
+	if len(result.Rows) != 1 || result.Rows[0].SKU != "com.example.pro" || result.Rows[0].Units != 5 || result.Rows[0].ConvertedProceeds != 33.07 {
+		t.Fatalf("unexpected rows: %+v", result.Rows)
+	}
+}
+
+func TestFinanceSummarizeLocalFileMarkdown(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "report.tsv")
+	if err := os.WriteFile(path, []byte(summarizeFinanceReport), 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"finance", "summarize", "--file", path, "--group-by", "month,territory,currency", "--output", "markdown"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	for _, want := range []string{"Month", "Territory", "Proceeds", "2025-11", "DE", "EUR", "11.00"} {
+		if !strings.Contains(stdout, want) {
+			t.Fatalf("expected markdown output to contain %q, got:\n%s", want, stdout)
+		}
+	}
+}
+
+func TestFinanceSummarizeValidationErrors(t *testing.T) {
+	t.Setenv("ASC_VENDOR_NUMBER", "")
+	t.Setenv("ASC_ANALYTICS_VENDOR_NUMBER", "")
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing vendor",
+			args:    []string{"finance", "summarize", "--from", "2025-10"},
+			wantErr: "--vendor is required",
+		},
+		{
+			name:    "missing from",
+			args:    []string{"finance", "summarize", "--vendor", "12345678"},
+			wantErr: "--from or --file is required",
+		},
+		{
+			name:    "invalid group",
+			args:    []string{"finance", "summarize", "--vendor", "12345678", "--from", "2025-10", "--group-by", "store"},
+			wantErr: "--group-by must be one of",
+		},
+		{
+			name:    "mixed currencies without target",
+			args:    []string{"finance", "summarize", "--vendor", "12345678", "--from", "2025-10", "--group-by", "sku"},
+			wantErr: "--currency is required",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			_, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
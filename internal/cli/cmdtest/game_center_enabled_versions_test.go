@@ -119,7 +119,7 @@ func TestGameCenterEnabledVersionsOutputErrors(t *testing.T) {
 	}{
 		{
 			name: "enabled-versions list unsupported output",
-			args: []string{"game-center", "enabled-versions", "list", "--app", "APP_ID", "--output", "yaml"},
+			args: []string{"game-center", "enabled-versions", "list", "--app", "APP_ID", "--output", "xml"},
 		},
 		{
 			name: "enabled-versions list pretty with table",
@@ -131,7 +131,7 @@ func TestGameCenterEnabledVersionsOutputErrors(t *testing.T) {
 		},
 		{
 			name: "enabled-versions compatible unsupported output",
-			args: []string{"game-center", "enabled-versions", "compatible-versions", "--id", "ENABLED_VERSION_ID", "--output", "yaml"},
+			args: []string{"game-center", "enabled-versions", "compatible-versions", "--id", "ENABLED_VERSION_ID", "--output", "xml"},
 		},
 		{
 			name: "enabled-versions compatible pretty with table",
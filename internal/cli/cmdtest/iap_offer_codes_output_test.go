@@ -280,8 +280,8 @@ func TestIAPOfferCodesListOutputErrors(t *testing.T) {
 	}{
 		{
 			name:    "unsupported output",
-			args:    []string{"iap", "offer-codes", "list", "--iap-id", "iap-1", "--output", "yaml"},
-			wantErr: "unsupported format: yaml",
+			args:    []string{"iap", "offer-codes", "list", "--iap-id", "iap-1", "--output", "xml"},
+			wantErr: "unsupported format: xml",
 		},
 		{
 			name:    "pretty with table",
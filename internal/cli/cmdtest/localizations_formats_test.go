@@ -0,0 +1,98 @@
+package cmdtest
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLocalizationsDownloadCSV(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	outputPath := filepath.Join(t.TempDir(), "metadata.csv")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path != "/v1/appStoreVersions/version-1/appStoreVersionLocalizations" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return apiTestResponse(`{"data":[` +
+			`{"type":"appStoreVersionLocalizations","id":"loc-1","attributes":{"locale":"en-US","description":"Hello","keywords":"a,b"}},` +
+			`{"type":"appStoreVersionLocalizations","id":"loc-2","attributes":{"locale":"de-DE","description":"Hallo"}}]}`), nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"localizations", "download", "--version", "version-1", "--path", outputPath}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	data, err := os.ReadFile(outputPath)
+	if err != nil {
+		t.Fatalf("ReadFile() error: %v", err)
+	}
+	want := "key,de-DE,en-US\ndescription,Hallo,Hello\nkeywords,,\"a,b\"\n"
+	if string(data) != want {
+		t.Fatalf("unexpected CSV:\n%s", data)
+	}
+	if !strings.Contains(stdout, `"locale":"de-DE"`) || !strings.Contains(stdout, outputPath) {
+		t.Fatalf("unexpected output: %s", stdout)
+	}
+}
+
+func TestLocalizationsUploadXLIFFDryRun(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	dir := t.TempDir()
+	content := `<?xml version="1.0" encoding="UTF-8"?>
+<xliff xmlns="urn:oasis:names:tc:xliff:document:1.2" version="1.2">
+  <file original="metadata" source-language="en-US" target-language="fr-FR" datatype="plaintext">
+    <body>
+      <trans-unit id="description"><source>Hello</source><target>Bonjour</target></trans-unit>
+    </body>
+  </file>
+</xliff>`
+	if err := os.WriteFile(filepath.Join(dir, "fr-FR.xliff"), []byte(content), 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/appStoreVersions/version-1/appStoreVersionLocalizations" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return apiTestResponse(`{"data":[{"type":"appStoreVersionLocalizations","id":"loc-fr","attributes":{"locale":"fr-FR"}}]}`), nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"localizations", "upload", "--version", "version-1", "--format", "xliff", "--path", dir, "--dry-run"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if !strings.Contains(stdout, `"locale":"fr-FR"`) || !strings.Contains(stdout, `"localizationId":"loc-fr"`) {
+		t.Fatalf("unexpected output: %s", stdout)
+	}
+}
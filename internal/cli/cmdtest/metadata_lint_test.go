@@ -0,0 +1,146 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeFastlaneMetadata(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(dir, "metadata", name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("MkdirAll() error: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatalf("WriteFile() error: %v", err)
+		}
+	}
+}
+
+func TestMetadataLintFastlaneReportsErrors(t *testing.T) {
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	dir := t.TempDir()
+	writeFastlaneMetadata(t, dir, map[string]string{
+		"en-US/name.txt":        "Photo Sorter",
+		"en-US/keywords.txt":    "photo,free,gallery",
+		"en-US/support_url.txt": "https://example.com/support",
+		"de-DE/name.txt":        "Fotosortierer",
+		"de-DE/keywords.txt":    "foto,galerie",
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"metadata", "lint", "--fastlane-dir", dir}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr == nil || !strings.Contains(runErr.Error(), "found 1 error(s)") {
+		t.Fatalf("expected lint error, got %v", runErr)
+	}
+
+	var result struct {
+		PrimaryLocale string   `json:"primaryLocale"`
+		Locales       []string `json:"locales"`
+		Valid         bool     `json:"valid"`
+		Errors        int      `json:"errors"`
+		Warnings      int      `json:"warnings"`
+		Findings      []struct {
+			Rule   string `json:"rule"`
+			Locale string `json:"locale"`
+			Field  string `json:"field"`
+		} `json:"findings"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if result.PrimaryLocale != "en-US" || len(result.Locales) != 2 || result.Valid {
+		t.Fatalf("unexpected result: %s", stdout)
+	}
+	if result.Errors != 1 || result.Warnings != 2 {
+		t.Fatalf("expected 1 error and 2 warnings, got %s", stdout)
+	}
+	seen := map[string]bool{}
+	for _, finding := range result.Findings {
+		seen[finding.Rule+" "+finding.Locale+" "+finding.Field] = true
+	}
+	for _, want := range []string{
+		"banned-words en-US keywords",
+		"keyword-duplicates en-US keywords",
+		"missing-fields de-DE supportUrl",
+	} {
+		if !seen[want] {
+			t.Fatalf("expected finding %q in %s", want, stdout)
+		}
+	}
+}
+
+func TestMetadataLintConfigFileOverridesRules(t *testing.T) {
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	dir := t.TempDir()
+	writeFastlaneMetadata(t, dir, map[string]string{
+		"en-US/keywords.txt":         "photo,free",
+		"en-US/promotional_text.txt": "Now 50% off for a limited time",
+	})
+	configPath := filepath.Join(t.TempDir(), "lint.json")
+	config := `{"banned_keywords": [], "limits": {"promotionalText": 10}, "rules": {"field-length": "warning"}}`
+	if err := os.WriteFile(configPath, []byte(config), 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"metadata", "lint", "--fastlane-dir", dir, "--config", configPath}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr != nil {
+		t.Fatalf("run error: %v", runErr)
+	}
+	if !strings.Contains(stdout, `"valid":true`) || !strings.Contains(stdout, "exceeds the 10 character limit") {
+		t.Fatalf("unexpected output: %s", stdout)
+	}
+}
+
+func TestMetadataLintRequiresOneSource(t *testing.T) {
+	tests := [][]string{
+		{"metadata", "lint"},
+		{"metadata", "lint", "--fastlane-dir", "a", "--path", "b"},
+		{"metadata", "lint", "--fastlane-dir", "a", "--format", "json"},
+	}
+	for _, args := range tests {
+		root := RootCommand("1.2.3")
+		root.FlagSet.SetOutput(io.Discard)
+
+		var runErr error
+		_, stderr := captureOutput(t, func() {
+			if err := root.Parse(args); err != nil {
+				t.Fatalf("parse error: %v", err)
+			}
+			runErr = root.Run(context.Background())
+		})
+		if !errors.Is(runErr, flag.ErrHelp) {
+			t.Fatalf("%v: expected ErrHelp, got %v", args, runErr)
+		}
+		if !strings.Contains(stderr, "Error:") {
+			t.Fatalf("%v: expected error message, got %q", args, stderr)
+		}
+	}
+}
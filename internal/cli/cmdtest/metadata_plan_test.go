@@ -0,0 +1,335 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/cmd"
+)
+
+func writeMetadataSpec(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	files := map[string]string{
+		"metadata.json": `{
+  "copyright": "2026 Example Inc.",
+  "primaryCategory": "GAMES",
+  "secondaryCategory": "ENTERTAINMENT",
+  "ageRating": {"gambling": false, "contests": "NONE"},
+  "reviewDetails": {"contactEmail": "review@example.com", "demoAccountPassword": "secret"}
+}`,
+		"version-localizations/en-US.strings": `"description" = "New description";
+"keywords" = "photo,album";
+`,
+		"version-localizations/fr-FR.strings": `"description" = "Bonjour";
+`,
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("MkdirAll() error: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatalf("WriteFile() error: %v", err)
+		}
+	}
+	return dir
+}
+
+// metadataLiveTransport serves live state for the spec above and records
+// every write request as "METHOD path" with its body.
+func metadataLiveTransport(t *testing.T, writes map[string]string, mu *sync.Mutex) roundTripFunc {
+	return func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet {
+			body, _ := io.ReadAll(req.Body)
+			mu.Lock()
+			writes[req.Method+" "+req.URL.Path] = string(body)
+			mu.Unlock()
+			return apiTestResponse(`{"data":{"type":"resources","id":"new-1","attributes":{}}}`), nil
+		}
+		switch req.URL.Path {
+		case "/v1/appStoreVersions/version-1/appStoreVersionLocalizations":
+			return apiTestResponse(`{"data":[{"type":"appStoreVersionLocalizations","id":"loc-en","attributes":{"locale":"en-US","description":"Old description","keywords":"photo,album"}}]}`), nil
+		case "/v1/appStoreVersions/version-1":
+			return apiTestResponse(`{"data":{"type":"appStoreVersions","id":"version-1","attributes":{"versionString":"1.0","copyright":"2025 Example Inc."}}}`), nil
+		case "/v1/appStoreVersions/version-1/appStoreReviewDetail":
+			return &http.Response{
+				StatusCode: http.StatusNotFound,
+				Body:       io.NopCloser(strings.NewReader(`{"errors":[{"code":"NOT_FOUND","title":"Not Found"}]}`)),
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+			}, nil
+		case "/v1/appInfos/info-1/primaryCategory":
+			return apiTestResponse(`{"data":{"type":"appCategories","id":"GAMES"}}`), nil
+		case "/v1/appInfos/info-1/secondaryCategory":
+			return apiTestResponse(`{"data":null}`), nil
+		case "/v1/appInfos/info-1/ageRatingDeclaration":
+			return apiTestResponse(`{"data":{"type":"ageRatingDeclarations","id":"age-1","attributes":{"gambling":false,"contests":"FREQUENT_OR_INTENSE"}}}`), nil
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	}
+}
+
+type metadataPlanOutput struct {
+	Changes []struct {
+		Resource string `json:"resource"`
+		Locale   string `json:"locale"`
+		Field    string `json:"field"`
+		Action   string `json:"action"`
+		Current  string `json:"current"`
+		Desired  string `json:"desired"`
+	} `json:"changes"`
+	Operations []struct {
+		Method   string `json:"method"`
+		Resource string `json:"resource"`
+		ID       string `json:"id"`
+	} `json:"operations"`
+	Creates int  `json:"creates"`
+	Updates int  `json:"updates"`
+	Drift   bool `json:"drift"`
+	Applied bool `json:"applied"`
+}
+
+func TestMetadataPlanReportsDrift(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	dir := writeMetadataSpec(t)
+
+	writes := map[string]string{}
+	var mu sync.Mutex
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = metadataLiveTransport(t, writes, &mu)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"metadata", "plan", "--dir", dir, "--version", "version-1", "--app-info", "info-1"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr == nil || !strings.Contains(runErr.Error(), "change(s) pending") {
+		t.Fatalf("expected drift error, got %v", runErr)
+	}
+	if code := cmd.ExitCodeFromError(runErr); code != cmd.ExitDrift {
+		t.Fatalf("expected drift exit code %d, got %d", cmd.ExitDrift, code)
+	}
+	if len(writes) != 0 {
+		t.Fatalf("plan must not write, got %v", writes)
+	}
+
+	var result metadataPlanOutput
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if !result.Drift || result.Applied || result.Creates != 2 || result.Updates != 4 {
+		t.Fatalf("unexpected summary: %s", stdout)
+	}
+
+	changes := map[string]string{}
+	for _, change := range result.Changes {
+		changes[change.Resource+" "+change.Locale+" "+change.Field] = change.Action + ": " + change.Current + " -> " + change.Desired
+	}
+	want := map[string]string{
+		"version-localization en-US description": "update: Old description -> New description",
+		"version-localization fr-FR description": "create:  -> Bonjour",
+		"version  copyright":                     "update: 2025 Example Inc. -> 2026 Example Inc.",
+		"app-info  secondaryCategory":            "update:  -> ENTERTAINMENT",
+		"age-rating  contests":                   "update: FREQUENT_OR_INTENSE -> NONE",
+		"review-details  contactEmail":           "create:  -> review@example.com",
+		"review-details  demoAccountPassword":    "create:  -> (sensitive)",
+	}
+	if len(changes) != len(want) {
+		t.Fatalf("expected %d changes, got %v", len(want), changes)
+	}
+	for key, value := range want {
+		if changes[key] != value {
+			t.Fatalf("change %q = %q, want %q (all: %v)", key, changes[key], value, changes)
+		}
+	}
+	if strings.Contains(stdout, "secret") {
+		t.Fatalf("plan output leaked a password: %s", stdout)
+	}
+}
+
+func TestMetadataApplySendsMinimalRequests(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	dir := writeMetadataSpec(t)
+
+	writes := map[string]string{}
+	var mu sync.Mutex
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = metadataLiveTransport(t, writes, &mu)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"metadata", "apply", "--dir", dir, "--version", "version-1", "--app-info", "info-1"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result metadataPlanOutput
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if !result.Applied || len(result.Operations) != 6 {
+		t.Fatalf("unexpected result: %s", stdout)
+	}
+
+	wantWrites := []string{
+		"PATCH /v1/appStoreVersionLocalizations/loc-en",
+		"POST /v1/appStoreVersionLocalizations",
+		"PATCH /v1/appStoreVersions/version-1",
+		"PATCH /v1/appInfos/info-1",
+		"PATCH /v1/ageRatingDeclarations/age-1",
+		"POST /v1/appStoreReviewDetails",
+	}
+	if len(writes) != len(wantWrites) {
+		t.Fatalf("expected %d writes, got %v", len(wantWrites), writes)
+	}
+	for _, key := range wantWrites {
+		if _, ok := writes[key]; !ok {
+			t.Fatalf("expected request %q, got %v", key, writes)
+		}
+	}
+
+	localization := writes["PATCH /v1/appStoreVersionLocalizations/loc-en"]
+	if !strings.Contains(localization, `"description":"New description"`) || strings.Contains(localization, "keywords") {
+		t.Fatalf("expected only the changed description, got %s", localization)
+	}
+	categories := writes["PATCH /v1/appInfos/info-1"]
+	if !strings.Contains(categories, `"secondaryCategory"`) || strings.Contains(categories, `"primaryCategory"`) {
+		t.Fatalf("expected only the secondary category, got %s", categories)
+	}
+	ageRating := writes["PATCH /v1/ageRatingDeclarations/age-1"]
+	if !strings.Contains(ageRating, `"contests":"NONE"`) || strings.Contains(ageRating, "gambling") {
+		t.Fatalf("expected only contests, got %s", ageRating)
+	}
+	if !strings.Contains(writes["POST /v1/appStoreReviewDetails"], `"demoAccountPassword":"secret"`) {
+		t.Fatalf("expected review details to be created, got %s", writes["POST /v1/appStoreReviewDetails"])
+	}
+}
+
+func TestMetadataPlanValidationErrors(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "")
+	dir := writeMetadataSpec(t)
+
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{args: []string{"metadata", "plan"}, want: "Error: --dir is required"},
+		{args: []string{"metadata", "plan", "--dir", dir, "--app-info", "info-1"}, want: "Error: --version is required"},
+		{args: []string{"metadata", "apply", "--dir", dir, "--version", "version-1"}, want: "Error: --app is required"},
+		{args: []string{"metadata", "plan", "--dir", dir, "--format", "yaml"}, want: "Error: --format must be one of"},
+	}
+	for _, test := range tests {
+		root := RootCommand("1.2.3")
+		root.FlagSet.SetOutput(io.Discard)
+
+		var runErr error
+		_, stderr := captureOutput(t, func() {
+			if err := root.Parse(test.args); err != nil {
+				t.Fatalf("parse error: %v", err)
+			}
+			runErr = root.Run(context.Background())
+		})
+		if !errors.Is(runErr, flag.ErrHelp) {
+			t.Fatalf("%v: expected ErrHelp, got %v", test.args, runErr)
+		}
+		if !strings.Contains(stderr, test.want) {
+			t.Fatalf("%v: expected %q, got %q", test.args, test.want, stderr)
+		}
+	}
+}
+
+func TestMetadataApplyClearsSecondaryCategory(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "metadata.json"), []byte(`{"primaryCategory": "GAMES", "secondaryCategory": ""}`), 0o644); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+
+	secondary := `{"type":"appCategories","id":"ENTERTAINMENT"}`
+	var patches []string
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appInfos/info-1/primaryCategory":
+			return apiTestResponse(`{"data":{"type":"appCategories","id":"GAMES"}}`), nil
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appInfos/info-1/secondaryCategory":
+			return apiTestResponse(`{"data":` + secondary + `}`), nil
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/appInfos/info-1":
+			body, _ := io.ReadAll(req.Body)
+			patches = append(patches, string(body))
+			if strings.Contains(string(body), `"secondaryCategory":{"data":null}`) {
+				secondary = "null"
+			}
+			return apiTestResponse(`{"data":{"type":"appInfos","id":"info-1","attributes":{}}}`), nil
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	run := func(command string) (metadataPlanOutput, error) {
+		root := RootCommand("1.2.3")
+		root.FlagSet.SetOutput(io.Discard)
+
+		var runErr error
+		stdout, _ := captureOutput(t, func() {
+			if err := root.Parse([]string{"metadata", command, "--dir", dir, "--app-info", "info-1"}); err != nil {
+				t.Fatalf("parse error: %v", err)
+			}
+			runErr = root.Run(context.Background())
+		})
+		var result metadataPlanOutput
+		if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+			t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+		}
+		return result, runErr
+	}
+
+	before, err := run("plan")
+	if err == nil || !before.Drift || len(before.Changes) != 1 || before.Changes[0].Field != "secondaryCategory" {
+		t.Fatalf("expected secondary category drift, got %+v (err %v)", before, err)
+	}
+	if _, err := run("apply"); err != nil {
+		t.Fatalf("apply error: %v", err)
+	}
+	if len(patches) != 1 || strings.Contains(patches[0], `"primaryCategory"`) {
+		t.Fatalf("expected one PATCH clearing the secondary category, got %v", patches)
+	}
+	after, err := run("plan")
+	if err != nil || after.Drift || len(after.Changes) != 0 {
+		t.Fatalf("expected no drift after apply, got %+v (err %v)", after, err)
+	}
+}
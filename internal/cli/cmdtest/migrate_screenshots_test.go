@@ -0,0 +1,283 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestMigrateExportDownloadsScreenshotsAndPreviews(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	outputDir := t.TempDir()
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = migrateExportTransport(t)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"migrate", "export", "--app", "app-1", "--version-id", "version-1", "--output-dir", outputDir}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	wantFiles := map[string]string{
+		"screenshots/en-US/APP_IPHONE_65_01_home.png":                   "png:/a/1242x2688bb.png",
+		"screenshots/en-US/APP_IPHONE_65_02_detail.jpg":                 "png:/b/1242x2688bb.jpg",
+		"screenshots/en-US/iMessage/IMESSAGE_APP_IPHONE_65_01_chat.png": "png:/c/1242x2688bb.png",
+		"screenshots/en-US/IPHONE_65_01_demo.mov":                       "mov",
+		"metadata/en-US/description.txt":                                "Hello\n",
+	}
+	for rel, want := range wantFiles {
+		data, err := os.ReadFile(filepath.Join(outputDir, rel))
+		if err != nil {
+			t.Fatalf("expected %s: %v", rel, err)
+		}
+		if string(data) != want {
+			t.Fatalf("%s = %q, want %q", rel, data, want)
+		}
+	}
+
+	var result struct {
+		AssetSets []struct {
+			Kind        string   `json:"kind"`
+			DisplayType string   `json:"displayType"`
+			Files       []string `json:"files"`
+		} `json:"assetSets"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("unmarshal output: %v (%q)", err, stdout)
+	}
+	if len(result.AssetSets) != 3 || result.AssetSets[2].Kind != "previews" {
+		t.Fatalf("unexpected asset sets: %+v", result.AssetSets)
+	}
+}
+
+func TestMigrateExportRefusesExistingAssetFiles(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	outputDir := t.TempDir()
+
+	victim := filepath.Join(t.TempDir(), "victim.txt")
+	if err := os.WriteFile(victim, []byte("keep"), 0o644); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+	planted := filepath.Join(outputDir, "screenshots", "en-US", "APP_IPHONE_65_01_home.png")
+	if err := os.MkdirAll(filepath.Dir(planted), 0o755); err != nil {
+		t.Fatalf("MkdirAll() error: %v", err)
+	}
+	if err := os.Symlink(victim, planted); err != nil {
+		t.Skipf("symlinks unavailable: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = migrateExportTransport(t)
+
+	for _, args := range [][]string{
+		{"migrate", "export", "--app", "app-1", "--version-id", "version-1", "--output-dir", outputDir},
+		{"migrate", "export", "--app", "app-1", "--version-id", "version-1", "--output-dir", outputDir, "--overwrite"},
+	} {
+		root := RootCommand("1.2.3")
+		root.FlagSet.SetOutput(io.Discard)
+
+		var runErr error
+		captureOutput(t, func() {
+			if err := root.Parse(args); err != nil {
+				t.Fatalf("parse error: %v", err)
+			}
+			runErr = root.Run(context.Background())
+		})
+		if runErr == nil {
+			t.Fatalf("%v: expected error for a symlink in the output directory", args)
+		}
+		data, err := os.ReadFile(victim)
+		if err != nil || string(data) != "keep" {
+			t.Fatalf("%v: symlink target changed to %q (err %v)", args, data, err)
+		}
+	}
+
+	if err := os.Remove(planted); err != nil {
+		t.Fatalf("Remove() error: %v", err)
+	}
+	if err := os.WriteFile(planted, []byte("old"), 0o644); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	captureOutput(t, func() {
+		if err := root.Parse([]string{"migrate", "export", "--app", "app-1", "--version-id", "version-1", "--output-dir", outputDir, "--overwrite"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+	if data, err := os.ReadFile(planted); err != nil || string(data) != "png:/a/1242x2688bb.png" {
+		t.Fatalf("expected --overwrite to replace the file, got %q (err %v)", data, err)
+	}
+}
+
+func TestMigrateImportDryRunListsScreenshotSets(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	fastlaneDir := t.TempDir()
+	for path, content := range map[string]string{
+		"metadata/en-US/description.txt":           "Hello",
+		"screenshots/en-US/APP_IPHONE_65_01.png":   "not decoded when the prefix matches",
+		"screenshots/en-US/APP_IPHONE_65_02.png":   "x",
+		"screenshots/en-US/IPHONE_65_01_intro.mov": "x",
+	} {
+		full := filepath.Join(fastlaneDir, path)
+		if err := os.MkdirAll(filepath.Dir(full), 0o755); err != nil {
+			t.Fatalf("MkdirAll() error: %v", err)
+		}
+		if err := os.WriteFile(full, []byte(content), 0o644); err != nil {
+			t.Fatalf("WriteFile() error: %v", err)
+		}
+	}
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"migrate", "import", "--app", "app-1", "--version-id", "version-1", "--fastlane-dir", fastlaneDir, "--dry-run", "--output", "table"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	for _, want := range []string{"Screenshots and Previews", "APP_IPHONE_65", "screenshots", "previews", "IPHONE_65"} {
+		if !strings.Contains(stdout, want) {
+			t.Fatalf("expected %q in output, got %q", want, stdout)
+		}
+	}
+}
+
+func TestMigrateImportReplaceKeepsRemoteAssetsWhenUploadFails(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	fastlaneDir := t.TempDir()
+	for path, content := range map[string]string{
+		"metadata/en-US/description.txt":         "Hello",
+		"screenshots/en-US/APP_IPHONE_65_01.png": "new screenshot",
+	} {
+		full := filepath.Join(fastlaneDir, path)
+		if err := os.MkdirAll(filepath.Dir(full), 0o755); err != nil {
+			t.Fatalf("MkdirAll() error: %v", err)
+		}
+		if err := os.WriteFile(full, []byte(content), 0o644); err != nil {
+			t.Fatalf("WriteFile() error: %v", err)
+		}
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	listed := false
+	var deletes []string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appStoreVersions/version-1/appStoreVersionLocalizations":
+			return apiTestResponse(`{"data":[{"type":"appStoreVersionLocalizations","id":"loc-en","attributes":{"locale":"en-US"}}]}`), nil
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/appStoreVersionLocalizations/loc-en":
+			return apiTestResponse(`{"data":{"type":"appStoreVersionLocalizations","id":"loc-en","attributes":{"locale":"en-US"}}}`), nil
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appStoreVersionLocalizations/loc-en/appScreenshotSets":
+			return apiTestResponse(`{"data":[{"type":"appScreenshotSets","id":"set-1","attributes":{"screenshotDisplayType":"APP_IPHONE_65"}}]}`), nil
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appScreenshotSets/set-1/appScreenshots":
+			listed = true
+			return apiTestResponse(`{"data":[{"type":"appScreenshots","id":"shot-old","attributes":{"fileName":"old.png","sourceFileChecksum":"ffff","assetDeliveryState":{"state":"COMPLETE"}}}]}`), nil
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/appScreenshots":
+			return &http.Response{
+				StatusCode: http.StatusConflict,
+				Body:       io.NopCloser(strings.NewReader(`{"errors":[{"code":"STATE_ERROR","title":"Upload rejected"}]}`)),
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+			}, nil
+		case req.Method == http.MethodDelete:
+			deletes = append(deletes, req.URL.Path)
+			return &http.Response{StatusCode: http.StatusNoContent, Body: io.NopCloser(strings.NewReader("")), Header: http.Header{}}, nil
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	captureOutput(t, func() {
+		if err := root.Parse([]string{"migrate", "import", "--app", "app-1", "--version-id", "version-1", "--fastlane-dir", fastlaneDir, "--replace-screenshots"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr == nil || !strings.Contains(runErr.Error(), "failed to upload APP_IPHONE_65 screenshots for en-US") {
+		t.Fatalf("expected upload error, got %v", runErr)
+	}
+	if !listed {
+		t.Fatalf("expected the existing screenshots to be listed")
+	}
+	if len(deletes) != 0 {
+		t.Fatalf("expected no deletions after a failed upload, got %v", deletes)
+	}
+}
+
+// migrateExportTransport serves one en-US localization with two screenshot
+// sets and one preview set.
+func migrateExportTransport(t *testing.T) roundTripFunc {
+	t.Helper()
+	return roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Host == "is1-ssl.mzstatic.com" {
+			return apiTestResponse("png:" + req.URL.Path), nil
+		}
+		if req.URL.Host == "apptrailers.itunes.apple.com" {
+			return apiTestResponse("mov"), nil
+		}
+		switch req.URL.Path {
+		case "/v1/appStoreVersions/version-1/appStoreVersionLocalizations":
+			return apiTestResponse(`{"data":[{"type":"appStoreVersionLocalizations","id":"loc-1","attributes":{"locale":"en-US","description":"Hello"}}]}`), nil
+		case "/v1/appStoreVersionLocalizations/loc-1/appScreenshotSets":
+			return apiTestResponse(`{"data":[` +
+				`{"type":"appScreenshotSets","id":"set-1","attributes":{"screenshotDisplayType":"APP_IPHONE_65"}},` +
+				`{"type":"appScreenshotSets","id":"set-2","attributes":{"screenshotDisplayType":"IMESSAGE_APP_IPHONE_65"}}]}`), nil
+		case "/v1/appScreenshotSets/set-1/appScreenshots":
+			return apiTestResponse(`{"data":[` +
+				`{"type":"appScreenshots","id":"shot-1","attributes":{"fileName":"home.png","imageAsset":{"templateUrl":"https://is1-ssl.mzstatic.com/a/{w}x{h}bb.{f}","width":1242,"height":2688}}},` +
+				`{"type":"appScreenshots","id":"shot-2","attributes":{"fileName":"detail.jpg","imageAsset":{"templateUrl":"https://is1-ssl.mzstatic.com/b/{w}x{h}bb.{f}","width":1242,"height":2688}}}]}`), nil
+		case "/v1/appScreenshotSets/set-2/appScreenshots":
+			return apiTestResponse(`{"data":[{"type":"appScreenshots","id":"shot-3","attributes":{"fileName":"chat.png","imageAsset":{"templateUrl":"https://is1-ssl.mzstatic.com/c/{w}x{h}bb.{f}","width":1242,"height":2688}}}]}`), nil
+		case "/v1/appStoreVersionLocalizations/loc-1/appPreviewSets":
+			return apiTestResponse(`{"data":[{"type":"appPreviewSets","id":"pset-1","attributes":{"previewType":"IPHONE_65"}}]}`), nil
+		case "/v1/appPreviewSets/pset-1/appPreviews":
+			return apiTestResponse(`{"data":[{"type":"appPreviews","id":"preview-1","attributes":{"fileName":"demo.mov","videoUrl":"https://apptrailers.itunes.apple.com/demo.mov"}}]}`), nil
+		case "/v1/apps/app-1/appInfos":
+			return apiTestResponse(`{"data":[]}`), nil
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+}
@@ -0,0 +1,83 @@
+package cmdtest
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"io"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/mockserver"
+)
+
+func TestMockServeValidationErrors(t *testing.T) {
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"mock", "serve"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		err := root.Run(context.Background())
+		if !errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("expected ErrHelp, got %v", err)
+		}
+	})
+
+	if stdout != "" {
+		t.Fatalf("expected empty stdout, got %q", stdout)
+	}
+	if !strings.Contains(stderr, "--dir is required") {
+		t.Fatalf("expected --dir error, got %q", stderr)
+	}
+}
+
+func TestBaseURLFlagTargetsMockServer(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_BASE_URL", "")
+	t.Cleanup(func() { asc.SetBaseURLOverride(nil) })
+
+	dir := t.TempDir()
+	fixture := filepath.Join(dir, "v1", "apps.json")
+	if err := os.MkdirAll(filepath.Dir(fixture), 0o755); err != nil {
+		t.Fatalf("MkdirAll() error: %v", err)
+	}
+	body := `{"data":[{"type":"apps","id":"app-1"},{"type":"apps","id":"app-2"},{"type":"apps","id":"app-3"}]}`
+	if err := os.WriteFile(fixture, []byte(body), 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+
+	handler, err := mockserver.New(mockserver.Options{Dir: dir, PageSize: 2})
+	if err != nil {
+		t.Fatalf("mockserver.New() error: %v", err)
+	}
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"--base-url", server.URL, "apps", "list", "--paginate"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if stderr != "" {
+		t.Fatalf("expected empty stderr, got %q", stderr)
+	}
+	for _, id := range []string{"app-1", "app-2", "app-3"} {
+		if !strings.Contains(stdout, `"id":"`+id+`"`) {
+			t.Fatalf("expected output to contain %s, got %q", id, stdout)
+		}
+	}
+}
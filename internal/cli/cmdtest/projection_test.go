@@ -0,0 +1,171 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const projectionBuildsBody = `{"data":[` +
+	`{"type":"builds","id":"build-1","attributes":{"version":"10","processingState":"VALID","uploadedDate":"2026-01-01T00:00:00Z"}},` +
+	`{"type":"builds","id":"build-2","attributes":{"version":"11","processingState":"PROCESSING","uploadedDate":"2026-01-02T00:00:00Z"}}` +
+	`],"links":{"self":"https://api.appstoreconnect.apple.com/v1/builds"}}`
+
+func runProjectionCommand(t *testing.T, args []string) (string, string, error) {
+	t.Helper()
+	setupAuth(t)
+	resetDefaultOutput(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Cleanup(func() {
+		shared.SetOutputFields("")
+		shared.SetOutputQuery("")
+	})
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return apiTestResponse(projectionBuildsBody), nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse(args); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	return stdout, stderr, runErr
+}
+
+func TestFieldsProjectsJSONResources(t *testing.T) {
+	stdout, _, err := runProjectionCommand(t, []string{
+		"--fields", "id,attributes.version",
+		"api", "GET", "/v1/builds",
+	})
+	if err != nil {
+		t.Fatalf("run error: %v", err)
+	}
+
+	var got map[string]interface{}
+	if err := json.Unmarshal([]byte(stdout), &got); err != nil {
+		t.Fatalf("unmarshal output: %v (%q)", err, stdout)
+	}
+	data, _ := got["data"].([]interface{})
+	if len(data) != 2 {
+		t.Fatalf("expected 2 resources, got %v", got["data"])
+	}
+	first, _ := data[0].(map[string]interface{})
+	if len(first) != 2 || first["id"] != "build-1" {
+		t.Fatalf("expected only id and attributes, got %v", first)
+	}
+	attributes, _ := first["attributes"].(map[string]interface{})
+	if len(attributes) != 1 || attributes["version"] != "10" {
+		t.Fatalf("expected only attributes.version, got %v", attributes)
+	}
+	if _, ok := got["links"]; !ok {
+		t.Fatalf("expected links to be preserved, got %v", got)
+	}
+}
+
+func TestFieldsDriveTableColumns(t *testing.T) {
+	stdout, _, err := runProjectionCommand(t, []string{
+		"--fields", "id,attributes.processingState",
+		"api", "GET", "/v1/builds", "--output", "table",
+	})
+	if err != nil {
+		t.Fatalf("run error: %v", err)
+	}
+
+	if !strings.Contains(stdout, "attributes.processingState") {
+		t.Fatalf("expected projected headers, got %q", stdout)
+	}
+	if strings.Contains(stdout, "uploadedDate") || strings.Contains(stdout, "2026-01-01") {
+		t.Fatalf("expected unprojected attributes to be dropped, got %q", stdout)
+	}
+	if !strings.Contains(stdout, "build-2") || !strings.Contains(stdout, "PROCESSING") {
+		t.Fatalf("expected projected values, got %q", stdout)
+	}
+}
+
+func TestQueryFiltersOutput(t *testing.T) {
+	stdout, _, err := runProjectionCommand(t, []string{
+		"--query", "data[?attributes.processingState == 'VALID'].{id: id, version: attributes.version}",
+		"api", "GET", "/v1/builds",
+	})
+	if err != nil {
+		t.Fatalf("run error: %v", err)
+	}
+	if strings.TrimSpace(stdout) != `[{"id":"build-1","version":"10"}]` {
+		t.Fatalf("unexpected query output: %q", stdout)
+	}
+}
+
+func TestQueryAndFieldsWithCSV(t *testing.T) {
+	stdout, _, err := runProjectionCommand(t, []string{
+		"--query", "data[?attributes.processingState == 'PROCESSING']",
+		"--fields", "id,attributes.version",
+		"api", "GET", "/v1/builds", "--output", "csv",
+	})
+	if err != nil {
+		t.Fatalf("run error: %v", err)
+	}
+	want := "id,attributes.version\nbuild-2,11\n"
+	if stdout != want {
+		t.Fatalf("expected %q, got %q", want, stdout)
+	}
+}
+
+func TestQueryEvaluationError(t *testing.T) {
+	_, _, err := runProjectionCommand(t, []string{
+		"--query", "length(data[0].attributes.version.missing)",
+		"api", "GET", "/v1/builds",
+	})
+	if err == nil || !strings.Contains(err.Error(), "--query") {
+		t.Fatalf("expected --query error, got %v", err)
+	}
+}
+
+func TestProjectionAppliesToCommandSpecificPrinters(t *testing.T) {
+	fastlaneDir := t.TempDir()
+	localeDir := filepath.Join(fastlaneDir, "metadata", "en-US")
+	if err := os.MkdirAll(localeDir, 0o755); err != nil {
+		t.Fatalf("MkdirAll() error: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(localeDir, "description.txt"), []byte("An app."), 0o644); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+
+	stdout, _, err := runProjectionCommand(t, []string{
+		"--query", "locales",
+		"migrate", "validate", "--fastlane-dir", fastlaneDir,
+	})
+	if err != nil {
+		t.Fatalf("run error: %v", err)
+	}
+	if strings.TrimSpace(stdout) != `["en-US"]` {
+		t.Fatalf("expected --query to apply to migrate output, got %q", stdout)
+	}
+
+	stdout, _, err = runProjectionCommand(t, []string{
+		"--fields", "fastlaneDir,valid",
+		"migrate", "validate", "--fastlane-dir", fastlaneDir, "--output", "table",
+	})
+	if err != nil {
+		t.Fatalf("run error: %v", err)
+	}
+	if !strings.Contains(stdout, "fastlaneDir") || strings.Contains(stdout, "Locales") {
+		t.Fatalf("expected the projected table instead of the migrate table, got %q", stdout)
+	}
+}
@@ -0,0 +1,211 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// initReleaseNotesRepo creates a repository tagged v1.0.0 followed by a few
+// conventional commits.
+func initReleaseNotesRepo(t *testing.T) string {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	dir := t.TempDir()
+	git := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+		cmd.Env = append(os.Environ(),
+			"GIT_AUTHOR_NAME=Test", "GIT_AUTHOR_EMAIL=test@example.com",
+			"GIT_COMMITTER_NAME=Test", "GIT_COMMITTER_EMAIL=test@example.com",
+			"GIT_CONFIG_GLOBAL=/dev/null", "GIT_CONFIG_NOSYSTEM=1",
+		)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %s: %v\n%s", strings.Join(args, " "), err, out)
+		}
+	}
+	git("init", "-q")
+	git("commit", "-q", "--allow-empty", "-m", "chore: initial")
+	git("tag", "v1.0.0")
+	git("commit", "-q", "--allow-empty", "-m", "feat: add photo widgets")
+	git("commit", "-q", "--allow-empty", "-m", "chore: bump dependencies")
+	git("commit", "-q", "--allow-empty", "-m", "fix(sync): lost edits\n\nRelease-Note: Edits made offline are no longer lost.")
+	return dir
+}
+
+func TestReleaseNotesGeneratePrintsNotes(t *testing.T) {
+	repo := initReleaseNotesRepo(t)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"release-notes", "generate", "--repo", repo, "--from", "v1.0.0"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result struct {
+		Commits int `json:"commits"`
+		Locales []struct {
+			Locale string `json:"locale"`
+			Notes  string `json:"notes"`
+		} `json:"locales"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("unmarshal output: %v\n%s", err, stdout)
+	}
+	if result.Commits != 2 || len(result.Locales) != 1 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if want := "• add photo widgets\n• lost edits"; result.Locales[0].Notes != want {
+		t.Fatalf("notes = %q, want %q", result.Locales[0].Notes, want)
+	}
+}
+
+func TestReleaseNotesGenerateUploadsWhatsNewPerLocale(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	repo := initReleaseNotesRepo(t)
+
+	templates := t.TempDir()
+	if err := os.WriteFile(filepath.Join(templates, "en-US.tmpl"), []byte("{{range .Commits}}- {{.Note}}\n{{end}}"), 0o644); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(templates, "de-DE.tmpl"), []byte("{{range .Commits}}* {{.Note}}\n{{end}}"), 0o644); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	written := map[string]string{}
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appStoreVersions/version-1/appStoreVersionLocalizations":
+			return apiTestResponse(`{"data":[{"type":"appStoreVersionLocalizations","id":"loc-en","attributes":{"locale":"en-US"}}]}`), nil
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/appStoreVersionLocalizations/loc-en":
+			written["en-US"] = readWhatsNew(t, req)
+			return apiTestResponse(`{"data":{"type":"appStoreVersionLocalizations","id":"loc-en","attributes":{"locale":"en-US"}}}`), nil
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/appStoreVersionLocalizations":
+			body := readWhatsNew(t, req)
+			if strings.HasPrefix(body, "* ") {
+				written["de-DE"] = body
+				return apiTestResponse(`{"data":{"type":"appStoreVersionLocalizations","id":"loc-de","attributes":{"locale":"de-DE"}}}`), nil
+			}
+			written["fr-FR"] = body
+			return apiTestResponse(`{"data":{"type":"appStoreVersionLocalizations","id":"loc-fr","attributes":{"locale":"fr-FR"}}}`), nil
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"release-notes", "generate",
+			"--repo", repo,
+			"--from", "v1.0.0",
+			"--trailer", "Release-Note",
+			"--template", templates,
+			"--locale", "en-US,de-DE,fr-FR",
+			"--version", "version-1",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	want := map[string]string{
+		"en-US": "- Edits made offline are no longer lost.",
+		"de-DE": "* Edits made offline are no longer lost.",
+		"fr-FR": "- Edits made offline are no longer lost.",
+	}
+	for locale, notes := range want {
+		if written[locale] != notes {
+			t.Fatalf("whatsNew for %s = %q, want %q", locale, written[locale], notes)
+		}
+	}
+
+	var result struct {
+		Target  string `json:"target"`
+		Locales []struct {
+			Locale         string `json:"locale"`
+			Action         string `json:"action"`
+			LocalizationID string `json:"localizationId"`
+		} `json:"locales"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("unmarshal output: %v\n%s", err, stdout)
+	}
+	if result.Target != "version version-1" || len(result.Locales) != 3 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if result.Locales[0].Action != "update" || result.Locales[1].Action != "create" || result.Locales[1].LocalizationID != "loc-de" {
+		t.Fatalf("unexpected locale results: %+v", result.Locales)
+	}
+}
+
+func readWhatsNew(t *testing.T, req *http.Request) string {
+	t.Helper()
+	var payload struct {
+		Data struct {
+			Attributes struct {
+				WhatsNew string `json:"whatsNew"`
+			} `json:"attributes"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+		t.Fatalf("decode request body: %v", err)
+	}
+	return payload.Data.Attributes.WhatsNew
+}
+
+func TestReleaseNotesGenerateValidationErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{name: "missing from", args: []string{"release-notes", "generate"}, wantErr: "--from is required"},
+		{name: "both targets", args: []string{"release-notes", "generate", "--from", "v1", "--version", "v", "--build", "b"}, wantErr: "mutually exclusive"},
+		{name: "dry run without target", args: []string{"release-notes", "generate", "--from", "v1", "--dry-run"}, wantErr: "--dry-run requires --version or --build"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			_, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected stderr to contain %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
@@ -59,7 +59,7 @@ func TestReviewsRatingsOutputErrors(t *testing.T) {
 	}{
 		{
 			name: "reviews ratings unsupported output",
-			args: []string{"reviews", "ratings", "--app", "123", "--output", "yaml"},
+			args: []string{"reviews", "ratings", "--app", "123", "--output", "xml"},
 		},
 		{
 			name: "reviews ratings pretty with table",
@@ -0,0 +1,290 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func usersSyncTransport(t *testing.T, requests *[]string) roundTripFunc {
+	return roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet {
+			body := ""
+			if req.Body != nil {
+				data, _ := io.ReadAll(req.Body)
+				body = string(data)
+			}
+			*requests = append(*requests, req.Method+" "+req.URL.Path+" "+body)
+		}
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/users":
+			return apiTestResponse(`{"data":[` +
+				`{"type":"users","id":"u-owner","attributes":{"username":"owner@example.com","roles":["ACCOUNT_HOLDER"],"allAppsVisible":true}},` +
+				`{"type":"users","id":"u-dev","attributes":{"username":"dev@example.com","firstName":"Dev","lastName":"One","roles":["DEVELOPER"],"allAppsVisible":false}},` +
+				`{"type":"users","id":"u-old","attributes":{"username":"old@example.com","roles":["SALES"],"allAppsVisible":true}}` +
+				`],"links":{}}`), nil
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/users/u-dev/visibleApps":
+			return apiTestResponse(`{"data":[{"type":"apps","id":"app-1","attributes":{"name":"First App"}}],"links":{}}`), nil
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/userInvitations":
+			return apiTestResponse(`{"data":[{"type":"userInvitations","id":"inv-stale","attributes":{"email":"gone@example.com","roles":["SALES"],"allAppsVisible":false}}],"links":{}}`), nil
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/userInvitations/inv-stale/visibleApps":
+			return apiTestResponse(`{"data":[{"type":"apps","id":"app-2","attributes":{"name":"Second App"}}],"links":{}}`), nil
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/userInvitations":
+			return &http.Response{
+				StatusCode: http.StatusCreated,
+				Body:       io.NopCloser(strings.NewReader(`{"data":{"type":"userInvitations","id":"inv-new","attributes":{"email":"new@example.com"}}}`)),
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+			}, nil
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/users/u-dev":
+			return apiTestResponse(`{"data":{"type":"users","id":"u-dev","attributes":{"username":"dev@example.com"}}}`), nil
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/users/u-dev/relationships/visibleApps",
+			req.Method == http.MethodDelete:
+			return &http.Response{StatusCode: http.StatusNoContent, Body: io.NopCloser(strings.NewReader("")), Header: http.Header{}}, nil
+		}
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		return nil, nil
+	})
+}
+
+func writeTeamFile(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "team.yaml")
+	content := `users:
+  - email: dev@example.com
+    roles: [DEVELOPER, MARKETING]
+    apps: ["app-1", "app-2"]
+  - email: new@example.com
+    firstName: New
+    lastName: Person
+    roles: [ADMIN]
+    allApps: true
+`
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+	return path
+}
+
+func TestUsersSyncAppliesPlan(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	var requests []string
+	http.DefaultTransport = usersSyncTransport(t, &requests)
+
+	stdout := runSalesStoreCommand(t, []string{"users", "sync", "--file", writeTeamFile(t), "--confirm"})
+
+	var result struct {
+		DryRun  bool `json:"dryRun"`
+		Changes []struct {
+			Action string `json:"action"`
+			Email  string `json:"email"`
+			ID     string `json:"id"`
+		} `json:"changes"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	var actions []string
+	for _, change := range result.Changes {
+		actions = append(actions, change.Action+" "+change.ID)
+	}
+	want := "revoke-invitation inv-stale,invite inv-new,update-user u-dev,delete-user u-old"
+	if strings.Join(actions, ",") != want {
+		t.Fatalf("unexpected changes %v, want %s", actions, want)
+	}
+
+	if len(requests) != 5 {
+		t.Fatalf("expected 5 write requests, got %d: %v", len(requests), requests)
+	}
+	for i, prefix := range []string{
+		"DELETE /v1/userInvitations/inv-stale",
+		"POST /v1/userInvitations",
+		"PATCH /v1/users/u-dev ",
+		"PATCH /v1/users/u-dev/relationships/visibleApps",
+		"DELETE /v1/users/u-old",
+	} {
+		if !strings.HasPrefix(requests[i], prefix) {
+			t.Fatalf("request %d: expected %q, got %q", i, prefix, requests[i])
+		}
+	}
+	if !strings.Contains(requests[1], `"email":"new@example.com"`) || !strings.Contains(requests[1], `"allAppsVisible":true`) {
+		t.Fatalf("unexpected invitation body: %s", requests[1])
+	}
+	if !strings.Contains(requests[2], `"roles":["DEVELOPER","MARKETING"]`) {
+		t.Fatalf("unexpected update body: %s", requests[2])
+	}
+	if !strings.Contains(requests[3], `"id":"app-2"`) {
+		t.Fatalf("unexpected visible apps body: %s", requests[3])
+	}
+}
+
+func TestUsersSyncDryRunWritesNothing(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	var requests []string
+	http.DefaultTransport = usersSyncTransport(t, &requests)
+
+	stdout := runSalesStoreCommand(t, []string{"users", "sync", "--file", writeTeamFile(t), "--dry-run", "--output", "table"})
+	if len(requests) != 0 {
+		t.Fatalf("expected no write requests, got %v", requests)
+	}
+	for _, want := range []string{"planned", "reported", "unlisted-user", "old@example.com", "roles: DEVELOPER -> DEVELOPER,MARKETING"} {
+		if !strings.Contains(stdout, want) {
+			t.Fatalf("expected output to contain %q, got %s", want, stdout)
+		}
+	}
+	if strings.Contains(stdout, "owner@example.com") {
+		t.Fatalf("expected the account holder to be left alone, got %s", stdout)
+	}
+}
+
+func TestUsersSyncRefusesDeletesAboveMax(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	var requests []string
+	http.DefaultTransport = usersSyncTransport(t, &requests)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	var runErr error
+	captureOutput(t, func() {
+		if err := root.Parse([]string{"users", "sync", "--file", writeTeamFile(t), "--confirm", "--max-deletes", "0"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr == nil || !strings.Contains(runErr.Error(), "plan deletes 1 users, more than --max-deletes 0") {
+		t.Fatalf("expected max-deletes error, got %v", runErr)
+	}
+	if len(requests) != 0 {
+		t.Fatalf("expected no write requests, got %v", requests)
+	}
+}
+
+func TestUsersSyncReportsStatusesWhenApplyFails(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	var requests []string
+	base := usersSyncTransport(t, &requests)
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method == http.MethodPatch && req.URL.Path == "/v1/users/u-dev" {
+			requests = append(requests, req.Method+" "+req.URL.Path)
+			return &http.Response{
+				StatusCode: http.StatusConflict,
+				Body:       io.NopCloser(strings.NewReader(`{"errors":[{"status":"409","code":"ENTITY_ERROR","title":"Role not allowed"}]}`)),
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+			}, nil
+		}
+		return base(req)
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"users", "sync", "--file", writeTeamFile(t), "--confirm"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr == nil || !strings.Contains(runErr.Error(), "update user dev@example.com") {
+		t.Fatalf("expected update error, got %v", runErr)
+	}
+	if len(requests) != 3 {
+		t.Fatalf("expected the sync to stop after the failed update, got %v", requests)
+	}
+
+	var result struct {
+		Changes []struct {
+			Action string `json:"action"`
+			Status string `json:"status"`
+		} `json:"changes"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	var statuses []string
+	for _, change := range result.Changes {
+		statuses = append(statuses, change.Action+" "+change.Status)
+	}
+	want := "revoke-invitation applied,invite applied,update-user failed,delete-user skipped"
+	if strings.Join(statuses, ",") != want {
+		t.Fatalf("unexpected statuses %v, want %s", statuses, want)
+	}
+}
+
+func TestUsersSyncCSVOutput(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	var requests []string
+	http.DefaultTransport = usersSyncTransport(t, &requests)
+
+	stdout := runSalesStoreCommand(t, []string{"users", "sync", "--file", writeTeamFile(t), "--dry-run", "--output", "csv"})
+	if !strings.HasPrefix(stdout, "Action,Email,ID,Details,Status\n") || !strings.Contains(stdout, "unlisted-user,old@example.com,u-old,,reported") {
+		t.Fatalf("unexpected csv output: %s", stdout)
+	}
+}
+
+func TestUsersAuditReportsAccessPerApp(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	var requests []string
+	http.DefaultTransport = usersSyncTransport(t, &requests)
+
+	stdout := runSalesStoreCommand(t, []string{"users", "audit", "--app", "app-2"})
+
+	var result struct {
+		Entries []struct {
+			Email   string `json:"email"`
+			Status  string `json:"status"`
+			AppID   string `json:"appId"`
+			AppName string `json:"appName"`
+		} `json:"entries"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	var got []string
+	for _, entry := range result.Entries {
+		got = append(got, entry.Email+" "+entry.Status+" "+entry.AppID+" "+entry.AppName)
+	}
+	want := "gone@example.com invited app-2 Second App,old@example.com active ALL ,owner@example.com active ALL "
+	if strings.Join(got, ",") != want {
+		t.Fatalf("unexpected audit entries %q, want %q", strings.Join(got, ","), want)
+	}
+}
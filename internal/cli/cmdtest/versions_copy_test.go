@@ -0,0 +1,290 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+type versionCopyOutput struct {
+	SourceVersionID string `json:"sourceVersionId"`
+	TargetVersionID string `json:"targetVersionId"`
+	Version         *struct {
+		ID string `json:"id"`
+	} `json:"version"`
+	Items []struct {
+		Resource string `json:"resource"`
+		Locale   string `json:"locale"`
+		Action   string `json:"action"`
+		TargetID string `json:"targetId"`
+		Message  string `json:"message"`
+	} `json:"items"`
+	Copied  int `json:"copied"`
+	Skipped int `json:"skipped"`
+}
+
+// versionCopyTransport serves a source version "old-1" with two locales,
+// review details and one attachment, and a target whose only localization is
+// en-US with no review details. Writes are recorded as "METHOD path".
+func versionCopyTransport(t *testing.T, writes map[string]string) roundTripFunc {
+	return func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet {
+			body, _ := io.ReadAll(req.Body)
+			writes[req.Method+" "+req.URL.Path] = string(body)
+			if req.Method == http.MethodPost && req.URL.Path == "/v1/appStoreVersions" {
+				return apiTestResponse(`{"data":{"type":"appStoreVersions","id":"new-1","attributes":{"versionString":"2.1.0","platform":"IOS","appStoreState":"PREPARE_FOR_SUBMISSION"}}}`), nil
+			}
+			return apiTestResponse(`{"data":{"type":"resources","id":"created-1","attributes":{}}}`), nil
+		}
+		switch req.URL.Path {
+		case "/v1/apps/app-1/appStoreVersions":
+			return apiTestResponse(`{"data":[` +
+				`{"type":"appStoreVersions","id":"older-1","attributes":{"versionString":"1.9","createdDate":"2026-01-01T00:00:00Z"}},` +
+				`{"type":"appStoreVersions","id":"old-1","attributes":{"versionString":"2.0","createdDate":"2026-06-01T00:00:00Z"}}]}`), nil
+		case "/v1/appStoreVersions/old-1/appStoreVersionLocalizations":
+			return apiTestResponse(`{"data":[` +
+				`{"type":"appStoreVersionLocalizations","id":"old-en","attributes":{"locale":"en-US","description":"Sort photos","keywords":"photo","whatsNew":"Bug fixes"}},` +
+				`{"type":"appStoreVersionLocalizations","id":"old-de","attributes":{"locale":"de-DE","description":"Fotos sortieren","whatsNew":"Fehlerbehebungen"}}]}`), nil
+		case "/v1/appStoreVersions/new-1/appStoreVersionLocalizations":
+			return apiTestResponse(`{"data":[{"type":"appStoreVersionLocalizations","id":"new-en","attributes":{"locale":"en-US"}}]}`), nil
+		case "/v1/appStoreVersions/old-1/appStoreReviewDetail":
+			return apiTestResponse(`{"data":{"type":"appStoreReviewDetails","id":"review-old","attributes":{"contactEmail":"review@example.com","notes":"Use the demo account","demoAccountRequired":true}}}`), nil
+		case "/v1/appStoreVersions/new-1/appStoreReviewDetail":
+			return &http.Response{
+				StatusCode: http.StatusNotFound,
+				Body:       io.NopCloser(strings.NewReader(`{"errors":[{"code":"NOT_FOUND","title":"Not Found"}]}`)),
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+			}, nil
+		case "/v1/appStoreReviewDetails/review-old/appStoreReviewAttachments":
+			return apiTestResponse(`{"data":[{"type":"appStoreReviewAttachments","id":"attach-1","attributes":{"fileName":"walkthrough.pdf"}}]}`), nil
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	}
+}
+
+func TestVersionsCopyCopiesLocalizationsAndReviewDetails(t *testing.T) {
+	setupAuth(t)
+
+	writes := map[string]string{}
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = versionCopyTransport(t, writes)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"versions", "copy", "--from", "old-1", "--to", "new-1", "--exclude-whats-new"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result versionCopyOutput
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if result.SourceVersionID != "old-1" || result.TargetVersionID != "new-1" || result.Copied != 3 || result.Skipped != 1 {
+		t.Fatalf("unexpected summary: %s", stdout)
+	}
+	actions := map[string]string{}
+	for _, item := range result.Items {
+		actions[item.Resource+" "+item.Locale] = item.Action
+	}
+	want := map[string]string{
+		"localization de-DE": "created",
+		"localization en-US": "updated",
+		"review-detail ":     "created",
+		"review-attachment ": "skipped",
+	}
+	for key, action := range want {
+		if actions[key] != action {
+			t.Fatalf("%s action = %q, want %q (items: %v)", key, actions[key], action, actions)
+		}
+	}
+
+	update := writes["PATCH /v1/appStoreVersionLocalizations/new-en"]
+	if !strings.Contains(update, `"description":"Sort photos"`) || strings.Contains(update, "whatsNew") || strings.Contains(update, "locale") {
+		t.Fatalf("unexpected localization update: %s", update)
+	}
+	create := writes["POST /v1/appStoreVersionLocalizations"]
+	if !strings.Contains(create, `"locale":"de-DE"`) || strings.Contains(create, "whatsNew") {
+		t.Fatalf("unexpected localization create: %s", create)
+	}
+	review := writes["POST /v1/appStoreReviewDetails"]
+	if !strings.Contains(review, `"contactEmail":"review@example.com"`) || !strings.Contains(review, `"demoAccountRequired":true`) || !strings.Contains(review, `"id":"new-1"`) {
+		t.Fatalf("unexpected review detail create: %s", review)
+	}
+}
+
+func TestVersionsCreateCopyFromLatest(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_APP_ID", "")
+
+	writes := map[string]string{}
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = versionCopyTransport(t, writes)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"versions", "create", "--app", "app-1", "--version", "2.1.0", "--copy-from", "latest"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result versionCopyOutput
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if result.Version == nil || result.Version.ID != "new-1" || result.SourceVersionID != "old-1" {
+		t.Fatalf("expected copy from old-1 into new-1, got %s", stdout)
+	}
+	if !strings.Contains(writes["PATCH /v1/appStoreVersionLocalizations/new-en"], `"whatsNew":"Bug fixes"`) {
+		t.Fatalf("expected whatsNew to be copied, got %v", writes)
+	}
+}
+
+func TestVersionsCopyValidationErrors(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "")
+
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{args: []string{"versions", "copy", "--to", "new-1"}, want: "Error: --from is required"},
+		{args: []string{"versions", "copy", "--from", "old-1"}, want: "Error: --to is required"},
+		{args: []string{"versions", "copy", "--from", "latest", "--to", "new-1"}, want: "Error: --app is required with --from latest"},
+		{args: []string{"versions", "copy", "--from", "new-1", "--to", "new-1"}, want: "Error: --from and --to must be different"},
+		{args: []string{"versions", "create", "--app", "app-1", "--version", "2.0", "--copy-screenshots"}, want: "require --copy-from"},
+	}
+	for _, test := range tests {
+		root := RootCommand("1.2.3")
+		root.FlagSet.SetOutput(io.Discard)
+
+		var runErr error
+		_, stderr := captureOutput(t, func() {
+			if err := root.Parse(test.args); err != nil {
+				t.Fatalf("parse error: %v", err)
+			}
+			runErr = root.Run(context.Background())
+		})
+		if !errors.Is(runErr, flag.ErrHelp) {
+			t.Fatalf("%v: expected ErrHelp, got %v", test.args, runErr)
+		}
+		if !strings.Contains(stderr, test.want) {
+			t.Fatalf("%v: expected %q, got %q", test.args, test.want, stderr)
+		}
+	}
+}
+
+// TestVersionsCopyReplacesFullScreenshotSet copies one screenshot into a
+// target set that already holds the maximum of ten other screenshots. The
+// mock rejects reservations on a full set, so the copy only succeeds when
+// the stale screenshots are deleted before the upload.
+func TestVersionsCopyReplacesFullScreenshotSet(t *testing.T) {
+	setupAuth(t)
+
+	writes := map[string]string{}
+	target := make([]string, 0, 10)
+	for i := 0; i < 10; i++ {
+		target = append(target, fmt.Sprintf("shot-old-%d", i))
+	}
+	remove := func(id string) {
+		for i, existing := range target {
+			if existing == id {
+				target = append(target[:i], target[i+1:]...)
+				return
+			}
+		}
+		t.Fatalf("deleted unknown screenshot %s", id)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	base := versionCopyTransport(t, writes)
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		path := req.URL.Path
+		switch {
+		case req.Method == http.MethodGet && path == "/v1/appStoreVersionLocalizations/old-en/appScreenshotSets":
+			return apiTestResponse(`{"data":[{"type":"appScreenshotSets","id":"src-set","attributes":{"screenshotDisplayType":"APP_IPHONE_65"}}]}`), nil
+		case req.Method == http.MethodGet && strings.HasSuffix(path, "/appScreenshotSets"):
+			if path == "/v1/appStoreVersionLocalizations/new-en/appScreenshotSets" {
+				return apiTestResponse(`{"data":[{"type":"appScreenshotSets","id":"tgt-set","attributes":{"screenshotDisplayType":"APP_IPHONE_65"}}]}`), nil
+			}
+			return apiTestResponse(`{"data":[]}`), nil
+		case req.Method == http.MethodGet && path == "/v1/appScreenshotSets/src-set/appScreenshots":
+			return apiTestResponse(`{"data":[{"type":"appScreenshots","id":"shot-src","attributes":{"fileName":"home.png","sourceFileChecksum":"abc","imageAsset":{"templateUrl":"https://is1.mzstatic.com/src/{w}x{h}bb.{f}","width":1242,"height":2688},"assetDeliveryState":{"state":"COMPLETE"}}}]}`), nil
+		case req.Method == http.MethodGet && req.URL.Host == "is1.mzstatic.com":
+			return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("new screenshot")), Header: http.Header{}}, nil
+		case req.Method == http.MethodGet && path == "/v1/appScreenshotSets/tgt-set/appScreenshots":
+			items := make([]string, 0, len(target))
+			for i, id := range target {
+				items = append(items, fmt.Sprintf(`{"type":"appScreenshots","id":%q,"attributes":{"fileName":"old-%d.png","sourceFileChecksum":"old%d","assetDeliveryState":{"state":"COMPLETE"}}}`, id, i, i))
+			}
+			return apiTestResponse(`{"data":[` + strings.Join(items, ",") + `]}`), nil
+		case req.Method == http.MethodDelete && strings.HasPrefix(path, "/v1/appScreenshots/"):
+			remove(strings.TrimPrefix(path, "/v1/appScreenshots/"))
+			return &http.Response{StatusCode: http.StatusNoContent, Body: io.NopCloser(strings.NewReader("")), Header: http.Header{}}, nil
+		case req.Method == http.MethodPost && path == "/v1/appScreenshots":
+			if len(target) >= 10 {
+				return &http.Response{
+					StatusCode: http.StatusConflict,
+					Body:       io.NopCloser(strings.NewReader(`{"errors":[{"code":"ENTITY_ERROR","title":"The set already has the maximum number of screenshots"}]}`)),
+					Header:     http.Header{"Content-Type": []string{"application/json"}},
+				}, nil
+			}
+			target = append(target, "shot-new")
+			return &http.Response{
+				StatusCode: http.StatusCreated,
+				Body:       io.NopCloser(strings.NewReader(`{"data":{"type":"appScreenshots","id":"shot-new","attributes":{"fileName":"home.png","uploadOperations":[{"method":"PUT","url":"https://upload.example.com/shot-new","length":14,"offset":0}]}}}`)),
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+			}, nil
+		case req.Method == http.MethodPut && req.URL.Host == "upload.example.com":
+			return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("")), Header: http.Header{}}, nil
+		case path == "/v1/appScreenshots/shot-new":
+			return apiTestResponse(`{"data":{"type":"appScreenshots","id":"shot-new","attributes":{"fileName":"home.png","assetDeliveryState":{"state":"COMPLETE"}}}}`), nil
+		}
+		return base(req)
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"versions", "copy", "--from", "old-1", "--to", "new-1", "--copy-screenshots"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if len(target) != 1 || target[0] != "shot-new" {
+		t.Fatalf("expected the target set to hold only the copied screenshot, got %v", target)
+	}
+	if !strings.Contains(stdout, `"resource":"screenshot-set"`) || !strings.Contains(stdout, `"targetId":"tgt-set"`) {
+		t.Fatalf("unexpected output: %s", stdout)
+	}
+}
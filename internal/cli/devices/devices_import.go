@@ -0,0 +1,732 @@
+package devices
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// deviceSlotLimit is Apple's per-device-class limit per membership year.
+const deviceSlotLimit = 100
+
+// platformDeviceClasses lists the device classes whose slots a platform's
+// devices use.
+var platformDeviceClasses = map[string][]asc.DeviceClass{
+	"IOS":       {asc.DeviceClassIPhone, asc.DeviceClassIPad, asc.DeviceClassAppleWatch},
+	"TV_OS":     {asc.DeviceClassAppleTV},
+	"VISION_OS": {asc.DeviceClassAppleVisionPro},
+	"MAC_OS":    {asc.DeviceClassMac},
+}
+
+var (
+	// Pre-2018 iOS/tvOS/watchOS UDIDs.
+	legacyUDIDPattern = regexp.MustCompile(`^[0-9a-fA-F]{40}$`)
+	// UDIDs of newer iOS, tvOS and visionOS devices and Apple silicon Macs.
+	modernUDIDPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{16}$`)
+	// Hardware UUIDs of Intel Macs.
+	macUUIDPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+)
+
+// deviceImportEntry is a device read from an import file.
+type deviceImportEntry struct {
+	Line     int
+	UDID     string
+	Name     string
+	Platform string
+}
+
+// DevicesImportCommand returns the devices import subcommand.
+func DevicesImportCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+
+	file := fs.String("file", "", "Device list file: Apple's tab-separated upload format or CSV")
+	platform := fs.String("platform", "", "Platform for rows without one: "+strings.Join(devicePlatformList(), ", "))
+	dryRun := fs.Bool("dry-run", false, "Validate and report without registering devices")
+	enableDisabled := fs.Bool("enable-disabled", false, "Enable devices of the file that are registered but disabled")
+	regenerateProfiles := fs.Bool("regenerate-profiles", false, "Regenerate active development profiles to include the new devices")
+	profileTypes := fs.String("profile-type", "", "Profile type(s) to regenerate, comma-separated (default: the development type of the imported platforms)")
+	bundleIDs := fs.String("bundle-id", "", "Only regenerate profiles of these bundle identifiers, comma-separated")
+	profiles := fs.String("profile", "", "Only regenerate these profiles, by ID or name, comma-separated")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "import",
+		ShortUsage: "asc devices import --file PATH [flags]",
+		ShortHelp:  "Register devices in bulk from a file.",
+		LongHelp: `Register devices in bulk from a file.
+
+Accepts Apple's "Device ID / Device Name / Device Platform" tab-separated
+upload format and CSV with udid,name,platform columns. A header row is
+optional; without one, columns are read in that order. Lines starting with #
+are ignored.
+
+UDIDs are validated per platform before anything is registered, devices that
+are already registered are skipped (disabled ones too, unless
+--enable-disabled is set), and the remaining device slots per device class (iPhone, iPad, Apple Watch, Apple
+TV, Apple Vision Pro, Mac) are reported. A dry run reports the slots that
+would remain after the import. Apple assigns the device class on
+registration, so a new iOS device is counted against iPhone, iPad and Apple
+Watch alike. When the new devices could go over the limit of a class, nothing
+is registered.
+
+--regenerate-profiles recreates active development profiles with the same
+name, bundle ID and certificates plus the new devices; expired, invalid and
+Xcode-managed profiles are left alone. The new profile is created before the
+old one is deleted. Narrow the profiles with --profile-type, --bundle-id and
+--profile.
+
+Examples:
+  asc devices import --file devices.txt
+  asc devices import --file devices.csv --platform IOS --dry-run
+  asc devices import --file devices.txt --enable-disabled
+  asc devices import --file devices.txt --regenerate-profiles
+  asc devices import --file devices.txt --regenerate-profiles --profile-type IOS_APP_DEVELOPMENT,IOS_APP_ADHOC
+  asc devices import --file devices.txt --regenerate-profiles --bundle-id com.example.app`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			path := strings.TrimSpace(*file)
+			if path == "" {
+				fmt.Fprintln(os.Stderr, "Error: --file is required")
+				return flag.ErrHelp
+			}
+			defaultPlatform, err := normalizeDevicePlatform(*platform)
+			if err != nil {
+				return fmt.Errorf("devices import: %w", err)
+			}
+			filter := deviceProfileFilter{
+				types:     shared.SplitCSV(strings.ToUpper(*profileTypes)),
+				bundleIDs: shared.SplitCSV(*bundleIDs),
+				profiles:  shared.SplitCSV(*profiles),
+			}
+			if !*regenerateProfiles {
+				visited := map[string]bool{}
+				fs.Visit(func(f *flag.Flag) {
+					visited[f.Name] = true
+				})
+				for _, name := range []string{"profile-type", "bundle-id", "profile"} {
+					if visited[name] {
+						return fmt.Errorf("devices import: --%s requires --regenerate-profiles", name)
+					}
+				}
+			}
+
+			input, err := shared.OpenExistingNoFollow(path)
+			if err != nil {
+				return fmt.Errorf("devices import: %w", err)
+			}
+			entries, err := parseDeviceImportFile(input, defaultPlatform)
+			input.Close()
+			if err != nil {
+				return fmt.Errorf("devices import: %s: %w", path, err)
+			}
+			if len(entries) == 0 {
+				return fmt.Errorf("devices import: %s contains no devices", path)
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("devices import: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			existing, err := fetchAllDevices(requestCtx, client)
+			if err != nil {
+				return fmt.Errorf("devices import: failed to fetch devices: %w", err)
+			}
+			byUDID := make(map[string]asc.Resource[asc.DeviceAttributes], len(existing))
+			registered := make(map[asc.DeviceClass]int)
+			for _, device := range existing {
+				byUDID[strings.ToLower(device.Attributes.UDID)] = device
+				registered[device.Attributes.DeviceClass]++
+			}
+
+			planned := plannedDeviceSlots(entries, byUDID)
+			overLimit := deviceSlotsOverLimit(registered, planned)
+			if overLimit != "" && !*dryRun {
+				return fmt.Errorf("devices import: %s; nothing was registered", overLimit)
+			}
+
+			result := &asc.DeviceImportResult{File: path, DryRun: *dryRun}
+			added := make(map[string][]string)
+			for _, entry := range entries {
+				item := asc.DeviceImportItem{Line: entry.Line, Name: entry.Name, UDID: entry.UDID, Platform: entry.Platform}
+				if device, ok := byUDID[strings.ToLower(entry.UDID)]; ok {
+					item.DeviceID = device.ID
+					switch {
+					case device.Attributes.Status != asc.DeviceStatusDisabled:
+						item.Status = "exists"
+						result.Skipped++
+					case !*enableDisabled:
+						item.Status = "exists (disabled)"
+						result.Skipped++
+					case *dryRun:
+						item.Status = "would-enable"
+					default:
+						if err := enableDevice(requestCtx, client, device.ID); err != nil {
+							item.Status = "failed"
+							item.Error = fmt.Sprintf("enable disabled device: %v", err)
+							result.Failed++
+							break
+						}
+						item.Status = "enabled"
+						added[entry.Platform] = append(added[entry.Platform], device.ID)
+						result.Enabled++
+					}
+					result.Devices = append(result.Devices, item)
+					continue
+				}
+				if *dryRun {
+					item.Status = "would-register"
+					result.Devices = append(result.Devices, item)
+					continue
+				}
+
+				device, err := client.CreateDevice(requestCtx, asc.DeviceCreateAttributes{
+					Name:     entry.Name,
+					UDID:     entry.UDID,
+					Platform: asc.DevicePlatform(entry.Platform),
+				})
+				if err != nil {
+					item.Status = "failed"
+					item.Error = err.Error()
+					result.Failed++
+					result.Devices = append(result.Devices, item)
+					continue
+				}
+				item.Status = "registered"
+				item.DeviceID = device.Data.ID
+				registered[device.Data.Attributes.DeviceClass]++
+				added[entry.Platform] = append(added[entry.Platform], device.Data.ID)
+				result.Registered++
+				result.Devices = append(result.Devices, item)
+			}
+			if *dryRun {
+				result.Capacity = deviceCapacity(entries, registered, planned)
+			} else {
+				result.Capacity = deviceCapacity(entries, registered, nil)
+			}
+
+			if *regenerateProfiles && len(added) > 0 {
+				profiles, err := regenerateDeviceProfiles(requestCtx, client, added, filter)
+				if err != nil {
+					return fmt.Errorf("devices import: %w", err)
+				}
+				result.Profiles = profiles
+			}
+
+			if err := shared.PrintOutput(result, *output, *pretty); err != nil {
+				return err
+			}
+			if overLimit != "" {
+				return shared.NewReportedError(fmt.Errorf("devices import: %s", overLimit))
+			}
+			failedProfiles := 0
+			for _, profile := range result.Profiles {
+				if profile.Status == "failed" {
+					failedProfiles++
+				}
+			}
+			if result.Failed > 0 || failedProfiles > 0 {
+				return shared.NewReportedError(fmt.Errorf("devices import: %d device(s) and %d profile(s) failed", result.Failed, failedProfiles))
+			}
+			return nil
+		},
+	}
+}
+
+// parseDeviceImportFile reads a tab-separated or comma-separated device list.
+// All rows are validated; errors are reported together with line numbers.
+func parseDeviceImportFile(reader io.Reader, defaultPlatform string) ([]deviceImportEntry, error) {
+	buffered := bufio.NewReader(reader)
+	peek, err := buffered.Peek(4096)
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		return nil, err
+	}
+	delimiter := ','
+	for _, line := range strings.Split(string(peek), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		if strings.Contains(line, "\t") {
+			delimiter = '\t'
+		}
+		break
+	}
+
+	csvReader := csv.NewReader(buffered)
+	csvReader.Comma = delimiter
+	csvReader.FieldsPerRecord = -1
+	csvReader.LazyQuotes = true
+	csvReader.Comment = '#'
+
+	udidColumn, nameColumn, platformColumn := 0, 1, 2
+	headerChecked := false
+	seen := make(map[string]int)
+	var entries []deviceImportEntry
+	var problems []string
+	for {
+		record, err := csvReader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := csvReader.FieldPos(0)
+		for i := range record {
+			record[i] = strings.TrimSpace(strings.TrimPrefix(record[i], "\ufeff"))
+		}
+		if len(strings.Join(record, "")) == 0 {
+			continue
+		}
+
+		if !headerChecked {
+			headerChecked = true
+			if columns, ok := deviceImportHeader(record); ok {
+				udidColumn, nameColumn, platformColumn = columns[0], columns[1], columns[2]
+				continue
+			}
+		}
+
+		field := func(index int) string {
+			if index >= 0 && index < len(record) {
+				return record[index]
+			}
+			return ""
+		}
+		entry := deviceImportEntry{Line: line, UDID: field(udidColumn), Name: field(nameColumn)}
+		if err := validateDeviceImportEntry(&entry, field(platformColumn), defaultPlatform); err != nil {
+			problems = append(problems, fmt.Sprintf("line %d: %v", line, err))
+			continue
+		}
+		key := strings.ToLower(entry.UDID)
+		if first, ok := seen[key]; ok {
+			problems = append(problems, fmt.Sprintf("line %d: duplicate UDID %s (also on line %d)", line, entry.UDID, first))
+			continue
+		}
+		seen[key] = line
+		entries = append(entries, entry)
+	}
+	if len(problems) > 0 {
+		return nil, errors.New(strings.Join(problems, "; "))
+	}
+	return entries, nil
+}
+
+// deviceImportHeader returns the udid, name and platform column indexes of a
+// header row; platform is -1 when the file has no platform column.
+func deviceImportHeader(record []string) ([3]int, bool) {
+	columns := [3]int{-1, -1, -1}
+	for i, value := range record {
+		switch strings.ToLower(value) {
+		case "device id", "udid", "device udid":
+			columns[0] = i
+		case "device name", "name":
+			columns[1] = i
+		case "device platform", "platform":
+			columns[2] = i
+		}
+	}
+	if columns[0] < 0 || columns[1] < 0 {
+		return columns, false
+	}
+	return columns, true
+}
+
+func validateDeviceImportEntry(entry *deviceImportEntry, platform, defaultPlatform string) error {
+	if entry.UDID == "" {
+		return fmt.Errorf("missing UDID")
+	}
+	if entry.Name == "" {
+		return fmt.Errorf("missing device name")
+	}
+	if platform == "" {
+		platform = defaultPlatform
+	}
+	if platform == "" {
+		return fmt.Errorf("missing platform (set a platform column or --platform)")
+	}
+	normalized, ok := deviceImportPlatform(platform)
+	if !ok {
+		return fmt.Errorf("unknown platform %q", platform)
+	}
+	entry.Platform = normalized
+
+	valid := modernUDIDPattern.MatchString(entry.UDID)
+	switch normalized {
+	case "MAC_OS":
+		valid = valid || macUUIDPattern.MatchString(entry.UDID)
+	default:
+		valid = valid || legacyUDIDPattern.MatchString(entry.UDID)
+	}
+	if !valid {
+		return fmt.Errorf("invalid %s UDID %q", normalized, entry.UDID)
+	}
+	return nil
+}
+
+// deviceImportPlatform maps platform values of Apple's upload files ("ios",
+// "mac") and API platform names to API platform names.
+func deviceImportPlatform(value string) (string, bool) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "ios", "iphone", "ipad", "watchos":
+		return "IOS", true
+	case "mac", "macos", "mac_os", "osx":
+		return "MAC_OS", true
+	case "tvos", "tv_os", "appletv":
+		return "TV_OS", true
+	case "visionos", "vision_os", "xros":
+		return "VISION_OS", true
+	}
+	return "", false
+}
+
+func fetchAllDevices(ctx context.Context, client *asc.Client) ([]asc.Resource[asc.DeviceAttributes], error) {
+	firstPage, err := client.GetDevices(ctx, asc.WithDevicesLimit(200))
+	if err != nil {
+		return nil, err
+	}
+	paginated, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetDevices(ctx, asc.WithDevicesNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, err
+	}
+	devices, ok := paginated.(*asc.DevicesResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected devices response type %T", paginated)
+	}
+	return devices.Data, nil
+}
+
+func enableDevice(ctx context.Context, client *asc.Client, deviceID string) error {
+	status := asc.DeviceStatusEnabled
+	_, err := client.UpdateDevice(ctx, deviceID, asc.DeviceUpdateAttributes{Status: &status})
+	return err
+}
+
+// plannedDeviceSlots counts the devices of the file that are not registered
+// yet against every device class of their platform; the actual class is only
+// known once Apple registers the device.
+func plannedDeviceSlots(entries []deviceImportEntry, byUDID map[string]asc.Resource[asc.DeviceAttributes]) map[asc.DeviceClass]int {
+	planned := make(map[asc.DeviceClass]int)
+	for _, entry := range entries {
+		if _, ok := byUDID[strings.ToLower(entry.UDID)]; ok {
+			continue
+		}
+		for _, class := range platformDeviceClasses[entry.Platform] {
+			planned[class]++
+		}
+	}
+	return planned
+}
+
+// deviceSlotsOverLimit describes the first device class, in name order, whose
+// planned registrations do not fit its remaining slots, or returns "".
+func deviceSlotsOverLimit(registered, planned map[asc.DeviceClass]int) string {
+	classes := make([]string, 0, len(planned))
+	for class := range planned {
+		classes = append(classes, string(class))
+	}
+	sort.Strings(classes)
+	for _, name := range classes {
+		class := asc.DeviceClass(name)
+		remaining := deviceSlotLimit - registered[class]
+		if remaining < 0 {
+			remaining = 0
+		}
+		if planned[class] > remaining {
+			return fmt.Sprintf("%d new device(s) could use %s slots, but only %d of %d remain", planned[class], class, remaining, deviceSlotLimit)
+		}
+	}
+	return ""
+}
+
+// deviceCapacity reports slot usage for the device classes of the import
+// file's platforms and of any class that already has devices registered,
+// with planned registrations subtracted from the remaining slots.
+func deviceCapacity(entries []deviceImportEntry, registered, planned map[asc.DeviceClass]int) []asc.DeviceCapacity {
+	classes := make(map[asc.DeviceClass]bool)
+	for _, entry := range entries {
+		for _, class := range platformDeviceClasses[entry.Platform] {
+			classes[class] = true
+		}
+	}
+	for class, count := range registered {
+		if class != "" && count > 0 {
+			classes[class] = true
+		}
+	}
+	capacity := make([]asc.DeviceCapacity, 0, len(classes))
+	for class := range classes {
+		remaining := deviceSlotLimit - registered[class] - planned[class]
+		if remaining < 0 {
+			remaining = 0
+		}
+		capacity = append(capacity, asc.DeviceCapacity{
+			DeviceClass: string(class),
+			Registered:  registered[class],
+			Planned:     planned[class],
+			Limit:       deviceSlotLimit,
+			Remaining:   remaining,
+		})
+	}
+	sort.Slice(capacity, func(i, j int) bool { return capacity[i].DeviceClass < capacity[j].DeviceClass })
+	return capacity
+}
+
+// deviceProfileTypes returns the profile types that can include devices of
+// a platform, development types first.
+func deviceProfileTypes(platform string) []string {
+	switch platform {
+	case "IOS", "VISION_OS":
+		return []string{"IOS_APP_DEVELOPMENT", "IOS_APP_ADHOC"}
+	case "TV_OS":
+		return []string{"TVOS_APP_DEVELOPMENT", "TVOS_APP_ADHOC"}
+	case "MAC_OS":
+		return []string{"MAC_APP_DEVELOPMENT", "MAC_CATALYST_APP_DEVELOPMENT"}
+	}
+	return nil
+}
+
+// deviceProfileFilter narrows the profiles --regenerate-profiles touches.
+type deviceProfileFilter struct {
+	types     []string
+	bundleIDs []string
+	profiles  []string
+}
+
+func (f deviceProfileFilter) matchesProfile(profile asc.Resource[asc.ProfileAttributes]) bool {
+	if len(f.profiles) == 0 {
+		return true
+	}
+	for _, value := range f.profiles {
+		if value == profile.ID || value == profile.Attributes.Name {
+			return true
+		}
+	}
+	return false
+}
+
+func (f deviceProfileFilter) matchesBundleID(identifier string) bool {
+	if len(f.bundleIDs) == 0 {
+		return true
+	}
+	for _, value := range f.bundleIDs {
+		if strings.EqualFold(value, identifier) {
+			return true
+		}
+	}
+	return false
+}
+
+// isXcodeManagedProfile reports whether Xcode automatic signing owns a
+// profile; Xcode regenerates those itself.
+func isXcodeManagedProfile(name string) bool {
+	return strings.HasPrefix(name, "XC ") || strings.Contains(name, "Team Provisioning Profile:")
+}
+
+// regenerateDeviceProfiles recreates the active, non-managed profiles of the
+// filter's types (by default the development type of each imported platform)
+// with the new devices added. A failed profile is reported and the others
+// continue.
+func regenerateDeviceProfiles(ctx context.Context, client *asc.Client, added map[string][]string, filter deviceProfileFilter) ([]asc.DeviceImportProfile, error) {
+	newDevices := make(map[string][]string)
+	defaults := make(map[string]bool)
+	for platform, ids := range added {
+		types := deviceProfileTypes(platform)
+		for _, profileType := range types {
+			newDevices[profileType] = append(newDevices[profileType], ids...)
+		}
+		if len(types) > 0 {
+			defaults[types[0]] = true
+		}
+	}
+	types := filter.types
+	if len(types) == 0 {
+		for profileType := range defaults {
+			types = append(types, profileType)
+		}
+		sort.Strings(types)
+	}
+
+	var results []asc.DeviceImportProfile
+	for _, profileType := range types {
+		deviceIDs := newDevices[profileType]
+		if len(deviceIDs) == 0 {
+			continue
+		}
+		profiles, err := fetchProfilesOfType(ctx, client, profileType)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch %s profiles: %w", profileType, err)
+		}
+		for _, profile := range profiles {
+			if profile.Attributes.ProfileState != asc.ProfileStateActive || isXcodeManagedProfile(profile.Attributes.Name) || !filter.matchesProfile(profile) {
+				continue
+			}
+			bundleID, err := client.GetProfileBundleID(ctx, profile.ID)
+			if err != nil {
+				results = append(results, asc.DeviceImportProfile{
+					Name:        profile.Attributes.Name,
+					ProfileType: profile.Attributes.ProfileType,
+					OldID:       profile.ID,
+					Status:      "failed",
+					Error:       fmt.Sprintf("fetch bundle ID: %v", err),
+				})
+				continue
+			}
+			if !filter.matchesBundleID(bundleID.Data.Attributes.Identifier) {
+				continue
+			}
+			results = append(results, regenerateDeviceProfile(ctx, client, profile, bundleID.Data.ID, deviceIDs))
+		}
+	}
+	return results, nil
+}
+
+func fetchProfilesOfType(ctx context.Context, client *asc.Client, profileType string) ([]asc.Resource[asc.ProfileAttributes], error) {
+	var all []asc.Resource[asc.ProfileAttributes]
+	next := ""
+	for {
+		resp, err := client.GetProfiles(ctx, asc.WithProfilesFilterType(profileType), asc.WithProfilesLimit(200), asc.WithProfilesNextURL(next))
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, resp.Data...)
+		if strings.TrimSpace(resp.Links.Next) == "" {
+			return all, nil
+		}
+		next = resp.Links.Next
+	}
+}
+
+// regenerateDeviceProfile creates a copy of a profile with the new devices
+// and then deletes the original. When the name is still taken, the original
+// is deleted first; if the copy then fails, the error carries what is needed
+// to create it by hand.
+func regenerateDeviceProfile(ctx context.Context, client *asc.Client, profile asc.Resource[asc.ProfileAttributes], bundleID string, newDeviceIDs []string) asc.DeviceImportProfile {
+	result := asc.DeviceImportProfile{
+		Name:        profile.Attributes.Name,
+		ProfileType: profile.Attributes.ProfileType,
+		OldID:       profile.ID,
+	}
+	fail := func(err error) asc.DeviceImportProfile {
+		result.Status = "failed"
+		result.Error = err.Error()
+		return result
+	}
+
+	certificateIDs, err := profileCertificateIDs(ctx, client, profile.ID)
+	if err != nil {
+		return fail(fmt.Errorf("fetch certificates: %w", err))
+	}
+	deviceIDs, err := profileDeviceIDs(ctx, client, profile.ID)
+	if err != nil {
+		return fail(fmt.Errorf("fetch devices: %w", err))
+	}
+	seen := make(map[string]bool, len(deviceIDs))
+	for _, id := range deviceIDs {
+		seen[id] = true
+	}
+	for _, id := range newDeviceIDs {
+		if !seen[id] {
+			seen[id] = true
+			deviceIDs = append(deviceIDs, id)
+		}
+	}
+	result.Devices = len(deviceIDs)
+
+	attrs := asc.ProfileCreateAttributes{
+		Name:        profile.Attributes.Name,
+		ProfileType: profile.Attributes.ProfileType,
+	}
+	created, err := client.CreateProfile(ctx, attrs, bundleID, certificateIDs, deviceIDs)
+	if err == nil {
+		result.NewID = created.Data.ID
+		if err := client.DeleteProfile(ctx, profile.ID); err != nil {
+			return fail(fmt.Errorf("created %s but could not delete the original: %w", created.Data.ID, err))
+		}
+		result.Status = "regenerated"
+		return result
+	}
+	if !isConflict(err) {
+		return fail(fmt.Errorf("create: %w", err))
+	}
+
+	if err := client.DeleteProfile(ctx, profile.ID); err != nil {
+		return fail(fmt.Errorf("delete: %w", err))
+	}
+	created, err = client.CreateProfile(ctx, attrs, bundleID, certificateIDs, deviceIDs)
+	if err != nil {
+		return fail(fmt.Errorf("profile was deleted but could not be recreated: %w; recreate it with: asc profiles create --name %q --profile-type %s --bundle %s --certificate %s --device %s",
+			err, attrs.Name, attrs.ProfileType, bundleID, strings.Join(certificateIDs, ","), strings.Join(deviceIDs, ",")))
+	}
+	result.NewID = created.Data.ID
+	result.Status = "regenerated"
+	return result
+}
+
+// isConflict reports whether a request failed with 409 Conflict, which ASC
+// returns with its own error codes in the body.
+func isConflict(err error) bool {
+	var apiErr *asc.APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusConflict {
+		return true
+	}
+	return errors.Is(err, asc.ErrConflict)
+}
+
+func profileCertificateIDs(ctx context.Context, client *asc.Client, profileID string) ([]string, error) {
+	var ids []string
+	next := ""
+	for {
+		resp, err := client.GetProfileCertificates(ctx, profileID, asc.WithProfileCertificatesLimit(200), asc.WithProfileCertificatesNextURL(next))
+		if err != nil {
+			return nil, err
+		}
+		for _, certificate := range resp.Data {
+			ids = append(ids, certificate.ID)
+		}
+		if strings.TrimSpace(resp.Links.Next) == "" {
+			return ids, nil
+		}
+		next = resp.Links.Next
+	}
+}
+
+func profileDeviceIDs(ctx context.Context, client *asc.Client, profileID string) ([]string, error) {
+	var ids []string
+	next := ""
+	for {
+		resp, err := client.GetProfileDevices(ctx, profileID, asc.WithProfileDevicesLimit(200), asc.WithProfileDevicesNextURL(next))
+		if err != nil {
+			return nil, err
+		}
+		for _, device := range resp.Data {
+			ids = append(ids, device.ID)
+		}
+		if strings.TrimSpace(resp.Links.Next) == "" {
+			return ids, nil
+		}
+		next = resp.Links.Next
+	}
+}
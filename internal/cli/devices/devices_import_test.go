@@ -0,0 +1,126 @@
+package devices
+
+import (
+	"context"
+	"flag"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestParseDeviceImportFile_AppleFormat(t *testing.T) {
+	input := "Device ID\tDevice Name\tDevice Platform\n" +
+		"00008030-001A2D3E0E38802E\tQA iPhone 12\tios\n" +
+		"# comment line\n" +
+		"\n" +
+		"A1B2C3D4-E5F6-A7B8-C9D0-E1F2A3B4C5D6\tQA MacBook\tmac\n"
+
+	entries, err := parseDeviceImportFile(strings.NewReader(input), "")
+	if err != nil {
+		t.Fatalf("parseDeviceImportFile() error: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 entries, got %+v", entries)
+	}
+	if entries[0].Name != "QA iPhone 12" || entries[0].Platform != "IOS" || entries[0].Line != 2 {
+		t.Fatalf("unexpected first entry: %+v", entries[0])
+	}
+	if entries[1].Platform != "MAC_OS" || entries[1].Line != 5 {
+		t.Fatalf("unexpected second entry: %+v", entries[1])
+	}
+}
+
+func TestParseDeviceImportFile_CSVWithoutHeader(t *testing.T) {
+	input := "0123456789abcdef0123456789abcdef01234567,Legacy iPad\n" +
+		"00008110-000A1B2C3D4E5F60,\"Vision, Lab\",visionos\n"
+
+	entries, err := parseDeviceImportFile(strings.NewReader(input), "IOS")
+	if err != nil {
+		t.Fatalf("parseDeviceImportFile() error: %v", err)
+	}
+	if len(entries) != 2 || entries[0].Platform != "IOS" || entries[1].Platform != "VISION_OS" || entries[1].Name != "Vision, Lab" {
+		t.Fatalf("unexpected entries: %+v", entries)
+	}
+}
+
+func TestParseDeviceImportFile_ReportsAllProblems(t *testing.T) {
+	input := "udid,name,platform\n" +
+		"00008030-001A2D3E0E38802E,Phone,IOS\n" +
+		"A1B2C3D4-E5F6-A7B8-C9D0-E1F2A3B4C5D6,Mac UUID as iPhone,IOS\n" +
+		"00008030-001a2d3e0e38802e,Duplicate,IOS\n" +
+		"00008030-001A2D3E0E38802F,No Platform,\n" +
+		"00008030-001A2D3E0E388030,Watch,wearos\n"
+
+	_, err := parseDeviceImportFile(strings.NewReader(input), "")
+	if err == nil {
+		t.Fatal("expected validation error")
+	}
+	for _, want := range []string{
+		"line 3: invalid IOS UDID",
+		"line 4: duplicate UDID 00008030-001a2d3e0e38802e (also on line 2)",
+		"line 5: missing platform",
+		"line 6: unknown platform \"wearos\"",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Fatalf("expected error to contain %q, got %v", want, err)
+		}
+	}
+}
+
+func TestPlatformDeviceClassesCoverPlatforms(t *testing.T) {
+	for _, platform := range devicePlatformList() {
+		if len(platformDeviceClasses[platform]) == 0 {
+			t.Fatalf("no device classes for %s", platform)
+		}
+	}
+}
+
+func TestDeviceCapacity_VisionOS(t *testing.T) {
+	entries := []deviceImportEntry{{UDID: "00008110-000A1B2C3D4E5F60", Name: "Vision", Platform: "VISION_OS"}}
+	capacity := deviceCapacity(entries, map[asc.DeviceClass]int{asc.DeviceClassAppleVisionPro: 3}, nil)
+	if len(capacity) != 1 || capacity[0].DeviceClass != "APPLE_VISION_PRO" || capacity[0].Registered != 3 || capacity[0].Remaining != 97 {
+		t.Fatalf("unexpected capacity: %+v", capacity)
+	}
+}
+
+func TestDeviceCapacity_SubtractsPlannedRegistrations(t *testing.T) {
+	entries := []deviceImportEntry{
+		{UDID: "00008030-001A2D3E0E38802E", Name: "New iPhone", Platform: "IOS"},
+		{UDID: "00008030-001A2D3E0E38802F", Name: "Known iPhone", Platform: "IOS"},
+		{UDID: "00008110-000A1B2C3D4E5F60", Name: "Apple TV", Platform: "TV_OS"},
+	}
+	byUDID := map[string]asc.Resource[asc.DeviceAttributes]{"00008030-001a2d3e0e38802f": {ID: "dev-1"}}
+	planned := plannedDeviceSlots(entries, byUDID)
+	want := map[asc.DeviceClass]int{asc.DeviceClassIPhone: 1, asc.DeviceClassIPad: 1, asc.DeviceClassAppleWatch: 1, asc.DeviceClassAppleTV: 1}
+	if !reflect.DeepEqual(planned, want) {
+		t.Fatalf("plannedDeviceSlots() = %v, want %v", planned, want)
+	}
+
+	registered := map[asc.DeviceClass]int{asc.DeviceClassIPhone: 98, asc.DeviceClassAppleTV: 100}
+	capacity := deviceCapacity(entries, registered, planned)
+	if len(capacity) != 4 || capacity[0].DeviceClass != "APPLE_TV" || capacity[0].Remaining != 0 ||
+		capacity[3].DeviceClass != "IPHONE" || capacity[3].Planned != 1 || capacity[3].Remaining != 1 {
+		t.Fatalf("unexpected capacity: %+v", capacity)
+	}
+	if got := deviceSlotsOverLimit(registered, planned); !strings.Contains(got, "APPLE_TV slots, but only 0 of 100 remain") {
+		t.Fatalf("deviceSlotsOverLimit() = %q, want APPLE_TV over the limit", got)
+	}
+	delete(registered, asc.DeviceClassAppleTV)
+	if got := deviceSlotsOverLimit(registered, planned); got != "" {
+		t.Fatalf("deviceSlotsOverLimit() = %q, want none", got)
+	}
+}
+
+func TestDevicesImportCommand_MissingFile(t *testing.T) {
+	cmd := DevicesImportCommand()
+
+	if err := cmd.FlagSet.Parse([]string{}); err != nil {
+		t.Fatalf("failed to parse flags: %v", err)
+	}
+
+	if err := cmd.Exec(context.Background(), []string{}); err != flag.ErrHelp {
+		t.Fatalf("expected flag.ErrHelp when --file is missing, got %v", err)
+	}
+}
@@ -28,12 +28,14 @@ Requires Account Holder, Admin, or Finance role.
 
 Examples:
   asc finance reports --vendor "12345678" --report-type FINANCIAL --region "US" --date "2025-12"
-  asc finance regions --output table`,
+  asc finance regions --output table
+  asc finance summarize --vendor "12345678" --from 2025-10 --to 2025-12 --currency USD --output table`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			FinanceReportsCommand(),
 			FinanceRegionsCommand(),
+			FinanceSummarizeCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -190,7 +192,7 @@ Examples:
 func FinanceRegionsCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("regions", flag.ExitOnError)
 
-	outputFormat := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	outputFormat := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -210,7 +212,8 @@ Source: https://developer.apple.com/help/app-store-connect/reference/financial-r
 
 Examples:
   asc finance regions
-  asc finance regions --output table`,
+  asc finance regions --output table
+  asc finance summarize --vendor "12345678" --from 2025-10 --to 2025-12 --currency USD --output table`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -0,0 +1,399 @@
+package finance
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// financeGroupKeys are the accepted --group-by keys.
+var financeGroupKeys = []string{"month", "sku", "title", "territory", "currency", "product-type"}
+
+// FinanceSummarizeCommand aggregates finance reports.
+func FinanceSummarizeCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("summarize", flag.ExitOnError)
+
+	vendor := fs.String("vendor", "", "Vendor number (or ASC_VENDOR_NUMBER env)")
+	reportType := fs.String("report-type", string(asc.FinanceReportTypeFinancial), "Report type: FINANCIAL or FINANCE_DETAIL")
+	regions := fs.String("regions", "ZZ", "Region codes, comma-separated (FINANCE_DETAIL always uses Z1)")
+	from := fs.String("from", "", "First report month (YYYY-MM, Apple fiscal month)")
+	to := fs.String("to", "", "Last report month (YYYY-MM, default: --from)")
+	files := fs.String("file", "", "Summarize local report files (.tsv or .tsv.gz), comma-separated, instead of downloading")
+	groupBy := fs.String("group-by", "sku,territory,currency", "Group by: "+strings.Join(financeGroupKeys, ", "))
+	currency := fs.String("currency", "", "Convert proceeds to this currency using the reports' exchange rates (e.g. USD)")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "summarize",
+		ShortUsage: "asc finance summarize (--from YYYY-MM [--to YYYY-MM] | --file PATHS) [flags]",
+		ShortHelp:  "Aggregate proceeds and units across finance reports.",
+		LongHelp: `Aggregate proceeds and units across finance reports.
+
+Downloads the reports for every month in --from..--to and every region in
+--regions (or reads --file), parses the sales and return lines and sums units
+and proceeds (extended partner share) per group. Regions without sales in a
+month have no report and are listed under "missing".
+
+Proceeds are in the currency Apple paid them in, so totals across currencies
+need --currency. Conversion uses the exchange rates in each month's reports
+(a --file report whose month cannot be read from its lines uses only its own);
+the consolidated ZZ report and the FINANCE_DETAIL report include them, the
+single-region reports do not. ZZ already covers every region, so it cannot be
+combined with other region codes.
+
+Examples:
+  asc finance summarize --vendor "12345678" --from 2025-10 --to 2025-12 --output table
+  asc finance summarize --vendor "12345678" --from 2025-10 --to 2025-12 --group-by sku --currency USD --output markdown
+  asc finance summarize --vendor "12345678" --report-type FINANCE_DETAIL --from 2025-12 --group-by month,territory --currency EUR --output csv
+  asc finance summarize --file "reports/2025-12.tsv.gz" --group-by sku,currency`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			groups, err := parseFinanceGroupBy(*groupBy)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				return flag.ErrHelp
+			}
+			targetCurrency := strings.ToUpper(strings.TrimSpace(*currency))
+			if targetCurrency == "" && !containsString(groups, "currency") {
+				fmt.Fprintln(os.Stderr, "Error: --currency is required when --group-by does not include currency")
+				return flag.ErrHelp
+			}
+
+			result := &asc.FinanceSummaryResult{GroupBy: groups, Currency: targetCurrency}
+			var reports []monthReport
+			if paths := shared.SplitCSV(*files); len(paths) > 0 {
+				if strings.TrimSpace(*from) != "" || strings.TrimSpace(*to) != "" {
+					fmt.Fprintln(os.Stderr, "Error: --file cannot be combined with --from or --to")
+					return flag.ErrHelp
+				}
+				result.Files = paths
+				reports, err = readFinanceReportFiles(paths)
+				if err != nil {
+					return fmt.Errorf("finance summarize: %w", err)
+				}
+			} else {
+				vendorNumber := shared.ResolveVendorNumber(*vendor)
+				if vendorNumber == "" {
+					fmt.Fprintln(os.Stderr, "Error: --vendor is required (or set ASC_VENDOR_NUMBER)")
+					return flag.ErrHelp
+				}
+				if strings.TrimSpace(*from) == "" {
+					fmt.Fprintln(os.Stderr, "Error: --from or --file is required")
+					return flag.ErrHelp
+				}
+				normalizedType, err := normalizeFinanceReportType(*reportType)
+				if err != nil {
+					return fmt.Errorf("finance summarize: %w", err)
+				}
+				months, err := financeMonthRange(*from, *to)
+				if err != nil {
+					return fmt.Errorf("finance summarize: %w", err)
+				}
+				regionCodes, err := normalizeFinanceSummaryRegions(normalizedType, *regions)
+				if err != nil {
+					return fmt.Errorf("finance summarize: %w", err)
+				}
+
+				client, err := shared.GetASCClient()
+				if err != nil {
+					return fmt.Errorf("finance summarize: %w", err)
+				}
+				result.VendorNumber = vendorNumber
+				result.ReportType = string(normalizedType)
+				result.From, result.To = months[0], months[len(months)-1]
+				result.Regions = regionCodes
+				for _, month := range months {
+					for _, region := range regionCodes {
+						report, err := downloadFinanceReport(ctx, client, asc.FinanceReportParams{
+							VendorNumber: vendorNumber,
+							ReportType:   normalizedType,
+							RegionCode:   region,
+							ReportDate:   month,
+						})
+						if asc.IsNotFound(err) {
+							result.Missing = append(result.Missing, month+" "+region)
+							continue
+						}
+						if err != nil {
+							return fmt.Errorf("finance summarize: %s %s: %w", month, region, err)
+						}
+						reports = append(reports, monthReport{month: month, report: report})
+					}
+				}
+			}
+
+			result.Reports = len(reports)
+			if err := summarizeFinanceReports(result, reports); err != nil {
+				return fmt.Errorf("finance summarize: %w", err)
+			}
+			return shared.PrintOutput(result, *output, *pretty)
+		},
+	}
+}
+
+// monthReport is a parsed report and the fiscal month it covers. file is set
+// for local reports.
+type monthReport struct {
+	month  string
+	file   string
+	report *asc.FinanceReport
+}
+
+// ratesKey groups reports whose exchange rates may be shared: reports of the
+// same month, or only the report itself when its month is unknown.
+func (r monthReport) ratesKey() string {
+	if r.month == "" {
+		return "file:" + r.file
+	}
+	return r.month
+}
+
+func parseFinanceGroupBy(value string) ([]string, error) {
+	groups := shared.SplitCSV(strings.ToLower(value))
+	if len(groups) == 0 {
+		return nil, fmt.Errorf("--group-by is required")
+	}
+	seen := make(map[string]bool, len(groups))
+	for _, group := range groups {
+		if !containsString(financeGroupKeys, group) {
+			return nil, fmt.Errorf("--group-by must be one of: %s", strings.Join(financeGroupKeys, ", "))
+		}
+		if seen[group] {
+			return nil, fmt.Errorf("--group-by lists %s twice", group)
+		}
+		seen[group] = true
+	}
+	return groups, nil
+}
+
+func normalizeFinanceSummaryRegions(reportType asc.FinanceReportType, value string) ([]string, error) {
+	if reportType == asc.FinanceReportTypeFinanceDetail {
+		return []string{"Z1"}, nil
+	}
+	var regions []string
+	for _, region := range shared.SplitCSV(value) {
+		code, err := normalizeFinanceReportRegion(reportType, region)
+		if err != nil {
+			return nil, err
+		}
+		if !containsString(regions, code) {
+			regions = append(regions, code)
+		}
+	}
+	if len(regions) == 0 {
+		return nil, fmt.Errorf("--regions is required")
+	}
+	// ZZ consolidates every region, so combining it with single regions
+	// would count their proceeds twice.
+	if len(regions) > 1 && containsString(regions, "ZZ") {
+		return nil, fmt.Errorf("--regions ZZ already includes every region and cannot be combined with others")
+	}
+	return regions, nil
+}
+
+// financeMonthRange returns every YYYY-MM month from start to end inclusive.
+func financeMonthRange(start, end string) ([]string, error) {
+	first, err := normalizeFinanceReportDate(start)
+	if err != nil {
+		return nil, fmt.Errorf("--from must be in YYYY-MM format")
+	}
+	last := first
+	if strings.TrimSpace(end) != "" {
+		if last, err = normalizeFinanceReportDate(end); err != nil {
+			return nil, fmt.Errorf("--to must be in YYYY-MM format")
+		}
+	}
+	current, _ := time.Parse("2006-01", first)
+	stop, _ := time.Parse("2006-01", last)
+	if stop.Before(current) {
+		return nil, fmt.Errorf("--to must not be before --from")
+	}
+	var months []string
+	for !current.After(stop) {
+		months = append(months, current.Format("2006-01"))
+		current = current.AddDate(0, 1, 0)
+	}
+	return months, nil
+}
+
+func downloadFinanceReport(ctx context.Context, client *asc.Client, params asc.FinanceReportParams) (*asc.FinanceReport, error) {
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+
+	download, err := client.DownloadFinanceReport(requestCtx, params)
+	if err != nil {
+		return nil, err
+	}
+	defer download.Body.Close()
+	return asc.ParseFinanceReport(download.Body)
+}
+
+func readFinanceReportFiles(paths []string) ([]monthReport, error) {
+	reports := make([]monthReport, 0, len(paths))
+	for _, path := range paths {
+		file, err := shared.OpenExistingNoFollow(path)
+		if err != nil {
+			return nil, err
+		}
+		report, err := asc.ParseFinanceReport(file)
+		file.Close()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		reports = append(reports, monthReport{month: financeReportMonth(report), file: path, report: report})
+	}
+	return reports, nil
+}
+
+// financeReportMonth derives the month of a local report from its line dates.
+func financeReportMonth(report *asc.FinanceReport) string {
+	for _, line := range report.Lines {
+		for _, value := range []string{line.EndDate, line.SettlementDate, line.TransactionDate} {
+			if parsed, err := time.Parse("01/02/2006", value); err == nil {
+				return parsed.Format("2006-01")
+			}
+		}
+	}
+	return ""
+}
+
+// financeRates holds the exchange rates of one month, keyed by currency.
+type financeRates map[string]asc.FinanceExchangeRate
+
+// convert converts amount from currency into target. Rates convert into the
+// bank account currency, so other targets go through it.
+func (r financeRates) convert(amount float64, currency, target string) (float64, bool) {
+	if currency == target {
+		return amount, true
+	}
+	source, ok := r[currency]
+	if !ok {
+		return 0, false
+	}
+	if source.BankCurrency == target {
+		return amount * source.Rate, true
+	}
+	destination, ok := r[target]
+	if !ok || destination.BankCurrency != source.BankCurrency {
+		return 0, false
+	}
+	return amount * source.Rate / destination.Rate, true
+}
+
+func summarizeFinanceReports(result *asc.FinanceSummaryResult, reports []monthReport) error {
+	ratesByKey := make(map[string]financeRates)
+	for _, item := range reports {
+		rates := ratesByKey[item.ratesKey()]
+		if rates == nil {
+			rates = make(financeRates)
+			ratesByKey[item.ratesKey()] = rates
+		}
+		for _, rate := range item.report.ExchangeRates {
+			rates[rate.Currency] = rate
+		}
+	}
+
+	groupByCurrency := containsString(result.GroupBy, "currency")
+	rowsByKey := make(map[string]*asc.FinanceSummaryRow)
+	var keys []string
+	var total float64
+	for _, item := range reports {
+		for _, line := range item.report.Lines {
+			row := asc.FinanceSummaryRow{}
+			for _, group := range result.GroupBy {
+				switch group {
+				case "month":
+					row.Month = item.month
+				case "sku":
+					row.SKU = line.SKU
+				case "title":
+					row.Title = line.Title
+				case "territory":
+					row.Territory = line.CountryOfSale
+				case "currency":
+					row.Currency = line.PartnerShareCurrency
+				case "product-type":
+					row.ProductType = line.ProductTypeIdentifier
+				}
+			}
+			key := strings.Join([]string{row.Month, row.SKU, row.Title, row.Territory, row.Currency, row.ProductType}, "\x1f")
+			existing, ok := rowsByKey[key]
+			if !ok {
+				existing = &row
+				if groupByCurrency {
+					existing.Proceeds = new(float64)
+				}
+				if result.Currency != "" {
+					existing.ConvertedProceeds = new(float64)
+				}
+				rowsByKey[key] = existing
+				keys = append(keys, key)
+			}
+			existing.Units += line.Quantity
+			result.Units += line.Quantity
+			if existing.Proceeds != nil {
+				*existing.Proceeds += line.ExtendedPartnerShare
+			}
+			if result.Currency != "" {
+				converted, ok := ratesByKey[item.ratesKey()].convert(line.ExtendedPartnerShare, line.PartnerShareCurrency, result.Currency)
+				if !ok {
+					return fmt.Errorf("no exchange rate from %s to %s for %s (use region ZZ or FINANCE_DETAIL, whose reports include rates)", line.PartnerShareCurrency, result.Currency, item.label())
+				}
+				*existing.ConvertedProceeds += converted
+				total += converted
+			}
+		}
+	}
+
+	sort.Strings(keys)
+	result.Rows = make([]asc.FinanceSummaryRow, 0, len(keys))
+	for _, key := range keys {
+		row := rowsByKey[key]
+		roundFinanceAmount(row.Proceeds)
+		roundFinanceAmount(row.ConvertedProceeds)
+		result.Rows = append(result.Rows, *row)
+	}
+	if result.Currency != "" {
+		result.ConvertedProceeds = &total
+		roundFinanceAmount(result.ConvertedProceeds)
+	}
+	return nil
+}
+
+func (r monthReport) label() string {
+	switch {
+	case r.month != "":
+		return r.month
+	case r.file != "":
+		return r.file
+	default:
+		return "the report"
+	}
+}
+
+func roundFinanceAmount(value *float64) {
+	if value != nil {
+		*value = math.Round(*value*100) / 100
+	}
+}
+
+func containsString(values []string, target string) bool {
+	for _, value := range values {
+		if value == target {
+			return true
+		}
+	}
+	return false
+}
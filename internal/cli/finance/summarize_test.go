@@ -0,0 +1,142 @@
+package finance
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestFinanceMonthRange(t *testing.T) {
+	months, err := financeMonthRange("2025-11", "2026-02")
+	if err != nil {
+		t.Fatalf("financeMonthRange() error: %v", err)
+	}
+	if want := []string{"2025-11", "2025-12", "2026-01", "2026-02"}; !reflect.DeepEqual(months, want) {
+		t.Fatalf("expected %v, got %v", want, months)
+	}
+	if months, _ := financeMonthRange("2025-11", ""); !reflect.DeepEqual(months, []string{"2025-11"}) {
+		t.Fatalf("expected single month, got %v", months)
+	}
+	if _, err := financeMonthRange("2025-11", "2025-10"); err == nil {
+		t.Fatal("expected error when --to is before --from")
+	}
+}
+
+func TestParseFinanceGroupBy(t *testing.T) {
+	groups, err := parseFinanceGroupBy("SKU, territory")
+	if err != nil || !reflect.DeepEqual(groups, []string{"sku", "territory"}) {
+		t.Fatalf("unexpected groups %v (err %v)", groups, err)
+	}
+	for _, value := range []string{"", "store", "sku,sku"} {
+		if _, err := parseFinanceGroupBy(value); err == nil {
+			t.Fatalf("expected error for %q", value)
+		}
+	}
+}
+
+func TestNormalizeFinanceSummaryRegions(t *testing.T) {
+	regions, err := normalizeFinanceSummaryRegions(asc.FinanceReportTypeFinancial, "US, EU,US")
+	if err != nil || !reflect.DeepEqual(regions, []string{"US", "EU"}) {
+		t.Fatalf("unexpected regions %v (err %v)", regions, err)
+	}
+	if _, err := normalizeFinanceSummaryRegions(asc.FinanceReportTypeFinancial, "ZZ,US"); err == nil {
+		t.Fatal("expected error combining ZZ with a single region")
+	}
+	if regions, err := normalizeFinanceSummaryRegions(asc.FinanceReportTypeFinancial, "ZZ"); err != nil || !reflect.DeepEqual(regions, []string{"ZZ"}) {
+		t.Fatalf("unexpected regions %v (err %v)", regions, err)
+	}
+}
+
+func TestFinanceRatesConvert(t *testing.T) {
+	rates := financeRates{
+		"USD": {Currency: "USD", Rate: 1, BankCurrency: "USD"},
+		"EUR": {Currency: "EUR", Rate: 1.1, BankCurrency: "USD"},
+		"JPY": {Currency: "JPY", Rate: 0.0067, BankCurrency: "USD"},
+	}
+	tests := []struct {
+		currency string
+		target   string
+		amount   float64
+		want     float64
+	}{
+		{currency: "EUR", target: "EUR", amount: 10, want: 10},
+		{currency: "EUR", target: "USD", amount: 10, want: 11},
+		{currency: "JPY", target: "EUR", amount: 1000, want: 6.7 / 1.1},
+	}
+	for _, test := range tests {
+		got, ok := rates.convert(test.amount, test.currency, test.target)
+		if !ok || got-test.want > 1e-9 || test.want-got > 1e-9 {
+			t.Fatalf("convert(%v %s -> %s) = %v, %v; want %v", test.amount, test.currency, test.target, got, ok, test.want)
+		}
+	}
+	if _, ok := rates.convert(1, "GBP", "USD"); ok {
+		t.Fatal("expected conversion without a rate to fail")
+	}
+}
+
+func TestSummarizeFinanceReports(t *testing.T) {
+	reports := []monthReport{{
+		month: "2025-10",
+		report: &asc.FinanceReport{
+			Lines: []asc.FinanceReportLine{
+				{SKU: "pro", CountryOfSale: "US", Quantity: 3, ExtendedPartnerShare: 20.97, PartnerShareCurrency: "USD"},
+				{SKU: "pro", CountryOfSale: "DE", Quantity: 2, ExtendedPartnerShare: 11, PartnerShareCurrency: "EUR"},
+				{SKU: "lite", CountryOfSale: "US", Quantity: 1, ExtendedPartnerShare: 0.7, PartnerShareCurrency: "USD"},
+			},
+			ExchangeRates: []asc.FinanceExchangeRate{
+				{Currency: "USD", Rate: 1, BankCurrency: "USD"},
+				{Currency: "EUR", Rate: 1.1, BankCurrency: "USD"},
+			},
+		},
+	}}
+
+	result := &asc.FinanceSummaryResult{GroupBy: []string{"sku"}, Currency: "USD"}
+	if err := summarizeFinanceReports(result, reports); err != nil {
+		t.Fatalf("summarizeFinanceReports() error: %v", err)
+	}
+	if len(result.Rows) != 2 || result.Units != 6 {
+		t.Fatalf("unexpected summary: %+v", result)
+	}
+	pro := result.Rows[1]
+	if pro.SKU != "pro" || pro.Units != 5 || pro.Proceeds != nil || *pro.ConvertedProceeds != 33.07 {
+		t.Fatalf("unexpected pro row: %+v", pro)
+	}
+	if *result.ConvertedProceeds != 33.77 {
+		t.Fatalf("expected total 33.77, got %v", *result.ConvertedProceeds)
+	}
+
+	byCurrency := &asc.FinanceSummaryResult{GroupBy: []string{"currency"}}
+	if err := summarizeFinanceReports(byCurrency, reports); err != nil {
+		t.Fatalf("summarizeFinanceReports() error: %v", err)
+	}
+	if len(byCurrency.Rows) != 2 || byCurrency.Rows[0].Currency != "EUR" || *byCurrency.Rows[0].Proceeds != 11 || byCurrency.Rows[0].ConvertedProceeds != nil {
+		t.Fatalf("unexpected currency summary: %+v", byCurrency.Rows)
+	}
+
+	missingRate := &asc.FinanceSummaryResult{GroupBy: []string{"sku"}, Currency: "GBP"}
+	if err := summarizeFinanceReports(missingRate, reports); err == nil {
+		t.Fatal("expected error without a GBP exchange rate")
+	}
+}
+
+func TestSummarizeFinanceReports_KeepsRatesOfUndatedFilesApart(t *testing.T) {
+	report := func(rate float64) *asc.FinanceReport {
+		return &asc.FinanceReport{
+			Lines:         []asc.FinanceReportLine{{SKU: "pro", Quantity: 1, ExtendedPartnerShare: 10, PartnerShareCurrency: "EUR"}},
+			ExchangeRates: []asc.FinanceExchangeRate{{Currency: "EUR", Rate: rate, BankCurrency: "USD"}},
+		}
+	}
+	reports := []monthReport{
+		{file: "october.tsv", report: report(1.1)},
+		{file: "november.tsv", report: report(1.2)},
+	}
+
+	result := &asc.FinanceSummaryResult{GroupBy: []string{"sku"}, Currency: "USD"}
+	if err := summarizeFinanceReports(result, reports); err != nil {
+		t.Fatalf("summarizeFinanceReports() error: %v", err)
+	}
+	if *result.ConvertedProceeds != 23 {
+		t.Fatalf("expected each file converted with its own rate (11 + 12), got %v", *result.ConvertedProceeds)
+	}
+}
@@ -73,7 +73,7 @@ func IAPPricesCommand() *ffcli.Command {
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
 	iapID := fs.String("iap-id", "", "In-app purchase ID")
 	territory := fs.String("territory", "", "Territory filter (e.g., USA)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -980,6 +980,8 @@ func printIAPPricesResult(result *iapPricesResult, format string, pretty bool) e
 			return fmt.Errorf("--pretty is only valid with JSON output")
 		}
 		return printIAPPricesMarkdown(result)
+	case "yaml", "yml", "ndjson":
+		return shared.PrintOutput(result, format, pretty)
 	default:
 		return fmt.Errorf("unsupported format: %s", format)
 	}
@@ -39,7 +39,7 @@ func IAPPromotedPurchaseGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("promoted-purchase get", flag.ExitOnError)
 
 	iapID := fs.String("id", "", "In-app purchase ID")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
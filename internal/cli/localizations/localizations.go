@@ -59,7 +59,7 @@ func LocalizationsListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -203,24 +203,38 @@ func LocalizationsDownloadCommand() *ffcli.Command {
 	appInfoID := fs.String("app-info", "", "App Info ID (optional override)")
 	locType := fs.String("type", shared.LocalizationTypeVersion, "Localization type: version (default) or app-info")
 	locale := fs.String("locale", "", "Filter by locale(s), comma-separated")
-	path := fs.String("path", "localizations", "Output path (directory or .strings file)")
+	path := fs.String("path", "localizations", "Output path (directory or file)")
+	format := fs.String("format", "", "File format: strings, xliff, json, csv, xcstrings (default: inferred from --path extension, else strings)")
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
 		Name:       "download",
 		ShortUsage: "asc localizations download [flags]",
-		ShortHelp:  "Download localizations to .strings files.",
-		LongHelp: `Download localizations to .strings files.
+		ShortHelp:  "Download localizations to .strings, XLIFF, JSON, CSV or String Catalog files.",
+		LongHelp: `Download localizations to .strings, XLIFF, JSON, CSV or String Catalog files.
+
+Formats:
+  strings    One <locale>.strings file per locale (default)
+  xliff      One <locale>.xliff file per locale (XLIFF 1.2); source text comes
+             from en-US when present, else the first locale
+  json       One file: {"<locale>": {"<key>": "<value>"}}
+  csv        One file: a key column followed by one column per locale
+  xcstrings  One Xcode String Catalog with every locale
+
+Single-file formats write <path>/localizations.<ext> unless --path ends with
+the format's extension.
 
 Examples:
   asc localizations download --version "VERSION_ID" --path "./localizations"
   asc localizations download --app "APP_ID" --type app-info --path "./localizations"
   asc localizations download --version "VERSION_ID" --locale "en-US" --path "en-US.strings"
-  asc localizations download --version "VERSION_ID" --paginate --path "./localizations"`,
+  asc localizations download --version "VERSION_ID" --paginate --path "./localizations"
+  asc localizations download --version "VERSION_ID" --format xliff --path "./xliff"
+  asc localizations download --version "VERSION_ID" --path "./metadata.csv"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -230,6 +244,10 @@ Examples:
 			if err := shared.ValidateNextURL(*next); err != nil {
 				return fmt.Errorf("localizations download: %w", err)
 			}
+			fileFormat, err := shared.NormalizeLocalizationFormat(*format, *path)
+			if err != nil {
+				return fmt.Errorf("localizations download: %w", err)
+			}
 
 			normalizedType, err := shared.NormalizeLocalizationType(*locType)
 			if err != nil {
@@ -280,7 +298,7 @@ Examples:
 						return fmt.Errorf("localizations download: unexpected pagination response type")
 					}
 
-					files, err := shared.WriteVersionLocalizationStrings(*path, aggregated.Data)
+					files, err := shared.WriteVersionLocalizationFiles(*path, fileFormat, aggregated.Data)
 					if err != nil {
 						return fmt.Errorf("localizations download: %w", err)
 					}
@@ -300,7 +318,7 @@ Examples:
 					return fmt.Errorf("localizations download: failed to fetch: %w", err)
 				}
 
-				files, err := shared.WriteVersionLocalizationStrings(*path, resp.Data)
+				files, err := shared.WriteVersionLocalizationFiles(*path, fileFormat, resp.Data)
 				if err != nil {
 					return fmt.Errorf("localizations download: %w", err)
 				}
@@ -360,7 +378,7 @@ Examples:
 						return fmt.Errorf("localizations download: unexpected pagination response type")
 					}
 
-					files, err := shared.WriteAppInfoLocalizationStrings(*path, aggregated.Data)
+					files, err := shared.WriteAppInfoLocalizationFiles(*path, fileFormat, aggregated.Data)
 					if err != nil {
 						return fmt.Errorf("localizations download: %w", err)
 					}
@@ -381,7 +399,7 @@ Examples:
 					return fmt.Errorf("localizations download: failed to fetch: %w", err)
 				}
 
-				files, err := shared.WriteAppInfoLocalizationStrings(*path, resp.Data)
+				files, err := shared.WriteAppInfoLocalizationFiles(*path, fileFormat, resp.Data)
 				if err != nil {
 					return fmt.Errorf("localizations download: %w", err)
 				}
@@ -411,22 +429,30 @@ func LocalizationsUploadCommand() *ffcli.Command {
 	appInfoID := fs.String("app-info", "", "App Info ID (optional override)")
 	locType := fs.String("type", shared.LocalizationTypeVersion, "Localization type: version (default) or app-info")
 	locale := fs.String("locale", "", "Filter by locale(s), comma-separated")
-	path := fs.String("path", "", "Input path (directory or .strings file)")
+	path := fs.String("path", "", "Input path (directory or file)")
+	format := fs.String("format", "", "File format: strings, xliff, json, csv, xcstrings (default: inferred from --path extension, else strings)")
 	dryRun := fs.Bool("dry-run", false, "Validate file without uploading")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
 		Name:       "upload",
 		ShortUsage: "asc localizations upload [flags]",
-		ShortHelp:  "Upload localizations from .strings files.",
-		LongHelp: `Upload localizations from .strings files.
+		ShortHelp:  "Upload localizations from .strings, XLIFF, JSON, CSV or String Catalog files.",
+		LongHelp: `Upload localizations from .strings, XLIFF, JSON, CSV or String Catalog files.
+
+See "asc localizations download --help" for the layout of each format. XLIFF
+locales come from each file's target-language and units without a target are
+skipped; empty CSV cells are skipped. For a single .strings file, --locale
+names its locale; otherwise --locale filters the locales read.
 
 Examples:
   asc localizations upload --version "VERSION_ID" --path "./localizations"
   asc localizations upload --app "APP_ID" --type app-info --path "./localizations"
   asc localizations upload --version "VERSION_ID" --locale "en-US" --path "en-US.strings"
-  asc localizations upload --version "VERSION_ID" --path "./localizations" --dry-run`,
+  asc localizations upload --version "VERSION_ID" --path "./localizations" --dry-run
+  asc localizations upload --version "VERSION_ID" --format xliff --path "./xliff"
+  asc localizations upload --version "VERSION_ID" --path "./metadata.csv" --locale "de-DE,fr-FR"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -439,6 +465,10 @@ Examples:
 			if err != nil {
 				return fmt.Errorf("localizations upload: %w", err)
 			}
+			fileFormat, err := shared.NormalizeLocalizationFormat(*format, *path)
+			if err != nil {
+				return fmt.Errorf("localizations upload: %w", err)
+			}
 
 			locales := shared.SplitCSV(*locale)
 
@@ -457,7 +487,7 @@ Examples:
 				requestCtx, cancel := shared.ContextWithTimeout(ctx)
 				defer cancel()
 
-				valuesByLocale, err := shared.ReadLocalizationStrings(*path, locales)
+				valuesByLocale, err := shared.ReadLocalizationFiles(*path, fileFormat, locales)
 				if err != nil {
 					return fmt.Errorf("localizations upload: %w", err)
 				}
@@ -495,7 +525,7 @@ Examples:
 					return fmt.Errorf("localizations upload: %w", err)
 				}
 
-				valuesByLocale, err := shared.ReadLocalizationStrings(*path, locales)
+				valuesByLocale, err := shared.ReadLocalizationFiles(*path, fileFormat, locales)
 				if err != nil {
 					return fmt.Errorf("localizations upload: %w", err)
 				}
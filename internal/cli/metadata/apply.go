@@ -0,0 +1,133 @@
+package metadata
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// MetadataApplyCommand returns the metadata apply subcommand.
+func MetadataApplyCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("apply", flag.ExitOnError)
+	flags := bindPlanFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "apply",
+		ShortUsage: "asc metadata apply --dir DIR [--version VERSION_ID] [--app APP_ID] [flags]",
+		ShortHelp:  "Update App Store Connect to match a metadata spec.",
+		LongHelp: `Update App Store Connect to match a metadata spec.
+
+Computes the same plan as "asc metadata plan" and sends only the requests it
+lists: one POST or PATCH per changed localization, and one PATCH each for
+copyright, categories, age rating and review details when they differ.
+Nothing is deleted, except that an empty secondaryCategory clears the
+secondary category. See "asc metadata plan --help" for the spec layout.
+
+Examples:
+  asc metadata apply --dir ./metadata --version "VERSION_ID" --app "APP_ID"
+  asc metadata apply --dir ./metadata --version "VERSION_ID" --format xcstrings --output table`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			result, steps, live, err := runMetadataPlan(ctx, "metadata apply", flags)
+			if err != nil {
+				return err
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("metadata apply: %w", err)
+			}
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			if err := applySteps(requestCtx, client, live, steps); err != nil {
+				return fmt.Errorf("metadata apply: %w", err)
+			}
+			result.Applied = true
+
+			return shared.PrintOutput(result, *flags.output, *flags.pretty)
+		},
+	}
+}
+
+// applySteps sends the planned requests. Localization steps are batched per
+// resource so each locale is created or updated once.
+func applySteps(ctx context.Context, client *asc.Client, live *liveState, steps []planStep) error {
+	versionValues := make(map[string]map[string]string)
+	appInfoValues := make(map[string]map[string]string)
+	for _, step := range steps {
+		switch step.operation.Resource {
+		case resourceVersionLocalization:
+			versionValues[step.operation.Locale] = planStringValues(step.desired)
+		case resourceAppInfoLocalization:
+			appInfoValues[step.operation.Locale] = planStringValues(step.desired)
+		}
+	}
+	if len(versionValues) > 0 {
+		if _, err := shared.UploadVersionLocalizations(ctx, client, live.versionID, versionValues, false); err != nil {
+			return fmt.Errorf("version localizations: %w", err)
+		}
+	}
+	if len(appInfoValues) > 0 {
+		if _, err := shared.UploadAppInfoLocalizations(ctx, client, live.appInfoID, appInfoValues, false); err != nil {
+			return fmt.Errorf("app info localizations: %w", err)
+		}
+	}
+
+	for _, step := range steps {
+		var err error
+		switch step.operation.Resource {
+		case resourceVersion:
+			copyright := formatPlanValue(step.desired[specFieldCopyright])
+			_, err = client.UpdateAppStoreVersion(ctx, live.versionID, asc.AppStoreVersionUpdateAttributes{Copyright: &copyright})
+		case resourceAppInfo:
+			primary := formatPlanValue(step.desired[specFieldPrimaryCategory])
+			secondary := formatPlanValue(step.desired[specFieldSecondaryCategory])
+			if primary != "" || secondary != "" {
+				_, err = client.UpdateAppInfoCategories(ctx, live.appInfoID, primary, secondary)
+			}
+			// An empty secondary category in the spec removes the live one,
+			// which UpdateAppInfoCategories cannot express.
+			if _, clear := step.desired[specFieldSecondaryCategory]; err == nil && clear && secondary == "" {
+				_, err = client.ClearAppInfoSecondaryCategory(ctx, live.appInfoID)
+			}
+		case resourceAgeRating:
+			var attributes asc.AgeRatingDeclarationAttributes
+			if err = decodeAttributes(step.desired, &attributes); err == nil {
+				_, err = client.UpdateAgeRatingDeclaration(ctx, live.ageRatingID, attributes)
+			}
+		case resourceReviewDetails:
+			if step.operation.Method == "POST" {
+				var attributes asc.AppStoreReviewDetailCreateAttributes
+				if err = decodeAttributes(step.desired, &attributes); err == nil {
+					_, err = client.CreateAppStoreReviewDetail(ctx, live.versionID, &attributes)
+				}
+			} else {
+				var attributes asc.AppStoreReviewDetailUpdateAttributes
+				if err = decodeAttributes(step.desired, &attributes); err == nil {
+					_, err = client.UpdateAppStoreReviewDetail(ctx, live.reviewDetailID, attributes)
+				}
+			}
+		default:
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", step.operation.Resource, err)
+		}
+	}
+	return nil
+}
+
+func planStringValues(values map[string]any) map[string]string {
+	result := make(map[string]string, len(values))
+	for key, value := range values {
+		result[key] = formatPlanValue(value)
+	}
+	return result
+}
@@ -0,0 +1,388 @@
+package metadata
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	diffSourceVersion  = "version"
+	diffSourceApp      = "app"
+	diffSourceFastlane = "fastlane"
+	diffSourceMetadata = "metadata"
+	diffSourceSnapshot = "snapshot"
+	diffOutputUnified  = "unified"
+	diffScopeVersion   = "version"
+	diffScopeAppInfo   = "app-info"
+)
+
+// DiffCommand returns the diff command.
+func DiffCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("diff", flag.ExitOnError)
+
+	from := fs.String("from", "", "Source to compare from, as KIND:VALUE (required)")
+	to := fs.String("to", "", "Source to compare to, as KIND:VALUE (required)")
+	platform := fs.String("platform", "IOS", "Platform of the version used for app: and snapshot: sources: IOS, MAC_OS, TV_OS, VISION_OS")
+	locales := fs.String("locale", "", "Only compare these locales (comma-separated)")
+	format := fs.String("format", "", "Localization file format for metadata: sources: strings, xliff, json, csv, xcstrings (default: strings)")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), unified, table, markdown, csv, tsv, yaml, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "diff",
+		ShortUsage: "asc diff --from KIND:VALUE --to KIND:VALUE [flags]",
+		ShortHelp:  "Compare localized metadata between versions, apps, and local directories.",
+		LongHelp: `Compare localized metadata between versions, apps, and local directories.
+
+Sources:
+  version:VERSION_ID  Live localizations of an App Store version
+  app:APP_ID          Live app info localizations and the latest version of --platform
+  fastlane:DIR        A fastlane directory (DIR/metadata or DIR itself)
+  metadata:DIR        A metadata spec directory (see "asc metadata plan --help")
+  snapshot:DIR        An "asc backup" snapshot (app info and the latest version of --platform)
+
+Each change is reported per locale and field as added (only in --to), removed
+(only in --from) or changed. Only fields both sources can hold are compared:
+version: sources have no name or subtitle, so those are skipped when either
+side is a version.
+
+--output unified prints a text diff in the style of diff -u. The command exits
+non-zero when the sources differ, like diff(1).
+
+Examples:
+  asc diff --from version:"VERSION_ID" --to version:"OTHER_VERSION_ID"
+  asc diff --from app:"APP_ID" --to app:"WHITE_LABEL_APP_ID" --output unified
+  asc diff --from version:"VERSION_ID" --to fastlane:./fastlane --output markdown
+  asc diff --from snapshot:./snapshots/2026-10-01 --to snapshot:./snapshots/2026-10-18 --locale en-US`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if strings.TrimSpace(*from) == "" || strings.TrimSpace(*to) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --from and --to are required")
+				return flag.ErrHelp
+			}
+			normalizedPlatform, err := shared.NormalizeAppStoreVersionPlatform(*platform)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				return flag.ErrHelp
+			}
+			fileFormat, err := shared.NormalizeLocalizationFormat(*format, "")
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				return flag.ErrHelp
+			}
+			normalizedOutput := strings.ToLower(strings.TrimSpace(*output))
+			if normalizedOutput == diffOutputUnified && *pretty {
+				return fmt.Errorf("--pretty is only valid with JSON output")
+			}
+
+			loader := &diffLoader{platform: normalizedPlatform, format: fileFormat}
+			fromSource, err := loader.load(ctx, *from)
+			if err != nil {
+				return fmt.Errorf("diff: %w", err)
+			}
+			toSource, err := loader.load(ctx, *to)
+			if err != nil {
+				return fmt.Errorf("diff: %w", err)
+			}
+
+			result, err := diffSources(fromSource, toSource, shared.SplitCSV(*locales))
+			if err != nil {
+				return fmt.Errorf("diff: %w", err)
+			}
+			if normalizedOutput == diffOutputUnified {
+				printUnifiedDiff(os.Stdout, result)
+			} else if err := shared.PrintOutput(result, *output, *pretty); err != nil {
+				return err
+			}
+			if !result.Identical {
+				return shared.NewReportedError(fmt.Errorf("diff: %d difference(s)", len(result.Changes)))
+			}
+			return nil
+		},
+	}
+}
+
+// diffSource is one side of a diff: localized values by locale, keyed like
+// .strings files, and which kinds of fields the source can hold.
+type diffSource struct {
+	label   string
+	values  map[string]map[string]string
+	version bool
+	appInfo bool
+}
+
+// diffLoader resolves KIND:VALUE sources, creating the API client on first use.
+type diffLoader struct {
+	platform string
+	format   string
+	client   *asc.Client
+}
+
+func (l *diffLoader) load(ctx context.Context, value string) (*diffSource, error) {
+	kind, target, ok := strings.Cut(strings.TrimSpace(value), ":")
+	kind = strings.ToLower(strings.TrimSpace(kind))
+	target = strings.TrimSpace(target)
+	if !ok || target == "" {
+		return nil, fmt.Errorf("source %q must be KIND:VALUE, where KIND is version, app, fastlane, metadata or snapshot", value)
+	}
+	source := &diffSource{label: kind + ":" + target}
+
+	switch kind {
+	case diffSourceVersion:
+		client, err := l.apiClient()
+		if err != nil {
+			return nil, err
+		}
+		requestCtx, cancel := shared.ContextWithTimeout(ctx)
+		defer cancel()
+		localizations, err := shared.FetchVersionLocalizations(requestCtx, client, target)
+		if err != nil {
+			return nil, err
+		}
+		source.values = shared.VersionLocalizationValues(localizations)
+		source.version = true
+	case diffSourceApp:
+		values, err := l.fetchApp(ctx, target)
+		if err != nil {
+			return nil, err
+		}
+		source.values = values
+		source.version, source.appInfo = true, true
+	case diffSourceFastlane:
+		dir := target
+		if info, err := os.Stat(filepath.Join(dir, "metadata")); err == nil && info.IsDir() {
+			dir = filepath.Join(dir, "metadata")
+		}
+		values, err := shared.ReadFastlaneMetadataValues(dir)
+		if err != nil {
+			return nil, err
+		}
+		source.values = values
+		source.version, source.appInfo = true, true
+	case diffSourceMetadata:
+		spec, err := loadMetadataSpec(target, l.format)
+		if err != nil {
+			return nil, err
+		}
+		source.values = mergeLocalizationValues(spec.versionLocalizations, spec.appInfoLocalizations)
+		source.version = len(spec.versionLocalizations) > 0
+		source.appInfo = len(spec.appInfoLocalizations) > 0
+	case diffSourceSnapshot:
+		versionValues, appInfoValues, err := shared.ReadSnapshotLocalizations(target, l.platform)
+		if err != nil {
+			return nil, err
+		}
+		source.values = mergeLocalizationValues(versionValues, appInfoValues)
+		source.version, source.appInfo = true, true
+	default:
+		return nil, fmt.Errorf("unknown source kind %q (expected version, app, fastlane, metadata or snapshot)", kind)
+	}
+	return source, nil
+}
+
+func (l *diffLoader) apiClient() (*asc.Client, error) {
+	if l.client != nil {
+		return l.client, nil
+	}
+	client, err := shared.GetASCClient()
+	if err != nil {
+		return nil, err
+	}
+	l.client = client
+	return client, nil
+}
+
+// fetchApp reads an app's app info localizations and the localizations of its
+// latest version on the loader's platform.
+func (l *diffLoader) fetchApp(ctx context.Context, appID string) (map[string]map[string]string, error) {
+	client, err := l.apiClient()
+	if err != nil {
+		return nil, err
+	}
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+
+	versionID, err := shared.ResolveLatestAppStoreVersionID(requestCtx, client, appID, l.platform, "")
+	if err != nil {
+		return nil, err
+	}
+	versionLocalizations, err := shared.FetchVersionLocalizations(requestCtx, client, versionID)
+	if err != nil {
+		return nil, err
+	}
+	appInfoID, err := shared.ResolveAppInfoID(requestCtx, client, appID, "")
+	if err != nil {
+		return nil, err
+	}
+	appInfoLocalizations, err := shared.FetchAppInfoLocalizations(requestCtx, client, appInfoID)
+	if err != nil {
+		return nil, err
+	}
+	return mergeLocalizationValues(shared.VersionLocalizationValues(versionLocalizations), shared.AppInfoLocalizationValues(appInfoLocalizations)), nil
+}
+
+// mergeLocalizationValues combines per-locale values; version and app info
+// fields never share a key.
+func mergeLocalizationValues(sets ...map[string]map[string]string) map[string]map[string]string {
+	merged := make(map[string]map[string]string)
+	for _, set := range sets {
+		for locale, fields := range set {
+			if merged[locale] == nil {
+				merged[locale] = make(map[string]string, len(fields))
+			}
+			for key, value := range fields {
+				merged[locale][key] = value
+			}
+		}
+	}
+	return merged
+}
+
+// diffSources compares the fields both sources can hold, optionally limited
+// to locales. Values are compared with surrounding whitespace trimmed.
+func diffSources(from, to *diffSource, locales []string) (*asc.MetadataDiffResult, error) {
+	compareVersion := from.version && to.version
+	compareAppInfo := from.appInfo && to.appInfo
+	result := &asc.MetadataDiffResult{From: from.label, To: to.label, Scopes: []string{}, Changes: []asc.MetadataDiffChange{}}
+	if compareVersion {
+		result.Scopes = append(result.Scopes, diffScopeVersion)
+	}
+	if compareAppInfo {
+		result.Scopes = append(result.Scopes, diffScopeAppInfo)
+	}
+	if len(result.Scopes) == 0 {
+		return nil, fmt.Errorf("%s and %s have no fields in common to compare", from.label, to.label)
+	}
+
+	localeFilter := make(map[string]bool, len(locales))
+	for _, locale := range locales {
+		localeFilter[locale] = true
+	}
+	allLocales := make(map[string]struct{})
+	for _, values := range []map[string]map[string]string{from.values, to.values} {
+		for locale := range values {
+			if len(localeFilter) == 0 || localeFilter[locale] {
+				allLocales[locale] = struct{}{}
+			}
+		}
+	}
+
+	for _, locale := range sortedKeys(allLocales) {
+		fields := make(map[string]struct{})
+		for _, values := range []map[string]string{from.values[locale], to.values[locale]} {
+			for field := range values {
+				appInfoField := shared.IsAppInfoLocalizationKey(field)
+				if appInfoField && compareAppInfo || !appInfoField && compareVersion {
+					fields[field] = struct{}{}
+				}
+			}
+		}
+		for _, field := range sortedKeys(fields) {
+			fromValue := strings.TrimSpace(from.values[locale][field])
+			toValue := strings.TrimSpace(to.values[locale][field])
+			if fromValue == toValue {
+				continue
+			}
+			change := asc.MetadataDiffChange{Locale: locale, Field: field, From: fromValue, To: toValue}
+			switch {
+			case fromValue == "":
+				change.Change = "added"
+				result.Added++
+			case toValue == "":
+				change.Change = "removed"
+				result.Removed++
+			default:
+				change.Change = "changed"
+				result.Changed++
+			}
+			result.Changes = append(result.Changes, change)
+		}
+	}
+	result.Identical = len(result.Changes) == 0
+	return result, nil
+}
+
+func sortedKeys(set map[string]struct{}) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// printUnifiedDiff writes result in the style of diff -u, with one hunk per
+// locale and field.
+func printUnifiedDiff(w io.Writer, result *asc.MetadataDiffResult) {
+	if result.Identical {
+		return
+	}
+	fmt.Fprintf(w, "--- %s\n+++ %s\n", result.From, result.To)
+	for _, change := range result.Changes {
+		fmt.Fprintf(w, "@@ %s %s @@\n", change.Locale, change.Field)
+		for _, line := range diffLines(splitDiffLines(change.From), splitDiffLines(change.To)) {
+			fmt.Fprintln(w, line)
+		}
+	}
+}
+
+func splitDiffLines(value string) []string {
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, "\n")
+}
+
+// diffLines returns a line diff of a and b from their longest common
+// subsequence, prefixing lines with " ", "-" or "+".
+func diffLines(a, b []string) []string {
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	lines := make([]string, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			lines = append(lines, " "+a[i])
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			lines = append(lines, "-"+a[i])
+			i++
+		default:
+			lines = append(lines, "+"+b[j])
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		lines = append(lines, "-"+a[i])
+	}
+	for ; j < len(b); j++ {
+		lines = append(lines, "+"+b[j])
+	}
+	return lines
+}
@@ -0,0 +1,25 @@
+package metadata
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiffLines(t *testing.T) {
+	got := diffLines(
+		[]string{"intro", "old feature", "outro"},
+		[]string{"intro", "new feature", "extra", "outro"},
+	)
+	want := []string{" intro", "-old feature", "+new feature", "+extra", " outro"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("diffLines() = %q, want %q", got, want)
+	}
+}
+
+func TestDiffSourcesRequiresCommonScope(t *testing.T) {
+	from := &diffSource{label: "version:1", version: true}
+	to := &diffSource{label: "metadata:dir", appInfo: true}
+	if _, err := diffSources(from, to, nil); err == nil {
+		t.Fatal("expected an error for sources without common fields")
+	}
+}
@@ -0,0 +1,295 @@
+package metadata
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/config"
+)
+
+// MetadataLintCommand returns the metadata lint subcommand.
+func MetadataLintCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("lint", flag.ExitOnError)
+
+	fastlaneDir := fs.String("fastlane-dir", "", "Lint a fastlane directory (reads <dir>/metadata)")
+	path := fs.String("path", "", "Lint localization files (directory or file)")
+	format := fs.String("format", "", "Localization file format for --path: strings, xliff, json, csv, xcstrings (default: inferred)")
+	versionID := fs.String("version", "", "Lint live App Store version localizations by version ID")
+	appID := fs.String("app", "", "With --version, also lint app info localizations (name, subtitle) for this app ID (or ASC_APP_ID env)")
+	appInfoID := fs.String("app-info", "", "App Info ID (optional override)")
+	primaryLocale := fs.String("primary-locale", "", "Locale other locales are compared against (default: config, then en-US)")
+	configPath := fs.String("config", "", "Path to a lint config JSON file (same keys as metadata_lint in config.json)")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "lint",
+		ShortUsage: "asc metadata lint (--fastlane-dir DIR | --path PATH | --version VERSION_ID) [flags]",
+		ShortHelp:  "Lint App Store metadata text against configurable rules.",
+		LongHelp: `Lint App Store metadata text against configurable rules.
+
+Reads metadata from exactly one source: a fastlane directory, localization
+files (see "asc localizations download --help" for formats), or live App Store
+Connect data for a version. All findings are reported in one pass; the command
+exits non-zero when any finding has severity error.
+
+Rules:
+  field-length        Field exceeds its character limit (name/subtitle 30,
+                      keywords 100 bytes, promotionalText 170,
+                      description/whatsNew 4000)
+  keyword-duplicates  Keyword repeated, or already in the name or subtitle (warning)
+  banned-words        Configured banned word in any field; banned keyword
+                      (default "free") in keywords
+  url-syntax          URL field is not a valid http(s) URL
+  placeholder-text    lorem ipsum, TODO, TBD, {{...}}, [insert ...] and
+                      configured placeholders
+  missing-locales     Primary or required locale is missing
+  missing-fields      Field set for the primary locale but missing elsewhere (warning)
+
+Rules are configured under "metadata_lint" in ~/.asc/config.json or the
+repository's .asc/config.json, and --config layers a JSON file with the same
+keys on top:
+
+  {
+    "primary_locale": "en-US",
+    "required_locales": ["en-US", "de-DE"],
+    "banned_words": ["CompetitorApp"],
+    "banned_keywords": ["free", "best"],
+    "placeholders": ["coming soon"],
+    "limits": {"promotionalText": 150},
+    "rules": {"keyword-duplicates": "error", "missing-fields": "off"}
+  }
+
+With --report junit --report-file FILE, each rule becomes a JUnit test case.
+
+Examples:
+  asc metadata lint --fastlane-dir ./fastlane
+  asc metadata lint --path ./localizations --primary-locale en-US
+  asc metadata lint --version "VERSION_ID" --app "APP_ID" --output table
+  asc metadata lint --fastlane-dir ./fastlane --config ./metadata-lint.json --report junit --report-file lint.xml`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			sources := 0
+			for _, value := range []string{*fastlaneDir, *path, *versionID} {
+				if strings.TrimSpace(value) != "" {
+					sources++
+				}
+			}
+			if sources != 1 {
+				fmt.Fprintln(os.Stderr, "Error: exactly one of --fastlane-dir, --path or --version is required")
+				return flag.ErrHelp
+			}
+			if strings.TrimSpace(*format) != "" && strings.TrimSpace(*path) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --format requires --path")
+				return flag.ErrHelp
+			}
+
+			lintConfig, err := loadLintConfig(strings.TrimSpace(*configPath))
+			if err != nil {
+				return fmt.Errorf("metadata lint: %w", err)
+			}
+			settings, err := resolveLintSettings(lintConfig...)
+			if err != nil {
+				return fmt.Errorf("metadata lint: %w", err)
+			}
+
+			var values map[string]map[string]string
+			var source string
+			switch {
+			case strings.TrimSpace(*fastlaneDir) != "":
+				source = strings.TrimSpace(*fastlaneDir)
+				values, err = shared.ReadFastlaneMetadataValues(filepath.Join(source, "metadata"))
+			case strings.TrimSpace(*path) != "":
+				source = strings.TrimSpace(*path)
+				var fileFormat string
+				fileFormat, err = shared.NormalizeLocalizationFormat(*format, source)
+				if err == nil {
+					values, err = shared.ReadLocalizationFiles(source, fileFormat, nil)
+				}
+			default:
+				source = "version " + strings.TrimSpace(*versionID)
+				values, err = fetchLiveMetadata(ctx, strings.TrimSpace(*versionID), shared.ResolveAppID(*appID), strings.TrimSpace(*appInfoID))
+			}
+			if err != nil {
+				return fmt.Errorf("metadata lint: %w", err)
+			}
+
+			result := lintMetadata(source, values, resolvePrimaryLocale(*primaryLocale, lintConfig, values), settings)
+			shared.SetReportTestCases(lintJUnitTestCases(result))
+
+			if err := shared.PrintOutput(result, *output, *pretty); err != nil {
+				return err
+			}
+			if result.Errors > 0 {
+				return shared.NewReportedError(fmt.Errorf("metadata lint: found %d error(s)", result.Errors))
+			}
+			return nil
+		},
+	}
+}
+
+// loadLintConfig returns the metadata_lint section of the active config file
+// followed by the --config file, skipping whichever is absent.
+func loadLintConfig(path string) ([]*config.MetadataLintConfig, error) {
+	var configs []*config.MetadataLintConfig
+	cfg, err := config.Load()
+	switch {
+	case err == nil:
+		configs = append(configs, cfg.MetadataLint)
+	case !errors.Is(err, config.ErrNotFound):
+		return nil, err
+	}
+
+	if path == "" {
+		return configs, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read lint config: %w", err)
+	}
+	var fileConfig config.MetadataLintConfig
+	if err := json.Unmarshal(data, &fileConfig); err != nil {
+		return nil, fmt.Errorf("parse lint config %s: %w", path, err)
+	}
+	return append(configs, &fileConfig), nil
+}
+
+// resolvePrimaryLocale picks the flag value, then the last configured
+// primary_locale, then en-US, en, or the first locale.
+func resolvePrimaryLocale(flagValue string, configs []*config.MetadataLintConfig, values map[string]map[string]string) string {
+	if value := strings.TrimSpace(flagValue); value != "" {
+		return value
+	}
+	for i := len(configs) - 1; i >= 0; i-- {
+		if configs[i] != nil && strings.TrimSpace(configs[i].PrimaryLocale) != "" {
+			return strings.TrimSpace(configs[i].PrimaryLocale)
+		}
+	}
+	for _, candidate := range []string{"en-US", "en"} {
+		if _, ok := values[candidate]; ok {
+			return candidate
+		}
+	}
+	locales := sortedLocales(values)
+	if len(locales) == 0 {
+		return ""
+	}
+	return locales[0]
+}
+
+func sortedLocales(values map[string]map[string]string) []string {
+	locales := make([]string, 0, len(values))
+	for locale := range values {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+	return locales
+}
+
+func lintMetadata(source string, values map[string]map[string]string, primary string, settings *lintSettings) *asc.MetadataLintResult {
+	input := &lintInput{values: values, locales: sortedLocales(values), primary: primary}
+	findings, rules := runLintRules(input, settings)
+
+	result := &asc.MetadataLintResult{
+		Source:        source,
+		PrimaryLocale: primary,
+		Locales:       input.locales,
+		Rules:         rules,
+		Findings:      findings,
+	}
+	for _, finding := range findings {
+		if finding.Severity == severityError {
+			result.Errors++
+		} else {
+			result.Warnings++
+		}
+	}
+	result.Valid = result.Errors == 0
+	return result
+}
+
+// lintJUnitTestCases reports one test case per rule that ran. A rule fails
+// when it has error findings; warnings are listed in system-out.
+func lintJUnitTestCases(result *asc.MetadataLintResult) []shared.JUnitTestCase {
+	cases := make([]shared.JUnitTestCase, 0, len(result.Rules))
+	for _, rule := range result.Rules {
+		testCase := shared.JUnitTestCase{Name: rule, Classname: "metadata lint"}
+		var lines []string
+		errorCount := 0
+		for _, finding := range result.Findings {
+			if finding.Rule != rule {
+				continue
+			}
+			if finding.Severity == severityError {
+				errorCount++
+			}
+			lines = append(lines, formatLintFinding(finding))
+		}
+		if errorCount > 0 {
+			testCase.Failure = "LINT"
+			testCase.Message = fmt.Sprintf("%d error(s)", errorCount)
+		}
+		testCase.SystemOut = strings.Join(lines, "\n")
+		cases = append(cases, testCase)
+	}
+	return cases
+}
+
+func formatLintFinding(finding asc.MetadataLintFinding) string {
+	location := finding.Locale
+	if finding.Field != "" {
+		location += " " + finding.Field
+	}
+	return fmt.Sprintf("%s: %s: %s", finding.Severity, strings.TrimSpace(location), finding.Message)
+}
+
+// fetchLiveMetadata reads version localizations and, when appID is set, app
+// info localizations into one set of values by locale.
+func fetchLiveMetadata(ctx context.Context, versionID, appID, appInfoID string) (map[string]map[string]string, error) {
+	client, err := shared.GetASCClient()
+	if err != nil {
+		return nil, err
+	}
+
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+
+	versionLocalizations, err := shared.FetchVersionLocalizations(requestCtx, client, versionID)
+	if err != nil {
+		return nil, err
+	}
+	values := shared.VersionLocalizationValues(versionLocalizations)
+
+	if appID == "" {
+		return values, nil
+	}
+	appInfo, err := shared.ResolveAppInfoID(requestCtx, client, appID, appInfoID)
+	if err != nil {
+		return nil, err
+	}
+	appInfoLocalizations, err := shared.FetchAppInfoLocalizations(requestCtx, client, appInfo)
+	if err != nil {
+		return nil, err
+	}
+	for locale, fields := range shared.AppInfoLocalizationValues(appInfoLocalizations) {
+		if values[locale] == nil {
+			values[locale] = make(map[string]string, len(fields))
+		}
+		for key, value := range fields {
+			values[locale][key] = value
+		}
+	}
+	return values, nil
+}
@@ -0,0 +1,418 @@
+package metadata
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/config"
+)
+
+// Lint severities. Rules set to severityOff are not run.
+const (
+	severityError   = "error"
+	severityWarning = "warning"
+	severityOff     = "off"
+)
+
+// defaultFieldLimits are App Store Connect character limits per field. The
+// keywords limit counts UTF-8 bytes rather than characters.
+var defaultFieldLimits = map[string]int{
+	"name":            30,
+	"subtitle":        30,
+	"keywords":        100,
+	"promotionalText": 170,
+	"description":     4000,
+	"whatsNew":        4000,
+}
+
+var urlFields = []string{"supportUrl", "marketingUrl", "privacyPolicyUrl", "privacyChoicesUrl"}
+
+// defaultPlaceholderPatterns match common template and filler text.
+var defaultPlaceholderPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`(?i)lorem ipsum`),
+	regexp.MustCompile(`\b(TODO|TBD|FIXME|XXX)\b`),
+	regexp.MustCompile(`\{\{[^}]*\}\}`),
+	regexp.MustCompile(`(?i)\[(insert|placeholder)[^\]]*\]`),
+	regexp.MustCompile(`(?i)<(insert|placeholder)[^>]*>`),
+}
+
+var defaultBannedKeywords = []string{"free"}
+
+// lintInput is the metadata under lint: field values by locale.
+type lintInput struct {
+	values  map[string]map[string]string
+	locales []string
+	primary string
+}
+
+// lintSettings is the resolved rule configuration.
+type lintSettings struct {
+	limits          map[string]int
+	requiredLocales []string
+	bannedWords     []string
+	bannedKeywords  []string
+	placeholders    []string
+	severities      map[string]string
+}
+
+type lintRule struct {
+	id          string
+	description string
+	severity    string
+	check       func(input *lintInput, settings *lintSettings) []asc.MetadataLintFinding
+}
+
+var lintRules = []lintRule{
+	{id: "field-length", description: "Field exceeds its App Store character limit", severity: severityError, check: checkFieldLength},
+	{id: "keyword-duplicates", description: "Keyword repeated in the keywords field or already in the name or subtitle", severity: severityWarning, check: checkKeywordDuplicates},
+	{id: "banned-words", description: "Configured banned word in any field, or banned keyword in keywords", severity: severityError, check: checkBannedWords},
+	{id: "url-syntax", description: "URL field is not a valid http(s) URL", severity: severityError, check: checkURLSyntax},
+	{id: "placeholder-text", description: "Placeholder or filler text such as lorem ipsum or TODO", severity: severityError, check: checkPlaceholderText},
+	{id: "missing-locales", description: "Required or primary locale is missing", severity: severityError, check: checkMissingLocales},
+	{id: "missing-fields", description: "Field set for the primary locale but missing in another locale", severity: severityWarning, check: checkMissingFields},
+}
+
+func lintRuleByID(id string) (lintRule, bool) {
+	for _, rule := range lintRules {
+		if rule.id == id {
+			return rule, true
+		}
+	}
+	return lintRule{}, false
+}
+
+// resolveLintSettings layers config files over the defaults; later configs win.
+func resolveLintSettings(configs ...*config.MetadataLintConfig) (*lintSettings, error) {
+	settings := &lintSettings{
+		limits:         make(map[string]int, len(defaultFieldLimits)),
+		bannedKeywords: defaultBannedKeywords,
+		severities:     make(map[string]string, len(lintRules)),
+	}
+	for field, limit := range defaultFieldLimits {
+		settings.limits[field] = limit
+	}
+	for _, rule := range lintRules {
+		settings.severities[rule.id] = rule.severity
+	}
+
+	for _, cfg := range configs {
+		if cfg == nil {
+			continue
+		}
+		for field, limit := range cfg.Limits {
+			if limit <= 0 {
+				return nil, fmt.Errorf("limit for %q must be positive", field)
+			}
+			settings.limits[field] = limit
+		}
+		for id, severity := range cfg.Rules {
+			if _, ok := lintRuleByID(id); !ok {
+				return nil, fmt.Errorf("unknown lint rule %q", id)
+			}
+			normalized := strings.ToLower(strings.TrimSpace(severity))
+			switch normalized {
+			case severityError, severityWarning, severityOff:
+			default:
+				return nil, fmt.Errorf("rule %q: severity must be error, warning or off", id)
+			}
+			settings.severities[id] = normalized
+		}
+		if len(cfg.RequiredLocales) > 0 {
+			settings.requiredLocales = cfg.RequiredLocales
+		}
+		if len(cfg.BannedWords) > 0 {
+			settings.bannedWords = cfg.BannedWords
+		}
+		if cfg.BannedKeywords != nil {
+			settings.bannedKeywords = cfg.BannedKeywords
+		}
+		if len(cfg.Placeholders) > 0 {
+			settings.placeholders = cfg.Placeholders
+		}
+	}
+	return settings, nil
+}
+
+// runLintRules runs every enabled rule and returns findings in rule order,
+// along with the IDs of the rules that ran.
+func runLintRules(input *lintInput, settings *lintSettings) ([]asc.MetadataLintFinding, []string) {
+	findings := []asc.MetadataLintFinding{}
+	ran := make([]string, 0, len(lintRules))
+	for _, rule := range lintRules {
+		severity := settings.severities[rule.id]
+		if severity == severityOff {
+			continue
+		}
+		ran = append(ran, rule.id)
+		for _, finding := range rule.check(input, settings) {
+			finding.Rule = rule.id
+			finding.Severity = severity
+			findings = append(findings, finding)
+		}
+	}
+	return findings, ran
+}
+
+// sortedFields returns the field names of values in a stable order.
+func sortedFields(values map[string]string) []string {
+	fields := make([]string, 0, len(values))
+	for field := range values {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+func checkFieldLength(input *lintInput, settings *lintSettings) []asc.MetadataLintFinding {
+	var findings []asc.MetadataLintFinding
+	for _, locale := range input.locales {
+		values := input.values[locale]
+		for _, field := range sortedFields(values) {
+			limit, ok := settings.limits[field]
+			if !ok {
+				continue
+			}
+			// App Store Connect measures keywords in bytes, so multibyte
+			// keywords reach the limit sooner.
+			if field == "keywords" {
+				if length := len(values[field]); length > limit {
+					findings = append(findings, asc.MetadataLintFinding{
+						Locale:  locale,
+						Field:   field,
+						Message: fmt.Sprintf("%d bytes exceeds the %d byte limit", length, limit),
+					})
+				}
+				continue
+			}
+			if length := utf8.RuneCountInString(values[field]); length > limit {
+				findings = append(findings, asc.MetadataLintFinding{
+					Locale:  locale,
+					Field:   field,
+					Message: fmt.Sprintf("%d characters exceeds the %d character limit", length, limit),
+				})
+			}
+		}
+	}
+	return findings
+}
+
+func splitKeywords(value string) []string {
+	var keywords []string
+	for _, part := range strings.Split(value, ",") {
+		if keyword := strings.TrimSpace(part); keyword != "" {
+			keywords = append(keywords, keyword)
+		}
+	}
+	return keywords
+}
+
+func splitWords(value string) []string {
+	return strings.FieldsFunc(strings.ToLower(value), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+func checkKeywordDuplicates(input *lintInput, _ *lintSettings) []asc.MetadataLintFinding {
+	var findings []asc.MetadataLintFinding
+	for _, locale := range input.locales {
+		values := input.values[locale]
+		keywords := splitKeywords(values["keywords"])
+		if len(keywords) == 0 {
+			continue
+		}
+
+		titleWords := make(map[string]bool)
+		for _, word := range splitWords(values["name"] + " " + values["subtitle"]) {
+			titleWords[word] = true
+		}
+
+		seen := make(map[string]bool, len(keywords))
+		for _, keyword := range keywords {
+			normalized := strings.ToLower(keyword)
+			if seen[normalized] {
+				findings = append(findings, asc.MetadataLintFinding{
+					Locale:  locale,
+					Field:   "keywords",
+					Message: fmt.Sprintf("keyword %q is listed more than once", keyword),
+				})
+				continue
+			}
+			seen[normalized] = true
+
+			words := splitWords(keyword)
+			inTitle := len(words) > 0 && len(titleWords) > 0
+			for _, word := range words {
+				if !titleWords[word] {
+					inTitle = false
+					break
+				}
+			}
+			if inTitle {
+				findings = append(findings, asc.MetadataLintFinding{
+					Locale:  locale,
+					Field:   "keywords",
+					Message: fmt.Sprintf("keyword %q already appears in the app name or subtitle", keyword),
+				})
+			}
+		}
+	}
+	return findings
+}
+
+func containsWord(value, word string) bool {
+	pattern := `(?i)(^|[^\pL\pN])` + regexp.QuoteMeta(word) + `($|[^\pL\pN])`
+	matched, err := regexp.MatchString(pattern, value)
+	return err == nil && matched
+}
+
+func checkBannedWords(input *lintInput, settings *lintSettings) []asc.MetadataLintFinding {
+	var findings []asc.MetadataLintFinding
+	for _, locale := range input.locales {
+		values := input.values[locale]
+		for _, field := range sortedFields(values) {
+			for _, word := range settings.bannedWords {
+				if word = strings.TrimSpace(word); word != "" && containsWord(values[field], word) {
+					findings = append(findings, asc.MetadataLintFinding{
+						Locale:  locale,
+						Field:   field,
+						Message: fmt.Sprintf("contains banned word %q", word),
+					})
+				}
+			}
+		}
+		for _, word := range settings.bannedKeywords {
+			if word = strings.TrimSpace(word); word != "" && containsWord(values["keywords"], word) {
+				findings = append(findings, asc.MetadataLintFinding{
+					Locale:  locale,
+					Field:   "keywords",
+					Message: fmt.Sprintf("keywords contain banned keyword %q", word),
+				})
+			}
+		}
+	}
+	return findings
+}
+
+func checkURLSyntax(input *lintInput, _ *lintSettings) []asc.MetadataLintFinding {
+	var findings []asc.MetadataLintFinding
+	for _, locale := range input.locales {
+		values := input.values[locale]
+		for _, field := range urlFields {
+			raw, ok := values[field]
+			if !ok {
+				continue
+			}
+			if err := validateMetadataURL(raw); err != nil {
+				findings = append(findings, asc.MetadataLintFinding{
+					Locale:  locale,
+					Field:   field,
+					Message: err.Error(),
+				})
+			}
+		}
+	}
+	return findings
+}
+
+func validateMetadataURL(raw string) error {
+	if strings.ContainsAny(raw, " \t\n") {
+		return fmt.Errorf("URL %q contains whitespace", raw)
+	}
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return fmt.Errorf("URL %q is not valid", raw)
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return fmt.Errorf("URL %q must start with http:// or https://", raw)
+	}
+	if parsed.Hostname() == "" || !strings.Contains(parsed.Hostname(), ".") {
+		return fmt.Errorf("URL %q has no valid host", raw)
+	}
+	return nil
+}
+
+func checkPlaceholderText(input *lintInput, settings *lintSettings) []asc.MetadataLintFinding {
+	var findings []asc.MetadataLintFinding
+	for _, locale := range input.locales {
+		values := input.values[locale]
+		for _, field := range sortedFields(values) {
+			value := values[field]
+			match := ""
+			for _, pattern := range defaultPlaceholderPatterns {
+				if found := pattern.FindString(value); found != "" {
+					match = found
+					break
+				}
+			}
+			if match == "" {
+				lower := strings.ToLower(value)
+				for _, placeholder := range settings.placeholders {
+					if placeholder != "" && strings.Contains(lower, strings.ToLower(placeholder)) {
+						match = placeholder
+						break
+					}
+				}
+			}
+			if match != "" {
+				findings = append(findings, asc.MetadataLintFinding{
+					Locale:  locale,
+					Field:   field,
+					Message: fmt.Sprintf("contains placeholder text %q", match),
+				})
+			}
+		}
+	}
+	return findings
+}
+
+func checkMissingLocales(input *lintInput, settings *lintSettings) []asc.MetadataLintFinding {
+	var findings []asc.MetadataLintFinding
+	if _, ok := input.values[input.primary]; !ok && input.primary != "" {
+		findings = append(findings, asc.MetadataLintFinding{
+			Locale:  input.primary,
+			Message: "primary locale is missing",
+		})
+	}
+	for _, locale := range settings.requiredLocales {
+		if locale == input.primary {
+			continue
+		}
+		if _, ok := input.values[locale]; !ok {
+			findings = append(findings, asc.MetadataLintFinding{
+				Locale:  locale,
+				Message: "required locale is missing",
+			})
+		}
+	}
+	return findings
+}
+
+func checkMissingFields(input *lintInput, _ *lintSettings) []asc.MetadataLintFinding {
+	primaryValues, ok := input.values[input.primary]
+	if !ok {
+		return nil
+	}
+	var findings []asc.MetadataLintFinding
+	for _, locale := range input.locales {
+		if locale == input.primary {
+			continue
+		}
+		values := input.values[locale]
+		for _, field := range sortedFields(primaryValues) {
+			if _, ok := values[field]; !ok {
+				findings = append(findings, asc.MetadataLintFinding{
+					Locale:  locale,
+					Field:   field,
+					Message: fmt.Sprintf("set for primary locale %s but missing", input.primary),
+				})
+			}
+		}
+	}
+	return findings
+}
@@ -0,0 +1,169 @@
+package metadata
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/config"
+)
+
+func lintForTest(t *testing.T, values map[string]map[string]string, configs ...*config.MetadataLintConfig) *asc.MetadataLintResult {
+	t.Helper()
+	settings, err := resolveLintSettings(configs...)
+	if err != nil {
+		t.Fatalf("resolveLintSettings() error: %v", err)
+	}
+	return lintMetadata("test", values, resolvePrimaryLocale("", configs, values), settings)
+}
+
+func findingLines(result *asc.MetadataLintResult) string {
+	lines := make([]string, 0, len(result.Findings))
+	for _, finding := range result.Findings {
+		lines = append(lines, finding.Rule+" "+formatLintFinding(finding))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func assertFindings(t *testing.T, result *asc.MetadataLintResult, want ...string) {
+	t.Helper()
+	got := findingLines(result)
+	for _, line := range want {
+		if !strings.Contains(got, line) {
+			t.Fatalf("expected finding %q in:\n%s", line, got)
+		}
+	}
+}
+
+func TestLintMetadata_Rules(t *testing.T) {
+	values := map[string]map[string]string{
+		"en-US": {
+			"name":         "Photo Sorter",
+			"subtitle":     "Organize albums fast",
+			"keywords":     "photo,album,free,Album,sorter photo,gallery",
+			"description":  "Lorem ipsum dolor sit amet",
+			"supportUrl":   "example.com/help",
+			"marketingUrl": "https://example.com",
+		},
+		"de-DE": {
+			"name":            "Fotosortierer mit einem viel zu langen Namen",
+			"promotionalText": "Jetzt {{discount}} sparen",
+		},
+	}
+
+	result := lintForTest(t, values)
+	if result.PrimaryLocale != "en-US" {
+		t.Fatalf("expected en-US primary locale, got %q", result.PrimaryLocale)
+	}
+	assertFindings(t, result,
+		"field-length error: de-DE name: 44 characters exceeds the 30 character limit",
+		`keyword-duplicates warning: en-US keywords: keyword "photo" already appears in the app name or subtitle`,
+		`keyword-duplicates warning: en-US keywords: keyword "Album" is listed more than once`,
+		`keyword-duplicates warning: en-US keywords: keyword "sorter photo" already appears`,
+		`banned-words error: en-US keywords: keywords contain banned keyword "free"`,
+		`url-syntax error: en-US supportUrl: URL "example.com/help" must start with http:// or https://`,
+		`placeholder-text error: en-US description: contains placeholder text "Lorem ipsum"`,
+		`placeholder-text error: de-DE promotionalText: contains placeholder text "{{discount}}"`,
+		"missing-fields warning: de-DE description: set for primary locale en-US but missing",
+	)
+	if strings.Contains(findingLines(result), `keyword "gallery"`) {
+		t.Fatalf("gallery should not be flagged:\n%s", findingLines(result))
+	}
+	if result.Valid || result.Errors != 5 {
+		t.Fatalf("expected 5 errors, got %d:\n%s", result.Errors, findingLines(result))
+	}
+}
+
+func TestLintMetadata_KeywordsLimitCountsBytes(t *testing.T) {
+	// 40 characters, but 120 bytes in UTF-8.
+	keywords := strings.Repeat("写真", 20)
+	values := map[string]map[string]string{
+		"ja": {"name": strings.Repeat("写", 30), "keywords": keywords},
+	}
+
+	result := lintForTest(t, values)
+	assertFindings(t, result, "field-length error: ja keywords: 120 bytes exceeds the 100 byte limit")
+	if strings.Contains(findingLines(result), "ja name") {
+		t.Fatalf("name should be measured in characters:\n%s", findingLines(result))
+	}
+}
+
+func TestLintMetadata_Config(t *testing.T) {
+	values := map[string]map[string]string{
+		"en-US": {"description": "Better than RivalApp. Coming soon: sync.", "keywords": "free"},
+		"fr-FR": {"description": "Bonjour"},
+	}
+	global := &config.MetadataLintConfig{
+		PrimaryLocale: "fr-FR",
+		BannedWords:   []string{"RivalApp"},
+	}
+	repo := &config.MetadataLintConfig{
+		RequiredLocales: []string{"en-US", "ja"},
+		BannedKeywords:  []string{},
+		Placeholders:    []string{"coming soon"},
+		Limits:          map[string]int{"description": 20},
+		Rules:           map[string]string{"field-length": "warning", "missing-fields": "off"},
+	}
+
+	result := lintForTest(t, values, global, repo)
+	if result.PrimaryLocale != "fr-FR" {
+		t.Fatalf("expected fr-FR primary locale, got %q", result.PrimaryLocale)
+	}
+	assertFindings(t, result,
+		"field-length warning: en-US description: 40 characters exceeds the 20 character limit",
+		`banned-words error: en-US description: contains banned word "RivalApp"`,
+		`placeholder-text error: en-US description: contains placeholder text "coming soon"`,
+		"missing-locales error: ja: required locale is missing",
+	)
+	lines := findingLines(result)
+	if strings.Contains(lines, "banned keyword") || strings.Contains(lines, "missing-fields") {
+		t.Fatalf("unexpected findings:\n%s", lines)
+	}
+	for _, rule := range result.Rules {
+		if rule == "missing-fields" {
+			t.Fatal("disabled rule should not be reported as run")
+		}
+	}
+}
+
+func TestResolveLintSettings_RejectsInvalidConfig(t *testing.T) {
+	tests := map[string]*config.MetadataLintConfig{
+		"unknown rule": {Rules: map[string]string{"spelling": "error"}},
+		"bad severity": {Rules: map[string]string{"url-syntax": "fatal"}},
+		"bad limit":    {Limits: map[string]int{"keywords": 0}},
+	}
+	for name, cfg := range tests {
+		if _, err := resolveLintSettings(cfg); err == nil {
+			t.Fatalf("%s: expected error", name)
+		}
+	}
+}
+
+func TestLintMetadata_MissingPrimaryLocale(t *testing.T) {
+	values := map[string]map[string]string{"de-DE": {"description": "Hallo"}}
+	result := lintForTest(t, values, &config.MetadataLintConfig{PrimaryLocale: "en-US"})
+	assertFindings(t, result, "missing-locales error: en-US: primary locale is missing")
+}
+
+func TestLintJUnitTestCases(t *testing.T) {
+	result := &asc.MetadataLintResult{
+		Rules: []string{"field-length", "keyword-duplicates", "url-syntax"},
+		Findings: []asc.MetadataLintFinding{
+			{Rule: "field-length", Severity: severityError, Locale: "en-US", Field: "name", Message: "too long"},
+			{Rule: "keyword-duplicates", Severity: severityWarning, Locale: "en-US", Field: "keywords", Message: "dup"},
+		},
+	}
+	cases := lintJUnitTestCases(result)
+	if len(cases) != 3 {
+		t.Fatalf("expected 3 test cases, got %d", len(cases))
+	}
+	if cases[0].Failure == "" || cases[0].SystemOut != "error: en-US name: too long" {
+		t.Fatalf("unexpected field-length case: %+v", cases[0])
+	}
+	if cases[1].Failure != "" || cases[1].SystemOut == "" {
+		t.Fatalf("warnings should not fail: %+v", cases[1])
+	}
+	if cases[2].Failure != "" || cases[2].SystemOut != "" {
+		t.Fatalf("unexpected url-syntax case: %+v", cases[2])
+	}
+}
@@ -0,0 +1,38 @@
+package metadata
+
+import (
+	"context"
+	"flag"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// MetadataCommand returns the metadata command with subcommands.
+func MetadataCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("metadata", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "metadata",
+		ShortUsage: "asc metadata <subcommand> [flags]",
+		ShortHelp:  "Check and manage App Store metadata.",
+		LongHelp: `Check and manage App Store metadata (localizations, categories, age rating, review details, ...).
+
+Examples:
+  asc metadata lint --fastlane-dir ./fastlane
+  asc metadata lint --path ./localizations
+  asc metadata plan --dir ./metadata --version "VERSION_ID" --app "APP_ID"
+  asc metadata apply --dir ./metadata --version "VERSION_ID" --app "APP_ID"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			MetadataLintCommand(),
+			MetadataPlanCommand(),
+			MetadataApplyCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
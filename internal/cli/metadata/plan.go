@@ -0,0 +1,461 @@
+package metadata
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const sensitiveValue = "(sensitive)"
+
+// sensitiveFields are masked in plan output.
+var sensitiveFields = map[string]bool{
+	"demoAccountPassword": true,
+}
+
+// planFlags are shared by metadata plan and metadata apply.
+type planFlags struct {
+	dir       *string
+	versionID *string
+	appID     *string
+	appInfoID *string
+	format    *string
+	output    *string
+	pretty    *bool
+}
+
+func bindPlanFlags(fs *flag.FlagSet) planFlags {
+	return planFlags{
+		dir:       fs.String("dir", "", "Spec directory (metadata.json, version-localizations/, app-info-localizations/)"),
+		versionID: fs.String("version", "", "App Store version ID (required when the spec has version metadata)"),
+		appID:     fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env; required when the spec has app info metadata)"),
+		appInfoID: fs.String("app-info", "", "App Info ID (optional override)"),
+		format:    fs.String("format", "", "Localization file format in the spec: strings, xliff, json, csv, xcstrings (default: strings)"),
+		output:    fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson"),
+		pretty:    fs.Bool("pretty", false, "Pretty-print JSON output"),
+	}
+}
+
+// planStep is one API request together with the field changes it carries.
+type planStep struct {
+	operation asc.MetadataPlanOperation
+	changes   []asc.MetadataPlanChange
+	desired   map[string]any
+}
+
+// liveState is the App Store Connect state for the parts a spec manages.
+type liveState struct {
+	versionID              string
+	appInfoID              string
+	versionLocalizations   map[string]map[string]string
+	versionLocalizationIDs map[string]string
+	appInfoLocalizations   map[string]map[string]string
+	appInfoLocalizationIDs map[string]string
+	copyright              string
+	primaryCategory        string
+	secondaryCategory      string
+	ageRatingID            string
+	ageRating              map[string]any
+	reviewDetailID         string
+	reviewDetails          map[string]any
+}
+
+// MetadataPlanCommand returns the metadata plan subcommand.
+func MetadataPlanCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("plan", flag.ExitOnError)
+	flags := bindPlanFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "plan",
+		ShortUsage: "asc metadata plan --dir DIR [--version VERSION_ID] [--app APP_ID] [flags]",
+		ShortHelp:  "Show field-level differences between a metadata spec and App Store Connect.",
+		LongHelp: `Show field-level differences between a metadata spec and App Store Connect.
+
+A spec directory may contain:
+  metadata.json             copyright, primaryCategory, secondaryCategory,
+                            ageRating and reviewDetails
+  version-localizations/    App Store version localizations
+  app-info-localizations/   App info localizations (name, subtitle, privacy URLs)
+
+Localization directories use the files written by "asc localizations download"
+(see --format). Only fields present in the spec are managed: missing fields,
+missing locales and empty values are left untouched, except that
+"secondaryCategory": "" removes the secondary category. ageRating and
+reviewDetails use the same attribute names as the API:
+
+  {
+    "copyright": "2026 Example Inc.",
+    "primaryCategory": "PRODUCTIVITY",
+    "ageRating": {"gambling": false, "violenceCartoonOrFantasy": "NONE"},
+    "reviewDetails": {"contactEmail": "review@example.com", "demoAccountRequired": false}
+  }
+
+Exit codes: 0 when App Store Connect matches the spec, 6 when the plan has
+changes, and the usual non-zero codes (1 for generic errors, 2 for usage, 3
+for auth, ...) when the check itself fails, so a scheduled job can tell edits
+made outside the spec from a failed run. Run "asc metadata apply" to make
+the changes.
+
+Examples:
+  asc metadata plan --dir ./metadata --version "VERSION_ID" --app "APP_ID"
+  asc metadata plan --dir ./metadata --version "VERSION_ID" --output table
+  asc metadata plan --dir ./metadata --app "APP_ID" --format json`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			result, _, _, err := runMetadataPlan(ctx, "metadata plan", flags)
+			if err != nil {
+				return err
+			}
+			if err := shared.PrintOutput(result, *flags.output, *flags.pretty); err != nil {
+				return err
+			}
+			if result.Drift {
+				return shared.NewReportedError(fmt.Errorf("metadata plan: %d change(s) pending: %w", len(result.Changes), shared.ErrDrift))
+			}
+			return nil
+		},
+	}
+}
+
+// runMetadataPlan validates flags, loads the spec, fetches live state and
+// computes the plan.
+func runMetadataPlan(ctx context.Context, command string, flags planFlags) (*asc.MetadataPlanResult, []planStep, *liveState, error) {
+	dir := strings.TrimSpace(*flags.dir)
+	if dir == "" {
+		fmt.Fprintln(os.Stderr, "Error: --dir is required")
+		return nil, nil, nil, flag.ErrHelp
+	}
+	format, err := shared.NormalizeLocalizationFormat(*flags.format, "")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return nil, nil, nil, flag.ErrHelp
+	}
+
+	spec, err := loadMetadataSpec(dir, format)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("%s: %w", command, err)
+	}
+
+	versionID := strings.TrimSpace(*flags.versionID)
+	if spec.needsVersion() && versionID == "" {
+		fmt.Fprintln(os.Stderr, "Error: --version is required when the spec has version localizations, copyright or reviewDetails")
+		return nil, nil, nil, flag.ErrHelp
+	}
+	appID := shared.ResolveAppID(*flags.appID)
+	appInfoID := strings.TrimSpace(*flags.appInfoID)
+	if spec.needsAppInfo() && appID == "" && appInfoID == "" {
+		fmt.Fprintln(os.Stderr, "Error: --app is required when the spec has app info localizations, categories or ageRating")
+		return nil, nil, nil, flag.ErrHelp
+	}
+
+	client, err := shared.GetASCClient()
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("%s: %w", command, err)
+	}
+
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+
+	live := &liveState{}
+	if spec.needsVersion() {
+		live.versionID = versionID
+	}
+	if spec.needsAppInfo() {
+		live.appInfoID, err = shared.ResolveAppInfoID(requestCtx, client, appID, appInfoID)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("%s: %w", command, err)
+		}
+	}
+	if err := fetchLiveState(requestCtx, client, spec, live); err != nil {
+		return nil, nil, nil, fmt.Errorf("%s: %w", command, err)
+	}
+
+	steps, err := planSteps(spec, live)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("%s: %w", command, err)
+	}
+	return planResult(dir, live, steps), steps, live, nil
+}
+
+// fetchLiveState fills live with current values for the parts spec manages.
+func fetchLiveState(ctx context.Context, client *asc.Client, spec *metadataSpec, live *liveState) error {
+	if len(spec.versionLocalizations) > 0 {
+		items, err := shared.FetchVersionLocalizations(ctx, client, live.versionID)
+		if err != nil {
+			return err
+		}
+		live.versionLocalizations = shared.VersionLocalizationValues(items)
+		live.versionLocalizationIDs = make(map[string]string, len(items))
+		for _, item := range items {
+			live.versionLocalizationIDs[item.Attributes.Locale] = item.ID
+		}
+	}
+	if spec.Copyright != nil {
+		resp, err := client.GetAppStoreVersion(ctx, live.versionID)
+		if err != nil {
+			return fmt.Errorf("failed to fetch version: %w", err)
+		}
+		live.copyright = resp.Data.Attributes.Copyright
+	}
+	if spec.ReviewDetails != nil {
+		resp, err := client.GetAppStoreReviewDetailForVersion(ctx, live.versionID)
+		switch {
+		case err == nil:
+			live.reviewDetailID = resp.Data.ID
+			live.reviewDetails = reviewDetailValues(resp.Data.Attributes)
+		case !asc.IsNotFound(err):
+			return fmt.Errorf("failed to fetch review details: %w", err)
+		}
+	}
+
+	if len(spec.appInfoLocalizations) > 0 {
+		items, err := shared.FetchAppInfoLocalizations(ctx, client, live.appInfoID)
+		if err != nil {
+			return err
+		}
+		live.appInfoLocalizations = shared.AppInfoLocalizationValues(items)
+		live.appInfoLocalizationIDs = make(map[string]string, len(items))
+		for _, item := range items {
+			live.appInfoLocalizationIDs[item.Attributes.Locale] = item.ID
+		}
+	}
+	if spec.PrimaryCategory != nil || spec.SecondaryCategory != nil {
+		var err error
+		live.primaryCategory, err = shared.FetchAppInfoCategoryID(ctx, client.GetAppInfoPrimaryCategory, live.appInfoID)
+		if err != nil {
+			return fmt.Errorf("failed to fetch primary category: %w", err)
+		}
+		live.secondaryCategory, err = shared.FetchAppInfoCategoryID(ctx, client.GetAppInfoSecondaryCategory, live.appInfoID)
+		if err != nil {
+			return fmt.Errorf("failed to fetch secondary category: %w", err)
+		}
+	}
+	if spec.AgeRating != nil {
+		resp, err := client.GetAgeRatingDeclarationForAppInfo(ctx, live.appInfoID)
+		if err != nil {
+			return fmt.Errorf("failed to fetch age rating declaration: %w", err)
+		}
+		live.ageRatingID = resp.Data.ID
+		live.ageRating, err = attributeValues(resp.Data.Attributes)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// planSteps compares spec with live state and returns the requests needed to
+// converge, in the order apply runs them.
+func planSteps(spec *metadataSpec, live *liveState) ([]planStep, error) {
+	var steps []planStep
+	steps = append(steps, localizationSteps(resourceVersionLocalization, spec.versionLocalizations, live.versionLocalizations, live.versionLocalizationIDs)...)
+	steps = append(steps, localizationSteps(resourceAppInfoLocalization, spec.appInfoLocalizations, live.appInfoLocalizations, live.appInfoLocalizationIDs)...)
+
+	if spec.Copyright != nil {
+		desired := map[string]any{specFieldCopyright: *spec.Copyright}
+		current := map[string]any{specFieldCopyright: live.copyright}
+		steps = appendStep(steps, "PATCH", resourceVersion, "", live.versionID, desired, current)
+	}
+
+	categories := map[string]any{}
+	if spec.PrimaryCategory != nil {
+		categories[specFieldPrimaryCategory] = *spec.PrimaryCategory
+	}
+	if spec.SecondaryCategory != nil {
+		categories[specFieldSecondaryCategory] = *spec.SecondaryCategory
+	}
+	if len(categories) > 0 {
+		current := map[string]any{
+			specFieldPrimaryCategory:   live.primaryCategory,
+			specFieldSecondaryCategory: live.secondaryCategory,
+		}
+		steps = appendStep(steps, "PATCH", resourceAppInfo, "", live.appInfoID, categories, current)
+	}
+
+	if spec.AgeRating != nil {
+		desired, err := attributeValues(spec.AgeRating)
+		if err != nil {
+			return nil, err
+		}
+		steps = appendStep(steps, "PATCH", resourceAgeRating, "", live.ageRatingID, desired, live.ageRating)
+	}
+
+	if spec.ReviewDetails != nil {
+		desired, err := attributeValues(spec.ReviewDetails)
+		if err != nil {
+			return nil, err
+		}
+		if live.reviewDetailID == "" {
+			steps = appendStep(steps, "POST", resourceReviewDetails, "", "", desired, nil)
+		} else {
+			steps = appendStep(steps, "PATCH", resourceReviewDetails, "", live.reviewDetailID, desired, live.reviewDetails)
+		}
+	}
+	return steps, nil
+}
+
+// localizationSteps plans a POST for each new locale and a PATCH for each
+// existing locale with changed fields.
+func localizationSteps(resource string, desired, current map[string]map[string]string, ids map[string]string) []planStep {
+	locales := make([]string, 0, len(desired))
+	for locale := range desired {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+
+	var steps []planStep
+	for _, locale := range locales {
+		desiredValues := stringValues(desired[locale])
+		id, exists := ids[locale]
+		if !exists {
+			steps = appendStep(steps, "POST", resource, locale, "", desiredValues, nil)
+			continue
+		}
+		steps = appendStep(steps, "PATCH", resource, locale, id, desiredValues, stringValues(current[locale]))
+	}
+	return steps
+}
+
+// appendStep adds a step for the desired fields that differ from current. A
+// nil current means the resource does not exist yet.
+func appendStep(steps []planStep, method, resource, locale, id string, desired, current map[string]any) []planStep {
+	action := "update"
+	if current == nil {
+		action = "create"
+	}
+
+	keys := make([]string, 0, len(desired))
+	for key := range desired {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	step := planStep{desired: make(map[string]any)}
+	for _, key := range keys {
+		desiredValue := formatPlanValue(desired[key])
+		currentValue := ""
+		if value, ok := current[key]; ok {
+			currentValue = formatPlanValue(value)
+		}
+		if current != nil && desiredValue == currentValue {
+			continue
+		}
+		step.desired[key] = desired[key]
+		if sensitiveFields[key] {
+			desiredValue = sensitiveValue
+			if currentValue != "" {
+				currentValue = sensitiveValue
+			}
+		}
+		step.changes = append(step.changes, asc.MetadataPlanChange{
+			Resource: resource,
+			Locale:   locale,
+			Field:    key,
+			Action:   action,
+			Current:  currentValue,
+			Desired:  desiredValue,
+		})
+	}
+	if len(step.changes) == 0 {
+		return steps
+	}
+	step.operation = asc.MetadataPlanOperation{
+		Method:   method,
+		Resource: resource,
+		Locale:   locale,
+		ID:       id,
+		Fields:   len(step.changes),
+	}
+	return append(steps, step)
+}
+
+func planResult(dir string, live *liveState, steps []planStep) *asc.MetadataPlanResult {
+	result := &asc.MetadataPlanResult{
+		Dir:        dir,
+		VersionID:  live.versionID,
+		AppInfoID:  live.appInfoID,
+		Changes:    []asc.MetadataPlanChange{},
+		Operations: []asc.MetadataPlanOperation{},
+	}
+	for _, step := range steps {
+		result.Changes = append(result.Changes, step.changes...)
+		result.Operations = append(result.Operations, step.operation)
+		if step.operation.Method == "POST" {
+			result.Creates++
+		} else {
+			result.Updates++
+		}
+	}
+	result.Drift = len(steps) > 0
+	return result
+}
+
+// attributeValues flattens API attributes into their JSON field values,
+// omitting unset optional fields.
+func attributeValues(attributes any) (map[string]any, error) {
+	data, err := json.Marshal(attributes)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]any)
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// decodeAttributes converts planned field values back into API attributes.
+func decodeAttributes(values map[string]any, attributes any) error {
+	data, err := json.Marshal(values)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, attributes)
+}
+
+// reviewDetailValues lists every review detail field, including empty ones,
+// because the API omits empty values from responses.
+func reviewDetailValues(attributes asc.AppStoreReviewDetailAttributes) map[string]any {
+	return map[string]any{
+		"contactFirstName":    attributes.ContactFirstName,
+		"contactLastName":     attributes.ContactLastName,
+		"contactPhone":        attributes.ContactPhone,
+		"contactEmail":        attributes.ContactEmail,
+		"demoAccountName":     attributes.DemoAccountName,
+		"demoAccountPassword": attributes.DemoAccountPassword,
+		"demoAccountRequired": attributes.DemoAccountRequired,
+		"notes":               attributes.Notes,
+	}
+}
+
+func stringValues(values map[string]string) map[string]any {
+	result := make(map[string]any, len(values))
+	for key, value := range values {
+		result[key] = value
+	}
+	return result
+}
+
+func formatPlanValue(value any) string {
+	switch typed := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return typed
+	case bool:
+		return strconv.FormatBool(typed)
+	default:
+		return fmt.Sprint(typed)
+	}
+}
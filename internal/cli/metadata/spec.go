@@ -0,0 +1,131 @@
+package metadata
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	specSettingsFile            = "metadata.json"
+	specVersionLocalizationsDir = "version-localizations"
+	specAppInfoLocalizationsDir = "app-info-localizations"
+	resourceVersionLocalization = "version-localization"
+	resourceAppInfoLocalization = "app-info-localization"
+	resourceVersion             = "version"
+	resourceAppInfo             = "app-info"
+	resourceAgeRating           = "age-rating"
+	resourceReviewDetails       = "review-details"
+	specFieldCopyright          = "copyright"
+	specFieldPrimaryCategory    = "primaryCategory"
+	specFieldSecondaryCategory  = "secondaryCategory"
+)
+
+// metadataSpec is the desired state read from a spec directory. Nil fields
+// and absent localization keys are left unmanaged.
+type metadataSpec struct {
+	Copyright         *string                                   `json:"copyright,omitempty"`
+	PrimaryCategory   *string                                   `json:"primaryCategory,omitempty"`
+	SecondaryCategory *string                                   `json:"secondaryCategory,omitempty"`
+	AgeRating         *asc.AgeRatingDeclarationAttributes       `json:"ageRating,omitempty"`
+	ReviewDetails     *asc.AppStoreReviewDetailUpdateAttributes `json:"reviewDetails,omitempty"`
+
+	versionLocalizations map[string]map[string]string
+	appInfoLocalizations map[string]map[string]string
+}
+
+// needsVersion reports whether the spec manages version-scoped resources.
+func (s *metadataSpec) needsVersion() bool {
+	return len(s.versionLocalizations) > 0 || s.Copyright != nil || s.ReviewDetails != nil
+}
+
+// needsAppInfo reports whether the spec manages app info-scoped resources.
+func (s *metadataSpec) needsAppInfo() bool {
+	return len(s.appInfoLocalizations) > 0 || s.PrimaryCategory != nil || s.SecondaryCategory != nil || s.AgeRating != nil
+}
+
+// loadMetadataSpec reads metadata.json and the localization directories from
+// dir. Every part is optional, but the spec must manage something.
+func loadMetadataSpec(dir, format string) (*metadataSpec, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("%q is not a directory", dir)
+	}
+
+	spec := &metadataSpec{}
+	settingsPath := filepath.Join(dir, specSettingsFile)
+	data, err := os.ReadFile(settingsPath)
+	switch {
+	case err == nil:
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(spec); err != nil {
+			return nil, fmt.Errorf("parse %s: %w", settingsPath, err)
+		}
+	case !os.IsNotExist(err):
+		return nil, err
+	}
+
+	if spec.PrimaryCategory != nil && strings.TrimSpace(*spec.PrimaryCategory) == "" {
+		return nil, fmt.Errorf("parse %s: primaryCategory cannot be empty", settingsPath)
+	}
+	if spec.SecondaryCategory != nil {
+		secondary := strings.TrimSpace(*spec.SecondaryCategory)
+		spec.SecondaryCategory = &secondary
+	}
+
+	spec.versionLocalizations, err = readSpecLocalizations(filepath.Join(dir, specVersionLocalizationsDir), format)
+	if err != nil {
+		return nil, err
+	}
+	if err := shared.ValidateVersionLocalizationKeys(spec.versionLocalizations); err != nil {
+		return nil, fmt.Errorf("%s: %w", specVersionLocalizationsDir, err)
+	}
+	spec.appInfoLocalizations, err = readSpecLocalizations(filepath.Join(dir, specAppInfoLocalizationsDir), format)
+	if err != nil {
+		return nil, err
+	}
+	if err := shared.ValidateAppInfoLocalizationKeys(spec.appInfoLocalizations); err != nil {
+		return nil, fmt.Errorf("%s: %w", specAppInfoLocalizationsDir, err)
+	}
+
+	if !spec.needsVersion() && !spec.needsAppInfo() {
+		return nil, fmt.Errorf("no metadata found in %q (expected %s, %s/ or %s/)", dir, specSettingsFile, specVersionLocalizationsDir, specAppInfoLocalizationsDir)
+	}
+	return spec, nil
+}
+
+// readSpecLocalizations reads a localization directory, dropping empty values
+// because App Store Connect cannot clear fields through these endpoints.
+func readSpecLocalizations(dir, format string) (map[string]map[string]string, error) {
+	if _, err := os.Stat(dir); err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	values, err := shared.ReadLocalizationFiles(dir, format, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filepath.Base(dir), err)
+	}
+	for locale, fields := range values {
+		for key, value := range fields {
+			if strings.TrimSpace(value) == "" {
+				delete(fields, key)
+			}
+		}
+		if len(fields) == 0 {
+			delete(values, locale)
+		}
+	}
+	return values, nil
+}
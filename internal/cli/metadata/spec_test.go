@@ -0,0 +1,65 @@
+package metadata
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+func writeSpecFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatalf("MkdirAll() error: %v", err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+}
+
+func TestLoadMetadataSpec_DropsEmptyValues(t *testing.T) {
+	dir := t.TempDir()
+	writeSpecFile(t, dir, "app-info-localizations/localizations.json", `{"en-US": {"name": "Photo Sorter", "subtitle": ""}, "de-DE": {"subtitle": " "}}`)
+
+	spec, err := loadMetadataSpec(dir, shared.LocalizationFormatJSON)
+	if err != nil {
+		t.Fatalf("loadMetadataSpec() error: %v", err)
+	}
+	if spec.needsVersion() || !spec.needsAppInfo() {
+		t.Fatalf("expected an app info-only spec")
+	}
+	if len(spec.appInfoLocalizations) != 1 || len(spec.appInfoLocalizations["en-US"]) != 1 {
+		t.Fatalf("unexpected localizations: %v", spec.appInfoLocalizations)
+	}
+}
+
+func TestLoadMetadataSpec_Errors(t *testing.T) {
+	tests := map[string]struct {
+		files map[string]string
+		want  string
+	}{
+		"empty":         {want: "no metadata found"},
+		"unknown field": {files: map[string]string{"metadata.json": `{"copyrite": "2026"}`}, want: `unknown field "copyrite"`},
+		"empty primary category": {
+			files: map[string]string{"metadata.json": `{"primaryCategory": " "}`},
+			want:  "primaryCategory cannot be empty",
+		},
+		"bad key": {
+			files: map[string]string{"version-localizations/en-US.strings": `"name" = "Photo Sorter";`},
+			want:  `unsupported keys for locale "en-US": name`,
+		},
+	}
+	for name, test := range tests {
+		dir := t.TempDir()
+		for file, content := range test.files {
+			writeSpecFile(t, dir, file, content)
+		}
+		_, err := loadMetadataSpec(dir, shared.LocalizationFormatStrings)
+		if err == nil || !strings.Contains(err.Error(), test.want) {
+			t.Fatalf("%s: expected error containing %q, got %v", name, test.want, err)
+		}
+	}
+}
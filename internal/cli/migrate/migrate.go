@@ -50,7 +50,9 @@ func MigrateImportCommand() *ffcli.Command {
 	versionID := fs.String("version-id", "", "App Store version ID (required)")
 	fastlaneDir := fs.String("fastlane-dir", "", "Path to fastlane directory (required)")
 	dryRun := fs.Bool("dry-run", false, "Preview changes without uploading")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	skipScreenshots := fs.Bool("skip-screenshots", false, "Skip the screenshots/ directory (screenshots and app previews)")
+	replaceScreenshots := fs.Bool("replace-screenshots", false, "Replace each imported set: keep unchanged files, upload the rest and delete remote-only assets (default: append)")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -73,12 +75,28 @@ Reads from the standard fastlane structure:
   │   │   └── marketing_url.txt  (Version)
   │   └── de-DE/
   │       └── ...
+  └── screenshots/
+      ├── en-US/
+      │   ├── APP_IPHONE_65_01_home.png  (display type from prefix)
+      │   ├── iPhone 15 Pro Max-02.png   (display type from image size)
+      │   ├── IPHONE_65_01_demo.mov      (app preview; prefix required)
+      │   └── iMessage/
+      │       └── ...                    (iMessage screenshots)
+      └── de-DE/
+          └── ...
 
 Note: privacy_url.txt is not supported (app-level, not localized).
 
+Screenshots are grouped into sets by display type and uploaded in file name
+order. By default they are appended to existing sets; --replace-screenshots
+makes each set match the local files, skipping files whose checksum matches a
+remote asset and deleting remote-only assets after the uploads succeed.
+
 Examples:
   asc migrate import --app "APP_ID" --version-id "VERSION_ID" --fastlane-dir ./fastlane
-  asc migrate import --app "APP_ID" --version-id "VERSION_ID" --fastlane-dir ./fastlane --dry-run`,
+  asc migrate import --app "APP_ID" --version-id "VERSION_ID" --fastlane-dir ./fastlane --dry-run
+  asc migrate import --app "APP_ID" --version-id "VERSION_ID" --fastlane-dir ./fastlane --replace-screenshots
+  asc migrate import --app "APP_ID" --version-id "VERSION_ID" --fastlane-dir ./fastlane --skip-screenshots`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -117,12 +135,21 @@ Examples:
 				return fmt.Errorf("migrate import: %w", err)
 			}
 
+			var assetSets []FastlaneAssetSet
+			if !*skipScreenshots {
+				assetSets, err = readFastlaneScreenshots(filepath.Join(*fastlaneDir, "screenshots"))
+				if err != nil {
+					return fmt.Errorf("migrate import: %w", err)
+				}
+			}
+
 			if *dryRun {
 				result := &MigrateImportResult{
 					DryRun:               true,
 					VersionID:            strings.TrimSpace(*versionID),
 					Localizations:        localizations,
 					AppInfoLocalizations: appInfoLocs,
+					AssetSets:            assetSets,
 				}
 				return printMigrateOutput(result, *output, *pretty)
 			}
@@ -169,10 +196,11 @@ Examples:
 					}
 				} else {
 					// Create new localization
-					_, err := client.CreateAppStoreVersionLocalization(requestCtx, strings.TrimSpace(*versionID), attrs)
+					created, err := client.CreateAppStoreVersionLocalization(requestCtx, strings.TrimSpace(*versionID), attrs)
 					if err != nil {
 						return fmt.Errorf("migrate import: failed to create %s: %w", loc.Locale, err)
 					}
+					localeToID[loc.Locale] = created.Data.ID
 				}
 
 				uploaded = append(uploaded, LocalizationUploadItem{
@@ -243,6 +271,18 @@ Examples:
 				}
 			}
 
+			// Upload screenshot and preview sets
+			for i := range assetSets {
+				set := &assetSets[i]
+				localizationID, exists := localeToID[set.Locale]
+				if !exists {
+					return fmt.Errorf("migrate import: no version localization for screenshots locale %q (add metadata/%s)", set.Locale, set.Locale)
+				}
+				if err := importFastlaneAssetSet(ctx, client, localizationID, set, *replaceScreenshots); err != nil {
+					return fmt.Errorf("migrate import: failed to upload %s %s for %s: %w", set.DisplayType, set.Kind, set.Locale, err)
+				}
+			}
+
 			result := &MigrateImportResult{
 				DryRun:               false,
 				VersionID:            strings.TrimSpace(*versionID),
@@ -250,6 +290,7 @@ Examples:
 				AppInfoLocalizations: appInfoLocs,
 				Uploaded:             uploaded,
 				AppInfoUploaded:      appInfoUploaded,
+				AssetSets:            assetSets,
 			}
 
 			return printMigrateOutput(result, *output, *pretty)
@@ -264,7 +305,9 @@ func MigrateExportCommand() *ffcli.Command {
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
 	versionID := fs.String("version-id", "", "App Store version ID (required)")
 	outputDir := fs.String("output-dir", "", "Output directory for fastlane structure (required)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	skipScreenshots := fs.Bool("skip-screenshots", false, "Skip downloading screenshots and app previews")
+	overwrite := fs.Bool("overwrite", false, "Replace screenshot and preview files that already exist in --output-dir")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -275,8 +318,16 @@ func MigrateExportCommand() *ffcli.Command {
 
 Creates the standard fastlane structure with all localizations.
 
+Screenshots and app previews are downloaded to screenshots/<locale>/ (iMessage
+screenshots to screenshots/<locale>/iMessage/) as
+<DISPLAY_TYPE>_<NN>_<file name>, so "asc migrate import" restores the same sets
+in the same order. Existing screenshot and preview files are not replaced
+unless --overwrite is set.
+
 Examples:
-  asc migrate export --app "APP_ID" --version-id "VERSION_ID" --output-dir ./fastlane`,
+  asc migrate export --app "APP_ID" --version-id "VERSION_ID" --output-dir ./fastlane
+  asc migrate export --app "APP_ID" --version-id "VERSION_ID" --output-dir ./fastlane --overwrite
+  asc migrate export --app "APP_ID" --version-id "VERSION_ID" --output-dir ./fastlane --skip-screenshots`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -336,6 +387,19 @@ Examples:
 				exported = append(exported, locale)
 			}
 
+			// Download screenshot and preview sets
+			var assetSets []FastlaneAssetSet
+			if !*skipScreenshots {
+				screenshotsDir := filepath.Join(*outputDir, "screenshots")
+				for _, loc := range resp.Data {
+					sets, err := exportFastlaneScreenshots(ctx, client, screenshotsDir, loc.Attributes.Locale, loc.ID, *overwrite)
+					if err != nil {
+						return fmt.Errorf("migrate export: %w", err)
+					}
+					assetSets = append(assetSets, sets...)
+				}
+			}
+
 			// Export App Info localizations (name, subtitle)
 			appInfos, err := client.GetAppInfos(requestCtx, resolvedAppID)
 			if err == nil && len(appInfos.Data) > 0 {
@@ -362,6 +426,7 @@ Examples:
 				OutputDir:  *outputDir,
 				Locales:    exported,
 				TotalFiles: totalFiles,
+				AssetSets:  assetSets,
 			}
 
 			return printMigrateOutput(result, *output, *pretty)
@@ -401,14 +466,16 @@ type MigrateImportResult struct {
 	AppInfoLocalizations []AppInfoFastlaneLocalization `json:"appInfoLocalizations,omitempty"`
 	Uploaded             []LocalizationUploadItem      `json:"uploaded,omitempty"`
 	AppInfoUploaded      []LocalizationUploadItem      `json:"appInfoUploaded,omitempty"`
+	AssetSets            []FastlaneAssetSet            `json:"assetSets,omitempty"`
 }
 
 // MigrateExportResult is the result of a migrate export operation.
 type MigrateExportResult struct {
-	VersionID  string   `json:"versionId"`
-	OutputDir  string   `json:"outputDir"`
-	Locales    []string `json:"locales"`
-	TotalFiles int      `json:"totalFiles"`
+	VersionID  string             `json:"versionId"`
+	OutputDir  string             `json:"outputDir"`
+	Locales    []string           `json:"locales"`
+	TotalFiles int                `json:"totalFiles"`
+	AssetSets  []FastlaneAssetSet `json:"assetSets,omitempty"`
 }
 
 // readFastlaneMetadata reads metadata from a fastlane metadata directory.
@@ -505,11 +572,10 @@ func writeAndCount(path, content string) int {
 func printMigrateOutput(data interface{}, format string, pretty bool) error {
 	format = strings.ToLower(format)
 
-	if format == "json" {
-		if pretty {
-			return asc.PrintPrettyJSON(data)
-		}
-		return asc.PrintJSON(data)
+	// --fields and --query reshape the result, so the migrate tables no
+	// longer apply.
+	if format == "json" || format == "yaml" || format == "yml" || format == "ndjson" || shared.ProjectionRequested() {
+		return shared.PrintOutput(data, format, pretty)
 	}
 
 	switch v := data.(type) {
@@ -535,7 +601,7 @@ func printMigrateOutput(data interface{}, format string, pretty bool) error {
 			return printMigrateValidateResultTable(v)
 		}
 	default:
-		return asc.PrintJSON(data)
+		return shared.PrintOutput(data, "json", false)
 	}
 
 	return fmt.Errorf("unsupported format: %s", format)
@@ -595,7 +661,7 @@ func MigrateValidateCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("migrate validate", flag.ExitOnError)
 
 	fastlaneDir := fs.String("fastlane-dir", "", "Path to fastlane directory (required)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
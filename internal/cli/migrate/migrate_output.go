@@ -46,6 +46,14 @@ func printMigrateImportResultMarkdown(result *MigrateImportResult) error {
 		asc.RenderMarkdown(headers, rows)
 	}
 
+	if len(result.AssetSets) > 0 {
+		fmt.Println()
+		fmt.Println("### Screenshots and Previews")
+		fmt.Println()
+		headers, rows := assetSetRows(result.AssetSets, !result.DryRun)
+		asc.RenderMarkdown(headers, rows)
+	}
+
 	if len(result.Uploaded) > 0 {
 		fmt.Println()
 		fmt.Println("### Uploaded")
@@ -119,6 +127,13 @@ func printMigrateImportResultTable(result *MigrateImportResult) error {
 		asc.RenderTable(headers, rows)
 	}
 
+	if len(result.AssetSets) > 0 {
+		fmt.Println()
+		fmt.Println("Screenshots and Previews:")
+		headers, rows := assetSetRows(result.AssetSets, !result.DryRun)
+		asc.RenderTable(headers, rows)
+	}
+
 	return nil
 }
 
@@ -131,6 +146,13 @@ func printMigrateExportResultMarkdown(result *MigrateExportResult) error {
 		fmt.Printf("- %s\n", locale)
 	}
 	fmt.Printf("\n**Total Files:** %d\n", result.TotalFiles)
+	if len(result.AssetSets) > 0 {
+		fmt.Println()
+		fmt.Println("### Screenshots and Previews")
+		fmt.Println()
+		headers, rows := assetSetRows(result.AssetSets, false)
+		asc.RenderMarkdown(headers, rows)
+	}
 	return nil
 }
 
@@ -144,9 +166,32 @@ func printMigrateExportResultTable(result *MigrateExportResult) error {
 	}
 	asc.RenderTable(headers, rows)
 	fmt.Printf("\nTotal Files: %d\n", result.TotalFiles)
+	if len(result.AssetSets) > 0 {
+		fmt.Println()
+		headers, rows := assetSetRows(result.AssetSets, false)
+		asc.RenderTable(headers, rows)
+	}
 	return nil
 }
 
+// assetSetRows renders one row per screenshot/preview set; uploaded adds the
+// upload, skip and deletion counts of an import.
+func assetSetRows(sets []FastlaneAssetSet, uploaded bool) ([]string, [][]string) {
+	headers := []string{"Locale", "Kind", "Display Type", "Files"}
+	if uploaded {
+		headers = append(headers, "Uploaded", "Skipped", "Deleted")
+	}
+	rows := make([][]string, 0, len(sets))
+	for _, set := range sets {
+		row := []string{set.Locale, set.Kind, set.DisplayType, fmt.Sprintf("%d", len(set.Files))}
+		if uploaded {
+			row = append(row, fmt.Sprintf("%d", set.Uploaded), fmt.Sprintf("%d", set.Skipped), fmt.Sprintf("%d", set.Deleted))
+		}
+		rows = append(rows, row)
+	}
+	return headers, rows
+}
+
 func printMigrateValidateResultMarkdown(result *MigrateValidateResult) error {
 	fmt.Printf("**Fastlane Directory:** %s\n\n", result.FastlaneDir)
 
@@ -0,0 +1,299 @@
+package migrate
+
+import (
+	"context"
+	"fmt"
+	"image"
+	_ "image/jpeg" // register JPEG decoding for dimension detection
+	_ "image/png"  // register PNG decoding for dimension detection
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// Asset set kinds in the fastlane screenshots tree.
+const (
+	assetKindScreenshots = "screenshots"
+	assetKindPreviews    = "previews"
+)
+
+// fastlaneIMessageDir is the per-locale subdirectory fastlane uses for iMessage screenshots.
+const fastlaneIMessageDir = "iMessage"
+
+// FastlaneAssetSet is a screenshot or preview set in the fastlane screenshots tree.
+type FastlaneAssetSet struct {
+	Locale      string   `json:"locale"`
+	Kind        string   `json:"kind"`
+	DisplayType string   `json:"displayType"`
+	Files       []string `json:"files"`
+	Uploaded    int      `json:"uploaded,omitempty"`
+	Skipped     int      `json:"skipped,omitempty"`
+	Deleted     int      `json:"deleted,omitempty"`
+}
+
+// readFastlaneScreenshots reads screenshots/<locale>/ (and its iMessage
+// subdirectory) into asset sets. Files are ordered by name within each set.
+// A missing screenshots directory yields no sets.
+func readFastlaneScreenshots(screenshotsDir string) ([]FastlaneAssetSet, error) {
+	entries, err := os.ReadDir(screenshotsDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read screenshots directory: %w", err)
+	}
+
+	var sets []FastlaneAssetSet
+	for _, entry := range entries {
+		if !entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		locale := entry.Name()
+		localeDir := filepath.Join(screenshotsDir, locale)
+
+		localeSets := make(map[string]*FastlaneAssetSet)
+		var order []string
+		add := func(kind, displayType, path string) {
+			key := kind + "/" + displayType
+			set, ok := localeSets[key]
+			if !ok {
+				set = &FastlaneAssetSet{Locale: locale, Kind: kind, DisplayType: displayType}
+				localeSets[key] = set
+				order = append(order, key)
+			}
+			set.Files = append(set.Files, path)
+		}
+
+		for _, dir := range []string{localeDir, filepath.Join(localeDir, fastlaneIMessageDir)} {
+			iMessage := dir != localeDir
+			files, err := os.ReadDir(dir)
+			if err != nil {
+				if iMessage && os.IsNotExist(err) {
+					continue
+				}
+				return nil, fmt.Errorf("failed to read %s: %w", dir, err)
+			}
+			for _, file := range files {
+				name := file.Name()
+				if !file.Type().IsRegular() || strings.HasPrefix(name, ".") {
+					continue
+				}
+				path := filepath.Join(dir, name)
+				ext := strings.ToLower(filepath.Ext(name))
+				switch {
+				case shared.ScreenshotFileExtensions[ext]:
+					displayType, err := screenshotDisplayTypeForFile(path, iMessage)
+					if err != nil {
+						return nil, err
+					}
+					add(assetKindScreenshots, displayType, path)
+				case shared.PreviewFileExtensions[ext] && !iMessage:
+					previewType := shared.PreviewTypeFromName(name)
+					if previewType == "" {
+						return nil, fmt.Errorf("cannot infer preview type for %s; prefix the file name with a preview type such as IPHONE_65_", path)
+					}
+					add(assetKindPreviews, previewType, path)
+				}
+			}
+		}
+
+		for _, key := range order {
+			set := localeSets[key]
+			sort.Strings(set.Files)
+			sets = append(sets, *set)
+		}
+	}
+
+	sort.SliceStable(sets, func(i, j int) bool {
+		if sets[i].Locale != sets[j].Locale {
+			return sets[i].Locale < sets[j].Locale
+		}
+		if sets[i].Kind != sets[j].Kind {
+			return sets[i].Kind > sets[j].Kind // screenshots before previews
+		}
+		return sets[i].DisplayType < sets[j].DisplayType
+	})
+	return sets, nil
+}
+
+// screenshotDisplayTypeForFile infers the display type from a file name prefix
+// (e.g. APP_IPHONE_65_01.png or IPHONE_65-home.png), then from the image size.
+func screenshotDisplayTypeForFile(path string, iMessage bool) (string, error) {
+	displayType := shared.ScreenshotDisplayTypeFromName(filepath.Base(path))
+	if displayType == "" {
+		width, height, err := imageDimensions(path)
+		if err != nil {
+			return "", fmt.Errorf("read %s: %w", path, err)
+		}
+		displayType = asc.ScreenshotDisplayTypeForSize(width, height)
+		if displayType == "" {
+			return "", fmt.Errorf("cannot infer screenshot display type for %s (%dx%d); prefix the file name with a display type such as APP_IPHONE_65_", path, width, height)
+		}
+	}
+	if iMessage && !strings.HasPrefix(displayType, "IMESSAGE_") {
+		candidate := "IMESSAGE_" + displayType
+		if !asc.IsValidScreenshotDisplayType(candidate) {
+			return "", fmt.Errorf("%s: %s has no iMessage equivalent", path, displayType)
+		}
+		displayType = candidate
+	}
+	return displayType, nil
+}
+
+func imageDimensions(path string) (int, int, error) {
+	file, err := shared.OpenExistingNoFollow(path)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer file.Close()
+
+	config, _, err := image.DecodeConfig(file)
+	if err != nil {
+		return 0, 0, err
+	}
+	return config.Width, config.Height, nil
+}
+
+// importFastlaneAssetSet uploads one set. With replace, the remote set is
+// synced to the files instead: unchanged assets are kept, new ones uploaded,
+// and the rest deleted once the uploads succeed.
+func importFastlaneAssetSet(ctx context.Context, client *asc.Client, localizationID string, set *FastlaneAssetSet, replace bool) error {
+	uploadCtx, cancel := shared.ContextWithAssetUploadTimeout(ctx)
+	defer cancel()
+
+	if set.Kind == assetKindPreviews {
+		remote, err := shared.EnsurePreviewSet(uploadCtx, client, localizationID, set.DisplayType)
+		if err != nil {
+			return err
+		}
+		if replace {
+			results, _, err := shared.SyncPreviewSet(uploadCtx, client, remote.ID, set.Files, true)
+			if err != nil {
+				return err
+			}
+			set.countSyncResults(results)
+			return nil
+		}
+		for _, path := range set.Files {
+			if _, err := shared.UploadPreviewAsset(uploadCtx, client, remote.ID, path); err != nil {
+				return err
+			}
+			set.Uploaded++
+		}
+		return nil
+	}
+
+	remote, err := shared.EnsureScreenshotSet(uploadCtx, client, localizationID, set.DisplayType)
+	if err != nil {
+		return err
+	}
+	if replace {
+		results, _, err := shared.SyncScreenshotSet(uploadCtx, client, remote.ID, set.Files, true)
+		if err != nil {
+			return err
+		}
+		set.countSyncResults(results)
+		return nil
+	}
+	for _, path := range set.Files {
+		if _, err := shared.UploadScreenshotAsset(uploadCtx, client, remote.ID, path); err != nil {
+			return err
+		}
+		set.Uploaded++
+	}
+	return nil
+}
+
+func (set *FastlaneAssetSet) countSyncResults(results []asc.AssetUploadResultItem) {
+	for _, item := range results {
+		switch item.Action {
+		case shared.AssetActionUploaded:
+			set.Uploaded++
+		case shared.AssetActionSkipped:
+			set.Skipped++
+		case shared.AssetActionDeleted:
+			set.Deleted++
+		}
+	}
+}
+
+// exportFastlaneScreenshots downloads every screenshot and preview set of a
+// localization into screenshots/<locale>/, naming files
+// <DISPLAY_TYPE>_<NN>_<original name> so a later import keeps type and order.
+// Existing files are only replaced when overwrite is set.
+func exportFastlaneScreenshots(ctx context.Context, client *asc.Client, screenshotsDir, locale, localizationID string, overwrite bool) ([]FastlaneAssetSet, error) {
+	downloadCtx, cancel := shared.ContextWithAssetUploadTimeout(ctx)
+	defer cancel()
+
+	localeDir := filepath.Join(screenshotsDir, locale)
+	var sets []FastlaneAssetSet
+
+	screenshotSets, err := client.GetAppScreenshotSets(downloadCtx, localizationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch screenshot sets for %s: %w", locale, err)
+	}
+	for _, remote := range screenshotSets.Data {
+		displayType := remote.Attributes.ScreenshotDisplayType
+		dir := localeDir
+		if strings.HasPrefix(displayType, "IMESSAGE_") {
+			dir = filepath.Join(localeDir, fastlaneIMessageDir)
+		}
+		screenshots, err := client.GetAppScreenshots(downloadCtx, remote.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch screenshots for set %s: %w", remote.ID, err)
+		}
+		set := FastlaneAssetSet{Locale: locale, Kind: assetKindScreenshots, DisplayType: displayType}
+		for i, screenshot := range screenshots.Data {
+			url := shared.ScreenshotDownloadURL(screenshot.Attributes)
+			if url == "" {
+				continue
+			}
+			path := filepath.Join(dir, exportedAssetName(displayType, i, screenshot.Attributes.FileName, ".png"))
+			if err := shared.DownloadAssetFile(downloadCtx, client, url, path, overwrite); err != nil {
+				return nil, fmt.Errorf("failed to download screenshot %s: %w", screenshot.ID, err)
+			}
+			set.Files = append(set.Files, path)
+		}
+		if len(set.Files) > 0 {
+			sets = append(sets, set)
+		}
+	}
+
+	previewSets, err := client.GetAppPreviewSets(downloadCtx, localizationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch preview sets for %s: %w", locale, err)
+	}
+	for _, remote := range previewSets.Data {
+		previewType := remote.Attributes.PreviewType
+		previews, err := client.GetAppPreviews(downloadCtx, remote.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch previews for set %s: %w", remote.ID, err)
+		}
+		set := FastlaneAssetSet{Locale: locale, Kind: assetKindPreviews, DisplayType: previewType}
+		for i, preview := range previews.Data {
+			if preview.Attributes.VideoURL == "" {
+				continue
+			}
+			path := filepath.Join(localeDir, exportedAssetName(previewType, i, preview.Attributes.FileName, ".mov"))
+			if err := shared.DownloadAssetFile(downloadCtx, client, preview.Attributes.VideoURL, path, overwrite); err != nil {
+				return nil, fmt.Errorf("failed to download preview %s: %w", preview.ID, err)
+			}
+			set.Files = append(set.Files, path)
+		}
+		if len(set.Files) > 0 {
+			sets = append(sets, set)
+		}
+	}
+
+	return sets, nil
+}
+
+// exportedAssetName builds <DISPLAY_TYPE>_<NN>_<name>, keeping the original
+// file name (minus any directory) when the API reports one.
+func exportedAssetName(displayType string, index int, fileName, defaultExt string) string {
+	return fmt.Sprintf("%s_%02d_%s", displayType, index+1, shared.AssetFileName(fileName, defaultExt))
+}
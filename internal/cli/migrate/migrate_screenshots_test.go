@@ -0,0 +1,130 @@
+package migrate
+
+import (
+	"image"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+func writeTestPNG(t *testing.T, path string, width, height int) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatalf("MkdirAll() error: %v", err)
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("Create() error: %v", err)
+	}
+	defer file.Close()
+	if err := png.Encode(file, image.NewRGBA(image.Rect(0, 0, width, height))); err != nil {
+		t.Fatalf("png.Encode() error: %v", err)
+	}
+}
+
+func TestReadFastlaneScreenshots_GroupsAndOrders(t *testing.T) {
+	dir := t.TempDir()
+	enUS := filepath.Join(dir, "en-US")
+	writeTestPNG(t, filepath.Join(enUS, "b-second.png"), 1242, 2688)
+	writeTestPNG(t, filepath.Join(enUS, "a-first.png"), 2688, 1242) // landscape
+	writeTestPNG(t, filepath.Join(enUS, "APP_IPHONE_67_01.png"), 10, 10)
+	writeTestPNG(t, filepath.Join(enUS, fastlaneIMessageDir, "chat.png"), 1242, 2688)
+	if err := os.WriteFile(filepath.Join(enUS, "IPHONE_65_01_demo.mov"), []byte("video"), 0o644); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(enUS, ".DS_Store"), []byte("x"), 0o644); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "screenshots.html"), []byte("x"), 0o644); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+	writeTestPNG(t, filepath.Join(dir, "de-DE", "shot.png"), 2048, 2732)
+
+	sets, err := readFastlaneScreenshots(dir)
+	if err != nil {
+		t.Fatalf("readFastlaneScreenshots() error: %v", err)
+	}
+
+	type summary struct {
+		locale, kind, displayType string
+		files                     []string
+	}
+	want := []summary{
+		{"de-DE", assetKindScreenshots, "APP_IPAD_PRO_3GEN_129", []string{"shot.png"}},
+		{"en-US", assetKindScreenshots, "APP_IPHONE_65", []string{"a-first.png", "b-second.png"}},
+		{"en-US", assetKindScreenshots, "APP_IPHONE_67", []string{"APP_IPHONE_67_01.png"}},
+		{"en-US", assetKindScreenshots, "IMESSAGE_APP_IPHONE_65", []string{"chat.png"}},
+		{"en-US", assetKindPreviews, "IPHONE_65", []string{"IPHONE_65_01_demo.mov"}},
+	}
+	if len(sets) != len(want) {
+		t.Fatalf("expected %d sets, got %+v", len(want), sets)
+	}
+	for i, set := range sets {
+		if set.Locale != want[i].locale || set.Kind != want[i].kind || set.DisplayType != want[i].displayType {
+			t.Fatalf("set %d: got %s/%s/%s, want %+v", i, set.Locale, set.Kind, set.DisplayType, want[i])
+		}
+		if len(set.Files) != len(want[i].files) {
+			t.Fatalf("set %d: got files %v, want %v", i, set.Files, want[i].files)
+		}
+		for j, file := range set.Files {
+			if filepath.Base(file) != want[i].files[j] {
+				t.Fatalf("set %d: got files %v, want %v", i, set.Files, want[i].files)
+			}
+		}
+	}
+}
+
+func TestReadFastlaneScreenshots_MissingDirectory(t *testing.T) {
+	sets, err := readFastlaneScreenshots(filepath.Join(t.TempDir(), "screenshots"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(sets) != 0 {
+		t.Fatalf("expected no sets, got %+v", sets)
+	}
+}
+
+func TestReadFastlaneScreenshots_UnknownSize(t *testing.T) {
+	dir := t.TempDir()
+	writeTestPNG(t, filepath.Join(dir, "en-US", "odd.png"), 100, 200)
+
+	_, err := readFastlaneScreenshots(dir)
+	if err == nil || !strings.Contains(err.Error(), "cannot infer screenshot display type") {
+		t.Fatalf("expected inference error, got %v", err)
+	}
+}
+
+func TestReadFastlaneScreenshots_PreviewWithoutPrefix(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "en-US"), 0o755); err != nil {
+		t.Fatalf("MkdirAll() error: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "en-US", "demo.mov"), []byte("video"), 0o644); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+
+	_, err := readFastlaneScreenshots(dir)
+	if err == nil || !strings.Contains(err.Error(), "cannot infer preview type") {
+		t.Fatalf("expected preview type error, got %v", err)
+	}
+}
+
+func TestExportedAssetName(t *testing.T) {
+	if got := exportedAssetName("APP_IPHONE_65", 0, "home.png", ".png"); got != "APP_IPHONE_65_01_home.png" {
+		t.Fatalf("unexpected name %q", got)
+	}
+	if got := exportedAssetName("IPHONE_65", 9, "../../etc/demo", ".mov"); got != "IPHONE_65_10_demo.mov" {
+		t.Fatalf("unexpected name %q", got)
+	}
+	if got := exportedAssetName("APP_DESKTOP", 1, "", ".png"); got != "APP_DESKTOP_02_asset.png" {
+		t.Fatalf("unexpected name %q", got)
+	}
+	// Exported names must round-trip through import inference.
+	if got := shared.ScreenshotDisplayTypeFromName(exportedAssetName("APP_IPHONE_61", 0, "x.png", ".png")); got != "APP_IPHONE_61" {
+		t.Fatalf("round trip = %q", got)
+	}
+}
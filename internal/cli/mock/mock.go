@@ -0,0 +1,147 @@
+package mock
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/mockserver"
+)
+
+// MockCommand returns the mock command group.
+func MockCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("mock", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "mock",
+		ShortUsage: "asc mock <subcommand> [flags]",
+		ShortHelp:  "Run a local mock App Store Connect API.",
+		LongHelp: `Run a local mock App Store Connect API.
+
+Examples:
+  asc mock serve --dir ./fixtures
+  ASC_BASE_URL=http://127.0.0.1:8080 asc apps list`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			MockServeCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// MockServeCommand returns the mock serve subcommand.
+func MockServeCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+
+	dir := fs.String("dir", "", "Fixture directory (required)")
+	addr := fs.String("addr", "127.0.0.1:8080", "Listen address (loopback only)")
+	pageSize := fs.Int("page-size", 0, "Default page size for collections (0 = no paging unless limit is set)")
+	rateLimitEvery := fs.Int("rate-limit-every", 0, "Respond 429 to every Nth request (0 = disabled)")
+	retryAfter := fs.Duration("retry-after", time.Second, "Retry-After value for 429 responses")
+
+	return &ffcli.Command{
+		Name:       "serve",
+		ShortUsage: "asc mock serve --dir <fixtures> [flags]",
+		ShortHelp:  "Serve JSON:API fixtures as a mock App Store Connect API.",
+		LongHelp: `Serve JSON:API fixtures as a mock App Store Connect API.
+
+Fixtures are looked up by request path:
+  GET /v1/apps            -> <dir>/v1/apps.json
+  GET /v1/apps/123        -> <dir>/v1/apps/123.json (or the item in v1/apps.json)
+  GET /v1/apps/123/builds -> <dir>/v1/apps/123/builds.json
+
+Collections are paginated with links.next when --page-size or the limit
+query parameter is set. POST, PATCH and DELETE update an in-memory copy
+of the fixtures; relationship changes are accepted and ignored.
+
+Point the CLI at the server with --base-url, ASC_BASE_URL or base_url in
+config.json. Requests still need credentials, but any valid .p8 key works.
+
+Examples:
+  asc mock serve --dir ./fixtures
+  asc mock serve --dir ./fixtures --addr 127.0.0.1:0 --page-size 2
+  asc mock serve --dir ./fixtures --rate-limit-every 5 --retry-after 2s`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if strings.TrimSpace(*dir) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --dir is required")
+				return flag.ErrHelp
+			}
+			if *pageSize < 0 {
+				fmt.Fprintln(os.Stderr, "Error: --page-size must be >= 0")
+				return flag.ErrHelp
+			}
+			if *rateLimitEvery < 0 {
+				fmt.Fprintln(os.Stderr, "Error: --rate-limit-every must be >= 0")
+				return flag.ErrHelp
+			}
+
+			host, _, err := net.SplitHostPort(strings.TrimSpace(*addr))
+			if err != nil {
+				return fmt.Errorf("mock serve: invalid --addr: %w", err)
+			}
+			if !asc.IsLoopbackHost(host) {
+				return fmt.Errorf("mock serve: --addr must be a loopback address")
+			}
+
+			server, err := mockserver.New(mockserver.Options{
+				Dir:            *dir,
+				PageSize:       *pageSize,
+				RateLimitEvery: *rateLimitEvery,
+				RetryAfter:     *retryAfter,
+			})
+			if err != nil {
+				return fmt.Errorf("mock serve: %w", err)
+			}
+
+			listener, err := net.Listen("tcp", strings.TrimSpace(*addr))
+			if err != nil {
+				return fmt.Errorf("mock serve: %w", err)
+			}
+
+			httpServer := &http.Server{
+				Handler:           server,
+				ReadHeaderTimeout: 10 * time.Second,
+			}
+
+			fmt.Fprintf(os.Stderr, "Serving mock App Store Connect API on http://%s\n", listener.Addr().String())
+			fmt.Fprintf(os.Stderr, "Use: ASC_BASE_URL=http://%s asc <command>\n", listener.Addr().String())
+
+			serveCtx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			errCh := make(chan error, 1)
+			go func() {
+				errCh <- httpServer.Serve(listener)
+			}()
+
+			select {
+			case err := <-errCh:
+				if err != nil && !errors.Is(err, http.ErrServerClosed) {
+					return fmt.Errorf("mock serve: %w", err)
+				}
+				return nil
+			case <-serveCtx.Done():
+				shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancel()
+				return httpServer.Shutdown(shutdownCtx)
+			}
+		},
+	}
+}
@@ -50,7 +50,7 @@ func submitCommand() *ffcli.Command {
 	wait := fs.Bool("wait", false, "Wait for notarization to complete")
 	pollInterval := fs.String("poll-interval", "15s", "Polling interval when using --wait")
 	timeout := fs.String("timeout", "30m", "Timeout when using --wait")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -231,7 +231,7 @@ func statusCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("notarization status", flag.ExitOnError)
 
 	submissionID := fs.String("id", "", "Submission ID (required)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -277,7 +277,7 @@ func logCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("notarization log", flag.ExitOnError)
 
 	submissionID := fs.String("id", "", "Submission ID (required)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -324,7 +324,7 @@ func listCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("notarization list", flag.ExitOnError)
 
 	limit := fs.Int("limit", 0, "Maximum number of results to display (0 = all)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -2,15 +2,11 @@ package preorders
 
 import (
 	"context"
-	"encoding/base64"
-	"encoding/json"
 	"flag"
 	"path/filepath"
 	"testing"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
-
-	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
 )
 
 func TestPreOrdersGetCommand_MissingApp(t *testing.T) {
@@ -217,58 +213,3 @@ func TestPreOrdersCommand_DefaultOutputJSON(t *testing.T) {
 		})
 	}
 }
-
-func TestMapTerritoryAvailabilityIDs(t *testing.T) {
-	relationships := asc.TerritoryAvailabilityRelationships{
-		Territory: asc.Relationship{
-			Data: asc.ResourceData{
-				Type: asc.ResourceTypeTerritories,
-				ID:   "usa",
-			},
-		},
-	}
-	relationshipsJSON, err := json.Marshal(relationships)
-	if err != nil {
-		t.Fatalf("failed to marshal relationships: %v", err)
-	}
-
-	resp := &asc.TerritoryAvailabilitiesResponse{
-		Data: []asc.Resource[asc.TerritoryAvailabilityAttributes]{
-			{
-				Type:          asc.ResourceTypeTerritoryAvailabilities,
-				ID:            "ta-1",
-				Relationships: relationshipsJSON,
-			},
-		},
-	}
-
-	ids, err := mapTerritoryAvailabilityIDs(resp)
-	if err != nil {
-		t.Fatalf("mapTerritoryAvailabilityIDs() error: %v", err)
-	}
-	if ids["USA"] != "ta-1" {
-		t.Fatalf("expected territory USA to map to ta-1, got %q", ids["USA"])
-	}
-}
-
-func TestMapTerritoryAvailabilityIDs_FallbackID(t *testing.T) {
-	payload := `{"s":"6740467361","t":"USA"}`
-	encoded := base64.RawStdEncoding.EncodeToString([]byte(payload))
-
-	resp := &asc.TerritoryAvailabilitiesResponse{
-		Data: []asc.Resource[asc.TerritoryAvailabilityAttributes]{
-			{
-				Type: asc.ResourceTypeTerritoryAvailabilities,
-				ID:   encoded,
-			},
-		},
-	}
-
-	ids, err := mapTerritoryAvailabilityIDs(resp)
-	if err != nil {
-		t.Fatalf("mapTerritoryAvailabilityIDs() error: %v", err)
-	}
-	if ids["USA"] != encoded {
-		t.Fatalf("expected territory USA to map to %q, got %q", encoded, ids["USA"])
-	}
-}
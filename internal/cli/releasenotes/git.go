@@ -0,0 +1,143 @@
+package releasenotes
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strings"
+)
+
+// gitLogFormat separates commit fields with 0x1f and commits with 0x1e.
+const gitLogFormat = "%H%x1f%s%x1f%b%x1f%(trailers:only,unfold)%x1e"
+
+var conventionalSubjectRegex = regexp.MustCompile(`^([A-Za-z]+)(?:\(([^)]*)\))?(!)?:\s+(.+)$`)
+
+// runGit runs git in dir and returns its stdout.
+var runGit = func(ctx context.Context, dir string, args ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, "git", append([]string{"-C", dir}, args...)...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return nil, fmt.Errorf("git %s: %s", args[0], message)
+		}
+		return nil, fmt.Errorf("git %s: %w", args[0], err)
+	}
+	return out, nil
+}
+
+// gitCommit is a non-merge commit as seen by release note templates.
+type gitCommit struct {
+	Hash      string
+	ShortHash string
+	// Type and Scope come from a conventional-commit subject ("feat(ui): ...")
+	// and are empty for other subjects.
+	Type     string
+	Scope    string
+	Subject  string
+	Body     string
+	Breaking bool
+	// Note is the text to publish: the selected trailer's value, or Subject.
+	Note     string
+	trailers map[string]string
+}
+
+// Trailer returns the value of a commit trailer, matching key case-insensitively.
+func (c gitCommit) Trailer(key string) string {
+	return c.trailers[strings.ToLower(key)]
+}
+
+// readCommits returns the non-merge commits reachable from to but not from,
+// oldest first.
+func readCommits(ctx context.Context, dir, from, to string) ([]gitCommit, error) {
+	for _, ref := range []string{from, to} {
+		if strings.HasPrefix(ref, "-") {
+			return nil, fmt.Errorf("invalid git ref %q", ref)
+		}
+	}
+	// --end-of-options keeps git from reading the range as an option.
+	out, err := runGit(ctx, dir, "log", "--no-merges", "--reverse", "--format="+gitLogFormat, "--end-of-options", from+".."+to)
+	if err != nil {
+		return nil, err
+	}
+	var commits []gitCommit
+	for _, record := range strings.Split(string(out), "\x1e") {
+		fields := strings.Split(strings.TrimLeft(record, "\n"), "\x1f")
+		if len(fields) != 4 {
+			continue
+		}
+		commits = append(commits, parseCommit(fields[0], fields[1], fields[2], fields[3]))
+	}
+	return commits, nil
+}
+
+func parseCommit(hash, subject, body, trailers string) gitCommit {
+	commit := gitCommit{
+		Hash:     strings.TrimSpace(hash),
+		Subject:  strings.TrimSpace(subject),
+		Body:     strings.TrimSpace(body),
+		trailers: parseTrailers(trailers),
+	}
+	commit.ShortHash = commit.Hash
+	if len(commit.ShortHash) > 7 {
+		commit.ShortHash = commit.ShortHash[:7]
+	}
+	if match := conventionalSubjectRegex.FindStringSubmatch(commit.Subject); match != nil {
+		commit.Type = strings.ToLower(match[1])
+		commit.Scope = strings.TrimSpace(match[2])
+		commit.Breaking = match[3] == "!"
+		commit.Subject = strings.TrimSpace(match[4])
+	}
+	if commit.Trailer("BREAKING CHANGE") != "" || commit.Trailer("BREAKING-CHANGE") != "" {
+		commit.Breaking = true
+	}
+	commit.Note = commit.Subject
+	return commit
+}
+
+func parseTrailers(value string) map[string]string {
+	trailers := make(map[string]string)
+	for _, line := range strings.Split(value, "\n") {
+		key, val, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		val = strings.TrimSpace(val)
+		if key == "" || val == "" {
+			continue
+		}
+		if _, exists := trailers[key]; !exists {
+			trailers[key] = val
+		}
+	}
+	return trailers
+}
+
+// selectCommits keeps commits whose type is in types (all commits when types
+// is empty) and, when trailer is set, that carry it; the trailer's value
+// becomes the commit's Note.
+func selectCommits(commits []gitCommit, types []string, trailer string) []gitCommit {
+	allowed := make(map[string]bool, len(types))
+	for _, commitType := range types {
+		allowed[strings.ToLower(commitType)] = true
+	}
+	selected := make([]gitCommit, 0, len(commits))
+	for _, commit := range commits {
+		if len(allowed) > 0 && !allowed[commit.Type] {
+			continue
+		}
+		if trailer != "" {
+			note := commit.Trailer(trailer)
+			if note == "" {
+				continue
+			}
+			commit.Note = note
+		}
+		selected = append(selected, commit)
+	}
+	return selected
+}
@@ -0,0 +1,241 @@
+package releasenotes
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// whatsNewMaxLength is the App Store and TestFlight limit for whatsNew.
+const whatsNewMaxLength = 4000
+
+// defaultTypes are the conventional-commit types included when neither
+// --types nor --trailer is set.
+var defaultTypes = []string{"feat", "fix", "perf"}
+
+// ReleaseNotesCommand returns the release-notes command with subcommands.
+func ReleaseNotesCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("release-notes", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "release-notes",
+		ShortUsage: "asc release-notes <subcommand> [flags]",
+		ShortHelp:  "Generate release notes from git history.",
+		LongHelp: `Generate release notes from git history.
+
+Examples:
+  asc release-notes generate --from v1.2.0
+  asc release-notes generate --from v1.2.0 --version "VERSION_ID" --locale "en-US,de-DE" --template ./notes
+  asc release-notes generate --from v1.2.0 --build "BUILD_ID" --types all`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			ReleaseNotesGenerateCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// ReleaseNotesGenerateCommand returns the release-notes generate subcommand.
+func ReleaseNotesGenerateCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("generate", flag.ExitOnError)
+
+	repo := fs.String("repo", ".", "Path to the git repository")
+	from := fs.String("from", "", "Git ref to start after, usually the previous release tag (required)")
+	to := fs.String("to", "HEAD", "Git ref to end at")
+	types := fs.String("types", "", "Conventional-commit types to include, comma-separated, or \"all\" (default: feat,fix,perf; all with --trailer)")
+	trailer := fs.String("trailer", "", "Only include commits with this trailer (e.g. Release-Note) and use its value as the note")
+	templatePath := fs.String("template", "", "Go template file, or a directory of <locale>.tmpl files (default: one bullet per commit)")
+	fallbackLocale := fs.String("fallback-locale", "en-US", "Template used for locales without their own <locale>.tmpl")
+	locales := fs.String("locale", "en-US", "Locales to generate, comma-separated")
+	versionID := fs.String("version", "", "Write the notes to whatsNew of this App Store version ID")
+	buildID := fs.String("build", "", "Write the notes to TestFlight What to Test of this build ID")
+	dryRun := fs.Bool("dry-run", false, "With --version or --build, report what would be written without writing")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "generate",
+		ShortUsage: "asc release-notes generate --from REF [--to REF] [flags]",
+		ShortHelp:  "Render release notes from commits and optionally upload them.",
+		LongHelp: `Render release notes from commits and optionally upload them.
+
+Reads the non-merge commits in --from..--to (oldest first) from the local
+repository. Commits are selected by conventional-commit type ("feat: ...",
+"fix(ui): ...") and, with --trailer, by a commit trailer whose value replaces
+the subject as the note:
+
+  fix: crash when opening settings
+
+  Release-Note: Fixed a crash when opening Settings.
+
+Notes are rendered with a Go text/template. Templates see .From, .To,
+.Locale and .Commits; each commit has .Note, .Subject, .Type, .Scope, .Body,
+.Breaking, .Hash, .ShortHash and .Trailer "Key". .Type "feat" "perf" and
+.Breaking filter the commits. With a template directory each locale uses
+<locale>.tmpl and falls back to <fallback-locale>.tmpl, so untranslated
+locales get the English notes.
+
+The rendered text is written to whatsNew with --version, or to TestFlight
+What to Test with --build. Without either, the notes are only printed.
+
+Examples:
+  asc release-notes generate --from v1.2.0
+  asc release-notes generate --from v1.2.0 --to v1.3.0 --template ./notes.tmpl --output table
+  asc release-notes generate --from v1.2.0 --version "VERSION_ID" --locale "en-US,de-DE,fr-FR" --template ./notes --dry-run
+  asc release-notes generate --from v1.2.0 --build "BUILD_ID" --trailer "Release-Note"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			fromRef := strings.TrimSpace(*from)
+			if fromRef == "" {
+				fmt.Fprintln(os.Stderr, "Error: --from is required")
+				return flag.ErrHelp
+			}
+			toRef := strings.TrimSpace(*to)
+			if toRef == "" {
+				toRef = "HEAD"
+			}
+			version := strings.TrimSpace(*versionID)
+			build := strings.TrimSpace(*buildID)
+			if version != "" && build != "" {
+				fmt.Fprintln(os.Stderr, "Error: --version and --build are mutually exclusive")
+				return flag.ErrHelp
+			}
+			if *dryRun && version == "" && build == "" {
+				fmt.Fprintln(os.Stderr, "Error: --dry-run requires --version or --build")
+				return flag.ErrHelp
+			}
+			localeList := shared.SplitCSV(*locales)
+			if len(localeList) == 0 {
+				fmt.Fprintln(os.Stderr, "Error: --locale is required")
+				return flag.ErrHelp
+			}
+			trailerKey := strings.TrimSpace(*trailer)
+			typeList := resolveTypes(*types, trailerKey)
+
+			templates, err := newTemplateSet(strings.TrimSpace(*templatePath), strings.TrimSpace(*fallbackLocale))
+			if err != nil {
+				return fmt.Errorf("release-notes generate: %w", err)
+			}
+			commits, err := readCommits(ctx, strings.TrimSpace(*repo), fromRef, toRef)
+			if err != nil {
+				return fmt.Errorf("release-notes generate: %w", err)
+			}
+			commits = selectCommits(commits, typeList, trailerKey)
+			if len(commits) == 0 {
+				return fmt.Errorf("release-notes generate: no matching commits in %s..%s", fromRef, toRef)
+			}
+
+			result := &asc.ReleaseNotesResult{From: fromRef, To: toRef, Commits: len(commits), DryRun: *dryRun}
+			for _, locale := range localeList {
+				tmpl, name, err := templates.forLocale(locale)
+				if err != nil {
+					return fmt.Errorf("release-notes generate: %w", err)
+				}
+				notes, err := renderNotes(tmpl, templateData{From: fromRef, To: toRef, Locale: locale, Commits: commits})
+				if err != nil {
+					return fmt.Errorf("release-notes generate: %w", err)
+				}
+				if notes == "" {
+					return fmt.Errorf("release-notes generate: template %s rendered no text for %s", name, locale)
+				}
+				if length := utf8.RuneCountInString(notes); length > whatsNewMaxLength {
+					return fmt.Errorf("release-notes generate: notes for %s are %d characters (limit %d)", locale, length, whatsNewMaxLength)
+				}
+				result.Locales = append(result.Locales, asc.ReleaseNotesLocale{Locale: locale, Template: name, Notes: notes})
+			}
+
+			switch {
+			case version != "":
+				result.Target = "version " + version
+				err = uploadWhatsNew(ctx, version, result, *dryRun)
+			case build != "":
+				result.Target = "build " + build
+				err = uploadWhatToTest(ctx, build, result, *dryRun)
+			}
+			if err != nil {
+				return fmt.Errorf("release-notes generate: %w", err)
+			}
+			return shared.PrintOutput(result, *output, *pretty)
+		},
+	}
+}
+
+// resolveTypes parses --types; "all" disables type filtering, which is also
+// the default when commits are selected by trailer.
+func resolveTypes(value, trailer string) []string {
+	types := shared.SplitCSV(strings.ToLower(value))
+	if len(types) == 1 && types[0] == "all" {
+		return nil
+	}
+	if len(types) == 0 && trailer == "" {
+		return defaultTypes
+	}
+	return types
+}
+
+// uploadWhatsNew writes each locale's notes to whatsNew of an App Store version.
+func uploadWhatsNew(ctx context.Context, versionID string, result *asc.ReleaseNotesResult, dryRun bool) error {
+	client, err := shared.GetASCClient()
+	if err != nil {
+		return err
+	}
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+
+	values := make(map[string]map[string]string, len(result.Locales))
+	for _, locale := range result.Locales {
+		values[locale.Locale] = map[string]string{"whatsNew": locale.Notes}
+	}
+	uploads, err := shared.UploadVersionLocalizations(requestCtx, client, versionID, values, dryRun)
+	if err != nil {
+		return err
+	}
+	byLocale := make(map[string]asc.LocalizationUploadLocaleResult, len(uploads))
+	for _, upload := range uploads {
+		byLocale[upload.Locale] = upload
+	}
+	for i := range result.Locales {
+		upload := byLocale[result.Locales[i].Locale]
+		result.Locales[i].Action = upload.Action
+		result.Locales[i].LocalizationID = upload.LocalizationID
+	}
+	return nil
+}
+
+// uploadWhatToTest writes each locale's notes to a build's TestFlight What to Test.
+func uploadWhatToTest(ctx context.Context, buildID string, result *asc.ReleaseNotesResult, dryRun bool) error {
+	if dryRun {
+		for i := range result.Locales {
+			result.Locales[i].Action = "upsert"
+		}
+		return nil
+	}
+	client, err := shared.GetASCClient()
+	if err != nil {
+		return err
+	}
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+
+	for i := range result.Locales {
+		resp, err := shared.UpsertBetaBuildLocalization(requestCtx, client, buildID, result.Locales[i].Locale, result.Locales[i].Notes)
+		if err != nil {
+			return fmt.Errorf("failed to update What to Test for %s: %w", result.Locales[i].Locale, err)
+		}
+		result.Locales[i].Action = "upsert"
+		result.Locales[i].LocalizationID = resp.Data.ID
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package releasenotes
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseCommit(t *testing.T) {
+	tests := []struct {
+		name     string
+		subject  string
+		trailers string
+		wantType string
+		scope    string
+		note     string
+		breaking bool
+	}{
+		{name: "plain", subject: "Update dependencies", note: "Update dependencies"},
+		{name: "type", subject: "feat: add widgets", wantType: "feat", note: "add widgets"},
+		{name: "scope", subject: "Fix(ui): align buttons", wantType: "fix", scope: "ui", note: "align buttons"},
+		{name: "bang", subject: "feat(api)!: drop v1", wantType: "feat", scope: "api", note: "drop v1", breaking: true},
+		{name: "breaking trailer", subject: "refactor: rename", trailers: "BREAKING CHANGE: renamed\n", wantType: "refactor", note: "rename", breaking: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			commit := parseCommit("0123456789abcdef", test.subject, "", test.trailers)
+			if commit.Type != test.wantType || commit.Scope != test.scope || commit.Note != test.note || commit.Breaking != test.breaking {
+				t.Fatalf("parseCommit(%q) = %+v", test.subject, commit)
+			}
+			if commit.ShortHash != "0123456" {
+				t.Fatalf("expected short hash 0123456, got %q", commit.ShortHash)
+			}
+		})
+	}
+}
+
+func TestReadCommitsEndsOptionsBeforeRange(t *testing.T) {
+	original := runGit
+	t.Cleanup(func() { runGit = original })
+	var gotArgs []string
+	runGit = func(ctx context.Context, dir string, args ...string) ([]byte, error) {
+		gotArgs = args
+		return nil, nil
+	}
+
+	if _, err := readCommits(context.Background(), ".", "v1.0.0", "HEAD"); err != nil {
+		t.Fatalf("readCommits() error: %v", err)
+	}
+	if n := len(gotArgs); n < 2 || gotArgs[n-2] != "--end-of-options" || gotArgs[n-1] != "v1.0.0..HEAD" {
+		t.Fatalf("expected --end-of-options before the range, got %v", gotArgs)
+	}
+
+	gotArgs = nil
+	for _, refs := range [][2]string{{"--output=/tmp/notes", "HEAD"}, {"v1.0.0", "-p"}} {
+		_, err := readCommits(context.Background(), ".", refs[0], refs[1])
+		if err == nil || !strings.Contains(err.Error(), "invalid git ref") {
+			t.Fatalf("readCommits(%q, %q) error = %v, want invalid git ref", refs[0], refs[1], err)
+		}
+	}
+	if gotArgs != nil {
+		t.Fatalf("expected git not to run for option-like refs, got %v", gotArgs)
+	}
+}
+
+func TestSelectCommits(t *testing.T) {
+	commits := []gitCommit{
+		parseCommit("a", "feat: add widgets", "", "Release-Note: Widgets are here.\n"),
+		parseCommit("b", "fix: crash", "", ""),
+		parseCommit("c", "chore: bump", "", "release-note: Faster launch.\n"),
+	}
+
+	notes := func(selected []gitCommit) []string {
+		var values []string
+		for _, commit := range selected {
+			values = append(values, commit.Note)
+		}
+		return values
+	}
+
+	if got := notes(selectCommits(commits, defaultTypes, "")); !reflect.DeepEqual(got, []string{"add widgets", "crash"}) {
+		t.Fatalf("unexpected type selection: %v", got)
+	}
+	if got := notes(selectCommits(commits, nil, "Release-Note")); !reflect.DeepEqual(got, []string{"Widgets are here.", "Faster launch."}) {
+		t.Fatalf("unexpected trailer selection: %v", got)
+	}
+	if got := notes(selectCommits(commits, []string{"chore"}, "Release-Note")); !reflect.DeepEqual(got, []string{"Faster launch."}) {
+		t.Fatalf("unexpected combined selection: %v", got)
+	}
+}
+
+func TestResolveTypes(t *testing.T) {
+	if got := resolveTypes("", ""); !reflect.DeepEqual(got, defaultTypes) {
+		t.Fatalf("expected default types, got %v", got)
+	}
+	if got := resolveTypes("", "Release-Note"); got != nil {
+		t.Fatalf("expected no type filter with trailer, got %v", got)
+	}
+	if got := resolveTypes("all", ""); got != nil {
+		t.Fatalf("expected no type filter for all, got %v", got)
+	}
+	if got := resolveTypes("Feat, docs", ""); !reflect.DeepEqual(got, []string{"feat", "docs"}) {
+		t.Fatalf("unexpected types: %v", got)
+	}
+}
+
+func TestTemplateSetFallsBackToFallbackLocale(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "en-US.tmpl"), []byte("New:\n{{range .Type \"feat\"}}- {{.Note}}\n{{end}}"), 0o644); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "de-DE.tmpl"), []byte("Neu in {{.To}}: {{len .Commits}}"), 0o644); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+	set, err := newTemplateSet(dir, "en-US")
+	if err != nil {
+		t.Fatalf("newTemplateSet() error: %v", err)
+	}
+	data := templateData{To: "v2", Commits: []gitCommit{
+		parseCommit("a", "feat: widgets", "", ""),
+		parseCommit("b", "fix: crash", "", ""),
+	}}
+
+	cases := map[string]struct {
+		template string
+		notes    string
+	}{
+		"de-DE": {template: "de-DE.tmpl", notes: "Neu in v2: 2"},
+		"fr-FR": {template: "en-US.tmpl", notes: "New:\n- widgets"},
+	}
+	for locale, want := range cases {
+		tmpl, name, err := set.forLocale(locale)
+		if err != nil {
+			t.Fatalf("forLocale(%s) error: %v", locale, err)
+		}
+		if filepath.Base(name) != want.template {
+			t.Fatalf("forLocale(%s) used %s, want %s", locale, name, want.template)
+		}
+		data.Locale = locale
+		notes, err := renderNotes(tmpl, data)
+		if err != nil {
+			t.Fatalf("renderNotes(%s) error: %v", locale, err)
+		}
+		if notes != want.notes {
+			t.Fatalf("renderNotes(%s) = %q, want %q", locale, notes, want.notes)
+		}
+	}
+
+	noFallback, err := newTemplateSet(dir, "ja")
+	if err != nil {
+		t.Fatalf("newTemplateSet() error: %v", err)
+	}
+	if _, _, err := noFallback.forLocale("fr-FR"); err == nil {
+		t.Fatal("expected error when neither locale nor fallback template exists")
+	}
+}
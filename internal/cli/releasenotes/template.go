@@ -0,0 +1,111 @@
+package releasenotes
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+// templateExt is the extension of per-locale templates in a template directory.
+const templateExt = ".tmpl"
+
+// defaultTemplateName is reported for locales rendered with defaultTemplate.
+const defaultTemplateName = "default"
+
+// defaultTemplate lists one note per line; it has no words to translate, so
+// it suits every locale.
+const defaultTemplate = `{{range .Commits}}• {{.Note}}
+{{end}}`
+
+// templateData is what release note templates render.
+type templateData struct {
+	From    string
+	To      string
+	Locale  string
+	Commits []gitCommit
+}
+
+// Type returns the commits of the given conventional-commit types, e.g.
+// {{range .Type "feat"}}.
+func (d templateData) Type(types ...string) []gitCommit {
+	var commits []gitCommit
+	for _, commit := range d.Commits {
+		for _, commitType := range types {
+			if commit.Type == strings.ToLower(commitType) {
+				commits = append(commits, commit)
+				break
+			}
+		}
+	}
+	return commits
+}
+
+// Breaking returns the commits marked as breaking changes.
+func (d templateData) Breaking() []gitCommit {
+	var commits []gitCommit
+	for _, commit := range d.Commits {
+		if commit.Breaking {
+			commits = append(commits, commit)
+		}
+	}
+	return commits
+}
+
+// templateSet resolves the template for each locale. With a directory, a
+// locale uses <dir>/<locale>.tmpl and falls back to <dir>/<fallback>.tmpl.
+type templateSet struct {
+	path     string
+	dir      bool
+	fallback string
+}
+
+func newTemplateSet(path, fallback string) (*templateSet, error) {
+	set := &templateSet{path: path, fallback: fallback}
+	if path == "" {
+		return set, nil
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	set.dir = info.IsDir()
+	return set, nil
+}
+
+// forLocale returns the parsed template for locale and the file it came from.
+func (s *templateSet) forLocale(locale string) (*template.Template, string, error) {
+	if s.path == "" {
+		tmpl, err := template.New(defaultTemplateName).Parse(defaultTemplate)
+		return tmpl, defaultTemplateName, err
+	}
+	path := s.path
+	if s.dir {
+		path = filepath.Join(s.path, locale+templateExt)
+		if _, err := os.Stat(path); os.IsNotExist(err) && s.fallback != "" {
+			path = filepath.Join(s.path, s.fallback+templateExt)
+		}
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) && s.dir {
+			return nil, "", fmt.Errorf("no template for %s in %s (and no %s%s fallback)", locale, s.path, s.fallback, templateExt)
+		}
+		return nil, "", err
+	}
+	tmpl, err := template.New(filepath.Base(path)).Option("missingkey=error").Parse(string(data))
+	if err != nil {
+		return nil, "", fmt.Errorf("parse template %s: %w", path, err)
+	}
+	return tmpl, path, nil
+}
+
+func renderNotes(tmpl *template.Template, data templateData) (string, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("render template %s: %w", tmpl.Name(), err)
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
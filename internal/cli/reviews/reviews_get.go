@@ -16,7 +16,7 @@ func ReviewsGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("reviews get", flag.ExitOnError)
 
 	reviewID := fs.String("id", "", "Customer review ID")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -23,7 +23,7 @@ func ReviewsRatingsCommand() *ffcli.Command {
 	country := fs.String("country", "us", "Country code (e.g., us, gb, de)")
 	all := fs.Bool("all", false, "Fetch ratings from all countries")
 	workers := fs.Int("workers", 10, "Number of parallel workers for --all")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -91,7 +91,7 @@ func executeSingleRatings(ctx context.Context, client *itunes.Client, appID, cou
 	case "markdown":
 		return printRatingsMarkdown(ratings)
 	default:
-		return shared.PrintOutput(ratings, "json", pretty)
+		return shared.PrintOutput(ratings, output, pretty)
 	}
 }
 
@@ -107,7 +107,7 @@ func executeAllRatings(ctx context.Context, client *itunes.Client, appID string,
 	case "markdown":
 		return printGlobalRatingsMarkdown(global)
 	default:
-		return shared.PrintOutput(global, "json", pretty)
+		return shared.PrintOutput(global, output, pretty)
 	}
 }
 
@@ -123,7 +123,7 @@ func normalizeRatingsOutput(output string, pretty bool) (string, error) {
 	switch format {
 	case "json":
 		return format, nil
-	case "table", "markdown":
+	case "table", "markdown", "yaml", "yml", "ndjson":
 		if pretty {
 			return "", fmt.Errorf("--pretty is only valid with JSON output")
 		}
@@ -46,3 +46,15 @@ func ResolveAppInfoID(ctx context.Context, client *asc.Client, appID, appInfoID
 	}
 	return resp.Data[0].ID, nil
 }
+
+// FetchAppInfoCategoryID returns the related category ID, or "" when none is set.
+func FetchAppInfoCategoryID(ctx context.Context, fetch func(context.Context, string) (*asc.AppCategoryResponse, error), appInfoID string) (string, error) {
+	resp, err := fetch(ctx, appInfoID)
+	if err != nil {
+		if asc.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return resp.Data.ID, nil
+}
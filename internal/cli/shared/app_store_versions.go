@@ -1,8 +1,11 @@
 package shared
 
 import (
+	"context"
 	"fmt"
 	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
 )
 
 var appStoreVersionPlatforms = map[string]struct{}{
@@ -101,3 +104,41 @@ func appStoreVersionStateList() []string {
 		"NOT_APPLICABLE",
 	}
 }
+
+// ResolveLatestAppStoreVersionID returns the most recently created version of the
+// app on platform, ignoring excludeID.
+func ResolveLatestAppStoreVersionID(ctx context.Context, client *asc.Client, appID, platform, excludeID string) (string, error) {
+	firstPage, err := client.GetAppStoreVersions(ctx, appID,
+		asc.WithAppStoreVersionsPlatforms([]string{platform}),
+		asc.WithAppStoreVersionsLimit(200),
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch versions: %w", err)
+	}
+	resp, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetAppStoreVersions(ctx, appID, asc.WithAppStoreVersionsNextURL(nextURL))
+	})
+	if err != nil {
+		return "", err
+	}
+	versions, ok := resp.(*asc.AppStoreVersionsResponse)
+	if !ok {
+		return "", fmt.Errorf("unexpected pagination response type")
+	}
+
+	latestID := ""
+	latestCreated := ""
+	for _, version := range versions.Data {
+		if version.ID == excludeID {
+			continue
+		}
+		if latestID == "" || version.Attributes.CreatedDate > latestCreated {
+			latestID = version.ID
+			latestCreated = version.Attributes.CreatedDate
+		}
+	}
+	if latestID == "" {
+		return "", fmt.Errorf("no previous %s version found for app %s", platform, appID)
+	}
+	return latestID, nil
+}
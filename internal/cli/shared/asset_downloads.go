@@ -0,0 +1,126 @@
+package shared
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// ScreenshotDownloadURL returns the full-size image URL of a screenshot in
+// the format of its original file name (png by default), or "" when the
+// image is not available yet.
+func ScreenshotDownloadURL(attrs asc.AppScreenshotAttributes) string {
+	if attrs.ImageAsset == nil || attrs.ImageAsset.TemplateURL == "" {
+		return ""
+	}
+	format := strings.TrimPrefix(strings.ToLower(filepath.Ext(attrs.FileName)), ".")
+	switch format {
+	case "":
+		format = "png"
+	case "jpeg":
+		format = "jpg"
+	}
+	return attrs.ImageAsset.URL(format)
+}
+
+// AssetFileName returns the base name of an asset's reported file name with
+// any directory removed, "asset" when it has none, and defaultExt when it has
+// no extension.
+func AssetFileName(fileName, defaultExt string) string {
+	base := filepath.Base(strings.ReplaceAll(strings.TrimSpace(fileName), "\\", "/"))
+	if base == "." || base == "/" || base == "" {
+		base = "asset" + defaultExt
+	}
+	if filepath.Ext(base) == "" {
+		base += defaultExt
+	}
+	return base
+}
+
+// DownloadAssetFile downloads a screenshot or preview file to path, creating
+// parent directories as needed. The file is written to a temporary file and
+// renamed into place, so an interrupted download never leaves a partial file.
+// An existing file is only replaced when overwrite is set, and symlinks at
+// path are never followed.
+func DownloadAssetFile(ctx context.Context, client *asc.Client, url, path string, overwrite bool) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	if overwrite {
+		if info, err := os.Lstat(path); err == nil {
+			if info.Mode()&os.ModeSymlink != 0 {
+				return fmt.Errorf("refusing to overwrite symlink %q", path)
+			}
+			if info.IsDir() {
+				return fmt.Errorf("output path %q is a directory", path)
+			}
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	} else {
+		// Reserve the destination so an existing file or symlink is refused
+		// before downloading; the rename below replaces the placeholder.
+		placeholder, err := OpenNewFileNoFollow(path, 0o644)
+		if err != nil {
+			if errors.Is(err, os.ErrExist) {
+				return fmt.Errorf("output file already exists: %w", err)
+			}
+			return err
+		}
+		if err := placeholder.Close(); err != nil {
+			return err
+		}
+	}
+
+	success := false
+	if !overwrite {
+		defer func() {
+			if !success {
+				_ = os.Remove(path)
+			}
+		}()
+	}
+
+	download, err := client.DownloadAsset(ctx, url)
+	if err != nil {
+		return err
+	}
+	defer download.Body.Close()
+
+	tempFile, err := os.CreateTemp(filepath.Dir(path), ".asc-asset-*")
+	if err != nil {
+		return err
+	}
+	tempPath := tempFile.Name()
+	defer func() {
+		if !success {
+			_ = os.Remove(tempPath)
+		}
+	}()
+
+	if _, err := io.Copy(tempFile, download.Body); err != nil {
+		_ = tempFile.Close()
+		return err
+	}
+	if err := tempFile.Sync(); err != nil {
+		_ = tempFile.Close()
+		return err
+	}
+	if err := tempFile.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tempPath, 0o644); err != nil {
+		return err
+	}
+	if err := os.Rename(tempPath, path); err != nil {
+		return err
+	}
+	success = true
+	return nil
+}
@@ -0,0 +1,726 @@
+package shared
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+const (
+	assetUploadDefaultTimeout = 10 * time.Minute
+	assetPollInterval         = 2 * time.Second
+)
+
+// NormalizeScreenshotDisplayType converts a device type such as IPHONE_65 into a
+// screenshot display type (APP_IPHONE_65).
+func NormalizeScreenshotDisplayType(input string) (string, error) {
+	value := strings.ToUpper(strings.TrimSpace(input))
+	if value == "" {
+		return "", fmt.Errorf("device type is required")
+	}
+	if !strings.HasPrefix(value, "APP_") && !strings.HasPrefix(value, "IMESSAGE_") {
+		value = "APP_" + value
+	}
+	if !asc.IsValidScreenshotDisplayType(value) {
+		return "", fmt.Errorf("unsupported screenshot display type %q", value)
+	}
+	return value, nil
+}
+
+// FindScreenshotSet returns the localization's screenshot set for displayType
+// and whether it exists.
+func FindScreenshotSet(ctx context.Context, client *asc.Client, localizationID, displayType string) (asc.Resource[asc.AppScreenshotSetAttributes], bool, error) {
+	resp, err := client.GetAppScreenshotSets(ctx, localizationID)
+	if err != nil {
+		return asc.Resource[asc.AppScreenshotSetAttributes]{}, false, err
+	}
+	for _, set := range resp.Data {
+		if strings.EqualFold(set.Attributes.ScreenshotDisplayType, displayType) {
+			return set, true, nil
+		}
+	}
+	return asc.Resource[asc.AppScreenshotSetAttributes]{}, false, nil
+}
+
+// EnsureScreenshotSet returns the localization's screenshot set for displayType,
+// creating it when missing.
+func EnsureScreenshotSet(ctx context.Context, client *asc.Client, localizationID, displayType string) (asc.Resource[asc.AppScreenshotSetAttributes], error) {
+	set, ok, err := FindScreenshotSet(ctx, client, localizationID, displayType)
+	if err != nil || ok {
+		return set, err
+	}
+	created, err := client.CreateAppScreenshotSet(ctx, localizationID, displayType)
+	if err != nil {
+		return asc.Resource[asc.AppScreenshotSetAttributes]{}, err
+	}
+	return created.Data, nil
+}
+
+// UploadScreenshotAsset uploads one screenshot file into a set and waits for delivery.
+func UploadScreenshotAsset(ctx context.Context, client *asc.Client, setID, filePath string) (asc.AssetUploadResultItem, error) {
+	if err := asc.ValidateImageFile(filePath); err != nil {
+		return asc.AssetUploadResultItem{}, err
+	}
+
+	file, err := OpenExistingNoFollow(filePath)
+	if err != nil {
+		return asc.AssetUploadResultItem{}, err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return asc.AssetUploadResultItem{}, err
+	}
+
+	checksum, err := asc.ComputeChecksumFromReader(file, asc.ChecksumAlgorithmMD5)
+	if err != nil {
+		return asc.AssetUploadResultItem{}, err
+	}
+
+	created, err := client.CreateAppScreenshot(ctx, setID, info.Name(), info.Size())
+	if err != nil {
+		return asc.AssetUploadResultItem{}, err
+	}
+	if len(created.Data.Attributes.UploadOperations) == 0 {
+		return asc.AssetUploadResultItem{}, fmt.Errorf("no upload operations returned for %q", info.Name())
+	}
+
+	if err := asc.UploadAssetFromFile(ctx, file, info.Size(), created.Data.Attributes.UploadOperations); err != nil {
+		return asc.AssetUploadResultItem{}, err
+	}
+
+	if _, err := client.UpdateAppScreenshot(ctx, created.Data.ID, true, checksum.Hash); err != nil {
+		return asc.AssetUploadResultItem{}, err
+	}
+
+	state, err := waitForScreenshotDelivery(ctx, client, created.Data.ID)
+	if err != nil {
+		return asc.AssetUploadResultItem{}, err
+	}
+
+	return asc.AssetUploadResultItem{
+		FileName: info.Name(),
+		FilePath: filePath,
+		AssetID:  created.Data.ID,
+		State:    state,
+	}, nil
+}
+
+func waitForScreenshotDelivery(ctx context.Context, client *asc.Client, screenshotID string) (string, error) {
+	return waitForAssetDeliveryState(ctx, screenshotID, func(ctx context.Context) (*asc.AssetDeliveryState, error) {
+		resp, err := client.GetAppScreenshot(ctx, screenshotID)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Data.Attributes.AssetDeliveryState, nil
+	})
+}
+
+// NormalizePreviewType converts a device type into an app preview type.
+func NormalizePreviewType(input string) (string, error) {
+	value := strings.ToUpper(strings.TrimSpace(input))
+	if value == "" {
+		return "", fmt.Errorf("device type is required")
+	}
+	value = strings.TrimPrefix(value, "APP_")
+	if !asc.IsValidPreviewType(value) {
+		return "", fmt.Errorf("unsupported preview type %q", value)
+	}
+	return value, nil
+}
+
+// EnsurePreviewSet returns the localization's preview set for previewType,
+// creating it when missing.
+func EnsurePreviewSet(ctx context.Context, client *asc.Client, localizationID, previewType string) (asc.Resource[asc.AppPreviewSetAttributes], error) {
+	resp, err := client.GetAppPreviewSets(ctx, localizationID)
+	if err != nil {
+		return asc.Resource[asc.AppPreviewSetAttributes]{}, err
+	}
+	for _, set := range resp.Data {
+		if strings.EqualFold(set.Attributes.PreviewType, previewType) {
+			return set, nil
+		}
+	}
+	created, err := client.CreateAppPreviewSet(ctx, localizationID, previewType)
+	if err != nil {
+		return asc.Resource[asc.AppPreviewSetAttributes]{}, err
+	}
+	return created.Data, nil
+}
+
+// UploadPreviewAsset uploads one preview file into a set and waits for delivery.
+func UploadPreviewAsset(ctx context.Context, client *asc.Client, setID, filePath string) (asc.AssetUploadResultItem, error) {
+	if err := asc.ValidateImageFile(filePath); err != nil {
+		return asc.AssetUploadResultItem{}, err
+	}
+
+	mimeType, err := detectPreviewMimeType(filePath)
+	if err != nil {
+		return asc.AssetUploadResultItem{}, err
+	}
+
+	file, err := OpenExistingNoFollow(filePath)
+	if err != nil {
+		return asc.AssetUploadResultItem{}, err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return asc.AssetUploadResultItem{}, err
+	}
+
+	checksum, err := asc.ComputeChecksumFromReader(file, asc.ChecksumAlgorithmMD5)
+	if err != nil {
+		return asc.AssetUploadResultItem{}, err
+	}
+
+	created, err := client.CreateAppPreview(ctx, setID, info.Name(), info.Size(), mimeType)
+	if err != nil {
+		return asc.AssetUploadResultItem{}, err
+	}
+	if len(created.Data.Attributes.UploadOperations) == 0 {
+		return asc.AssetUploadResultItem{}, fmt.Errorf("no upload operations returned for %q", info.Name())
+	}
+
+	if err := asc.UploadAssetFromFile(ctx, file, info.Size(), created.Data.Attributes.UploadOperations); err != nil {
+		return asc.AssetUploadResultItem{}, err
+	}
+
+	if _, err := client.UpdateAppPreview(ctx, created.Data.ID, true, checksum.Hash); err != nil {
+		return asc.AssetUploadResultItem{}, err
+	}
+
+	state, err := waitForPreviewDelivery(ctx, client, created.Data.ID)
+	if err != nil {
+		return asc.AssetUploadResultItem{}, err
+	}
+
+	return asc.AssetUploadResultItem{
+		FileName: info.Name(),
+		FilePath: filePath,
+		AssetID:  created.Data.ID,
+		State:    state,
+	}, nil
+}
+
+func detectPreviewMimeType(path string) (string, error) {
+	ext := strings.ToLower(filepath.Ext(path))
+	if ext == "" {
+		return "", fmt.Errorf("preview file %q is missing an extension", path)
+	}
+	mimeType := mime.TypeByExtension(ext)
+	if mimeType == "" {
+		return "", fmt.Errorf("unsupported preview file extension %q", ext)
+	}
+	if idx := strings.Index(mimeType, ";"); idx > 0 {
+		mimeType = mimeType[:idx]
+	}
+	return mimeType, nil
+}
+
+func waitForPreviewDelivery(ctx context.Context, client *asc.Client, previewID string) (string, error) {
+	return waitForAssetDeliveryState(ctx, previewID, func(ctx context.Context) (*asc.AssetDeliveryState, error) {
+		resp, err := client.GetAppPreview(ctx, previewID)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Data.Attributes.AssetDeliveryState, nil
+	})
+}
+
+// ContextWithAssetUploadTimeout returns a context with the asset upload timeout.
+func ContextWithAssetUploadTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithTimeout(ctx, asc.ResolveTimeoutWithDefault(assetUploadDefaultTimeout))
+}
+
+// CollectAssetFiles returns the asset file at path, or the sorted files in a directory.
+func CollectAssetFiles(path string) ([]string, error) {
+	info, err := os.Lstat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.Mode()&os.ModeSymlink != 0 {
+		return nil, fmt.Errorf("refusing to read symlink %q", path)
+	}
+	if info.IsDir() {
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return nil, err
+		}
+		files := make([]string, 0, len(entries))
+		for _, entry := range entries {
+			if entry.IsDir() {
+				continue
+			}
+			fullPath := filepath.Join(path, entry.Name())
+			if err := asc.ValidateImageFile(fullPath); err != nil {
+				return nil, err
+			}
+			files = append(files, fullPath)
+		}
+		if len(files) == 0 {
+			return nil, fmt.Errorf("no files found in %q", path)
+		}
+		sort.Strings(files)
+		return files, nil
+	}
+	if !info.Mode().IsRegular() {
+		return nil, fmt.Errorf("expected regular file: %q", path)
+	}
+	if err := asc.ValidateImageFile(path); err != nil {
+		return nil, err
+	}
+	return []string{path}, nil
+}
+
+// ScreenshotDisplayTypeFromName infers a screenshot display type from a file
+// name prefix such as APP_IPHONE_65_01.png or iphone_65-home.png. The longest
+// matching prefix wins; it returns "" when no prefix matches.
+func ScreenshotDisplayTypeFromName(name string) string {
+	upper := strings.ToUpper(name)
+	best := ""
+	bestLength := 0
+	for _, displayType := range asc.ValidScreenshotDisplayTypes {
+		forms := []string{displayType}
+		if short := strings.TrimPrefix(displayType, "APP_"); short != displayType {
+			forms = append(forms, short)
+		}
+		for _, form := range forms {
+			if len(form) > bestLength && hasTypePrefix(upper, form) {
+				best = displayType
+				bestLength = len(form)
+			}
+		}
+	}
+	return best
+}
+
+// PreviewTypeFromName infers a preview type from a file name prefix such as
+// IPHONE_65_demo.mov. It returns "" when no prefix matches.
+func PreviewTypeFromName(name string) string {
+	upper := strings.TrimPrefix(strings.ToUpper(name), "APP_")
+	best := ""
+	for _, previewType := range asc.ValidPreviewTypes {
+		if len(previewType) > len(best) && hasTypePrefix(upper, previewType) {
+			best = previewType
+		}
+	}
+	return best
+}
+
+// hasTypePrefix reports whether name starts with prefix followed by a separator,
+// so IPHONE_6 never matches IPHONE_65.
+func hasTypePrefix(name, prefix string) bool {
+	if !strings.HasPrefix(name, prefix) {
+		return false
+	}
+	if len(name) == len(prefix) {
+		return true
+	}
+	switch name[len(prefix)] {
+	case '_', '-', '.', ' ':
+		return true
+	}
+	return false
+}
+
+func waitForAssetDeliveryState(ctx context.Context, assetID string, fetch func(context.Context) (*asc.AssetDeliveryState, error)) (string, error) {
+	ticker := time.NewTicker(assetPollInterval)
+	defer ticker.Stop()
+
+	var lastState string
+	for {
+		state, err := fetch(ctx)
+		if err != nil {
+			return lastState, err
+		}
+		if state != nil {
+			lastState = state.State
+			switch strings.ToUpper(state.State) {
+			case "COMPLETE":
+				return state.State, nil
+			case "FAILED":
+				return state.State, fmt.Errorf("asset %s delivery failed: %s", assetID, formatAssetErrors(state.Errors))
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return lastState, fmt.Errorf("timed out waiting for asset %s delivery: %w", assetID, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+func formatAssetErrors(errors []asc.ErrorDetail) string {
+	if len(errors) == 0 {
+		return "unknown error"
+	}
+	parts := make([]string, 0, len(errors))
+	for _, item := range errors {
+		if item.Code != "" && item.Message != "" {
+			parts = append(parts, fmt.Sprintf("%s: %s", item.Code, item.Message))
+			continue
+		}
+		if item.Message != "" {
+			parts = append(parts, item.Message)
+			continue
+		}
+		if item.Code != "" {
+			parts = append(parts, item.Code)
+		}
+	}
+	if len(parts) == 0 {
+		return "unknown error"
+	}
+	return strings.Join(parts, "; ")
+}
+
+// Asset sync actions reported in AssetUploadResultItem.Action.
+const (
+	AssetActionUploaded = "uploaded"
+	AssetActionSkipped  = "skipped"
+	AssetActionDeleted  = "deleted"
+)
+
+// remoteAsset is an existing screenshot or preview considered by a sync.
+type remoteAsset struct {
+	id       string
+	fileName string
+	checksum string
+	state    string
+}
+
+// assetSyncOps are the per-kind operations a set sync needs.
+type assetSyncOps struct {
+	kind    string
+	limit   int
+	upload  func(ctx context.Context, filePath string) (asc.AssetUploadResultItem, error)
+	remove  func(ctx context.Context, id string) error
+	reorder func(ctx context.Context, ids []string) error
+}
+
+// AssetSyncPlan counts what a set sync does: files to upload, remote assets
+// kept (matched or, without prune, left in place) and remote assets deleted.
+type AssetSyncPlan struct {
+	Upload int
+	Keep   int
+	Delete int
+}
+
+// assetSyncPlan maps local files to remote assets by MD5 checksum.
+type assetSyncPlan struct {
+	matches   []int // per file: index into existing, or -1 to upload
+	matched   []bool
+	unmatched []int
+	counts    AssetSyncPlan
+}
+
+// SyncScreenshotSet makes a screenshot set match files: byte-identical images
+// (by MD5 sourceFileChecksum) are kept, new or changed files are uploaded,
+// unmatched remote screenshots are deleted when prune is set (after the
+// uploads, unless the set needs the room first), and the set is reordered to
+// follow the order of files. Nothing changes when the result
+// would exceed the per-set limit. It reports whether a reorder was needed.
+func SyncScreenshotSet(ctx context.Context, client *asc.Client, setID string, files []string, prune bool) ([]asc.AssetUploadResultItem, bool, error) {
+	existing, err := remoteScreenshots(ctx, client, setID)
+	if err != nil {
+		return nil, false, err
+	}
+	return syncAssetSet(ctx, existing, files, prune, assetSyncOps{
+		kind:  AssetKindScreenshot,
+		limit: asc.MaxScreenshotsPerSet,
+		upload: func(ctx context.Context, filePath string) (asc.AssetUploadResultItem, error) {
+			return UploadScreenshotAsset(ctx, client, setID, filePath)
+		},
+		remove: client.DeleteAppScreenshot,
+		reorder: func(ctx context.Context, ids []string) error {
+			return client.ReplaceAppScreenshotsForSet(ctx, setID, ids)
+		},
+	})
+}
+
+// SyncPreviewSet is SyncScreenshotSet for app preview sets.
+func SyncPreviewSet(ctx context.Context, client *asc.Client, setID string, files []string, prune bool) ([]asc.AssetUploadResultItem, bool, error) {
+	existing, err := remotePreviews(ctx, client, setID)
+	if err != nil {
+		return nil, false, err
+	}
+	return syncAssetSet(ctx, existing, files, prune, assetSyncOps{
+		kind:  AssetKindPreview,
+		limit: asc.MaxPreviewsPerSet,
+		upload: func(ctx context.Context, filePath string) (asc.AssetUploadResultItem, error) {
+			return UploadPreviewAsset(ctx, client, setID, filePath)
+		},
+		remove: client.DeleteAppPreview,
+		reorder: func(ctx context.Context, ids []string) error {
+			return client.ReplaceAppPreviewsForSet(ctx, setID, ids)
+		},
+	})
+}
+
+// PlanScreenshotSetSync reports what SyncScreenshotSet would do without
+// changing anything. An empty setID stands for a set that does not exist yet.
+func PlanScreenshotSetSync(ctx context.Context, client *asc.Client, setID string, files []string, prune bool) (AssetSyncPlan, error) {
+	var existing []remoteAsset
+	if setID != "" {
+		var err error
+		if existing, err = remoteScreenshots(ctx, client, setID); err != nil {
+			return AssetSyncPlan{}, err
+		}
+	}
+	plan, err := planAssetSync(existing, files, prune, AssetKindScreenshot, asc.MaxScreenshotsPerSet)
+	if err != nil {
+		return AssetSyncPlan{}, err
+	}
+	return plan.counts, nil
+}
+
+// PlanPreviewSetSync is PlanScreenshotSetSync for app preview sets.
+func PlanPreviewSetSync(ctx context.Context, client *asc.Client, setID string, files []string, prune bool) (AssetSyncPlan, error) {
+	var existing []remoteAsset
+	if setID != "" {
+		var err error
+		if existing, err = remotePreviews(ctx, client, setID); err != nil {
+			return AssetSyncPlan{}, err
+		}
+	}
+	plan, err := planAssetSync(existing, files, prune, AssetKindPreview, asc.MaxPreviewsPerSet)
+	if err != nil {
+		return AssetSyncPlan{}, err
+	}
+	return plan.counts, nil
+}
+
+// CheckScreenshotSetCapacity returns an error when adding screenshots to the
+// set without replacing any would exceed the per-set limit.
+func CheckScreenshotSetCapacity(ctx context.Context, client *asc.Client, setID string, adding int) error {
+	existing, err := remoteScreenshots(ctx, client, setID)
+	if err != nil {
+		return err
+	}
+	return checkAssetSetCapacity(len(existing), adding, AssetKindScreenshot, asc.MaxScreenshotsPerSet)
+}
+
+// CheckPreviewSetCapacity is CheckScreenshotSetCapacity for app preview sets.
+func CheckPreviewSetCapacity(ctx context.Context, client *asc.Client, setID string, adding int) error {
+	existing, err := remotePreviews(ctx, client, setID)
+	if err != nil {
+		return err
+	}
+	return checkAssetSetCapacity(len(existing), adding, AssetKindPreview, asc.MaxPreviewsPerSet)
+}
+
+func checkAssetSetCapacity(existing, adding int, kind string, limit int) error {
+	if total := existing + adding; total > limit {
+		return fmt.Errorf("set would hold %d %ss (%d existing, %d new), more than the limit of %d; use --sync --prune to replace the set", total, kind, existing, adding, limit)
+	}
+	return nil
+}
+
+func remoteScreenshots(ctx context.Context, client *asc.Client, setID string) ([]remoteAsset, error) {
+	resp, err := client.GetAppScreenshots(ctx, setID)
+	if err != nil {
+		return nil, err
+	}
+	existing := make([]remoteAsset, 0, len(resp.Data))
+	for _, item := range resp.Data {
+		existing = append(existing, remoteAsset{
+			id:       item.ID,
+			fileName: item.Attributes.FileName,
+			checksum: item.Attributes.SourceFileChecksum,
+			state:    assetDeliveryStateName(item.Attributes.AssetDeliveryState),
+		})
+	}
+	return existing, nil
+}
+
+func remotePreviews(ctx context.Context, client *asc.Client, setID string) ([]remoteAsset, error) {
+	resp, err := client.GetAppPreviews(ctx, setID)
+	if err != nil {
+		return nil, err
+	}
+	existing := make([]remoteAsset, 0, len(resp.Data))
+	for _, item := range resp.Data {
+		existing = append(existing, remoteAsset{
+			id:       item.ID,
+			fileName: item.Attributes.FileName,
+			checksum: item.Attributes.SourceFileChecksum,
+			state:    assetDeliveryStateName(item.Attributes.AssetDeliveryState),
+		})
+	}
+	return existing, nil
+}
+
+// planAssetSync matches files to existing assets and checks that the set
+// stays within limit once kept, remote-only (without prune) and uploaded
+// assets are counted.
+func planAssetSync(existing []remoteAsset, files []string, prune bool, kind string, limit int) (*assetSyncPlan, error) {
+	// Remote assets that failed processing never count as a match.
+	byChecksum := make(map[string][]int)
+	for i, item := range existing {
+		if item.checksum == "" || strings.EqualFold(item.state, "FAILED") {
+			continue
+		}
+		key := strings.ToLower(item.checksum)
+		byChecksum[key] = append(byChecksum[key], i)
+	}
+
+	plan := &assetSyncPlan{
+		matches: make([]int, len(files)),
+		matched: make([]bool, len(existing)),
+	}
+	for i, filePath := range files {
+		checksum, err := asc.ComputeFileChecksum(filePath, asc.ChecksumAlgorithmMD5)
+		if err != nil {
+			return nil, err
+		}
+		key := strings.ToLower(checksum.Hash)
+		if candidates := byChecksum[key]; len(candidates) > 0 {
+			plan.matches[i] = candidates[0]
+			byChecksum[key] = candidates[1:]
+			plan.matched[candidates[0]] = true
+			plan.counts.Keep++
+			continue
+		}
+		plan.matches[i] = -1
+		plan.counts.Upload++
+	}
+	for i := range existing {
+		if plan.matched[i] {
+			continue
+		}
+		plan.unmatched = append(plan.unmatched, i)
+		if prune {
+			plan.counts.Delete++
+		} else {
+			plan.counts.Keep++
+		}
+	}
+
+	if total := plan.counts.Keep + plan.counts.Upload; total > limit {
+		message := fmt.Sprintf("set would hold %d %ss (%d kept, %d new), more than the limit of %d", total, kind, plan.counts.Keep, plan.counts.Upload, limit)
+		if !prune && len(plan.unmatched) > 0 {
+			message += "; prune the set to replace its remote-only " + kind + "s"
+		}
+		return nil, errors.New(message)
+	}
+	return plan, nil
+}
+
+func syncAssetSet(ctx context.Context, existing []remoteAsset, files []string, prune bool, ops assetSyncOps) ([]asc.AssetUploadResultItem, bool, error) {
+	plan, err := planAssetSync(existing, files, prune, ops.kind, ops.limit)
+	if err != nil {
+		return nil, false, err
+	}
+	results := make([]asc.AssetUploadResultItem, 0, len(files)+len(existing))
+
+	pruneUnmatched := func() error {
+		for _, index := range plan.unmatched {
+			item := existing[index]
+			if err := ops.remove(ctx, item.id); err != nil {
+				return err
+			}
+			results = append(results, asc.AssetUploadResultItem{
+				FileName: item.fileName,
+				AssetID:  item.id,
+				State:    item.state,
+				Action:   AssetActionDeleted,
+			})
+		}
+		return nil
+	}
+
+	// Upload before pruning so a failed upload leaves the live set intact,
+	// unless the set has no room for the new assets until stale ones go.
+	pruneFirst := prune && len(existing)+plan.counts.Upload > ops.limit
+	if pruneFirst {
+		if err := pruneUnmatched(); err != nil {
+			return nil, false, err
+		}
+	}
+
+	desired := make([]string, 0, len(files)+len(existing))
+	var uploaded []string
+	for i, filePath := range files {
+		if index := plan.matches[i]; index >= 0 {
+			desired = append(desired, existing[index].id)
+			results = append(results, asc.AssetUploadResultItem{
+				FileName: filepath.Base(filePath),
+				FilePath: filePath,
+				AssetID:  existing[index].id,
+				State:    existing[index].state,
+				Action:   AssetActionSkipped,
+			})
+			continue
+		}
+		item, err := ops.upload(ctx, filePath)
+		if err != nil {
+			return nil, false, err
+		}
+		item.Action = AssetActionUploaded
+		desired = append(desired, item.AssetID)
+		uploaded = append(uploaded, item.AssetID)
+		results = append(results, item)
+	}
+
+	if prune && !pruneFirst {
+		if err := pruneUnmatched(); err != nil {
+			return nil, false, err
+		}
+	}
+
+	// The set's current order: surviving remote assets, then new uploads.
+	current := make([]string, 0, len(existing)+len(uploaded))
+	for i, item := range existing {
+		if plan.matched[i] {
+			current = append(current, item.id)
+			continue
+		}
+		if !prune {
+			current = append(current, item.id)
+			desired = append(desired, item.id) // kept remote-only assets stay after the local files
+		}
+	}
+	current = append(current, uploaded...)
+
+	if equalStrings(current, desired) {
+		return results, false, nil
+	}
+	if err := ops.reorder(ctx, desired); err != nil {
+		return nil, false, err
+	}
+	return results, true, nil
+}
+
+func assetDeliveryStateName(state *asc.AssetDeliveryState) string {
+	if state == nil {
+		return ""
+	}
+	return state.State
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
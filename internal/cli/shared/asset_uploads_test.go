@@ -0,0 +1,246 @@
+package shared
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func writeSyncFile(t *testing.T, dir, name, content string) (string, string) {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+	sum := md5.Sum([]byte(content))
+	return path, hex.EncodeToString(sum[:])
+}
+
+type fakeSyncOps struct {
+	uploaded  []string
+	removed   []string
+	reordered []string
+	calls     []string
+	uploadErr error
+}
+
+func (f *fakeSyncOps) ops() assetSyncOps {
+	return assetSyncOps{
+		kind:  AssetKindScreenshot,
+		limit: asc.MaxScreenshotsPerSet,
+		upload: func(ctx context.Context, filePath string) (asc.AssetUploadResultItem, error) {
+			if f.uploadErr != nil {
+				return asc.AssetUploadResultItem{}, f.uploadErr
+			}
+			id := "new-" + filepath.Base(filePath)
+			f.uploaded = append(f.uploaded, filepath.Base(filePath))
+			f.calls = append(f.calls, "upload")
+			return asc.AssetUploadResultItem{FileName: filepath.Base(filePath), FilePath: filePath, AssetID: id, State: "COMPLETE"}, nil
+		},
+		remove: func(ctx context.Context, id string) error {
+			f.removed = append(f.removed, id)
+			f.calls = append(f.calls, "remove")
+			return nil
+		},
+		reorder: func(ctx context.Context, ids []string) error {
+			f.reordered = ids
+			return nil
+		},
+	}
+}
+
+func actions(results []asc.AssetUploadResultItem) []string {
+	out := make([]string, 0, len(results))
+	for _, item := range results {
+		out = append(out, item.AssetID+":"+item.Action)
+	}
+	return out
+}
+
+func TestSyncAssetSet_SkipsUnchangedAndKeepsOrder(t *testing.T) {
+	dir := t.TempDir()
+	a, sumA := writeSyncFile(t, dir, "01.png", "a")
+	b, sumB := writeSyncFile(t, dir, "02.png", "b")
+
+	existing := []remoteAsset{
+		{id: "r-a", checksum: sumA, state: "COMPLETE"},
+		{id: "r-b", checksum: sumB, state: "COMPLETE"},
+	}
+	fake := &fakeSyncOps{}
+	results, reordered, err := syncAssetSet(context.Background(), existing, []string{a, b}, false, fake.ops())
+	if err != nil {
+		t.Fatalf("syncAssetSet() error: %v", err)
+	}
+	if reordered || fake.reordered != nil || len(fake.uploaded) != 0 {
+		t.Fatalf("expected no changes, got uploads %v reorder %v", fake.uploaded, fake.reordered)
+	}
+	if got := actions(results); !reflect.DeepEqual(got, []string{"r-a:skipped", "r-b:skipped"}) {
+		t.Fatalf("unexpected results: %v", got)
+	}
+}
+
+func TestSyncAssetSet_UploadsChangedPrunesAndReorders(t *testing.T) {
+	dir := t.TempDir()
+	first, _ := writeSyncFile(t, dir, "01.png", "new first")
+	second, sumSecond := writeSyncFile(t, dir, "02.png", "second")
+
+	existing := []remoteAsset{
+		{id: "r-second", checksum: sumSecond, state: "COMPLETE"},
+		{id: "r-stale", checksum: "ffff", fileName: "old.png", state: "COMPLETE"},
+	}
+	fake := &fakeSyncOps{}
+	results, reordered, err := syncAssetSet(context.Background(), existing, []string{first, second}, true, fake.ops())
+	if err != nil {
+		t.Fatalf("syncAssetSet() error: %v", err)
+	}
+	if !reflect.DeepEqual(fake.uploaded, []string{"01.png"}) {
+		t.Fatalf("unexpected uploads: %v", fake.uploaded)
+	}
+	if !reflect.DeepEqual(fake.removed, []string{"r-stale"}) {
+		t.Fatalf("unexpected deletions: %v", fake.removed)
+	}
+	if !reordered || !reflect.DeepEqual(fake.reordered, []string{"new-01.png", "r-second"}) {
+		t.Fatalf("unexpected reorder: %v (%v)", fake.reordered, reordered)
+	}
+	want := []string{"new-01.png:uploaded", "r-second:skipped", "r-stale:deleted"}
+	if got := actions(results); !reflect.DeepEqual(got, want) {
+		t.Fatalf("results = %v, want %v", got, want)
+	}
+}
+
+func TestSyncAssetSet_WithoutPruneKeepsRemoteOnlyAssetsLast(t *testing.T) {
+	dir := t.TempDir()
+	a, _ := writeSyncFile(t, dir, "01.png", "a")
+
+	existing := []remoteAsset{
+		{id: "r-other", checksum: "ffff", state: "COMPLETE"},
+	}
+	fake := &fakeSyncOps{}
+	_, reordered, err := syncAssetSet(context.Background(), existing, []string{a}, false, fake.ops())
+	if err != nil {
+		t.Fatalf("syncAssetSet() error: %v", err)
+	}
+	if len(fake.removed) != 0 {
+		t.Fatalf("expected no deletions without prune, got %v", fake.removed)
+	}
+	if !reordered || !reflect.DeepEqual(fake.reordered, []string{"new-01.png", "r-other"}) {
+		t.Fatalf("unexpected reorder: %v", fake.reordered)
+	}
+}
+
+func TestSyncAssetSet_FailedRemoteAssetIsReuploaded(t *testing.T) {
+	dir := t.TempDir()
+	a, sumA := writeSyncFile(t, dir, "01.png", "a")
+
+	existing := []remoteAsset{
+		{id: "r-failed", checksum: sumA, state: "FAILED"},
+	}
+	fake := &fakeSyncOps{}
+	results, _, err := syncAssetSet(context.Background(), existing, []string{a}, true, fake.ops())
+	if err != nil {
+		t.Fatalf("syncAssetSet() error: %v", err)
+	}
+	want := []string{"new-01.png:uploaded", "r-failed:deleted"}
+	if got := actions(results); !reflect.DeepEqual(got, want) {
+		t.Fatalf("results = %v, want %v", got, want)
+	}
+}
+
+func TestSyncAssetSet_FailedUploadKeepsRemoteAssets(t *testing.T) {
+	dir := t.TempDir()
+	a, _ := writeSyncFile(t, dir, "01.png", "a")
+
+	existing := []remoteAsset{
+		{id: "r-old", checksum: "ffff", state: "COMPLETE"},
+	}
+	fake := &fakeSyncOps{uploadErr: errors.New("upload failed")}
+	_, _, err := syncAssetSet(context.Background(), existing, []string{a}, true, fake.ops())
+	if err == nil || !strings.Contains(err.Error(), "upload failed") {
+		t.Fatalf("expected upload error, got %v", err)
+	}
+	if len(fake.removed) != 0 {
+		t.Fatalf("expected no deletions after a failed upload, got %v", fake.removed)
+	}
+}
+
+func TestSyncAssetSet_ReplacesFullSetDeletingFirst(t *testing.T) {
+	dir := t.TempDir()
+	var files []string
+	var existing []remoteAsset
+	for i := 0; i < asc.MaxScreenshotsPerSet; i++ {
+		path, _ := writeSyncFile(t, dir, fmt.Sprintf("%02d.png", i), fmt.Sprintf("new %d", i))
+		files = append(files, path)
+		existing = append(existing, remoteAsset{id: fmt.Sprintf("r-%d", i), checksum: fmt.Sprintf("old%d", i), state: "COMPLETE"})
+	}
+	fake := &fakeSyncOps{}
+	if _, _, err := syncAssetSet(context.Background(), existing, files, true, fake.ops()); err != nil {
+		t.Fatalf("syncAssetSet() error: %v", err)
+	}
+	for i, call := range fake.calls {
+		want := "remove"
+		if i >= asc.MaxScreenshotsPerSet {
+			want = "upload"
+		}
+		if call != want {
+			t.Fatalf("call %d = %s, want %s (calls %v)", i, call, want, fake.calls)
+		}
+	}
+}
+
+func TestSyncAssetSet_RejectsOverLimitBeforeChanges(t *testing.T) {
+	dir := t.TempDir()
+	a, _ := writeSyncFile(t, dir, "01.png", "a")
+	var existing []remoteAsset
+	for i := 0; i < asc.MaxScreenshotsPerSet; i++ {
+		existing = append(existing, remoteAsset{id: fmt.Sprintf("r-%d", i), checksum: fmt.Sprintf("old%d", i), state: "COMPLETE"})
+	}
+	fake := &fakeSyncOps{}
+	_, _, err := syncAssetSet(context.Background(), existing, []string{a}, false, fake.ops())
+	if err == nil || !strings.Contains(err.Error(), "set would hold 11 screenshots (10 kept, 1 new), more than the limit of 10") {
+		t.Fatalf("expected limit error, got %v", err)
+	}
+	if len(fake.calls) != 0 {
+		t.Fatalf("expected no changes, got %v", fake.calls)
+	}
+}
+
+func TestScreenshotDisplayTypeFromName(t *testing.T) {
+	tests := map[string]string{
+		"APP_IPHONE_65_01.png":          "APP_IPHONE_65",
+		"iphone_65-home.png":            "APP_IPHONE_65",
+		"APP_IPAD_PRO_3GEN_129_01.png":  "APP_IPAD_PRO_3GEN_129",
+		"IPAD_PRO_129.png":              "APP_IPAD_PRO_129",
+		"IMESSAGE_APP_IPHONE_65_01.png": "IMESSAGE_APP_IPHONE_65",
+		"APPLE_TV_01.png":               "APP_APPLE_TV",
+		"IPHONE_651.png":                "",
+		"iPhone 15 Pro Max-01.png":      "",
+	}
+	for name, want := range tests {
+		if got := ScreenshotDisplayTypeFromName(name); got != want {
+			t.Fatalf("ScreenshotDisplayTypeFromName(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestPreviewTypeFromName(t *testing.T) {
+	tests := map[string]string{
+		"IPHONE_65_01_demo.mov":     "IPHONE_65",
+		"APP_IPAD_PRO_3GEN_11.mp4":  "IPAD_PRO_3GEN_11",
+		"apple_vision_pro-tour.mov": "APPLE_VISION_PRO",
+		"demo.mov":                  "",
+	}
+	for name, want := range tests {
+		if got := PreviewTypeFromName(name); got != want {
+			t.Fatalf("PreviewTypeFromName(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
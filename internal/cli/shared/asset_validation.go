@@ -0,0 +1,287 @@
+package shared
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// Asset kinds reported by validation.
+const (
+	AssetKindScreenshot = "screenshot"
+	AssetKindPreview    = "preview"
+)
+
+// ScreenshotFileExtensions and PreviewFileExtensions list the lowercase file
+// extensions accepted as screenshots and app previews.
+var (
+	ScreenshotFileExtensions = map[string]bool{".png": true, ".jpg": true, ".jpeg": true}
+	PreviewFileExtensions    = map[string]bool{".mov": true, ".mp4": true, ".m4v": true}
+)
+
+type assetSetKey struct {
+	dir         string
+	kind        string
+	displayType string
+}
+
+type assetValidator struct {
+	result *asc.AssetValidationResult
+	sets   map[assetSetKey]int
+}
+
+func newAssetValidator(path string) *assetValidator {
+	return &assetValidator{
+		result: &asc.AssetValidationResult{
+			Path:   path,
+			Files:  []asc.AssetValidationFile{},
+			Issues: []asc.AssetValidationIssue{},
+		},
+		sets: map[assetSetKey]int{},
+	}
+}
+
+// ValidateAssets checks local screenshots and previews under path (a file or
+// a directory walked recursively) without contacting App Store Connect.
+// Screenshots are checked for format, pixel size, alpha and color space;
+// sets are grouped by directory and display type and checked against the
+// per-set limits. screenshotType and previewType override name and size
+// inference when non-empty.
+func ValidateAssets(path, screenshotType, previewType string) (*asc.AssetValidationResult, error) {
+	info, err := os.Lstat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.Mode()&os.ModeSymlink != 0 {
+		return nil, fmt.Errorf("refusing to read symlink %q", path)
+	}
+
+	validator := newAssetValidator(path)
+	if !info.IsDir() {
+		validator.checkFile(path, screenshotType, previewType)
+		return validator.finish(), nil
+	}
+
+	err = filepath.WalkDir(path, func(current string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		name := entry.Name()
+		if current != path && strings.HasPrefix(name, ".") {
+			if entry.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		if entry.Type()&os.ModeSymlink != 0 {
+			validator.addIssue(current, "", asc.AssetIssueWarning, "symlink skipped")
+			return nil
+		}
+		validator.checkFile(current, screenshotType, previewType)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return validator.finish(), nil
+}
+
+// ValidateScreenshotFiles checks files that will be uploaded to a single
+// screenshot set of displayType.
+func ValidateScreenshotFiles(path string, files []string, displayType string) *asc.AssetValidationResult {
+	validator := newAssetValidator(path)
+	for _, file := range files {
+		validator.checkScreenshot(file, displayType)
+	}
+	return validator.finish()
+}
+
+// ValidatePreviewFiles checks files that will be uploaded to a single preview
+// set of previewType.
+func ValidatePreviewFiles(path string, files []string, previewType string) *asc.AssetValidationResult {
+	validator := newAssetValidator(path)
+	for _, file := range files {
+		validator.checkPreview(file, previewType)
+	}
+	return validator.finish()
+}
+
+func (v *assetValidator) checkFile(path, screenshotType, previewType string) {
+	ext := strings.ToLower(filepath.Ext(path))
+	switch {
+	case ScreenshotFileExtensions[ext]:
+		v.checkScreenshot(path, screenshotType)
+	case PreviewFileExtensions[ext]:
+		v.checkPreview(path, previewType)
+	default:
+		v.addIssue(path, "", asc.AssetIssueWarning, "not a screenshot (.png, .jpg) or preview (.mov, .mp4, .m4v); skipped")
+	}
+}
+
+func (v *assetValidator) checkScreenshot(path, displayType string) {
+	file := asc.AssetValidationFile{Path: path, Kind: AssetKindScreenshot}
+	if displayType == "" {
+		displayType = ScreenshotDisplayTypeFromName(filepath.Base(path))
+	}
+
+	info, err := inspectImageFile(path)
+	if err != nil {
+		file.DisplayType = displayType
+		v.result.Files = append(v.result.Files, file)
+		v.addIssue(path, displayType, asc.AssetIssueError, fmt.Sprintf("cannot read image: %v", err))
+		return
+	}
+	file.Format = info.Format
+	file.Width = info.Width
+	file.Height = info.Height
+	file.HasAlpha = info.HasAlpha
+	file.ColorSpace = info.ColorSpace
+
+	size := asc.ImageSize{Width: info.Width, Height: info.Height}
+	if displayType == "" {
+		displayType = asc.ScreenshotDisplayTypeForSize(info.Width, info.Height)
+		if displayType == "" {
+			v.addIssue(path, "", asc.AssetIssueError, fmt.Sprintf("size %s matches no screenshot display type", size))
+		}
+	} else if !asc.IsValidScreenshotSize(displayType, info.Width, info.Height) {
+		v.addIssue(path, displayType, asc.AssetIssueError, fmt.Sprintf("size %s is not accepted for %s (accepted: %s)", size, displayType, formatImageSizes(asc.ScreenshotSizes(displayType))))
+	}
+	file.DisplayType = displayType
+	v.result.Files = append(v.result.Files, file)
+
+	if info.HasAlpha {
+		v.addIssue(path, displayType, asc.AssetIssueError, "image has an alpha channel or transparency; export it without alpha")
+	}
+	switch info.ColorSpace {
+	case "CMYK":
+		v.addIssue(path, displayType, asc.AssetIssueError, "CMYK color space is not supported; use RGB")
+	case "Gray":
+		v.addIssue(path, displayType, asc.AssetIssueWarning, "grayscale image; App Store Connect expects RGB")
+	}
+
+	if displayType != "" {
+		v.sets[assetSetKey{dir: filepath.Dir(path), kind: AssetKindScreenshot, displayType: displayType}]++
+	}
+}
+
+func (v *assetValidator) checkPreview(path, previewType string) {
+	file := asc.AssetValidationFile{Path: path, Kind: AssetKindPreview}
+	if previewType == "" {
+		previewType = PreviewTypeFromName(filepath.Base(path))
+	}
+	file.DisplayType = previewType
+
+	info, size, err := inspectVideoFile(path)
+	if err != nil {
+		v.result.Files = append(v.result.Files, file)
+		v.addIssue(path, previewType, asc.AssetIssueError, fmt.Sprintf("cannot read video: %v", err))
+		return
+	}
+	file.Format = info.Format
+	file.Width = info.Width
+	file.Height = info.Height
+	v.result.Files = append(v.result.Files, file)
+
+	if size > asc.MaxPreviewFileSize {
+		v.addIssue(path, previewType, asc.AssetIssueError, fmt.Sprintf("file is %d MB, more than the limit of %d MB", size>>20, asc.MaxPreviewFileSize>>20))
+	}
+	if info.Duration < asc.MinPreviewDuration || info.Duration > asc.MaxPreviewDuration {
+		v.addIssue(path, previewType, asc.AssetIssueError, fmt.Sprintf("duration %.1fs is outside the accepted %v to %v", info.Duration.Seconds(), asc.MinPreviewDuration, asc.MaxPreviewDuration))
+	}
+	if previewType == "" {
+		v.addIssue(path, "", asc.AssetIssueWarning, "cannot infer preview type; prefix the file name with a preview type such as IPHONE_65_")
+		return
+	}
+	if !asc.IsValidPreviewSize(previewType, info.Width, info.Height) {
+		size := asc.ImageSize{Width: info.Width, Height: info.Height}
+		v.addIssue(path, previewType, asc.AssetIssueError, fmt.Sprintf("size %s is not accepted for %s (accepted: %s)", size, previewType, formatImageSizes(asc.PreviewSizes(previewType))))
+	}
+	v.sets[assetSetKey{dir: filepath.Dir(path), kind: AssetKindPreview, displayType: previewType}]++
+}
+
+func (v *assetValidator) addIssue(path, displayType, severity, message string) {
+	v.result.Issues = append(v.result.Issues, asc.AssetValidationIssue{
+		Path:        path,
+		DisplayType: displayType,
+		Severity:    severity,
+		Message:     message,
+	})
+}
+
+func (v *assetValidator) finish() *asc.AssetValidationResult {
+	keys := make([]assetSetKey, 0, len(v.sets))
+	for key := range v.sets {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].dir != keys[j].dir {
+			return keys[i].dir < keys[j].dir
+		}
+		if keys[i].kind != keys[j].kind {
+			return keys[i].kind < keys[j].kind
+		}
+		return keys[i].displayType < keys[j].displayType
+	})
+	for _, key := range keys {
+		limit := asc.MaxScreenshotsPerSet
+		if key.kind == AssetKindPreview {
+			limit = asc.MaxPreviewsPerSet
+		}
+		if count := v.sets[key]; count > limit {
+			v.addIssue(key.dir, key.displayType, asc.AssetIssueError, fmt.Sprintf("%d %ss exceed the limit of %d per set", count, key.kind, limit))
+		}
+	}
+
+	for _, issue := range v.result.Issues {
+		if issue.Severity == asc.AssetIssueError {
+			v.result.Errors++
+		} else {
+			v.result.Warnings++
+		}
+	}
+	v.result.Valid = v.result.Errors == 0
+	return v.result
+}
+
+func formatImageSizes(sizes []asc.ImageSize) string {
+	values := make([]string, 0, len(sizes))
+	for _, size := range sizes {
+		values = append(values, size.String())
+	}
+	return strings.Join(values, ", ")
+}
+
+// inspectImageFile reads an image header without following symlinks, like
+// the upload path does.
+func inspectImageFile(path string) (*asc.ImageInfo, error) {
+	file, err := OpenExistingNoFollow(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return asc.InspectImage(file)
+}
+
+// inspectVideoFile reads a movie header without following symlinks and
+// returns the file size alongside it.
+func inspectVideoFile(path string) (*asc.VideoInfo, int64, error) {
+	file, err := OpenExistingNoFollow(path)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer file.Close()
+	stat, err := file.Stat()
+	if err != nil {
+		return nil, 0, err
+	}
+	info, err := asc.InspectVideo(file, stat.Size())
+	return info, stat.Size(), err
+}
@@ -0,0 +1,204 @@
+package shared
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"image"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func writeValidationPNG(t *testing.T, path string, width, height int, opaque bool) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatalf("MkdirAll() error: %v", err)
+	}
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	if opaque {
+		for i := 3; i < len(img.Pix); i += 4 {
+			img.Pix[i] = 0xff
+		}
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("Create() error: %v", err)
+	}
+	defer file.Close()
+	if err := png.Encode(file, img); err != nil {
+		t.Fatalf("png.Encode() error: %v", err)
+	}
+}
+
+// writeValidationMovie writes a minimal QuickTime movie with one video track.
+func writeValidationMovie(t *testing.T, path string, width, height int, seconds uint32) {
+	t.Helper()
+	atom := func(kind string, payload ...[]byte) []byte {
+		body := bytes.Join(payload, nil)
+		out := binary.BigEndian.AppendUint32(nil, uint32(8+len(body)))
+		return append(append(out, kind...), body...)
+	}
+	mvhd := make([]byte, 100)
+	binary.BigEndian.PutUint32(mvhd[12:], 600)
+	binary.BigEndian.PutUint32(mvhd[16:], seconds*600)
+	tkhd := make([]byte, 84)
+	binary.BigEndian.PutUint32(tkhd[76:], uint32(width)<<16)
+	binary.BigEndian.PutUint32(tkhd[80:], uint32(height)<<16)
+	movie := bytes.Join([][]byte{
+		atom("ftyp", []byte("qt  "), make([]byte, 4)),
+		atom("moov", atom("mvhd", mvhd), atom("trak", atom("tkhd", tkhd))),
+	}, nil)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatalf("MkdirAll() error: %v", err)
+	}
+	if err := os.WriteFile(path, movie, 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+}
+
+func issueMessages(result *asc.AssetValidationResult) string {
+	lines := make([]string, 0, len(result.Issues))
+	for _, issue := range result.Issues {
+		lines = append(lines, issue.Severity+" "+filepath.Base(issue.Path)+": "+issue.Message)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func TestValidateAssets_ReportsAllIssues(t *testing.T) {
+	dir := t.TempDir()
+	enUS := filepath.Join(dir, "en-US")
+	writeValidationPNG(t, filepath.Join(enUS, "APP_IPHONE_65_01.png"), 1242, 2688, true)
+	writeValidationPNG(t, filepath.Join(enUS, "APP_IPHONE_65_02.png"), 1290, 2796, true)
+	writeValidationPNG(t, filepath.Join(enUS, "home.png"), 1242, 2688, false)
+	writeValidationPNG(t, filepath.Join(enUS, "odd.png"), 100, 200, true)
+	for i := 1; i <= 4; i++ {
+		writeValidationMovie(t, filepath.Join(enUS, fmt.Sprintf("IPHONE_65_demo%d.mov", i)), 886, 1920, 20)
+	}
+	if err := os.WriteFile(filepath.Join(enUS, "notes.txt"), []byte("x"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(enUS, ".DS_Store"), []byte("x"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+
+	result, err := ValidateAssets(dir, "", "")
+	if err != nil {
+		t.Fatalf("ValidateAssets() error: %v", err)
+	}
+	if result.Valid {
+		t.Fatal("expected invalid result")
+	}
+	if len(result.Files) != 8 {
+		t.Fatalf("expected 8 files, got %d", len(result.Files))
+	}
+
+	messages := issueMessages(result)
+	for _, want := range []string{
+		"error APP_IPHONE_65_02.png: size 1290x2796 is not accepted for APP_IPHONE_65",
+		"error home.png: image has an alpha channel",
+		"error odd.png: size 100x200 matches no screenshot display type",
+		"warning notes.txt: not a screenshot",
+		"error en-US: 4 previews exceed the limit of 3 per set",
+	} {
+		if !strings.Contains(messages, want) {
+			t.Fatalf("expected issue %q in:\n%s", want, messages)
+		}
+	}
+	if strings.Contains(messages, ".DS_Store") {
+		t.Fatalf("hidden files should be ignored:\n%s", messages)
+	}
+	if result.Errors != 4 || result.Warnings != 1 {
+		t.Fatalf("expected 4 errors and 1 warning, got %d and %d:\n%s", result.Errors, result.Warnings, messages)
+	}
+}
+
+func TestValidateScreenshotFiles_SetLimit(t *testing.T) {
+	dir := t.TempDir()
+	files := make([]string, 0, asc.MaxScreenshotsPerSet+1)
+	for i := 0; i <= asc.MaxScreenshotsPerSet; i++ {
+		path := filepath.Join(dir, fmt.Sprintf("shot%02d.png", i))
+		writeValidationPNG(t, path, 2688, 1242, true)
+		files = append(files, path)
+	}
+
+	result := ValidateScreenshotFiles(dir, files, "APP_IPHONE_65")
+	if result.Errors != 1 {
+		t.Fatalf("expected 1 error, got %d:\n%s", result.Errors, issueMessages(result))
+	}
+	if !strings.Contains(result.Issues[0].Message, "11 screenshots exceed the limit of 10 per set") {
+		t.Fatalf("unexpected issue %q", result.Issues[0].Message)
+	}
+
+	result = ValidateScreenshotFiles(dir, files[:2], "APP_IPHONE_65")
+	if !result.Valid || len(result.Issues) != 0 {
+		t.Fatalf("expected valid result, got:\n%s", issueMessages(result))
+	}
+}
+
+func TestValidateScreenshotFiles_RejectsSymlink(t *testing.T) {
+	dir := t.TempDir()
+	target := filepath.Join(dir, "target.png")
+	writeValidationPNG(t, target, 1242, 2688, true)
+	link := filepath.Join(dir, "APP_IPHONE_65_01.png")
+	if err := os.Symlink(target, link); err != nil {
+		t.Skipf("symlink not supported: %v", err)
+	}
+
+	result := ValidateScreenshotFiles(dir, []string{link}, "APP_IPHONE_65")
+	if result.Errors != 1 || !strings.Contains(result.Issues[0].Message, "cannot read image") {
+		t.Fatalf("expected symlinked screenshot to be rejected, got:\n%s", issueMessages(result))
+	}
+}
+
+func TestValidatePreviewFiles(t *testing.T) {
+	dir := t.TempDir()
+	good := filepath.Join(dir, "01.mov")
+	writeValidationMovie(t, good, 1920, 886, 20)
+	short := filepath.Join(dir, "02.mov")
+	writeValidationMovie(t, short, 886, 1920, 10)
+	wrongSize := filepath.Join(dir, "03.mov")
+	writeValidationMovie(t, wrongSize, 1080, 1920, 25)
+	notMovie := filepath.Join(dir, "04.mov")
+	if err := os.WriteFile(notMovie, []byte("movie"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+
+	result := ValidatePreviewFiles(dir, []string{good, short, wrongSize, notMovie}, "IPHONE_65")
+	messages := issueMessages(result)
+	for _, want := range []string{
+		"error 02.mov: duration 10.0s is outside the accepted 15s to 30s",
+		"error 03.mov: size 1080x1920 is not accepted for IPHONE_65",
+		"error 04.mov: cannot read video",
+	} {
+		if !strings.Contains(messages, want) {
+			t.Fatalf("expected issue %q in:\n%s", want, messages)
+		}
+	}
+	if result.Errors != 3 {
+		t.Fatalf("expected 3 errors, got %d:\n%s", result.Errors, messages)
+	}
+
+	files := []string{good, good, good, good}
+	result = ValidatePreviewFiles(dir, files, "IPHONE_65")
+	if result.Errors != 1 || !strings.Contains(issueMessages(result), "4 previews exceed the limit of 3 per set") {
+		t.Fatalf("expected set limit error, got:\n%s", issueMessages(result))
+	}
+	if result = ValidatePreviewFiles(dir, files[:3], "IPHONE_65"); !result.Valid {
+		t.Fatalf("expected valid result, got:\n%s", issueMessages(result))
+	}
+}
+
+func TestCheckAssetSetCapacity(t *testing.T) {
+	if err := checkAssetSetCapacity(1, 2, AssetKindPreview, asc.MaxPreviewsPerSet); err != nil {
+		t.Fatalf("expected a full set to be accepted, got %v", err)
+	}
+	err := checkAssetSetCapacity(2, 2, AssetKindPreview, asc.MaxPreviewsPerSet)
+	if err == nil || !strings.Contains(err.Error(), "set would hold 4 previews (2 existing, 2 new), more than the limit of 3") {
+		t.Fatalf("expected capacity error, got %v", err)
+	}
+}
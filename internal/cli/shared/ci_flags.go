@@ -11,8 +11,9 @@ const (
 )
 
 var (
-	reportFormat string
-	reportFile   string
+	reportFormat    string
+	reportFile      string
+	reportTestCases []JUnitTestCase
 )
 
 // BindCIFlags registers CI-related flags for report output.
@@ -46,6 +47,17 @@ func ReportFile() string {
 	return reportFile
 }
 
+// SetReportTestCases replaces the single per-command test case in the JUnit
+// report with cases, for commands that check many things (e.g. lint rules).
+func SetReportTestCases(cases []JUnitTestCase) {
+	reportTestCases = cases
+}
+
+// ReportTestCases returns the test cases set by the running command, if any.
+func ReportTestCases() []JUnitTestCase {
+	return reportTestCases
+}
+
 // SetReportFormat sets the report format (for testing).
 func SetReportFormat(format string) {
 	reportFormat = format
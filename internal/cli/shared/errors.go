@@ -1,5 +1,12 @@
 package shared
 
+import "errors"
+
+// ErrDrift marks a check that found App Store Connect out of sync with the
+// desired state; it exits with its own code so scheduled jobs can tell drift
+// from a failed check.
+var ErrDrift = errors.New("drift detected")
+
 // ReportedError marks an error as already reported to the user.
 // The main entrypoint should exit non-zero without duplicating output.
 type ReportedError interface {
@@ -0,0 +1,58 @@
+package shared
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// fastlaneMetadataFiles maps fastlane deliver file names to localization keys.
+var fastlaneMetadataFiles = map[string]string{
+	"description.txt":         "description",
+	"keywords.txt":            "keywords",
+	"release_notes.txt":       "whatsNew",
+	"promotional_text.txt":    "promotionalText",
+	"support_url.txt":         "supportUrl",
+	"marketing_url.txt":       "marketingUrl",
+	"name.txt":                "name",
+	"subtitle.txt":            "subtitle",
+	"privacy_url.txt":         "privacyPolicyUrl",
+	"privacy_choices_url.txt": "privacyChoicesUrl",
+}
+
+// ReadFastlaneMetadataValues reads fastlane deliver metadata (metadata/<locale>/*.txt)
+// into non-empty values by locale, keyed like .strings files. The
+// review_information and default directories are skipped.
+func ReadFastlaneMetadataValues(metadataDir string) (map[string]map[string]string, error) {
+	entries, err := os.ReadDir(metadataDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read metadata directory: %w", err)
+	}
+
+	values := make(map[string]map[string]string)
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		locale := entry.Name()
+		if locale == "review_information" || locale == "default" || strings.HasPrefix(locale, ".") {
+			continue
+		}
+		fields := make(map[string]string)
+		for fileName, key := range fastlaneMetadataFiles {
+			data, err := readLocalizationFile(filepath.Join(metadataDir, locale, fileName))
+			if err != nil {
+				if os.IsNotExist(err) {
+					continue
+				}
+				return nil, err
+			}
+			if value := strings.TrimSpace(string(data)); value != "" {
+				fields[key] = value
+			}
+		}
+		values[locale] = fields
+	}
+	return values, nil
+}
@@ -0,0 +1,468 @@
+package shared
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Localization file formats for download and upload.
+const (
+	LocalizationFormatStrings   = "strings"
+	LocalizationFormatXLIFF     = "xliff"
+	LocalizationFormatJSON      = "json"
+	LocalizationFormatCSV       = "csv"
+	LocalizationFormatXCStrings = "xcstrings"
+)
+
+// localizationBundleName is the file name used for single-file formats when
+// the path is a directory.
+const localizationBundleName = "localizations"
+
+var localizationFormatExtensions = map[string]string{
+	LocalizationFormatStrings:   ".strings",
+	LocalizationFormatXLIFF:     ".xliff",
+	LocalizationFormatJSON:      ".json",
+	LocalizationFormatCSV:       ".csv",
+	LocalizationFormatXCStrings: ".xcstrings",
+}
+
+// NormalizeLocalizationFormat validates a --format value. An empty value is
+// inferred from the path extension, falling back to strings.
+func NormalizeLocalizationFormat(value, path string) (string, error) {
+	normalized := strings.ToLower(strings.TrimSpace(value))
+	if normalized == "" {
+		ext := strings.ToLower(filepath.Ext(strings.TrimSpace(path)))
+		if ext == ".xlf" {
+			return LocalizationFormatXLIFF, nil
+		}
+		for format, formatExt := range localizationFormatExtensions {
+			if ext == formatExt {
+				return format, nil
+			}
+		}
+		return LocalizationFormatStrings, nil
+	}
+	if normalized == "xlf" {
+		return LocalizationFormatXLIFF, nil
+	}
+	if _, ok := localizationFormatExtensions[normalized]; !ok {
+		return "", fmt.Errorf("--format must be one of: strings, xliff, json, csv, xcstrings")
+	}
+	return normalized, nil
+}
+
+func localizationFormatExtension(format string) string {
+	return localizationFormatExtensions[format]
+}
+
+// isPerLocaleFormat reports whether format writes one file per locale.
+func isPerLocaleFormat(format string) bool {
+	return format == LocalizationFormatStrings || format == LocalizationFormatXLIFF
+}
+
+// localizationSourceLocale picks the locale used as XLIFF source text and the
+// String Catalog source language: en-US, then en, then the first locale.
+func localizationSourceLocale(locales []string) string {
+	for _, preferred := range []string{"en-US", "en"} {
+		for _, locale := range locales {
+			if locale == preferred {
+				return locale
+			}
+		}
+	}
+	if len(locales) == 0 {
+		return ""
+	}
+	return locales[0]
+}
+
+// orderedLocalizationKeys returns the keys present in any locale, following
+// order first and then sorted.
+func orderedLocalizationKeys(valuesByLocale map[string]map[string]string, order []string) []string {
+	present := make(map[string]bool)
+	for _, values := range valuesByLocale {
+		for key := range values {
+			present[key] = true
+		}
+	}
+	keys := make([]string, 0, len(present))
+	for _, key := range order {
+		if present[key] {
+			keys = append(keys, key)
+			delete(present, key)
+		}
+	}
+	extra := make([]string, 0, len(present))
+	for key := range present {
+		extra = append(extra, key)
+	}
+	sort.Strings(extra)
+	return append(keys, extra...)
+}
+
+func resolveLocalizationOutputFile(outputPath, format string) (string, error) {
+	if strings.TrimSpace(outputPath) == "" {
+		outputPath = "localizations"
+	}
+	ext := localizationFormatExtension(format)
+	if strings.HasSuffix(outputPath, ext) {
+		return outputPath, nil
+	}
+	if err := os.MkdirAll(outputPath, 0o755); err != nil {
+		return "", err
+	}
+	return filepath.Join(outputPath, localizationBundleName+ext), nil
+}
+
+// encodeLocalizations renders every locale into one json, csv, or xcstrings document.
+func encodeLocalizations(format string, valuesByLocale map[string]map[string]string, locales, order []string) ([]byte, error) {
+	switch format {
+	case LocalizationFormatJSON:
+		data, err := json.MarshalIndent(valuesByLocale, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return append(data, '\n'), nil
+	case LocalizationFormatCSV:
+		return encodeLocalizationCSV(valuesByLocale, locales, order)
+	case LocalizationFormatXCStrings:
+		return encodeStringCatalog(valuesByLocale, locales, order)
+	default:
+		return nil, fmt.Errorf("unsupported localization format %q", format)
+	}
+}
+
+func decodeLocalizations(format string, data []byte) (map[string]map[string]string, error) {
+	switch format {
+	case LocalizationFormatJSON:
+		var values map[string]map[string]string
+		if err := json.Unmarshal(data, &values); err != nil {
+			return nil, fmt.Errorf("invalid JSON localizations (expected {\"locale\": {\"key\": \"value\"}}): %w", err)
+		}
+		return values, nil
+	case LocalizationFormatCSV:
+		return decodeLocalizationCSV(data)
+	case LocalizationFormatXCStrings:
+		return decodeStringCatalog(data)
+	default:
+		return nil, fmt.Errorf("unsupported localization format %q", format)
+	}
+}
+
+// readLocalizationBundle reads a single-file format. A directory path reads
+// the default bundle file inside it.
+func readLocalizationBundle(inputPath, format string, locales []string) (map[string]map[string]string, error) {
+	info, err := os.Stat(inputPath)
+	if err != nil {
+		return nil, err
+	}
+	path := inputPath
+	if info.IsDir() {
+		path = filepath.Join(inputPath, localizationBundleName+localizationFormatExtension(format))
+	}
+	data, err := readLocalizationFile(path)
+	if err != nil {
+		return nil, err
+	}
+	values, err := decodeLocalizations(format, data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return filterLocalizations(path, values, locales)
+}
+
+// filterLocalizations keeps only locales (when non-empty) and drops empty locales.
+func filterLocalizations(source string, values map[string]map[string]string, locales []string) (map[string]map[string]string, error) {
+	filter := make(map[string]bool, len(locales))
+	for _, locale := range locales {
+		filter[locale] = true
+	}
+	result := make(map[string]map[string]string, len(values))
+	for locale, entries := range values {
+		if len(filter) > 0 && !filter[locale] {
+			continue
+		}
+		if len(entries) == 0 {
+			continue
+		}
+		result[locale] = entries
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("no localizations found in %q", source)
+	}
+	return result, nil
+}
+
+// encodeLocalizationCSV writes a key column followed by one column per locale.
+func encodeLocalizationCSV(valuesByLocale map[string]map[string]string, locales, order []string) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	if err := writer.Write(append([]string{"key"}, locales...)); err != nil {
+		return nil, err
+	}
+	for _, key := range orderedLocalizationKeys(valuesByLocale, order) {
+		row := make([]string, 0, len(locales)+1)
+		row = append(row, key)
+		for _, locale := range locales {
+			row = append(row, valuesByLocale[locale][key])
+		}
+		if err := writer.Write(row); err != nil {
+			return nil, err
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// decodeLocalizationCSV reads a key column followed by one column per locale.
+// Empty cells are skipped.
+func decodeLocalizationCSV(data []byte) (map[string]map[string]string, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSV: %w", err)
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("empty CSV")
+	}
+	header := records[0]
+	if len(header) < 2 || !strings.EqualFold(strings.TrimSpace(strings.TrimPrefix(header[0], "\ufeff")), "key") {
+		return nil, fmt.Errorf("CSV header must be key followed by one column per locale")
+	}
+	locales := make([]string, 0, len(header)-1)
+	seen := make(map[string]bool, len(header)-1)
+	for _, column := range header[1:] {
+		locale := strings.TrimSpace(column)
+		if locale == "" {
+			return nil, fmt.Errorf("CSV header has an empty locale column")
+		}
+		if seen[locale] {
+			return nil, fmt.Errorf("duplicate locale %q in CSV header", locale)
+		}
+		seen[locale] = true
+		locales = append(locales, locale)
+	}
+
+	values := make(map[string]map[string]string, len(locales))
+	for _, record := range records[1:] {
+		key := strings.TrimSpace(record[0])
+		if key == "" {
+			continue
+		}
+		for i, locale := range locales {
+			value := record[i+1]
+			if value == "" {
+				continue
+			}
+			if values[locale] == nil {
+				values[locale] = make(map[string]string)
+			}
+			values[locale][key] = value
+		}
+	}
+	return values, nil
+}
+
+type stringCatalog struct {
+	SourceLanguage string                        `json:"sourceLanguage"`
+	Strings        map[string]stringCatalogEntry `json:"strings"`
+	Version        string                        `json:"version"`
+}
+
+type stringCatalogEntry struct {
+	ExtractionState string                               `json:"extractionState,omitempty"`
+	Localizations   map[string]stringCatalogLocalization `json:"localizations,omitempty"`
+}
+
+type stringCatalogLocalization struct {
+	StringUnit *stringCatalogUnit `json:"stringUnit,omitempty"`
+}
+
+type stringCatalogUnit struct {
+	State string `json:"state"`
+	Value string `json:"value"`
+}
+
+// encodeStringCatalog writes an Xcode String Catalog (.xcstrings).
+func encodeStringCatalog(valuesByLocale map[string]map[string]string, locales, order []string) ([]byte, error) {
+	catalog := stringCatalog{
+		SourceLanguage: localizationSourceLocale(locales),
+		Strings:        make(map[string]stringCatalogEntry),
+		Version:        "1.0",
+	}
+	for _, key := range orderedLocalizationKeys(valuesByLocale, order) {
+		entry := stringCatalogEntry{
+			ExtractionState: "manual",
+			Localizations:   make(map[string]stringCatalogLocalization),
+		}
+		for _, locale := range locales {
+			value, ok := valuesByLocale[locale][key]
+			if !ok {
+				continue
+			}
+			entry.Localizations[locale] = stringCatalogLocalization{
+				StringUnit: &stringCatalogUnit{State: "translated", Value: value},
+			}
+		}
+		catalog.Strings[key] = entry
+	}
+	data, err := json.MarshalIndent(catalog, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+// decodeStringCatalog reads string units from an Xcode String Catalog. Plural
+// and device variations are not supported and are skipped.
+func decodeStringCatalog(data []byte) (map[string]map[string]string, error) {
+	var catalog stringCatalog
+	if err := json.Unmarshal(data, &catalog); err != nil {
+		return nil, fmt.Errorf("invalid String Catalog: %w", err)
+	}
+	values := make(map[string]map[string]string)
+	for key, entry := range catalog.Strings {
+		for locale, localization := range entry.Localizations {
+			if localization.StringUnit == nil || localization.StringUnit.Value == "" {
+				continue
+			}
+			if values[locale] == nil {
+				values[locale] = make(map[string]string)
+			}
+			values[locale][key] = localization.StringUnit.Value
+		}
+	}
+	return values, nil
+}
+
+type xliffDocument struct {
+	XMLName xml.Name    `xml:"urn:oasis:names:tc:xliff:document:1.2 xliff"`
+	Version string      `xml:"version,attr"`
+	Files   []xliffFile `xml:"file"`
+}
+
+type xliffFile struct {
+	Original       string           `xml:"original,attr"`
+	SourceLanguage string           `xml:"source-language,attr"`
+	TargetLanguage string           `xml:"target-language,attr,omitempty"`
+	Datatype       string           `xml:"datatype,attr"`
+	Units          []xliffTransUnit `xml:"body>trans-unit"`
+	Groups         []xliffGroup     `xml:"body>group"`
+}
+
+type xliffGroup struct {
+	Units  []xliffTransUnit `xml:"trans-unit"`
+	Groups []xliffGroup     `xml:"group"`
+}
+
+type xliffTransUnit struct {
+	ID     string  `xml:"id,attr"`
+	Source string  `xml:"source"`
+	Target *string `xml:"target"`
+}
+
+// writeXLIFFFile writes one XLIFF 1.2 file for locale, using the source
+// locale's values as source text.
+func writeXLIFFFile(path, sourceLocale, locale string, sourceValues, values map[string]string, order []string) error {
+	file := xliffFile{
+		Original:       "AppStoreConnect",
+		SourceLanguage: sourceLocale,
+		TargetLanguage: locale,
+		Datatype:       "plaintext",
+	}
+	for _, key := range orderedLocalizationKeys(map[string]map[string]string{locale: values}, order) {
+		value := values[key]
+		source, ok := sourceValues[key]
+		if !ok {
+			source = value
+		}
+		target := value
+		file.Units = append(file.Units, xliffTransUnit{ID: key, Source: source, Target: &target})
+	}
+
+	data, err := xml.MarshalIndent(xliffDocument{Version: "1.2", Files: []xliffFile{file}}, "", "  ")
+	if err != nil {
+		return err
+	}
+	content := append([]byte(xml.Header), data...)
+	return writeNewFile(path, append(content, '\n'))
+}
+
+// readXLIFFLocalizations reads an XLIFF file or every .xliff/.xlf file in a
+// directory. The locale comes from each <file>'s target-language, else the
+// file name. Units without a target are treated as untranslated and skipped.
+func readXLIFFLocalizations(inputPath string, locales []string) (map[string]map[string]string, error) {
+	info, err := os.Stat(inputPath)
+	if err != nil {
+		return nil, err
+	}
+	paths := []string{inputPath}
+	if info.IsDir() {
+		entries, err := os.ReadDir(inputPath)
+		if err != nil {
+			return nil, err
+		}
+		paths = paths[:0]
+		for _, entry := range entries {
+			ext := strings.ToLower(filepath.Ext(entry.Name()))
+			if entry.IsDir() || (ext != ".xliff" && ext != ".xlf") {
+				continue
+			}
+			paths = append(paths, filepath.Join(inputPath, entry.Name()))
+		}
+		if len(paths) == 0 {
+			return nil, fmt.Errorf("no .xliff files found in %q", inputPath)
+		}
+	}
+
+	values := make(map[string]map[string]string)
+	for _, path := range paths {
+		data, err := readLocalizationFile(path)
+		if err != nil {
+			return nil, err
+		}
+		var doc xliffDocument
+		if err := xml.Unmarshal(data, &doc); err != nil {
+			return nil, fmt.Errorf("%s: invalid XLIFF: %w", path, err)
+		}
+		fileLocale := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+		for _, file := range doc.Files {
+			locale := strings.TrimSpace(file.TargetLanguage)
+			if locale == "" {
+				locale = fileLocale
+			}
+			units := collectXLIFFUnits(file.Units, file.Groups)
+			for _, unit := range units {
+				if unit.Target == nil || *unit.Target == "" {
+					continue
+				}
+				if values[locale] == nil {
+					values[locale] = make(map[string]string)
+				}
+				if _, exists := values[locale][unit.ID]; exists {
+					return nil, fmt.Errorf("%s: duplicate trans-unit %q for locale %q", path, unit.ID, locale)
+				}
+				values[locale][unit.ID] = *unit.Target
+			}
+		}
+	}
+	return filterLocalizations(inputPath, values, locales)
+}
+
+func collectXLIFFUnits(units []xliffTransUnit, groups []xliffGroup) []xliffTransUnit {
+	result := append([]xliffTransUnit(nil), units...)
+	for _, group := range groups {
+		result = append(result, collectXLIFFUnits(group.Units, group.Groups)...)
+	}
+	return result
+}
@@ -0,0 +1,169 @@
+package shared
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNormalizeLocalizationFormat(t *testing.T) {
+	tests := []struct {
+		value, path, want string
+	}{
+		{"", "localizations", LocalizationFormatStrings},
+		{"", "en-US.strings", LocalizationFormatStrings},
+		{"", "./de.xlf", LocalizationFormatXLIFF},
+		{"", "./metadata.CSV", LocalizationFormatCSV},
+		{"", "Localizable.xcstrings", LocalizationFormatXCStrings},
+		{"XLIFF", "out.csv", LocalizationFormatXLIFF},
+		{"json", "", LocalizationFormatJSON},
+	}
+	for _, test := range tests {
+		got, err := NormalizeLocalizationFormat(test.value, test.path)
+		if err != nil {
+			t.Fatalf("NormalizeLocalizationFormat(%q, %q) error: %v", test.value, test.path, err)
+		}
+		if got != test.want {
+			t.Fatalf("NormalizeLocalizationFormat(%q, %q) = %q, want %q", test.value, test.path, got, test.want)
+		}
+	}
+	if _, err := NormalizeLocalizationFormat("yaml", ""); err == nil {
+		t.Fatal("expected error for unsupported format")
+	}
+}
+
+func TestLocalizationFormatsRoundTrip(t *testing.T) {
+	values := map[string]map[string]string{
+		"en-US": {"description": "Hello, \"world\"\nLine two", "keywords": "one,two"},
+		"de-DE": {"description": "Hallo <Welt> & mehr"},
+	}
+	for _, format := range []string{
+		LocalizationFormatStrings,
+		LocalizationFormatXLIFF,
+		LocalizationFormatJSON,
+		LocalizationFormatCSV,
+		LocalizationFormatXCStrings,
+	} {
+		t.Run(format, func(t *testing.T) {
+			dir := filepath.Join(t.TempDir(), "out")
+			files, err := writeLocalizationFiles(dir, format, values, versionLocalizationKeys)
+			if err != nil {
+				t.Fatalf("writeLocalizationFiles() error: %v", err)
+			}
+			if len(files) != 2 {
+				t.Fatalf("expected 2 file results, got %+v", files)
+			}
+
+			got, err := ReadLocalizationFiles(dir, format, nil)
+			if err != nil {
+				t.Fatalf("ReadLocalizationFiles() error: %v", err)
+			}
+			if !reflect.DeepEqual(got, values) {
+				t.Fatalf("round trip mismatch:\ngot  %#v\nwant %#v", got, values)
+			}
+
+			filtered, err := ReadLocalizationFiles(dir, format, []string{"de-DE"})
+			if err != nil {
+				t.Fatalf("ReadLocalizationFiles(filtered) error: %v", err)
+			}
+			if len(filtered) != 1 || filtered["de-DE"] == nil {
+				t.Fatalf("expected only de-DE, got %#v", filtered)
+			}
+		})
+	}
+}
+
+func TestWriteLocalizationFiles_CSVLayout(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "metadata.csv")
+	values := map[string]map[string]string{
+		"en-US": {"description": "Hello", "keywords": "a,b"},
+		"fr-FR": {"description": "Bonjour"},
+	}
+	if _, err := writeLocalizationFiles(path, LocalizationFormatCSV, values, versionLocalizationKeys); err != nil {
+		t.Fatalf("writeLocalizationFiles() error: %v", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error: %v", err)
+	}
+	want := "key,en-US,fr-FR\ndescription,Hello,Bonjour\nkeywords,\"a,b\",\n"
+	if string(data) != want {
+		t.Fatalf("unexpected CSV:\n%s", data)
+	}
+}
+
+func TestReadLocalizationFiles_XLIFFFromTMS(t *testing.T) {
+	dir := t.TempDir()
+	content := `<?xml version="1.0" encoding="UTF-8"?>
+<xliff xmlns="urn:oasis:names:tc:xliff:document:1.2" version="1.2">
+  <file original="metadata" source-language="en-US" target-language="ja" datatype="plaintext">
+    <body>
+      <group id="store">
+        <trans-unit id="description">
+          <source>Hello</source>
+          <target state="translated">こんにちは</target>
+        </trans-unit>
+        <trans-unit id="keywords">
+          <source>one, two</source>
+        </trans-unit>
+      </group>
+      <trans-unit id="whatsNew">
+        <source>Fixes</source>
+        <target>修正</target>
+      </trans-unit>
+    </body>
+  </file>
+</xliff>`
+	if err := os.WriteFile(filepath.Join(dir, "translations.xlf"), []byte(content), 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+
+	values, err := ReadLocalizationFiles(dir, LocalizationFormatXLIFF, nil)
+	if err != nil {
+		t.Fatalf("ReadLocalizationFiles() error: %v", err)
+	}
+	want := map[string]map[string]string{"ja": {"description": "こんにちは", "whatsNew": "修正"}}
+	if !reflect.DeepEqual(values, want) {
+		t.Fatalf("got %#v, want %#v", values, want)
+	}
+}
+
+func TestWriteLocalizationFiles_XLIFFUsesSourceLocale(t *testing.T) {
+	dir := t.TempDir()
+	values := map[string]map[string]string{
+		"en-US": {"description": "Hello"},
+		"de-DE": {"description": "Hallo"},
+	}
+	if _, err := writeLocalizationFiles(dir, LocalizationFormatXLIFF, values, versionLocalizationKeys); err != nil {
+		t.Fatalf("writeLocalizationFiles() error: %v", err)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "de-DE.xliff"))
+	if err != nil {
+		t.Fatalf("ReadFile() error: %v", err)
+	}
+	content := string(data)
+	for _, want := range []string{`source-language="en-US"`, `target-language="de-DE"`, "<source>Hello</source>", "<target>Hallo</target>"} {
+		if !strings.Contains(content, want) {
+			t.Fatalf("expected %q in:\n%s", want, content)
+		}
+	}
+}
+
+func TestReadLocalizationFiles_CSVErrors(t *testing.T) {
+	tests := map[string]string{
+		"bad header":       "id,en-US\ndescription,Hello\n",
+		"duplicate locale": "key,en-US,en-US\ndescription,a,b\n",
+		"no values":        "key,en-US\ndescription,\n",
+	}
+	for name, content := range tests {
+		path := filepath.Join(t.TempDir(), "metadata.csv")
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatalf("WriteFile() error: %v", err)
+		}
+		if _, err := ReadLocalizationFiles(path, LocalizationFormatCSV, nil); err == nil {
+			t.Fatalf("%s: expected error", name)
+		}
+	}
+}
@@ -50,6 +50,17 @@ func NormalizeLocalizationType(value string) (string, error) {
 }
 
 func WriteVersionLocalizationStrings(outputPath string, items []asc.Resource[asc.AppStoreVersionLocalizationAttributes]) ([]asc.LocalizationFileResult, error) {
+	return WriteVersionLocalizationFiles(outputPath, LocalizationFormatStrings, items)
+}
+
+// WriteVersionLocalizationFiles writes version localizations in the given format.
+func WriteVersionLocalizationFiles(outputPath, format string, items []asc.Resource[asc.AppStoreVersionLocalizationAttributes]) ([]asc.LocalizationFileResult, error) {
+	return writeLocalizationFiles(outputPath, format, VersionLocalizationValues(items), versionLocalizationKeys)
+}
+
+// VersionLocalizationValues maps version localizations to non-empty field
+// values by locale, keyed like .strings files (description, keywords, ...).
+func VersionLocalizationValues(items []asc.Resource[asc.AppStoreVersionLocalizationAttributes]) map[string]map[string]string {
 	byLocale := make(map[string]map[string]string, len(items))
 	for _, item := range items {
 		locale := strings.TrimSpace(item.Attributes.Locale)
@@ -58,10 +69,21 @@ func WriteVersionLocalizationStrings(outputPath string, items []asc.Resource[asc
 		}
 		byLocale[locale] = mapVersionLocalizationStrings(item.Attributes)
 	}
-	return writeLocalizationStrings(outputPath, byLocale, versionLocalizationKeys)
+	return byLocale
 }
 
 func WriteAppInfoLocalizationStrings(outputPath string, items []asc.Resource[asc.AppInfoLocalizationAttributes]) ([]asc.LocalizationFileResult, error) {
+	return WriteAppInfoLocalizationFiles(outputPath, LocalizationFormatStrings, items)
+}
+
+// WriteAppInfoLocalizationFiles writes app info localizations in the given format.
+func WriteAppInfoLocalizationFiles(outputPath, format string, items []asc.Resource[asc.AppInfoLocalizationAttributes]) ([]asc.LocalizationFileResult, error) {
+	return writeLocalizationFiles(outputPath, format, AppInfoLocalizationValues(items), appInfoLocalizationKeys)
+}
+
+// AppInfoLocalizationValues maps app info localizations to non-empty field
+// values by locale, keyed like .strings files (name, subtitle, ...).
+func AppInfoLocalizationValues(items []asc.Resource[asc.AppInfoLocalizationAttributes]) map[string]map[string]string {
 	byLocale := make(map[string]map[string]string, len(items))
 	for _, item := range items {
 		locale := strings.TrimSpace(item.Attributes.Locale)
@@ -70,10 +92,10 @@ func WriteAppInfoLocalizationStrings(outputPath string, items []asc.Resource[asc
 		}
 		byLocale[locale] = mapAppInfoLocalizationStrings(item.Attributes)
 	}
-	return writeLocalizationStrings(outputPath, byLocale, appInfoLocalizationKeys)
+	return byLocale
 }
 
-func writeLocalizationStrings(outputPath string, valuesByLocale map[string]map[string]string, order []string) ([]asc.LocalizationFileResult, error) {
+func writeLocalizationFiles(outputPath, format string, valuesByLocale map[string]map[string]string, order []string) ([]asc.LocalizationFileResult, error) {
 	if len(valuesByLocale) == 0 {
 		return nil, fmt.Errorf("no localizations returned")
 	}
@@ -84,19 +106,45 @@ func writeLocalizationStrings(outputPath string, valuesByLocale map[string]map[s
 	}
 	sort.Strings(locales)
 
-	paths, err := resolveLocalizationOutputPaths(outputPath, locales)
+	if !isPerLocaleFormat(format) {
+		path, err := resolveLocalizationOutputFile(outputPath, format)
+		if err != nil {
+			return nil, err
+		}
+		data, err := encodeLocalizations(format, valuesByLocale, locales, order)
+		if err != nil {
+			return nil, err
+		}
+		if err := writeNewFile(path, data); err != nil {
+			return nil, err
+		}
+		results := make([]asc.LocalizationFileResult, 0, len(locales))
+		for _, locale := range locales {
+			results = append(results, asc.LocalizationFileResult{Locale: locale, Path: path})
+		}
+		return results, nil
+	}
+
+	paths, err := resolveLocalizationOutputPaths(outputPath, locales, localizationFormatExtension(format))
 	if err != nil {
 		return nil, err
 	}
 
+	source := localizationSourceLocale(locales)
 	results := make([]asc.LocalizationFileResult, 0, len(locales))
 	for _, locale := range locales {
 		path, ok := paths[locale]
 		if !ok {
 			continue
 		}
-		if err := writeStringsFile(path, valuesByLocale[locale], order); err != nil {
-			return nil, err
+		var writeErr error
+		if format == LocalizationFormatXLIFF {
+			writeErr = writeXLIFFFile(path, source, locale, valuesByLocale[source], valuesByLocale[locale], order)
+		} else {
+			writeErr = writeStringsFile(path, valuesByLocale[locale], order)
+		}
+		if writeErr != nil {
+			return nil, writeErr
 		}
 		results = append(results, asc.LocalizationFileResult{
 			Locale: locale,
@@ -121,13 +169,13 @@ func isValidLocale(locale string) bool {
 	return localeValidationRegex.MatchString(locale)
 }
 
-func resolveLocalizationOutputPaths(outputPath string, locales []string) (map[string]string, error) {
+func resolveLocalizationOutputPaths(outputPath string, locales []string, ext string) (map[string]string, error) {
 	if strings.TrimSpace(outputPath) == "" {
 		outputPath = "localizations"
 	}
 
 	result := make(map[string]string, len(locales))
-	if strings.HasSuffix(outputPath, ".strings") {
+	if strings.HasSuffix(outputPath, ext) {
 		if len(locales) != 1 {
 			return nil, fmt.Errorf("output path %q requires exactly one locale", outputPath)
 		}
@@ -147,7 +195,7 @@ func resolveLocalizationOutputPaths(outputPath string, locales []string) (map[st
 		if !isValidLocale(locale) {
 			return nil, fmt.Errorf("invalid locale code %q: must match pattern like 'en', 'en-US', or 'zh-Hans'", locale)
 		}
-		result[locale] = filepath.Join(outputPath, locale+".strings")
+		result[locale] = filepath.Join(outputPath, locale+ext)
 	}
 	return result, nil
 }
@@ -181,6 +229,23 @@ func setIfNotEmpty(values map[string]string, key, value string) {
 }
 
 func ReadLocalizationStrings(inputPath string, locales []string) (map[string]map[string]string, error) {
+	return ReadLocalizationFiles(inputPath, LocalizationFormatStrings, locales)
+}
+
+// ReadLocalizationFiles reads localization values by locale from a file or
+// directory in the given format, keeping only locales when non-empty.
+func ReadLocalizationFiles(inputPath, format string, locales []string) (map[string]map[string]string, error) {
+	switch format {
+	case LocalizationFormatStrings:
+		return readStringsLocalizations(inputPath, locales)
+	case LocalizationFormatXLIFF:
+		return readXLIFFLocalizations(inputPath, locales)
+	default:
+		return readLocalizationBundle(inputPath, format, locales)
+	}
+}
+
+func readStringsLocalizations(inputPath string, locales []string) (map[string]map[string]string, error) {
 	info, err := os.Stat(inputPath)
 	if err != nil {
 		return nil, err
@@ -250,11 +315,8 @@ func ReadLocalizationStrings(inputPath string, locales []string) (map[string]map
 }
 
 func UploadVersionLocalizations(ctx context.Context, client *asc.Client, versionID string, valuesByLocale map[string]map[string]string, dryRun bool) ([]asc.LocalizationUploadLocaleResult, error) {
-	validateKeys := buildAllowedKeys(versionLocalizationKeys)
-	for locale, values := range valuesByLocale {
-		if err := validateLocalizationKeys(locale, values, validateKeys); err != nil {
-			return nil, err
-		}
+	if err := ValidateVersionLocalizationKeys(valuesByLocale); err != nil {
+		return nil, err
 	}
 
 	existing, err := client.GetAppStoreVersionLocalizations(ctx, versionID, asc.WithAppStoreVersionLocalizationsLimit(200))
@@ -293,11 +355,8 @@ func UploadVersionLocalizations(ctx context.Context, client *asc.Client, version
 }
 
 func UploadAppInfoLocalizations(ctx context.Context, client *asc.Client, appInfoID string, valuesByLocale map[string]map[string]string, dryRun bool) ([]asc.LocalizationUploadLocaleResult, error) {
-	validateKeys := buildAllowedKeys(appInfoLocalizationKeys)
-	for locale, values := range valuesByLocale {
-		if err := validateLocalizationKeys(locale, values, validateKeys); err != nil {
-			return nil, err
-		}
+	if err := ValidateAppInfoLocalizationKeys(valuesByLocale); err != nil {
+		return nil, err
 	}
 
 	existing, err := client.GetAppInfoLocalizations(ctx, appInfoID, asc.WithAppInfoLocalizationsLimit(200))
@@ -369,6 +428,41 @@ func hasNonEmptyLocalizationValues(values map[string]string) bool {
 	return false
 }
 
+// IsAppInfoLocalizationKey reports whether key is an app info localization
+// field (name, subtitle, ...) rather than a version localization field.
+func IsAppInfoLocalizationKey(key string) bool {
+	for _, appInfoKey := range appInfoLocalizationKeys {
+		if key == appInfoKey {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateVersionLocalizationKeys rejects keys that are not version localization fields.
+func ValidateVersionLocalizationKeys(valuesByLocale map[string]map[string]string) error {
+	return validateLocalizationKeysByLocale(valuesByLocale, buildAllowedKeys(versionLocalizationKeys))
+}
+
+// ValidateAppInfoLocalizationKeys rejects keys that are not app info localization fields.
+func ValidateAppInfoLocalizationKeys(valuesByLocale map[string]map[string]string) error {
+	return validateLocalizationKeysByLocale(valuesByLocale, buildAllowedKeys(appInfoLocalizationKeys))
+}
+
+func validateLocalizationKeysByLocale(valuesByLocale map[string]map[string]string, allowed map[string]bool) error {
+	locales := make([]string, 0, len(valuesByLocale))
+	for locale := range valuesByLocale {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+	for _, locale := range locales {
+		if err := validateLocalizationKeys(locale, valuesByLocale[locale], allowed); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func buildAllowedKeys(keys []string) map[string]bool {
 	allowed := make(map[string]bool, len(keys))
 	for _, key := range keys {
@@ -447,6 +541,15 @@ type stringsParser struct {
 }
 
 func readStringsFile(path string) (map[string]string, error) {
+	data, err := readLocalizationFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return parseStringsContent(string(data))
+}
+
+// readLocalizationFile reads a regular file without following symlinks.
+func readLocalizationFile(path string) ([]byte, error) {
 	info, err := os.Lstat(path)
 	if err != nil {
 		return nil, err
@@ -464,11 +567,7 @@ func readStringsFile(path string) (map[string]string, error) {
 	}
 	defer file.Close()
 
-	data, err := io.ReadAll(file)
-	if err != nil {
-		return nil, err
-	}
-	return parseStringsContent(string(data))
+	return io.ReadAll(file)
 }
 
 func parseStringsContent(content string) (map[string]string, error) {
@@ -642,10 +741,6 @@ func (p *stringsParser) errorf(message string) error {
 }
 
 func writeStringsFile(path string, values map[string]string, order []string) error {
-	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
-		return err
-	}
-
 	var b strings.Builder
 	for _, key := range order {
 		value, ok := values[key]
@@ -654,6 +749,14 @@ func writeStringsFile(path string, values map[string]string, order []string) err
 		}
 		fmt.Fprintf(&b, "\"%s\" = \"%s\";\n", key, escapeStringsValue(value))
 	}
+	return writeNewFile(path, []byte(b.String()))
+}
+
+// writeNewFile creates path and writes data, refusing to overwrite.
+func writeNewFile(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
 
 	// Create file securely to prevent symlink attacks and TOCTOU vulnerabilities
 	// O_EXCL ensures atomic creation, O_NOFOLLOW prevents symlink traversal
@@ -666,7 +769,7 @@ func writeStringsFile(path string, values map[string]string, order []string) err
 	}
 	defer file.Close()
 
-	if _, err := file.WriteString(b.String()); err != nil {
+	if _, err := file.Write(data); err != nil {
 		return err
 	}
 	return file.Sync()
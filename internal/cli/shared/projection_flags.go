@@ -0,0 +1,171 @@
+package shared
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/query"
+)
+
+var (
+	outputFields string
+	outputQuery  string
+)
+
+// BindProjectionFlags registers flags that reshape command output in-process.
+func BindProjectionFlags(fs *flag.FlagSet) {
+	fs.StringVar(&outputFields, "fields", "", "Comma-separated field paths to keep in each resource (e.g., id,attributes.version)")
+	fs.StringVar(&outputQuery, "query", "", "JMESPath-style expression applied to the JSON output before formatting")
+}
+
+// ValidateProjectionFlags validates --fields and --query.
+func ValidateProjectionFlags() error {
+	if _, err := parseOutputFields(outputFields); err != nil {
+		return err
+	}
+	if strings.TrimSpace(outputQuery) != "" {
+		if _, err := query.Compile(outputQuery); err != nil {
+			return fmt.Errorf("--query: %w", err)
+		}
+	}
+	return nil
+}
+
+// ProjectionRequested reports whether --fields or --query is set. Commands
+// with their own table renderers use it to fall back to PrintOutput.
+func ProjectionRequested() bool {
+	return strings.TrimSpace(outputFields) != "" || strings.TrimSpace(outputQuery) != ""
+}
+
+// SetOutputFields sets the --fields value (for testing).
+func SetOutputFields(fields string) {
+	outputFields = fields
+}
+
+// SetOutputQuery sets the --query value (for testing).
+func SetOutputQuery(expression string) {
+	outputQuery = expression
+}
+
+func parseOutputFields(value string) ([]string, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+	var fields []string
+	for _, field := range strings.Split(value, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		for _, part := range strings.Split(field, ".") {
+			if part == "" {
+				return nil, fmt.Errorf("--fields: invalid field path %q", field)
+			}
+		}
+		fields = append(fields, field)
+	}
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("--fields must list at least one field")
+	}
+	return fields, nil
+}
+
+// applyProjection applies --query and then --fields to data. It returns data
+// unchanged when neither flag is set.
+func applyProjection(data interface{}) (interface{}, error) {
+	fields, err := parseOutputFields(outputFields)
+	if err != nil {
+		return nil, err
+	}
+	expression := strings.TrimSpace(outputQuery)
+	if expression == "" && len(fields) == 0 {
+		return data, nil
+	}
+
+	value, err := query.ToGeneric(data)
+	if err != nil {
+		return nil, fmt.Errorf("projection: %w", err)
+	}
+	if expression != "" {
+		compiled, err := query.Compile(expression)
+		if err != nil {
+			return nil, fmt.Errorf("--query: %w", err)
+		}
+		value, err = compiled.Evaluate(value)
+		if err != nil {
+			return nil, fmt.Errorf("--query: %w", err)
+		}
+	}
+	if len(fields) > 0 {
+		value = projectFields(value, fields)
+	}
+	return &asc.ProjectedOutput{Value: value, Columns: fields}, nil
+}
+
+// projectFields keeps only fields in each resource: the elements (or object)
+// under "data" in a JSON:API document, the elements of a top-level array, or
+// the top-level object itself. Links, meta and included are left untouched.
+func projectFields(value interface{}, fields []string) interface{} {
+	switch v := value.(type) {
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, item := range v {
+			out[i] = selectFields(item, fields)
+		}
+		return out
+	case map[string]interface{}:
+		data, ok := v["data"]
+		if !ok {
+			return selectFields(v, fields)
+		}
+		doc := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			doc[key] = item
+		}
+		if list, isList := data.([]interface{}); isList {
+			doc["data"] = projectFields(list, fields)
+		} else if data != nil {
+			doc["data"] = selectFields(data, fields)
+		}
+		return doc
+	}
+	return value
+}
+
+// selectFields copies the dotted paths present in item, keeping their nesting.
+func selectFields(item interface{}, fields []string) interface{} {
+	obj, ok := item.(map[string]interface{})
+	if !ok {
+		return item
+	}
+	out := make(map[string]interface{})
+	for _, field := range fields {
+		parts := strings.Split(field, ".")
+		source := obj
+		target := out
+		for i, part := range parts {
+			value, exists := source[part]
+			if !exists {
+				break
+			}
+			if i == len(parts)-1 {
+				target[part] = value
+				break
+			}
+			next, isObject := value.(map[string]interface{})
+			if !isObject {
+				break
+			}
+			child, hasChild := target[part].(map[string]interface{})
+			if !hasChild {
+				child = make(map[string]interface{})
+				target[part] = child
+			}
+			source = next
+			target = child
+		}
+	}
+	return out
+}
@@ -0,0 +1,43 @@
+package shared
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateProjectionFlags(t *testing.T) {
+	t.Cleanup(func() {
+		SetOutputFields("")
+		SetOutputQuery("")
+	})
+
+	tests := []struct {
+		name    string
+		fields  string
+		query   string
+		wantErr string
+	}{
+		{name: "empty"},
+		{name: "valid", fields: "id, attributes.version", query: "data[*].id"},
+		{name: "empty path segment", fields: "attributes..version", wantErr: "invalid field path"},
+		{name: "only commas", fields: ",,", wantErr: "at least one field"},
+		{name: "bad query", query: "data[", wantErr: "--query"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			SetOutputFields(test.fields)
+			SetOutputQuery(test.query)
+			err := ValidateProjectionFlags()
+			if test.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
@@ -64,6 +64,9 @@ var (
 	retryLog            OptionalBool
 	debug               OptionalBool
 	apiDebug            OptionalBool
+	baseURL             string
+	recordDir           string
+	replayDir           string
 	noUpdate            bool
 )
 
@@ -79,8 +82,12 @@ func BindRootFlags(fs *flag.FlagSet) {
 	fs.Var(&retryLog, "retry-log", "Enable retry logging to stderr (overrides ASC_RETRY_LOG/config when set)")
 	fs.Var(&debug, "debug", "Enable debug logging to stderr")
 	fs.Var(&apiDebug, "api-debug", "Enable HTTP debug logging to stderr (redacts sensitive values)")
+	fs.StringVar(&baseURL, "base-url", "", "Override the App Store Connect API base URL (or ASC_BASE_URL)")
+	fs.StringVar(&recordDir, "record", "", "Record API interactions to a cassette directory (or ASC_RECORD_DIR)")
+	fs.StringVar(&replayDir, "replay", "", "Replay API interactions from a cassette directory (or ASC_REPLAY_DIR)")
 	fs.BoolVar(&noUpdate, "no-update", false, "Skip update checks and auto-update")
 	BindCIFlags(fs)
+	BindProjectionFlags(fs)
 }
 
 // SelectedProfile returns the current profile override.
@@ -233,6 +240,7 @@ type envCredentials struct {
 	keyID    string
 	issuerID string
 	keyPath  string
+	keyType  string
 	complete bool
 }
 
@@ -240,6 +248,7 @@ type resolvedCredentials struct {
 	keyID    string
 	issuerID string
 	keyPath  string
+	keyType  string
 }
 
 type credentialSource struct {
@@ -251,14 +260,20 @@ type credentialSource struct {
 func resolveEnvCredentials() (envCredentials, error) {
 	keyID := strings.TrimSpace(os.Getenv("ASC_KEY_ID"))
 	issuerID := strings.TrimSpace(os.Getenv("ASC_ISSUER_ID"))
+	rawKeyType := strings.TrimSpace(os.Getenv("ASC_KEY_TYPE"))
 	hasKeyPathEnv := strings.TrimSpace(os.Getenv("ASC_PRIVATE_KEY_PATH")) != "" ||
 		strings.TrimSpace(os.Getenv(privateKeyEnvVar)) != "" ||
 		strings.TrimSpace(os.Getenv(privateKeyBase64EnvVar)) != ""
 
-	if keyID == "" && issuerID == "" && !hasKeyPathEnv {
+	if keyID == "" && issuerID == "" && rawKeyType == "" && !hasKeyPathEnv {
 		return envCredentials{}, nil
 	}
 
+	keyType, err := config.NormalizeKeyType(rawKeyType)
+	if err != nil {
+		return envCredentials{}, fmt.Errorf("ASC_KEY_TYPE: %w", err)
+	}
+
 	keyPath, err := resolvePrivateKeyPath()
 	if err != nil {
 		return envCredentials{}, err
@@ -268,13 +283,14 @@ func resolveEnvCredentials() (envCredentials, error) {
 		keyID:    keyID,
 		issuerID: issuerID,
 		keyPath:  keyPath,
+		keyType:  keyType,
 	}
-	creds.complete = keyID != "" && issuerID != "" && keyPath != ""
+	creds.complete = keyID != "" && keyPath != "" && (issuerID != "" || keyType == config.KeyTypeIndividual)
 	return creds, nil
 }
 
 func resolveCredentials() (resolvedCredentials, error) {
-	var actualKeyID, actualIssuerID, actualKeyPath string
+	var actualKeyID, actualIssuerID, actualKeyPath, actualKeyType string
 	profile := resolveProfileName()
 	var envCreds envCredentials
 	envResolved := false
@@ -288,14 +304,12 @@ func resolveCredentials() (resolvedCredentials, error) {
 		envCreds = resolved
 		envResolved = true
 		if envCreds.complete {
-			sources.keyID = "env"
-			sources.issuerID = "env"
-			sources.keyPath = "env"
-			return resolvedCredentials{
+			return validateResolvedCredentials(resolvedCredentials{
 				keyID:    envCreds.keyID,
 				issuerID: envCreds.issuerID,
 				keyPath:  envCreds.keyPath,
-			}, nil
+				keyType:  envCreds.keyType,
+			})
 		}
 	}
 
@@ -309,13 +323,14 @@ func resolveCredentials() (resolvedCredentials, error) {
 		actualKeyID = cfg.KeyID
 		actualIssuerID = cfg.IssuerID
 		actualKeyPath = cfg.PrivateKeyPath
+		actualKeyType = cfg.KeyType
 		sources.keyID = storedSource
 		sources.issuerID = storedSource
 		sources.keyPath = storedSource
 	}
 
 	// Priority 2: Environment variables (fallback for CI/CD or when keychain unavailable)
-	if actualKeyID == "" || actualIssuerID == "" || actualKeyPath == "" {
+	if actualKeyID == "" || actualKeyPath == "" || (actualIssuerID == "" && !config.IsIndividualKeyType(actualKeyType)) {
 		if !envResolved {
 			resolved, err := resolveEnvCredentials()
 			if err != nil {
@@ -325,9 +340,10 @@ func resolveCredentials() (resolvedCredentials, error) {
 		}
 		if actualKeyID == "" && envCreds.keyID != "" {
 			actualKeyID = envCreds.keyID
+			actualKeyType = envCreds.keyType
 			sources.keyID = "env"
 		}
-		if actualIssuerID == "" && envCreds.issuerID != "" {
+		if actualIssuerID == "" && envCreds.issuerID != "" && !config.IsIndividualKeyType(actualKeyType) {
 			actualIssuerID = envCreds.issuerID
 			sources.issuerID = "env"
 		}
@@ -337,7 +353,11 @@ func resolveCredentials() (resolvedCredentials, error) {
 		}
 	}
 
-	if actualKeyID == "" || actualIssuerID == "" || actualKeyPath == "" {
+	if config.IsIndividualKeyType(actualKeyType) && actualIssuerID == "" {
+		// Individual keys have no issuer; check mixing on key ID and path only.
+		sources.issuerID = sources.keyID
+	}
+	if actualKeyID == "" || actualKeyPath == "" || (actualIssuerID == "" && !config.IsIndividualKeyType(actualKeyType)) {
 		if path, err := config.Path(); err == nil {
 			return resolvedCredentials{}, missingAuthError{msg: fmt.Sprintf("missing authentication. Run 'asc auth login' or create %s (see 'asc auth init')", path)}
 		}
@@ -347,18 +367,29 @@ func resolveCredentials() (resolvedCredentials, error) {
 		return resolvedCredentials{}, err
 	}
 
-	return resolvedCredentials{
+	return validateResolvedCredentials(resolvedCredentials{
 		keyID:    actualKeyID,
 		issuerID: actualIssuerID,
 		keyPath:  actualKeyPath,
-	}, nil
+		keyType:  actualKeyType,
+	})
 }
 
-func getASCClient() (*asc.Client, error) {
-	resolved, err := resolveCredentials()
+// validateResolvedCredentials normalizes the key type and checks the fields
+// each key type requires.
+func validateResolvedCredentials(creds resolvedCredentials) (resolvedCredentials, error) {
+	keyType, err := config.NormalizeKeyType(creds.keyType)
 	if err != nil {
-		return nil, err
+		return resolvedCredentials{}, err
 	}
+	creds.keyType = keyType
+	if keyType == config.KeyTypeIndividual && creds.issuerID != "" {
+		return resolvedCredentials{}, fmt.Errorf("individual API keys do not use an issuer ID; remove the issuer ID for key %s", creds.keyID)
+	}
+	return creds, nil
+}
+
+func getASCClient() (*asc.Client, error) {
 	if retryLog.IsSet() {
 		value := retryLog.Value()
 		asc.SetRetryLogOverride(&value)
@@ -377,9 +408,56 @@ func getASCClient() (*asc.Client, error) {
 	} else {
 		asc.SetDebugHTTPOverride(nil)
 	}
+	applyBaseURLOverride()
+	if err := applyCassetteOverride(); err != nil {
+		return nil, err
+	}
+	resolved, err := resolveCredentials()
+	if err != nil {
+		// Replay never reaches the network, so it works without credentials.
+		if opts, optsErr := asc.ResolveCassetteOptions(); optsErr == nil && opts != nil && opts.Mode == asc.CassetteReplay {
+			return asc.NewReplayClient()
+		}
+		return nil, err
+	}
 	return asc.NewClient(resolved.keyID, resolved.issuerID, resolved.keyPath)
 }
 
+// applyBaseURLOverride syncs the --base-url flag into the asc package when
+// a client is constructed.
+func applyBaseURLOverride() {
+	if strings.TrimSpace(baseURL) != "" {
+		value := baseURL
+		asc.SetBaseURLOverride(&value)
+	} else {
+		asc.SetBaseURLOverride(nil)
+	}
+}
+
+// resolveBaseURL returns the effective API base URL for the --base-url flag
+// without changing asc package state.
+func resolveBaseURL() string {
+	return asc.ResolveBaseURLWithOverride(baseURL)
+}
+
+// applyCassetteOverride syncs the --record/--replay flags into the asc package.
+func applyCassetteOverride() error {
+	record := strings.TrimSpace(recordDir)
+	replay := strings.TrimSpace(replayDir)
+	switch {
+	case record != "" && replay != "":
+		asc.SetCassetteOverride(nil)
+		return fmt.Errorf("--record and --replay are mutually exclusive")
+	case record != "":
+		asc.SetCassetteOverride(&asc.CassetteOptions{Mode: asc.CassetteRecord, Dir: record})
+	case replay != "":
+		asc.SetCassetteOverride(&asc.CassetteOptions{Mode: asc.CassetteReplay, Dir: replay})
+	default:
+		asc.SetCassetteOverride(nil)
+	}
+	return nil
+}
+
 func checkMixedCredentialSources(sources credentialSource) error {
 	keyIDSource := strings.TrimSpace(sources.keyID)
 	issuerSource := strings.TrimSpace(sources.issuerID)
@@ -513,6 +591,10 @@ func strictAuthEnabled() bool {
 
 func printOutput(data interface{}, format string, pretty bool) error {
 	format = strings.ToLower(format)
+	data, err := applyProjection(data)
+	if err != nil {
+		return err
+	}
 	switch format {
 	case "json":
 		if pretty {
@@ -529,6 +611,20 @@ func printOutput(data interface{}, format string, pretty bool) error {
 			return fmt.Errorf("--pretty is only valid with JSON output")
 		}
 		return asc.PrintTable(data)
+	case "csv", "tsv", "yaml", "yml", "ndjson":
+		if pretty {
+			return fmt.Errorf("--pretty is only valid with JSON output")
+		}
+		switch format {
+		case "csv":
+			return asc.PrintCSV(data)
+		case "tsv":
+			return asc.PrintTSV(data)
+		case "ndjson":
+			return asc.PrintNDJSON(data)
+		default:
+			return asc.PrintYAML(data)
+		}
 	default:
 		return fmt.Errorf("unsupported format: %s", format)
 	}
@@ -589,8 +685,9 @@ var (
 )
 
 // DefaultOutputFormat returns the default output format for CLI commands.
-// It checks the ASC_DEFAULT_OUTPUT environment variable first, falling back to "json".
-// Valid values are "json", "table", "markdown", and "md".
+// It checks the ASC_DEFAULT_OUTPUT environment variable first, then the
+// default_output config value, falling back to "json".
+// Valid values are listed in OutputFormats.
 func DefaultOutputFormat() string {
 	defaultOutputOnce.Do(func() {
 		defaultOutputValue = resolveDefaultOutput()
@@ -598,19 +695,29 @@ func DefaultOutputFormat() string {
 	return defaultOutputValue
 }
 
+// OutputFormats lists the values accepted by --output and ASC_DEFAULT_OUTPUT.
+var OutputFormats = []string{"json", "table", "markdown", "md", "csv", "tsv", "yaml", "yml", "ndjson"}
+
 func resolveDefaultOutput() string {
-	env := strings.TrimSpace(os.Getenv(defaultOutputEnvVar))
-	if env == "" {
-		return "json"
+	source := defaultOutputEnvVar
+	value := strings.TrimSpace(os.Getenv(defaultOutputEnvVar))
+	if value == "" {
+		if cfg, err := config.Load(); err == nil && cfg != nil {
+			source = "default_output config"
+			value = strings.TrimSpace(cfg.DefaultOutput)
+		}
 	}
-	normalized := strings.ToLower(env)
-	switch normalized {
-	case "json", "table", "markdown", "md":
-		return normalized
-	default:
-		fmt.Fprintf(os.Stderr, "Warning: invalid %s value %q (expected json, table, markdown, or md); using json\n", defaultOutputEnvVar, env)
+	if value == "" {
 		return "json"
 	}
+	normalized := strings.ToLower(value)
+	for _, format := range OutputFormats {
+		if normalized == format {
+			return normalized
+		}
+	}
+	fmt.Fprintf(os.Stderr, "Warning: invalid %s value %q (expected %s); using json\n", source, value, strings.Join(OutputFormats, ", "))
+	return "json"
 }
 
 func resolveAppID(appID string) string {
@@ -669,7 +776,9 @@ func splitCSVUpper(value string) []string {
 	return upper
 }
 
-func validateNextURL(next string) error {
+// validateNextURL checks that next points at the same scheme and host as
+// apiBaseURL, the API base URL the client will use.
+func validateNextURL(next, apiBaseURL string) error {
 	next = strings.TrimSpace(next)
 	if next == "" {
 		return nil
@@ -678,7 +787,11 @@ func validateNextURL(next string) error {
 	if err != nil {
 		return fmt.Errorf("--next must be a valid URL: %w", err)
 	}
-	if parsed.Scheme != "https" || parsed.Host != "api.appstoreconnect.apple.com" {
+	base, err := url.Parse(apiBaseURL)
+	if err != nil {
+		return fmt.Errorf("invalid base URL: %w", err)
+	}
+	if parsed.Scheme != base.Scheme || parsed.Host != base.Host {
 		return fmt.Errorf("--next must be an App Store Connect URL")
 	}
 	return nil
@@ -743,7 +856,7 @@ func SplitCSVUpper(value string) []string {
 }
 
 func ValidateNextURL(next string) error {
-	return validateNextURL(next)
+	return validateNextURL(next, resolveBaseURL())
 }
 
 func ValidateSort(value string, allowed ...string) error {
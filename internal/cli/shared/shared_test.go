@@ -14,6 +14,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/config"
 )
 
@@ -449,6 +450,91 @@ func TestGetASCClient_BypassKeychainPrefersEnvOverConfig(t *testing.T) {
 	}
 }
 
+func TestResolveCredentials_IndividualKeyFromConfig(t *testing.T) {
+	resetPrivateKeyTemp(t)
+
+	tempDir := t.TempDir()
+	configPath := filepath.Join(tempDir, "config.json")
+	keyPath := filepath.Join(tempDir, "AuthKey.p8")
+	writeECDSAPEM(t, keyPath)
+
+	cfg := &config.Config{
+		DefaultKeyName: "personal",
+		Keys: []config.Credential{
+			{
+				Name:           "personal",
+				KeyID:          "INDKEY",
+				PrivateKeyPath: keyPath,
+				KeyType:        config.KeyTypeIndividual,
+			},
+		},
+	}
+	if err := config.SaveAt(configPath, cfg); err != nil {
+		t.Fatalf("SaveAt() error: %v", err)
+	}
+
+	t.Setenv("ASC_CONFIG_PATH", configPath)
+	t.Setenv("ASC_PROFILE", "")
+	t.Setenv("ASC_KEY_ID", "")
+	t.Setenv("ASC_ISSUER_ID", "")
+	t.Setenv("ASC_KEY_TYPE", "")
+
+	previousProfile := selectedProfile
+	selectedProfile = ""
+	t.Cleanup(func() {
+		selectedProfile = previousProfile
+	})
+
+	resolved, err := resolveCredentials()
+	if err != nil {
+		t.Fatalf("resolveCredentials() error: %v", err)
+	}
+	if resolved.keyType != config.KeyTypeIndividual || resolved.issuerID != "" || resolved.keyID != "INDKEY" {
+		t.Fatalf("unexpected resolved credentials: %+v", resolved)
+	}
+	if _, err := getASCClient(); err != nil {
+		t.Fatalf("getASCClient() error: %v", err)
+	}
+}
+
+func TestResolveCredentials_IndividualEnvRejectsIssuer(t *testing.T) {
+	resetPrivateKeyTemp(t)
+
+	keyPath := filepath.Join(t.TempDir(), "AuthKey.p8")
+	writeECDSAPEM(t, keyPath)
+
+	t.Setenv("ASC_PROFILE", "")
+	t.Setenv("ASC_KEY_ID", "INDKEY")
+	t.Setenv("ASC_ISSUER_ID", "ISS")
+	t.Setenv("ASC_KEY_TYPE", "individual")
+	t.Setenv("ASC_PRIVATE_KEY_PATH", keyPath)
+
+	previousProfile := selectedProfile
+	selectedProfile = ""
+	t.Cleanup(func() {
+		selectedProfile = previousProfile
+	})
+
+	_, err := resolveCredentials()
+	if err == nil || !strings.Contains(err.Error(), "individual API keys do not use an issuer ID") {
+		t.Fatalf("expected individual issuer error, got %v", err)
+	}
+
+	t.Setenv("ASC_ISSUER_ID", "")
+	resolved, err := resolveCredentials()
+	if err != nil {
+		t.Fatalf("resolveCredentials() error: %v", err)
+	}
+	if resolved.keyType != config.KeyTypeIndividual {
+		t.Fatalf("expected individual key type, got %q", resolved.keyType)
+	}
+
+	t.Setenv("ASC_KEY_TYPE", "enterprise")
+	if _, err := resolveCredentials(); err == nil || !strings.Contains(err.Error(), "ASC_KEY_TYPE") {
+		t.Fatalf("expected ASC_KEY_TYPE error, got %v", err)
+	}
+}
+
 func resetPrivateKeyTemp(t *testing.T) {
 	t.Helper()
 	CleanupTempPrivateKeys()
@@ -530,3 +616,25 @@ func TestSetNoProgress(t *testing.T) {
 		t.Fatal("expected noProgress to be false after SetNoProgress(false)")
 	}
 }
+
+func TestValidateNextURL_UsesBaseURLFlagWithoutSideEffects(t *testing.T) {
+	t.Setenv("ASC_BASE_URL", "")
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "config.json"))
+	asc.SetBaseURLOverride(nil)
+	previous := baseURL
+	baseURL = "http://127.0.0.1:9000/"
+	t.Cleanup(func() {
+		baseURL = previous
+		asc.SetBaseURLOverride(nil)
+	})
+
+	if err := ValidateNextURL("http://127.0.0.1:9000/v1/apps?cursor=1"); err != nil {
+		t.Fatalf("ValidateNextURL() error: %v", err)
+	}
+	if err := ValidateNextURL("https://api.appstoreconnect.apple.com/v1/apps?cursor=1"); err == nil {
+		t.Fatal("expected a next URL off the --base-url host to be rejected")
+	}
+	if got := asc.ResolveBaseURL(); got != asc.BaseURL {
+		t.Fatalf("ValidateNextURL() changed the asc base URL to %q", got)
+	}
+}
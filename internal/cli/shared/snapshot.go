@@ -0,0 +1,250 @@
+package shared
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// SnapshotFormatVersion is bumped whenever the snapshot layout changes in a
+// way older restores cannot read.
+const SnapshotFormatVersion = 1
+
+// Snapshot files, relative to the snapshot directory.
+const (
+	SnapshotManifestFile       = "manifest.json"
+	SnapshotAppInfoFile        = "app-info.json"
+	SnapshotVersionsFile       = "versions.json"
+	SnapshotInAppPurchasesFile = "in-app-purchases.json"
+	SnapshotSubscriptionsFile  = "subscriptions.json"
+	SnapshotBetaGroupsFile     = "beta-groups.json"
+	SnapshotAvailabilityFile   = "availability.json"
+	SnapshotAppEventsFile      = "app-events.json"
+	SnapshotScreenshotsDir     = "screenshots"
+)
+
+// SnapshotManifest identifies the snapshot and the app it was taken from.
+type SnapshotManifest struct {
+	FormatVersion int         `json:"formatVersion"`
+	CreatedAt     string      `json:"createdAt"`
+	App           SnapshotApp `json:"app"`
+}
+
+// SnapshotApp is the source app recorded in the manifest.
+type SnapshotApp struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	BundleID      string `json:"bundleId"`
+	SKU           string `json:"sku,omitempty"`
+	PrimaryLocale string `json:"primaryLocale,omitempty"`
+}
+
+// SnapshotAppInfo holds categories, age rating and app info localizations.
+type SnapshotAppInfo struct {
+	ID                string                              `json:"id"`
+	PrimaryCategory   string                              `json:"primaryCategory,omitempty"`
+	SecondaryCategory string                              `json:"secondaryCategory,omitempty"`
+	AgeRating         *asc.AgeRatingDeclarationAttributes `json:"ageRating,omitempty"`
+	Localizations     []asc.AppInfoLocalizationAttributes `json:"localizations"`
+}
+
+// SnapshotVersion is one App Store version with its localizations.
+type SnapshotVersion struct {
+	ID            string                        `json:"id"`
+	VersionString string                        `json:"versionString"`
+	Platform      string                        `json:"platform"`
+	State         string                        `json:"state,omitempty"`
+	CreatedDate   string                        `json:"createdDate,omitempty"`
+	Copyright     string                        `json:"copyright,omitempty"`
+	Localizations []SnapshotVersionLocalization `json:"localizations"`
+}
+
+// SnapshotVersionLocalization adds downloaded screenshots to a version localization.
+type SnapshotVersionLocalization struct {
+	asc.AppStoreVersionLocalizationAttributes
+	Screenshots []SnapshotScreenshotSet `json:"screenshots,omitempty"`
+}
+
+// SnapshotScreenshotSet lists downloaded screenshots in display order; Files
+// are relative to the snapshot directory.
+type SnapshotScreenshotSet struct {
+	DisplayType string   `json:"displayType"`
+	Files       []string `json:"files"`
+}
+
+// SnapshotPrice is a price in one territory. Price point IDs are specific to
+// a product, so restores match on CustomerPrice instead.
+type SnapshotPrice struct {
+	Territory     string `json:"territory"`
+	CustomerPrice string `json:"customerPrice"`
+	PricePointID  string `json:"pricePointId,omitempty"`
+	StartDate     string `json:"startDate,omitempty"`
+	EndDate       string `json:"endDate,omitempty"`
+}
+
+// SnapshotInAppPurchase is an in-app purchase with localizations and prices.
+type SnapshotInAppPurchase struct {
+	asc.InAppPurchaseV2Attributes
+	BaseTerritory string                                    `json:"baseTerritory,omitempty"`
+	Localizations []asc.InAppPurchaseLocalizationAttributes `json:"localizations"`
+	Prices        []SnapshotPrice                           `json:"prices"`
+}
+
+// SnapshotSubscriptionGroup is a subscription group and its subscriptions.
+type SnapshotSubscriptionGroup struct {
+	ReferenceName string                                        `json:"referenceName"`
+	Localizations []asc.SubscriptionGroupLocalizationAttributes `json:"localizations"`
+	Subscriptions []SnapshotSubscription                        `json:"subscriptions"`
+}
+
+// SnapshotSubscription is a subscription with localizations and prices.
+type SnapshotSubscription struct {
+	asc.SubscriptionAttributes
+	Localizations []asc.SubscriptionLocalizationAttributes `json:"localizations"`
+	Prices        []SnapshotPrice                          `json:"prices"`
+}
+
+// SnapshotAvailability is the app's territory availability.
+type SnapshotAvailability struct {
+	AvailableInNewTerritories bool                            `json:"availableInNewTerritories"`
+	Territories               []SnapshotTerritoryAvailability `json:"territories"`
+}
+
+// SnapshotTerritoryAvailability is the availability in one territory.
+type SnapshotTerritoryAvailability struct {
+	Territory       string `json:"territory"`
+	Available       bool   `json:"available"`
+	ReleaseDate     string `json:"releaseDate,omitempty"`
+	PreOrderEnabled bool   `json:"preOrderEnabled,omitempty"`
+}
+
+// SnapshotAppEvent is an in-app event with its localizations.
+type SnapshotAppEvent struct {
+	asc.AppEventAttributes
+	Localizations []asc.AppEventLocalizationAttributes `json:"localizations"`
+}
+
+// Snapshot is everything a backup captures. Nil sections were not present in
+// the snapshot directory (or the app had nothing to back up).
+type Snapshot struct {
+	Manifest       SnapshotManifest
+	AppInfo        *SnapshotAppInfo
+	Versions       []SnapshotVersion
+	InAppPurchases []SnapshotInAppPurchase
+	Subscriptions  []SnapshotSubscriptionGroup
+	BetaGroups     []asc.BetaGroupAttributes
+	Availability   *SnapshotAvailability
+	AppEvents      []SnapshotAppEvent
+}
+
+// ReadSnapshot loads a snapshot directory. Only the manifest is required.
+func ReadSnapshot(dir string) (*Snapshot, error) {
+	snap := &Snapshot{}
+	found, err := readSnapshotFile(dir, SnapshotManifestFile, &snap.Manifest)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("%s not found in %s (is this a snapshot directory?)", SnapshotManifestFile, dir)
+	}
+	if snap.Manifest.FormatVersion < 1 || snap.Manifest.FormatVersion > SnapshotFormatVersion {
+		return nil, fmt.Errorf("unsupported snapshot format version %d (this build supports up to %d)", snap.Manifest.FormatVersion, SnapshotFormatVersion)
+	}
+
+	sections := []struct {
+		name  string
+		value any
+	}{
+		{SnapshotAppInfoFile, &snap.AppInfo},
+		{SnapshotVersionsFile, &snap.Versions},
+		{SnapshotInAppPurchasesFile, &snap.InAppPurchases},
+		{SnapshotSubscriptionsFile, &snap.Subscriptions},
+		{SnapshotBetaGroupsFile, &snap.BetaGroups},
+		{SnapshotAvailabilityFile, &snap.Availability},
+		{SnapshotAppEventsFile, &snap.AppEvents},
+	}
+	for _, section := range sections {
+		if _, err := readSnapshotFile(dir, section.name, section.value); err != nil {
+			return nil, err
+		}
+	}
+	return snap, nil
+}
+
+func readSnapshotFile(dir, name string, value any) (bool, error) {
+	data, err := os.ReadFile(filepath.Join(dir, name))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return false, nil
+		}
+		return false, err
+	}
+	if err := json.Unmarshal(data, value); err != nil {
+		return false, fmt.Errorf("parse %s: %w", name, err)
+	}
+	return true, nil
+}
+
+// LatestVersionIndexes returns the index of the most recently created version
+// of each platform, in platform order of first appearance.
+func LatestVersionIndexes(versions []SnapshotVersion) []int {
+	latest := make(map[string]int)
+	var order []string
+	for i, version := range versions {
+		current, ok := latest[version.Platform]
+		if !ok {
+			order = append(order, version.Platform)
+			latest[version.Platform] = i
+			continue
+		}
+		if version.CreatedDate > versions[current].CreatedDate {
+			latest[version.Platform] = i
+		}
+	}
+	indexes := make([]int, 0, len(order))
+	for _, platform := range order {
+		indexes = append(indexes, latest[platform])
+	}
+	return indexes
+}
+
+// ReadSnapshotLocalizations reads app info localizations and the localizations
+// of the most recently created platform version from an "asc backup" snapshot,
+// keyed like .strings files.
+func ReadSnapshotLocalizations(dir, platform string) (map[string]map[string]string, map[string]map[string]string, error) {
+	snap, err := ReadSnapshot(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	appInfoValues := make(map[string]map[string]string)
+	if snap.AppInfo != nil {
+		for _, attrs := range snap.AppInfo.Localizations {
+			if locale := strings.TrimSpace(attrs.Locale); locale != "" {
+				appInfoValues[locale] = mapAppInfoLocalizationStrings(attrs)
+			}
+		}
+	}
+
+	latest := -1
+	for _, index := range LatestVersionIndexes(snap.Versions) {
+		if snap.Versions[index].Platform == platform {
+			latest = index
+		}
+	}
+	if latest < 0 {
+		return nil, nil, fmt.Errorf("no %s version in snapshot %s", platform, dir)
+	}
+	versionValues := make(map[string]map[string]string, len(snap.Versions[latest].Localizations))
+	for _, localization := range snap.Versions[latest].Localizations {
+		if locale := strings.TrimSpace(localization.Locale); locale != "" {
+			versionValues[locale] = mapVersionLocalizationStrings(localization.AppStoreVersionLocalizationAttributes)
+		}
+	}
+	return versionValues, appInfoValues, nil
+}
@@ -0,0 +1,31 @@
+package shared
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReadSnapshotRejectsNewerFormat(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, SnapshotManifestFile), []byte(`{"formatVersion":99}`), 0o644); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+	_, err := ReadSnapshot(dir)
+	if err == nil || !strings.Contains(err.Error(), "unsupported snapshot format version 99") {
+		t.Fatalf("expected format version error, got %v", err)
+	}
+}
+
+func TestLatestVersionIndexes(t *testing.T) {
+	versions := []SnapshotVersion{
+		{VersionString: "1.0", Platform: "IOS", CreatedDate: "2026-01-01T00:00:00Z"},
+		{VersionString: "1.0", Platform: "MAC_OS", CreatedDate: "2026-02-01T00:00:00Z"},
+		{VersionString: "1.1", Platform: "IOS", CreatedDate: "2026-03-01T00:00:00Z"},
+	}
+	if got := LatestVersionIndexes(versions); !reflect.DeepEqual(got, []int{2, 1}) {
+		t.Fatalf("LatestVersionIndexes() = %v, want [2 1]", got)
+	}
+}
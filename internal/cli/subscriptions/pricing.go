@@ -54,7 +54,7 @@ func SubscriptionsPricingCommand() *ffcli.Command {
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
 	subscriptionID := fs.String("subscription-id", "", "Subscription ID")
 	territory := fs.String("territory", "USA", "Territory for pricing (e.g., USA)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -494,6 +494,8 @@ func printSubscriptionPricingResult(result *subscriptionPricingResult, format st
 			return fmt.Errorf("--pretty is only valid with JSON output")
 		}
 		return printSubscriptionPricingMarkdown(result)
+	case "yaml", "yml", "ndjson":
+		return shared.PrintOutput(result, format, pretty)
 	default:
 		return fmt.Errorf("unsupported format: %s", format)
 	}
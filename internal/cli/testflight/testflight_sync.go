@@ -81,6 +81,13 @@ type testFlightSyncClient interface {
 	GetBetaGroups(ctx context.Context, appID string, opts ...asc.BetaGroupsOption) (*asc.BetaGroupsResponse, error)
 	GetBetaGroupBuilds(ctx context.Context, groupID string, opts ...asc.BetaGroupBuildsOption) (*asc.BuildsResponse, error)
 	GetBetaGroupTesters(ctx context.Context, groupID string, opts ...asc.BetaGroupTestersOption) (*asc.BetaTestersResponse, error)
+	CreateBetaGroup(ctx context.Context, appID, name string, isInternalGroup bool) (*asc.BetaGroupResponse, error)
+	UpdateBetaGroup(ctx context.Context, groupID string, req asc.BetaGroupUpdateRequest) (*asc.BetaGroupResponse, error)
+	AddBetaTestersToGroup(ctx context.Context, groupID string, testerIDs []string) error
+	RemoveBetaTestersFromGroup(ctx context.Context, groupID string, testerIDs []string) error
+	CreateBetaTester(ctx context.Context, email, firstName, lastName string, groupIDs []string) (*asc.BetaTesterResponse, error)
+	AddBetaGroupsToBuild(ctx context.Context, buildID string, groupIDs []string) error
+	RemoveBetaGroupsFromBuild(ctx context.Context, buildID string, groupIDs []string) error
 }
 
 // TestFlightSyncCommand returns the testflight sync command group.
@@ -94,11 +101,13 @@ func TestFlightSyncCommand() *ffcli.Command {
 		LongHelp: `Sync TestFlight configuration.
 
 Examples:
-  asc testflight sync pull --app "APP_ID" --output "./testflight.yaml"`,
+  asc testflight sync pull --app "APP_ID" --output "./testflight.yaml"
+  asc testflight sync push --input "./testflight.yaml" --dry-run`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			TestFlightSyncPullCommand(),
+			TestFlightSyncPushCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -193,10 +202,7 @@ Examples:
 				Testers: len(config.Testers),
 			}
 
-			if *pretty {
-				return asc.PrintPrettyJSON(summary)
-			}
-			return asc.PrintJSON(summary)
+			return shared.PrintOutput(summary, "json", *pretty)
 		},
 	}
 }
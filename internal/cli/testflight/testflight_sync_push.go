@@ -0,0 +1,700 @@
+package testflight
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+	"gopkg.in/yaml.v3"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	testFlightPushCreateGroup   = "create-group"
+	testFlightPushUpdateGroup   = "update-group"
+	testFlightPushCreateTester  = "create-tester"
+	testFlightPushAddTesters    = "add-testers"
+	testFlightPushRemoveTesters = "remove-testers"
+	testFlightPushAddBuilds     = "add-builds"
+	testFlightPushRemoveBuilds  = "remove-builds"
+)
+
+type testFlightPushChange struct {
+	Action  string   `json:"action"`
+	Group   string   `json:"group,omitempty"`
+	GroupID string   `json:"groupId,omitempty"`
+	Fields  []string `json:"fields,omitempty"`
+	Email   string   `json:"email,omitempty"`
+	Name    string   `json:"name,omitempty"`
+	Groups  []string `json:"groups,omitempty"`
+	Testers []string `json:"testers,omitempty"`
+	Builds  []string `json:"builds,omitempty"`
+
+	attributes *asc.BetaGroupUpdateAttributes
+	internal   bool
+}
+
+type testFlightPushPlan struct {
+	changes  []testFlightPushChange
+	groupIDs map[string]string
+}
+
+type testFlightPushResult struct {
+	File    string                 `json:"file"`
+	AppID   string                 `json:"appId"`
+	DryRun  bool                   `json:"dryRun"`
+	Changes []testFlightPushChange `json:"changes"`
+}
+
+// TestFlightSyncPushCommand applies a TestFlight YAML config to App Store Connect.
+func TestFlightSyncPushCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("push", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (defaults to app.id in the file, then ASC_APP_ID env)")
+	input := fs.String("input", "", "Input YAML file path (required)")
+	pruneTesters := fs.Bool("prune-testers", false, "Remove testers missing from the file from the groups it lists")
+	dryRun := fs.Bool("dry-run", false, "Print the plan without applying changes")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, yaml, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "push",
+		ShortUsage: "asc testflight sync push [flags]",
+		ShortHelp:  "Apply a TestFlight YAML configuration to App Store Connect.",
+		LongHelp: `Apply a TestFlight YAML configuration to App Store Connect.
+
+The file uses the same schema written by "asc testflight sync pull".
+Groups are matched by ID, then by name; unmatched groups are created.
+Group settings (name, public link, public link limit, feedback) are
+updated to match the file.
+
+Testers are only synced when the file has a "testers" section. Builds are
+only synced for groups that list builds or that a top-level build names;
+for those groups, builds missing from the file are removed from the group. Testers are only added; with
+--prune-testers, testers missing from the file are removed from the groups
+it lists too; the file must then have a testers section. Groups that are not in the file are never modified.
+
+Testers without an ID are matched by email; unknown emails are created.
+
+Examples:
+  asc testflight sync push --input "./testflight.yaml" --dry-run
+  asc testflight sync push --input "./testflight.yaml"
+  asc testflight sync push --input "./testflight.yaml" --prune-testers --dry-run
+  asc testflight sync push --app "APP_ID" --input "./testflight.yaml" --output table`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			inputValue := strings.TrimSpace(*input)
+			if inputValue == "" {
+				fmt.Fprintf(os.Stderr, "Error: --input is required\n\n")
+				return flag.ErrHelp
+			}
+
+			config, err := readTestFlightConfigYAML(inputValue)
+			if err != nil {
+				return fmt.Errorf("testflight sync push: %w", err)
+			}
+
+			resolvedAppID, err := resolveTestFlightPushAppID(*appID, config)
+			if err != nil {
+				return fmt.Errorf("testflight sync push: %w", err)
+			}
+			if resolvedAppID == "" {
+				fmt.Fprintf(os.Stderr, "Error: --app is required (or set app.id in the file or ASC_APP_ID)\n\n")
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("testflight sync push: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			plan, err := planTestFlightPush(requestCtx, client, resolvedAppID, config, *pruneTesters)
+			if err != nil {
+				return fmt.Errorf("testflight sync push: %w", err)
+			}
+
+			if !*dryRun {
+				if err := applyTestFlightPushPlan(requestCtx, client, resolvedAppID, plan); err != nil {
+					return fmt.Errorf("testflight sync push: %w", err)
+				}
+			}
+
+			result := &testFlightPushResult{
+				File:    filepath.Clean(inputValue),
+				AppID:   resolvedAppID,
+				DryRun:  *dryRun,
+				Changes: plan.changes,
+			}
+			return printTestFlightPushResult(result, *output, *pretty)
+		},
+	}
+}
+
+func readTestFlightConfigYAML(path string) (*TestFlightConfig, error) {
+	file, err := shared.OpenExistingNoFollow(path)
+	if err != nil {
+		return nil, fmt.Errorf("read input: %w", err)
+	}
+	defer file.Close()
+
+	var config TestFlightConfig
+	decoder := yaml.NewDecoder(file)
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&config); err != nil {
+		return nil, fmt.Errorf("parse input: %w", err)
+	}
+	return &config, nil
+}
+
+func resolveTestFlightPushAppID(flagValue string, config *TestFlightConfig) (string, error) {
+	flagValue = strings.TrimSpace(flagValue)
+	fileValue := strings.TrimSpace(config.App.ID)
+	if flagValue != "" && fileValue != "" && flagValue != fileValue {
+		return "", fmt.Errorf("--app %q does not match app.id %q in the file", flagValue, fileValue)
+	}
+	if flagValue != "" {
+		return flagValue, nil
+	}
+	if fileValue != "" {
+		return fileValue, nil
+	}
+	return shared.ResolveAppID(""), nil
+}
+
+func planTestFlightPush(ctx context.Context, client testFlightSyncClient, appID string, desired *TestFlightConfig, pruneTesters bool) (*testFlightPushPlan, error) {
+	if desired == nil {
+		return nil, fmt.Errorf("config is required")
+	}
+	if err := validateTestFlightPushConfig(desired); err != nil {
+		return nil, err
+	}
+
+	manageTesters := len(desired.Testers) > 0
+	if pruneTesters && !manageTesters {
+		// Without a testers section every tester would look missing.
+		return nil, fmt.Errorf("--prune-testers requires a testers section in the file")
+	}
+	manageBuilds := len(desired.Builds) > 0
+	for _, group := range desired.Groups {
+		if len(group.Builds) > 0 {
+			manageBuilds = true
+		}
+	}
+
+	live, err := pullTestFlightConfig(ctx, client, appID, testFlightPullOptions{
+		includeBuilds:  manageBuilds,
+		includeTesters: manageTesters,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	liveByID := make(map[string]TestFlightGroupConfig, len(live.Groups))
+	liveByName := make(map[string][]TestFlightGroupConfig, len(live.Groups))
+	for _, group := range live.Groups {
+		liveByID[group.ID] = group
+		key := strings.ToLower(strings.TrimSpace(group.Name))
+		liveByName[key] = append(liveByName[key], group)
+	}
+
+	plan := &testFlightPushPlan{groupIDs: make(map[string]string)}
+	matched := make([]*TestFlightGroupConfig, len(desired.Groups))
+	groupChanges := make([]testFlightPushChange, 0)
+	for i, group := range desired.Groups {
+		name := strings.TrimSpace(group.Name)
+		var current *TestFlightGroupConfig
+		if id := strings.TrimSpace(group.ID); id != "" {
+			found, ok := liveByID[id]
+			if !ok {
+				return nil, fmt.Errorf("beta group %q not found", id)
+			}
+			current = &found
+		} else {
+			switch candidates := liveByName[strings.ToLower(name)]; len(candidates) {
+			case 0:
+			case 1:
+				current = &candidates[0]
+			default:
+				return nil, fmt.Errorf("multiple beta groups named %q; set the group ID", name)
+			}
+		}
+		matched[i] = current
+
+		if current == nil {
+			groupChanges = append(groupChanges, testFlightPushChange{
+				Action:     testFlightPushCreateGroup,
+				Group:      name,
+				Fields:     describeTestFlightGroupFields(TestFlightGroupConfig{}, group, false),
+				attributes: testFlightGroupUpdateAttributes(TestFlightGroupConfig{}, group, false),
+				internal:   group.IsInternalGroup,
+			})
+			continue
+		}
+
+		plan.groupIDs[strings.ToLower(name)] = current.ID
+		if current.IsInternalGroup != group.IsInternalGroup {
+			return nil, fmt.Errorf("beta group %q: isInternalGroup cannot be changed after creation", name)
+		}
+		if fields := describeTestFlightGroupFields(*current, group, true); len(fields) > 0 {
+			groupChanges = append(groupChanges, testFlightPushChange{
+				Action:     testFlightPushUpdateGroup,
+				Group:      name,
+				GroupID:    current.ID,
+				Fields:     fields,
+				attributes: testFlightGroupUpdateAttributes(*current, group, true),
+			})
+		}
+	}
+
+	resolveGroup := func(ref string) (int, error) {
+		ref = strings.TrimSpace(ref)
+		for i, group := range desired.Groups {
+			if ref == strings.TrimSpace(group.ID) && ref != "" {
+				return i, nil
+			}
+			if matched[i] != nil && ref == matched[i].ID {
+				return i, nil
+			}
+		}
+		for i, group := range desired.Groups {
+			if strings.EqualFold(ref, strings.TrimSpace(group.Name)) {
+				return i, nil
+			}
+		}
+		return -1, fmt.Errorf("group %q is not defined in groups", ref)
+	}
+
+	testerChanges := make([]testFlightPushChange, 0)
+	desiredTesters := make([]map[string]struct{}, len(desired.Groups))
+	if manageTesters {
+		liveByEmail := make(map[string]string, len(live.Testers))
+		for _, tester := range live.Testers {
+			if tester.Email != "" {
+				liveByEmail[strings.ToLower(tester.Email)] = tester.ID
+			}
+		}
+		for i := range desiredTesters {
+			desiredTesters[i] = make(map[string]struct{})
+		}
+
+		for _, tester := range desired.Testers {
+			indexes := make([]int, 0, len(tester.Groups))
+			for _, ref := range tester.Groups {
+				index, err := resolveGroup(ref)
+				if err != nil {
+					return nil, fmt.Errorf("tester %s: %w", testerLabel(tester), err)
+				}
+				indexes = append(indexes, index)
+			}
+
+			testerID := strings.TrimSpace(tester.ID)
+			email := strings.TrimSpace(tester.Email)
+			if testerID == "" && email != "" {
+				testerID = liveByEmail[strings.ToLower(email)]
+			}
+			if testerID == "" {
+				if email == "" {
+					return nil, fmt.Errorf("tester requires id or email")
+				}
+				if len(indexes) == 0 {
+					return nil, fmt.Errorf("tester %s: new testers require at least one group", email)
+				}
+				names := make([]string, 0, len(indexes))
+				for _, index := range indexes {
+					names = append(names, strings.TrimSpace(desired.Groups[index].Name))
+				}
+				testerChanges = append(testerChanges, testFlightPushChange{
+					Action: testFlightPushCreateTester,
+					Email:  email,
+					Name:   strings.TrimSpace(tester.Name),
+					Groups: uniqueSortedStrings(names),
+				})
+				continue
+			}
+			for _, index := range indexes {
+				desiredTesters[index][testerID] = struct{}{}
+			}
+		}
+	}
+
+	// A nil entry leaves the group's builds alone: only groups that list
+	// builds or that a top-level build references own their builds.
+	desiredBuilds := make([]map[string]struct{}, len(desired.Groups))
+	for i, group := range desired.Groups {
+		if len(group.Builds) == 0 {
+			continue
+		}
+		desiredBuilds[i] = make(map[string]struct{})
+		for _, buildID := range group.Builds {
+			if trimmed := strings.TrimSpace(buildID); trimmed != "" {
+				desiredBuilds[i][trimmed] = struct{}{}
+			}
+		}
+	}
+	for _, build := range desired.Builds {
+		buildID := strings.TrimSpace(build.ID)
+		if buildID == "" {
+			return nil, fmt.Errorf("build requires id")
+		}
+		for _, ref := range build.Groups {
+			index, err := resolveGroup(ref)
+			if err != nil {
+				return nil, fmt.Errorf("build %s: %w", buildID, err)
+			}
+			if desiredBuilds[index] == nil {
+				desiredBuilds[index] = make(map[string]struct{})
+			}
+			desiredBuilds[index][buildID] = struct{}{}
+		}
+	}
+
+	liveGroupTesters := make(map[string][]string)
+	for _, tester := range live.Testers {
+		for _, groupID := range tester.Groups {
+			liveGroupTesters[groupID] = append(liveGroupTesters[groupID], tester.ID)
+		}
+	}
+
+	membershipChanges := make([]testFlightPushChange, 0)
+	buildChanges := make([]testFlightPushChange, 0)
+	for i, group := range desired.Groups {
+		name := strings.TrimSpace(group.Name)
+		groupID := ""
+		var currentTesters, currentBuilds []string
+		if matched[i] != nil {
+			groupID = matched[i].ID
+			currentTesters = liveGroupTesters[groupID]
+			currentBuilds = matched[i].Builds
+		}
+
+		if manageTesters {
+			add, remove := diffStringSets(currentTesters, desiredTesters[i])
+			if len(add) > 0 {
+				membershipChanges = append(membershipChanges, testFlightPushChange{
+					Action: testFlightPushAddTesters, Group: name, GroupID: groupID, Testers: add,
+				})
+			}
+			if len(remove) > 0 && pruneTesters {
+				membershipChanges = append(membershipChanges, testFlightPushChange{
+					Action: testFlightPushRemoveTesters, Group: name, GroupID: groupID, Testers: remove,
+				})
+			}
+		}
+
+		if desiredBuilds[i] != nil {
+			add, remove := diffStringSets(currentBuilds, desiredBuilds[i])
+			if len(add) > 0 {
+				buildChanges = append(buildChanges, testFlightPushChange{
+					Action: testFlightPushAddBuilds, Group: name, GroupID: groupID, Builds: add,
+				})
+			}
+			if len(remove) > 0 {
+				buildChanges = append(buildChanges, testFlightPushChange{
+					Action: testFlightPushRemoveBuilds, Group: name, GroupID: groupID, Builds: remove,
+				})
+			}
+		}
+	}
+
+	plan.changes = make([]testFlightPushChange, 0, len(groupChanges)+len(testerChanges)+len(membershipChanges)+len(buildChanges))
+	plan.changes = append(plan.changes, groupChanges...)
+	plan.changes = append(plan.changes, testerChanges...)
+	plan.changes = append(plan.changes, membershipChanges...)
+	plan.changes = append(plan.changes, buildChanges...)
+	return plan, nil
+}
+
+func validateTestFlightPushConfig(config *TestFlightConfig) error {
+	names := make(map[string]struct{}, len(config.Groups))
+	for _, group := range config.Groups {
+		name := strings.TrimSpace(group.Name)
+		if name == "" {
+			return fmt.Errorf("every group requires a name")
+		}
+		key := strings.ToLower(name)
+		if _, ok := names[key]; ok {
+			return fmt.Errorf("duplicate group name %q", name)
+		}
+		names[key] = struct{}{}
+		if group.PublicLinkLimit != nil && (*group.PublicLinkLimit < 1 || *group.PublicLinkLimit > 10000) {
+			return fmt.Errorf("group %q: publicLinkLimit must be between 1 and 10000", name)
+		}
+		if group.IsInternalGroup && group.PublicLinkEnabled {
+			return fmt.Errorf("group %q: internal groups cannot enable a public link", name)
+		}
+	}
+	return nil
+}
+
+func describeTestFlightGroupFields(current, desired TestFlightGroupConfig, existing bool) []string {
+	fields := make([]string, 0)
+	if existing && strings.TrimSpace(current.Name) != strings.TrimSpace(desired.Name) {
+		fields = append(fields, fmt.Sprintf("name: %q -> %q", current.Name, strings.TrimSpace(desired.Name)))
+	}
+	if !existing && desired.IsInternalGroup {
+		fields = append(fields, "isInternalGroup: true")
+	}
+	if current.PublicLinkEnabled != desired.PublicLinkEnabled {
+		fields = append(fields, fmt.Sprintf("publicLinkEnabled: %t -> %t", current.PublicLinkEnabled, desired.PublicLinkEnabled))
+	}
+	if !samePublicLinkLimit(current.PublicLinkLimit, desired.PublicLinkLimit) {
+		fields = append(fields, fmt.Sprintf("publicLinkLimit: %s -> %s", formatPublicLinkLimit(current.PublicLinkLimit), formatPublicLinkLimit(desired.PublicLinkLimit)))
+	}
+	if current.FeedbackEnabled != desired.FeedbackEnabled {
+		fields = append(fields, fmt.Sprintf("feedbackEnabled: %t -> %t", current.FeedbackEnabled, desired.FeedbackEnabled))
+	}
+	return fields
+}
+
+func testFlightGroupUpdateAttributes(current, desired TestFlightGroupConfig, existing bool) *asc.BetaGroupUpdateAttributes {
+	attrs := &asc.BetaGroupUpdateAttributes{}
+	changed := false
+	if existing && strings.TrimSpace(current.Name) != strings.TrimSpace(desired.Name) {
+		attrs.Name = strings.TrimSpace(desired.Name)
+		changed = true
+	}
+	if current.PublicLinkEnabled != desired.PublicLinkEnabled {
+		value := desired.PublicLinkEnabled
+		attrs.PublicLinkEnabled = &value
+		changed = true
+	}
+	if !samePublicLinkLimit(current.PublicLinkLimit, desired.PublicLinkLimit) {
+		enabled := desired.PublicLinkLimit != nil
+		attrs.PublicLinkLimitEnabled = &enabled
+		if enabled {
+			attrs.PublicLinkLimit = *desired.PublicLinkLimit
+		}
+		changed = true
+	}
+	if current.FeedbackEnabled != desired.FeedbackEnabled {
+		value := desired.FeedbackEnabled
+		attrs.FeedbackEnabled = &value
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	return attrs
+}
+
+func samePublicLinkLimit(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+func formatPublicLinkLimit(value *int) string {
+	if value == nil {
+		return "none"
+	}
+	return fmt.Sprintf("%d", *value)
+}
+
+func diffStringSets(current []string, desired map[string]struct{}) ([]string, []string) {
+	currentSet := make(map[string]struct{}, len(current))
+	for _, value := range current {
+		currentSet[value] = struct{}{}
+	}
+
+	add := make([]string, 0)
+	for value := range desired {
+		if _, ok := currentSet[value]; !ok {
+			add = append(add, value)
+		}
+	}
+	remove := make([]string, 0)
+	for value := range currentSet {
+		if _, ok := desired[value]; !ok {
+			remove = append(remove, value)
+		}
+	}
+	sort.Strings(add)
+	sort.Strings(remove)
+	return add, remove
+}
+
+func testerLabel(tester TestFlightTesterConfig) string {
+	if email := strings.TrimSpace(tester.Email); email != "" {
+		return email
+	}
+	return strings.TrimSpace(tester.ID)
+}
+
+func splitTesterName(name string) (string, string) {
+	parts := strings.Fields(name)
+	switch len(parts) {
+	case 0:
+		return "", ""
+	case 1:
+		return parts[0], ""
+	default:
+		return parts[0], strings.Join(parts[1:], " ")
+	}
+}
+
+func applyTestFlightPushPlan(ctx context.Context, client testFlightSyncClient, appID string, plan *testFlightPushPlan) error {
+	if client == nil {
+		return fmt.Errorf("client is required")
+	}
+	if plan == nil {
+		return nil
+	}
+
+	groupID := func(change *testFlightPushChange) (string, error) {
+		if change.GroupID != "" {
+			return change.GroupID, nil
+		}
+		if id, ok := plan.groupIDs[strings.ToLower(change.Group)]; ok {
+			change.GroupID = id
+			return id, nil
+		}
+		return "", fmt.Errorf("beta group %q has not been created", change.Group)
+	}
+
+	for i := range plan.changes {
+		change := &plan.changes[i]
+		switch change.Action {
+		case testFlightPushCreateGroup:
+			resp, err := client.CreateBetaGroup(ctx, appID, change.Group, change.internal)
+			if err != nil {
+				return fmt.Errorf("create beta group %q: %w", change.Group, err)
+			}
+			change.GroupID = resp.Data.ID
+			plan.groupIDs[strings.ToLower(change.Group)] = resp.Data.ID
+			if change.attributes != nil {
+				if err := updateTestFlightGroup(ctx, client, change.GroupID, change.attributes); err != nil {
+					return fmt.Errorf("update beta group %q: %w", change.Group, err)
+				}
+			}
+		case testFlightPushUpdateGroup:
+			if err := updateTestFlightGroup(ctx, client, change.GroupID, change.attributes); err != nil {
+				return fmt.Errorf("update beta group %q: %w", change.Group, err)
+			}
+		case testFlightPushCreateTester:
+			groupIDs := make([]string, 0, len(change.Groups))
+			for _, name := range change.Groups {
+				id, err := groupID(&testFlightPushChange{Group: name})
+				if err != nil {
+					return fmt.Errorf("create beta tester %s: %w", change.Email, err)
+				}
+				groupIDs = append(groupIDs, id)
+			}
+			firstName, lastName := splitTesterName(change.Name)
+			if _, err := client.CreateBetaTester(ctx, change.Email, firstName, lastName, groupIDs); err != nil {
+				return fmt.Errorf("create beta tester %s: %w", change.Email, err)
+			}
+		case testFlightPushAddTesters:
+			id, err := groupID(change)
+			if err != nil {
+				return err
+			}
+			if err := client.AddBetaTestersToGroup(ctx, id, change.Testers); err != nil {
+				return fmt.Errorf("add testers to %q: %w", change.Group, err)
+			}
+		case testFlightPushRemoveTesters:
+			if err := client.RemoveBetaTestersFromGroup(ctx, change.GroupID, change.Testers); err != nil {
+				return fmt.Errorf("remove testers from %q: %w", change.Group, err)
+			}
+		case testFlightPushAddBuilds:
+			id, err := groupID(change)
+			if err != nil {
+				return err
+			}
+			for _, buildID := range change.Builds {
+				if err := client.AddBetaGroupsToBuild(ctx, buildID, []string{id}); err != nil {
+					return fmt.Errorf("add build %s to %q: %w", buildID, change.Group, err)
+				}
+			}
+		case testFlightPushRemoveBuilds:
+			for _, buildID := range change.Builds {
+				if err := client.RemoveBetaGroupsFromBuild(ctx, buildID, []string{change.GroupID}); err != nil {
+					return fmt.Errorf("remove build %s from %q: %w", buildID, change.Group, err)
+				}
+			}
+		default:
+			return fmt.Errorf("unknown change %q", change.Action)
+		}
+	}
+	return nil
+}
+
+func updateTestFlightGroup(ctx context.Context, client testFlightSyncClient, groupID string, attrs *asc.BetaGroupUpdateAttributes) error {
+	req := asc.BetaGroupUpdateRequest{
+		Data: asc.BetaGroupUpdateData{
+			Type:       asc.ResourceTypeBetaGroups,
+			ID:         groupID,
+			Attributes: attrs,
+		},
+	}
+	_, err := client.UpdateBetaGroup(ctx, groupID, req)
+	return err
+}
+
+func printTestFlightPushResult(result *testFlightPushResult, format string, pretty bool) error {
+	format = strings.ToLower(format)
+	switch format {
+	case "json", "yaml", "yml", "ndjson":
+		return shared.PrintOutput(result, format, pretty)
+	case "table", "markdown", "md":
+		if pretty {
+			return fmt.Errorf("--pretty is only valid with JSON output")
+		}
+		if shared.ProjectionRequested() {
+			return shared.PrintOutput(result, format, pretty)
+		}
+	default:
+		return fmt.Errorf("unsupported format: %s", format)
+	}
+
+	if result.DryRun {
+		fmt.Println("DRY RUN - No changes made")
+		fmt.Println()
+	}
+	if len(result.Changes) == 0 {
+		fmt.Println("No changes")
+		return nil
+	}
+
+	headers := []string{"Action", "Group", "Details"}
+	rows := make([][]string, 0, len(result.Changes))
+	for _, change := range result.Changes {
+		group := change.Group
+		if change.Action == testFlightPushCreateTester {
+			group = strings.Join(change.Groups, ", ")
+		}
+		rows = append(rows, []string{change.Action, group, testFlightPushChangeDetails(change)})
+	}
+	if format == "table" {
+		asc.RenderTable(headers, rows)
+	} else {
+		asc.RenderMarkdown(headers, rows)
+	}
+	return nil
+}
+
+func testFlightPushChangeDetails(change testFlightPushChange) string {
+	switch {
+	case len(change.Fields) > 0:
+		return strings.Join(change.Fields, "; ")
+	case change.Email != "":
+		return change.Email
+	case len(change.Testers) > 0:
+		return strings.Join(change.Testers, ", ")
+	case len(change.Builds) > 0:
+		return strings.Join(change.Builds, ", ")
+	default:
+		return ""
+	}
+}
@@ -17,6 +17,7 @@ type testFlightSyncStub struct {
 	groups         *asc.BetaGroupsResponse
 	buildsByGroup  map[string]*asc.BuildsResponse
 	testersByGroup map[string]*asc.BetaTestersResponse
+	calls          []string
 }
 
 func (s *testFlightSyncStub) GetApp(ctx context.Context, appID string) (*asc.AppResponse, error) {
@@ -41,6 +42,45 @@ func (s *testFlightSyncStub) GetBetaGroupTesters(ctx context.Context, groupID st
 	return &asc.BetaTestersResponse{}, nil
 }
 
+func (s *testFlightSyncStub) CreateBetaGroup(ctx context.Context, appID, name string, isInternalGroup bool) (*asc.BetaGroupResponse, error) {
+	if isInternalGroup {
+		s.calls = append(s.calls, "create-internal-group "+name)
+		return &asc.BetaGroupResponse{Data: asc.Resource[asc.BetaGroupAttributes]{ID: "new-" + name}}, nil
+	}
+	s.calls = append(s.calls, "create-group "+name)
+	return &asc.BetaGroupResponse{Data: asc.Resource[asc.BetaGroupAttributes]{ID: "new-" + name}}, nil
+}
+
+func (s *testFlightSyncStub) UpdateBetaGroup(ctx context.Context, groupID string, req asc.BetaGroupUpdateRequest) (*asc.BetaGroupResponse, error) {
+	s.calls = append(s.calls, "update-group "+groupID)
+	return &asc.BetaGroupResponse{Data: asc.Resource[asc.BetaGroupAttributes]{ID: groupID}}, nil
+}
+
+func (s *testFlightSyncStub) AddBetaTestersToGroup(ctx context.Context, groupID string, testerIDs []string) error {
+	s.calls = append(s.calls, "add-testers "+groupID+" "+strings.Join(testerIDs, ","))
+	return nil
+}
+
+func (s *testFlightSyncStub) RemoveBetaTestersFromGroup(ctx context.Context, groupID string, testerIDs []string) error {
+	s.calls = append(s.calls, "remove-testers "+groupID+" "+strings.Join(testerIDs, ","))
+	return nil
+}
+
+func (s *testFlightSyncStub) CreateBetaTester(ctx context.Context, email, firstName, lastName string, groupIDs []string) (*asc.BetaTesterResponse, error) {
+	s.calls = append(s.calls, "create-tester "+email+" "+strings.Join(groupIDs, ","))
+	return &asc.BetaTesterResponse{}, nil
+}
+
+func (s *testFlightSyncStub) AddBetaGroupsToBuild(ctx context.Context, buildID string, groupIDs []string) error {
+	s.calls = append(s.calls, "add-build "+buildID+" "+strings.Join(groupIDs, ","))
+	return nil
+}
+
+func (s *testFlightSyncStub) RemoveBetaGroupsFromBuild(ctx context.Context, buildID string, groupIDs []string) error {
+	s.calls = append(s.calls, "remove-build "+buildID+" "+strings.Join(groupIDs, ","))
+	return nil
+}
+
 func TestPullTestFlightConfig_IncludesBuildsAndTesters(t *testing.T) {
 	stub := testFlightSyncStub{
 		app: &asc.AppResponse{
@@ -345,3 +385,248 @@ func TestMarshalTestFlightConfigYAML(t *testing.T) {
 		t.Fatalf("expected bundleId to round-trip, got %q", decoded.App.BundleID)
 	}
 }
+
+func newTestFlightPushStub() *testFlightSyncStub {
+	return &testFlightSyncStub{
+		app: &asc.AppResponse{
+			Data: asc.Resource[asc.AppAttributes]{ID: "app-1", Attributes: asc.AppAttributes{Name: "Demo"}},
+		},
+		groups: &asc.BetaGroupsResponse{
+			Data: []asc.Resource[asc.BetaGroupAttributes]{
+				{
+					ID: "group-1",
+					Attributes: asc.BetaGroupAttributes{
+						Name:            "Alpha",
+						FeedbackEnabled: true,
+					},
+				},
+			},
+		},
+		buildsByGroup: map[string]*asc.BuildsResponse{
+			"group-1": {
+				Data: []asc.Resource[asc.BuildAttributes]{{ID: "build-1"}},
+			},
+		},
+		testersByGroup: map[string]*asc.BetaTestersResponse{
+			"group-1": {
+				Data: []asc.Resource[asc.BetaTesterAttributes]{
+					{ID: "tester-1", Attributes: asc.BetaTesterAttributes{Email: "ada@example.com"}},
+					{ID: "tester-2", Attributes: asc.BetaTesterAttributes{Email: "grace@example.com"}},
+				},
+			},
+		},
+	}
+}
+
+func TestPlanTestFlightPush_DiffsAgainstLiveState(t *testing.T) {
+	stub := newTestFlightPushStub()
+	limit := 50
+	desired := &TestFlightConfig{
+		App: TestFlightAppConfig{ID: "app-1"},
+		Groups: []TestFlightGroupConfig{
+			{ID: "group-1", Name: "Alpha", PublicLinkEnabled: true, PublicLinkLimit: &limit, FeedbackEnabled: true, Builds: []string{"build-2"}},
+			{Name: "Beta", FeedbackEnabled: true},
+		},
+		Testers: []TestFlightTesterConfig{
+			{Email: "ada@example.com", Groups: []string{"group-1", "Beta"}},
+			{Email: "new@example.com", Name: "New Tester", Groups: []string{"Beta"}},
+		},
+	}
+
+	plan, err := planTestFlightPush(context.Background(), stub, "app-1", desired, true)
+	if err != nil {
+		t.Fatalf("planTestFlightPush() error: %v", err)
+	}
+
+	got := make([]string, 0, len(plan.changes))
+	for _, change := range plan.changes {
+		got = append(got, change.Action+" "+change.Group+" "+testFlightPushChangeDetails(change))
+	}
+	want := []string{
+		"update-group Alpha publicLinkEnabled: false -> true; publicLinkLimit: none -> 50",
+		"create-group Beta feedbackEnabled: false -> true",
+		"create-tester  new@example.com",
+		"remove-testers Alpha tester-2",
+		"add-testers Beta tester-1",
+		"add-builds Alpha build-2",
+		"remove-builds Alpha build-1",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("unexpected plan:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+	if len(stub.calls) != 0 {
+		t.Fatalf("expected planning to make no writes, got %v", stub.calls)
+	}
+}
+
+func TestPlanTestFlightPush_KeepsUnlistedTestersWithoutPrune(t *testing.T) {
+	stub := newTestFlightPushStub()
+	desired := &TestFlightConfig{
+		Groups:  []TestFlightGroupConfig{{ID: "group-1", Name: "Alpha", FeedbackEnabled: true}},
+		Testers: []TestFlightTesterConfig{{Email: "ada@example.com", Groups: []string{"Alpha"}}},
+	}
+
+	plan, err := planTestFlightPush(context.Background(), stub, "app-1", desired, false)
+	if err != nil {
+		t.Fatalf("planTestFlightPush() error: %v", err)
+	}
+	if len(plan.changes) != 0 {
+		t.Fatalf("expected no changes without --prune-testers, got %+v", plan.changes)
+	}
+
+	plan, err = planTestFlightPush(context.Background(), stub, "app-1", desired, true)
+	if err != nil {
+		t.Fatalf("planTestFlightPush() error: %v", err)
+	}
+	if len(plan.changes) != 1 || plan.changes[0].Action != testFlightPushRemoveTesters || strings.Join(plan.changes[0].Testers, ",") != "tester-2" {
+		t.Fatalf("expected tester-2 to be removed with --prune-testers, got %+v", plan.changes)
+	}
+}
+
+func TestPlanTestFlightPush_ManagesBuildsPerGroup(t *testing.T) {
+	stub := newTestFlightPushStub()
+	stub.groups.Data = append(stub.groups.Data, asc.Resource[asc.BetaGroupAttributes]{
+		ID:         "group-2",
+		Attributes: asc.BetaGroupAttributes{Name: "Beta", FeedbackEnabled: true},
+	})
+	stub.buildsByGroup["group-2"] = &asc.BuildsResponse{
+		Data: []asc.Resource[asc.BuildAttributes]{{ID: "build-9"}},
+	}
+	desired := &TestFlightConfig{
+		Groups: []TestFlightGroupConfig{
+			{ID: "group-1", Name: "Alpha", FeedbackEnabled: true, Builds: []string{"build-2"}},
+			{ID: "group-2", Name: "Beta", FeedbackEnabled: true},
+		},
+	}
+
+	plan, err := planTestFlightPush(context.Background(), stub, "app-1", desired, false)
+	if err != nil {
+		t.Fatalf("planTestFlightPush() error: %v", err)
+	}
+	got := make([]string, 0, len(plan.changes))
+	for _, change := range plan.changes {
+		got = append(got, change.Action+" "+change.Group+" "+testFlightPushChangeDetails(change))
+	}
+	want := []string{"add-builds Alpha build-2", "remove-builds Alpha build-1"}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("unexpected plan:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+
+	desired.Builds = []TestFlightBuildConfig{{ID: "build-9", Groups: []string{"Beta"}}, {ID: "build-10", Groups: []string{"Beta"}}}
+	desired.Groups[0].Builds = nil
+	plan, err = planTestFlightPush(context.Background(), stub, "app-1", desired, false)
+	if err != nil {
+		t.Fatalf("planTestFlightPush() error: %v", err)
+	}
+	if len(plan.changes) != 1 || plan.changes[0].Action != testFlightPushAddBuilds || plan.changes[0].Group != "Beta" || strings.Join(plan.changes[0].Builds, ",") != "build-10" {
+		t.Fatalf("expected only build-10 to be added to Beta, got %+v", plan.changes)
+	}
+}
+
+func TestPlanTestFlightPush_PruneTestersRequiresTestersSection(t *testing.T) {
+	stub := newTestFlightPushStub()
+	desired := &TestFlightConfig{
+		Groups: []TestFlightGroupConfig{{ID: "group-1", Name: "Alpha", FeedbackEnabled: true}},
+	}
+
+	_, err := planTestFlightPush(context.Background(), stub, "app-1", desired, true)
+	if err == nil || !strings.Contains(err.Error(), "--prune-testers requires a testers section") {
+		t.Fatalf("expected a missing testers section error, got %v", err)
+	}
+	if len(stub.calls) != 0 {
+		t.Fatalf("expected no writes, got %v", stub.calls)
+	}
+}
+
+func TestApplyTestFlightPushPlan_CreatesInternalGroup(t *testing.T) {
+	stub := newTestFlightPushStub()
+	desired := &TestFlightConfig{
+		Groups: []TestFlightGroupConfig{{ID: "group-1", Name: "Alpha", FeedbackEnabled: true}, {Name: "Team", IsInternalGroup: true}},
+	}
+
+	plan, err := planTestFlightPush(context.Background(), stub, "app-1", desired, false)
+	if err != nil {
+		t.Fatalf("planTestFlightPush() error: %v", err)
+	}
+	if err := applyTestFlightPushPlan(context.Background(), stub, "app-1", plan); err != nil {
+		t.Fatalf("applyTestFlightPushPlan() error: %v", err)
+	}
+	if strings.Join(stub.calls, "\n") != "create-internal-group Team" {
+		t.Fatalf("expected the group to be created as internal without an update, got %v", stub.calls)
+	}
+}
+
+func TestPlanTestFlightPush_RejectsInternalGroupChange(t *testing.T) {
+	stub := newTestFlightPushStub()
+	desired := &TestFlightConfig{
+		Groups: []TestFlightGroupConfig{{ID: "group-1", Name: "Alpha", IsInternalGroup: true, FeedbackEnabled: true}},
+	}
+
+	_, err := planTestFlightPush(context.Background(), stub, "app-1", desired, false)
+	if err == nil || !strings.Contains(err.Error(), "isInternalGroup") {
+		t.Fatalf("expected isInternalGroup error, got %v", err)
+	}
+}
+
+func TestPlanTestFlightPush_UnknownGroupReference(t *testing.T) {
+	stub := newTestFlightPushStub()
+	desired := &TestFlightConfig{
+		Groups:  []TestFlightGroupConfig{{ID: "group-1", Name: "Alpha", FeedbackEnabled: true}},
+		Testers: []TestFlightTesterConfig{{Email: "ada@example.com", Groups: []string{"Missing"}}},
+	}
+
+	_, err := planTestFlightPush(context.Background(), stub, "app-1", desired, false)
+	if err == nil || !strings.Contains(err.Error(), `group "Missing" is not defined`) {
+		t.Fatalf("expected unknown group error, got %v", err)
+	}
+}
+
+func TestApplyTestFlightPushPlan_ResolvesCreatedGroups(t *testing.T) {
+	stub := newTestFlightPushStub()
+	desired := &TestFlightConfig{
+		Groups: []TestFlightGroupConfig{
+			{ID: "group-1", Name: "Alpha", FeedbackEnabled: true},
+			{Name: "Beta"},
+		},
+		Builds: []TestFlightBuildConfig{{ID: "build-1", Groups: []string{"Alpha", "Beta"}}},
+		Testers: []TestFlightTesterConfig{
+			{ID: "tester-1", Groups: []string{"Alpha"}},
+			{ID: "tester-2", Groups: []string{"Alpha"}},
+			{Email: "new@example.com", Groups: []string{"Beta"}},
+		},
+	}
+
+	plan, err := planTestFlightPush(context.Background(), stub, "app-1", desired, false)
+	if err != nil {
+		t.Fatalf("planTestFlightPush() error: %v", err)
+	}
+	if err := applyTestFlightPushPlan(context.Background(), stub, "app-1", plan); err != nil {
+		t.Fatalf("applyTestFlightPushPlan() error: %v", err)
+	}
+
+	want := []string{
+		"create-group Beta",
+		"create-tester new@example.com new-Beta",
+		"add-build build-1 new-Beta",
+	}
+	if strings.Join(stub.calls, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("unexpected calls:\n%s\nwant:\n%s", strings.Join(stub.calls, "\n"), strings.Join(want, "\n"))
+	}
+	if plan.changes[0].GroupID != "new-Beta" {
+		t.Fatalf("expected created group ID to be recorded, got %q", plan.changes[0].GroupID)
+	}
+}
+
+func TestResolveTestFlightPushAppID_Mismatch(t *testing.T) {
+	config := &TestFlightConfig{App: TestFlightAppConfig{ID: "app-1"}}
+	if _, err := resolveTestFlightPushAppID("app-2", config); err == nil {
+		t.Fatalf("expected mismatch error")
+	}
+	got, err := resolveTestFlightPushAppID("", config)
+	if err != nil {
+		t.Fatalf("resolveTestFlightPushAppID() error: %v", err)
+	}
+	if got != "app-1" {
+		t.Fatalf("expected app-1, got %q", got)
+	}
+}
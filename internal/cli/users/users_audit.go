@@ -0,0 +1,112 @@
+package users
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const allAppsAccess = "ALL"
+
+// UsersAuditCommand reports which users and invitations can access which apps.
+func UsersAuditCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("audit", flag.ExitOnError)
+
+	appID := fs.String("app", "", "Only show access to this app ID")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "audit",
+		ShortUsage: "asc users audit [flags]",
+		ShortHelp:  "Report who has access to which app.",
+		LongHelp: `Report who has access to which app.
+
+Lists one row per user or pending invitation and visible app. People who can
+see every app are listed once with app ID ALL. With --app, only people who can
+see that app are listed.
+
+Examples:
+  asc users audit --output table
+  asc users audit --app "APP_ID" --output table`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("users audit: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			result, err := auditUserAccess(requestCtx, client, strings.TrimSpace(*appID))
+			if err != nil {
+				return fmt.Errorf("users audit: %w", err)
+			}
+			return shared.PrintOutput(result, *output, *pretty)
+		},
+	}
+}
+
+func auditUserAccess(ctx context.Context, client *asc.Client, appID string) (*asc.UserAccessAuditResult, error) {
+	state, err := fetchTeamState(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &asc.UserAccessAuditResult{AppID: appID, Entries: []asc.UserAccessEntry{}}
+	add := func(entry asc.UserAccessEntry, allApps bool, apps []asc.Resource[asc.AppAttributes]) {
+		if allApps {
+			entry.AppID = allAppsAccess
+			result.Entries = append(result.Entries, entry)
+			return
+		}
+		for _, app := range apps {
+			if appID != "" && app.ID != appID {
+				continue
+			}
+			item := entry
+			item.AppID = app.ID
+			item.AppName = app.Attributes.Name
+			result.Entries = append(result.Entries, item)
+		}
+	}
+
+	for _, user := range state.users {
+		entry := asc.UserAccessEntry{
+			ID:     user.ID,
+			Email:  userLabel(user.Attributes),
+			Name:   strings.TrimSpace(user.Attributes.FirstName + " " + user.Attributes.LastName),
+			Status: "active",
+			Roles:  user.Attributes.Roles,
+		}
+		add(entry, user.Attributes.AllAppsVisible, state.userApps[user.ID])
+	}
+	for _, invitation := range state.invitations {
+		entry := asc.UserAccessEntry{
+			ID:     invitation.ID,
+			Email:  strings.TrimSpace(invitation.Attributes.Email),
+			Name:   strings.TrimSpace(invitation.Attributes.FirstName + " " + invitation.Attributes.LastName),
+			Status: "invited",
+			Roles:  invitation.Attributes.Roles,
+		}
+		add(entry, invitation.Attributes.AllAppsVisible, state.invitationApps[invitation.ID])
+	}
+
+	sort.SliceStable(result.Entries, func(i, j int) bool {
+		a, b := result.Entries[i], result.Entries[j]
+		if !strings.EqualFold(a.Email, b.Email) {
+			return strings.ToLower(a.Email) < strings.ToLower(b.Email)
+		}
+		return a.AppID < b.AppID
+	})
+	return result, nil
+}
@@ -0,0 +1,668 @@
+package users
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+	"gopkg.in/yaml.v3"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	usersSyncInvite           = "invite"
+	usersSyncUpdateUser       = "update-user"
+	usersSyncRevokeInvitation = "revoke-invitation"
+	usersSyncDeleteUser       = "delete-user"
+	usersSyncUnlistedUser     = "unlisted-user"
+)
+
+// accountHolderRole cannot be changed or removed through the API.
+const accountHolderRole = "ACCOUNT_HOLDER"
+
+// defaultUsersSyncMaxDeletes is how many users a sync may delete without
+// raising --max-deletes.
+const defaultUsersSyncMaxDeletes = 5
+
+// TeamConfig is the desired team membership read by "asc users sync".
+type TeamConfig struct {
+	Users  []TeamMemberConfig `yaml:"users"`
+	Ignore []string           `yaml:"ignore,omitempty"`
+}
+
+// TeamMemberConfig is a team member of a TeamConfig.
+type TeamMemberConfig struct {
+	Email               string   `yaml:"email"`
+	FirstName           string   `yaml:"firstName,omitempty"`
+	LastName            string   `yaml:"lastName,omitempty"`
+	Roles               []string `yaml:"roles"`
+	AllApps             bool     `yaml:"allApps,omitempty"`
+	Apps                []string `yaml:"apps,omitempty"`
+	ProvisioningAllowed *bool    `yaml:"provisioningAllowed,omitempty"`
+}
+
+type usersSyncChange struct {
+	Action string
+	Email  string
+	ID     string
+	Fields []string
+
+	// status is set by applyUsersSync; empty means the change was not applied.
+	status string
+
+	member     *TeamMemberConfig
+	attributes *asc.UserUpdateAttributes
+	apps       []string
+}
+
+// teamState is the live membership the plan is computed against.
+type teamState struct {
+	users          []asc.Resource[asc.UserAttributes]
+	userApps       map[string][]asc.Resource[asc.AppAttributes]
+	invitations    []asc.Resource[asc.UserInvitationAttributes]
+	invitationApps map[string][]asc.Resource[asc.AppAttributes]
+}
+
+// UsersSyncCommand applies a team membership YAML file to App Store Connect.
+func UsersSyncCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("sync", flag.ExitOnError)
+
+	file := fs.String("file", "", "Team YAML file path (required)")
+	dryRun := fs.Bool("dry-run", false, "Print the plan without applying changes")
+	confirm := fs.Bool("confirm", false, "Delete users that are not in the file")
+	maxDeletes := fs.Int("max-deletes", defaultUsersSyncMaxDeletes, "Maximum number of users --confirm may delete")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "sync",
+		ShortUsage: "asc users sync --file team.yaml [--dry-run] [--confirm]",
+		ShortHelp:  "Sync team membership with a YAML file.",
+		LongHelp: `Sync team membership with a YAML file.
+
+The file lists every team member by email with their roles and either
+allApps: true or the app IDs they can see:
+
+  users:
+    - email: jane@example.com
+      firstName: Jane
+      lastName: Doe
+      roles: [ADMIN]
+      allApps: true
+    - email: john@example.com
+      firstName: John
+      lastName: Smith
+      roles: [DEVELOPER, MARKETING]
+      apps: ["123456789"]
+  ignore:
+    - ci@example.com
+
+Missing people are invited, and roles and visible apps of existing users are
+updated. Pending invitations for people not in the file, or that no longer
+match it, are revoked (and re-sent when the person is in the file).
+
+Users that are not in the file are reported as unlisted-user; with --confirm
+they are deleted instead. A sync that would delete more than --max-deletes
+users (default 5) fails before changing anything. Emails under ignore and the
+account holder are never changed.
+
+Changes are applied in order and the sync stops at the first failure; the
+output then marks each change as applied, failed, or skipped.
+
+Examples:
+  asc users sync --file team.yaml --dry-run
+  asc users sync --file team.yaml
+  asc users sync --file team.yaml --confirm --output table
+  asc users sync --file team.yaml --confirm --max-deletes 20`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			path := strings.TrimSpace(*file)
+			if path == "" {
+				fmt.Fprintln(os.Stderr, "Error: --file is required")
+				return flag.ErrHelp
+			}
+			if *maxDeletes < 0 {
+				fmt.Fprintln(os.Stderr, "Error: --max-deletes must be 0 or greater")
+				return flag.ErrHelp
+			}
+
+			config, err := readTeamConfigYAML(path)
+			if err != nil {
+				return fmt.Errorf("users sync: %w", err)
+			}
+			if err := validateTeamConfig(config); err != nil {
+				return fmt.Errorf("users sync: %w", err)
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("users sync: %w", err)
+			}
+
+			state, err := fetchTeamState(ctx, client)
+			if err != nil {
+				return fmt.Errorf("users sync: %w", err)
+			}
+			changes, err := planUsersSync(state, config, *confirm)
+			if err != nil {
+				return fmt.Errorf("users sync: %w", err)
+			}
+
+			if deletes := countUsersSyncDeletes(changes); !*dryRun && deletes > *maxDeletes {
+				return fmt.Errorf("users sync: plan deletes %d users, more than --max-deletes %d; review it with --dry-run and raise --max-deletes to proceed", deletes, *maxDeletes)
+			}
+
+			if !*dryRun {
+				if err := applyUsersSync(ctx, client, changes); err != nil {
+					if printErr := shared.PrintOutput(usersSyncResult(path, *dryRun, changes), *output, *pretty); printErr != nil {
+						return printErr
+					}
+					return shared.NewReportedError(fmt.Errorf("users sync: %w", err))
+				}
+			}
+
+			return shared.PrintOutput(usersSyncResult(path, *dryRun, changes), *output, *pretty)
+		},
+	}
+}
+
+func readTeamConfigYAML(path string) (*TeamConfig, error) {
+	file, err := shared.OpenExistingNoFollow(path)
+	if err != nil {
+		return nil, fmt.Errorf("read input: %w", err)
+	}
+	defer file.Close()
+
+	var config TeamConfig
+	decoder := yaml.NewDecoder(file)
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&config); err != nil {
+		return nil, fmt.Errorf("parse input: %w", err)
+	}
+	return &config, nil
+}
+
+func validateTeamConfig(config *TeamConfig) error {
+	// An empty file would otherwise plan the removal of the whole team.
+	if len(config.Users) == 0 {
+		return fmt.Errorf("users must list at least one team member")
+	}
+	emails := make(map[string]struct{}, len(config.Users))
+	for i := range config.Users {
+		member := &config.Users[i]
+		member.Email = strings.TrimSpace(member.Email)
+		if member.Email == "" {
+			return fmt.Errorf("every user requires an email")
+		}
+		key := strings.ToLower(member.Email)
+		if _, ok := emails[key]; ok {
+			return fmt.Errorf("duplicate user %q", member.Email)
+		}
+		emails[key] = struct{}{}
+
+		member.Roles = normalizeRoles(member.Roles)
+		if len(member.Roles) == 0 {
+			return fmt.Errorf("user %s: roles are required", member.Email)
+		}
+		for _, role := range member.Roles {
+			if role == accountHolderRole {
+				return fmt.Errorf("user %s: the %s role cannot be assigned", member.Email, accountHolderRole)
+			}
+		}
+		member.Apps = uniqueSorted(member.Apps)
+		if member.AllApps && len(member.Apps) > 0 {
+			return fmt.Errorf("user %s: allApps and apps cannot be used together", member.Email)
+		}
+		if !member.AllApps && len(member.Apps) == 0 {
+			return fmt.Errorf("user %s: allApps or apps is required", member.Email)
+		}
+	}
+	return nil
+}
+
+func fetchTeamState(ctx context.Context, client *asc.Client) (*teamState, error) {
+	state := &teamState{
+		userApps:       make(map[string][]asc.Resource[asc.AppAttributes]),
+		invitationApps: make(map[string][]asc.Resource[asc.AppAttributes]),
+	}
+
+	next := ""
+	for {
+		requestCtx, cancel := shared.ContextWithTimeout(ctx)
+		resp, err := client.GetUsers(requestCtx, asc.WithUsersLimit(200), asc.WithUsersNextURL(next))
+		cancel()
+		if err != nil {
+			return nil, fmt.Errorf("fetch users: %w", err)
+		}
+		state.users = append(state.users, resp.Data...)
+		if strings.TrimSpace(resp.Links.Next) == "" {
+			break
+		}
+		next = resp.Links.Next
+	}
+	for _, user := range state.users {
+		if user.Attributes.AllAppsVisible {
+			continue
+		}
+		apps, err := fetchUserVisibleApps(ctx, client, user.ID)
+		if err != nil {
+			return nil, fmt.Errorf("fetch visible apps of %s: %w", userLabel(user.Attributes), err)
+		}
+		state.userApps[user.ID] = apps
+	}
+
+	next = ""
+	for {
+		requestCtx, cancel := shared.ContextWithTimeout(ctx)
+		resp, err := client.GetUserInvitations(requestCtx, asc.WithUserInvitationsLimit(200), asc.WithUserInvitationsNextURL(next))
+		cancel()
+		if err != nil {
+			return nil, fmt.Errorf("fetch invitations: %w", err)
+		}
+		state.invitations = append(state.invitations, resp.Data...)
+		if strings.TrimSpace(resp.Links.Next) == "" {
+			break
+		}
+		next = resp.Links.Next
+	}
+	for _, invitation := range state.invitations {
+		if invitation.Attributes.AllAppsVisible {
+			continue
+		}
+		apps, err := fetchInvitationVisibleApps(ctx, client, invitation.ID)
+		if err != nil {
+			return nil, fmt.Errorf("fetch visible apps of invitation %s: %w", invitation.Attributes.Email, err)
+		}
+		state.invitationApps[invitation.ID] = apps
+	}
+	return state, nil
+}
+
+func fetchUserVisibleApps(ctx context.Context, client *asc.Client, userID string) ([]asc.Resource[asc.AppAttributes], error) {
+	var apps []asc.Resource[asc.AppAttributes]
+	next := ""
+	for {
+		requestCtx, cancel := shared.ContextWithTimeout(ctx)
+		resp, err := client.GetUserVisibleApps(requestCtx, userID, asc.WithUserVisibleAppsLimit(200), asc.WithUserVisibleAppsNextURL(next))
+		cancel()
+		if err != nil {
+			return nil, err
+		}
+		apps = append(apps, resp.Data...)
+		if strings.TrimSpace(resp.Links.Next) == "" {
+			return apps, nil
+		}
+		next = resp.Links.Next
+	}
+}
+
+func fetchInvitationVisibleApps(ctx context.Context, client *asc.Client, invitationID string) ([]asc.Resource[asc.AppAttributes], error) {
+	var apps []asc.Resource[asc.AppAttributes]
+	next := ""
+	for {
+		requestCtx, cancel := shared.ContextWithTimeout(ctx)
+		resp, err := client.GetUserInvitationVisibleApps(requestCtx, invitationID, asc.WithUserInvitationVisibleAppsLimit(200), asc.WithUserInvitationVisibleAppsNextURL(next))
+		cancel()
+		if err != nil {
+			return nil, err
+		}
+		apps = append(apps, resp.Data...)
+		if strings.TrimSpace(resp.Links.Next) == "" {
+			return apps, nil
+		}
+		next = resp.Links.Next
+	}
+}
+
+func appIDs(apps []asc.Resource[asc.AppAttributes]) []string {
+	ids := make([]string, 0, len(apps))
+	for _, app := range apps {
+		ids = append(ids, app.ID)
+	}
+	return uniqueSorted(ids)
+}
+
+// planUsersSync computes the changes that make the team match config.
+// Changes are ordered: revocations, invitations, updates, then removals.
+func planUsersSync(state *teamState, config *TeamConfig, confirm bool) ([]usersSyncChange, error) {
+	ignored := make(map[string]bool, len(config.Ignore))
+	for _, email := range config.Ignore {
+		ignored[strings.ToLower(strings.TrimSpace(email))] = true
+	}
+	desired := make(map[string]*TeamMemberConfig, len(config.Users))
+	for i := range config.Users {
+		member := &config.Users[i]
+		key := strings.ToLower(member.Email)
+		if ignored[key] {
+			return nil, fmt.Errorf("user %s is both listed and ignored", member.Email)
+		}
+		desired[key] = member
+	}
+
+	revocations := make([]usersSyncChange, 0)
+	invitations := make([]usersSyncChange, 0)
+	updates := make([]usersSyncChange, 0)
+	removals := make([]usersSyncChange, 0)
+
+	existing := make(map[string]bool, len(state.users))
+	for _, user := range state.users {
+		label := userLabel(user.Attributes)
+		keys := userKeys(user.Attributes)
+		for _, key := range keys {
+			existing[key] = true
+		}
+		if isIgnored(keys, ignored) || hasRole(user.Attributes.Roles, accountHolderRole) {
+			continue
+		}
+
+		member := desiredMember(keys, desired)
+		if member == nil {
+			action := usersSyncUnlistedUser
+			if confirm {
+				action = usersSyncDeleteUser
+			}
+			removals = append(removals, usersSyncChange{Action: action, Email: label, ID: user.ID})
+			continue
+		}
+
+		change := usersSyncChange{Action: usersSyncUpdateUser, Email: label, ID: user.ID}
+		attrs := &asc.UserUpdateAttributes{Roles: member.Roles}
+		changed := false
+		currentRoles := normalizeRoles(user.Attributes.Roles)
+		if !sameStrings(currentRoles, member.Roles) {
+			change.Fields = append(change.Fields, fmt.Sprintf("roles: %s -> %s", strings.Join(currentRoles, ","), strings.Join(member.Roles, ",")))
+			changed = true
+		}
+		if user.Attributes.AllAppsVisible != member.AllApps {
+			value := member.AllApps
+			attrs.AllAppsVisible = &value
+			change.Fields = append(change.Fields, fmt.Sprintf("allApps: %t -> %t", user.Attributes.AllAppsVisible, member.AllApps))
+			changed = true
+		}
+		if member.ProvisioningAllowed != nil && user.Attributes.ProvisioningAllowed != *member.ProvisioningAllowed {
+			attrs.ProvisioningAllowed = member.ProvisioningAllowed
+			change.Fields = append(change.Fields, fmt.Sprintf("provisioningAllowed: %t -> %t", user.Attributes.ProvisioningAllowed, *member.ProvisioningAllowed))
+			changed = true
+		}
+		if currentApps := appIDs(state.userApps[user.ID]); !member.AllApps && !sameStrings(currentApps, member.Apps) {
+			change.apps = member.Apps
+			change.Fields = append(change.Fields, fmt.Sprintf("apps: %s -> %s", formatApps(currentApps), formatApps(member.Apps)))
+		}
+		if changed {
+			change.attributes = attrs
+		}
+		if len(change.Fields) > 0 {
+			updates = append(updates, change)
+		}
+	}
+
+	invited := make(map[string]bool, len(state.invitations))
+	for _, invitation := range state.invitations {
+		email := strings.TrimSpace(invitation.Attributes.Email)
+		key := strings.ToLower(email)
+		if ignored[key] {
+			continue
+		}
+		member := desired[key]
+		if member != nil && !existing[key] && invitationMatches(invitation, appIDs(state.invitationApps[invitation.ID]), member) {
+			invited[key] = true
+			continue
+		}
+		revocations = append(revocations, usersSyncChange{Action: usersSyncRevokeInvitation, Email: email, ID: invitation.ID})
+	}
+
+	for _, member := range config.Users {
+		key := strings.ToLower(member.Email)
+		if existing[key] || invited[key] {
+			continue
+		}
+		if strings.TrimSpace(member.FirstName) == "" || strings.TrimSpace(member.LastName) == "" {
+			return nil, fmt.Errorf("user %s: firstName and lastName are required to invite", member.Email)
+		}
+		member := member
+		fields := []string{"roles: " + strings.Join(member.Roles, ",")}
+		if member.AllApps {
+			fields = append(fields, "allApps: true")
+		} else {
+			fields = append(fields, "apps: "+formatApps(member.Apps))
+		}
+		invitations = append(invitations, usersSyncChange{
+			Action: usersSyncInvite,
+			Email:  member.Email,
+			Fields: fields,
+			member: &member,
+		})
+	}
+
+	changes := make([]usersSyncChange, 0, len(revocations)+len(invitations)+len(updates)+len(removals))
+	changes = append(changes, revocations...)
+	changes = append(changes, invitations...)
+	changes = append(changes, updates...)
+	changes = append(changes, removals...)
+	return changes, nil
+}
+
+// applyUsersSync applies changes in order and stops at the first failure.
+// Every request gets its own timeout; the status of each change records
+// whether it was applied, failed, or skipped after an earlier failure.
+func applyUsersSync(ctx context.Context, client *asc.Client, changes []usersSyncChange) error {
+	for i := range changes {
+		change := &changes[i]
+		if change.Action == usersSyncUnlistedUser {
+			// Reported only; deleting requires --confirm.
+			continue
+		}
+		if err := applyUsersSyncChange(ctx, client, change); err != nil {
+			change.status = "failed"
+			for j := i + 1; j < len(changes); j++ {
+				if changes[j].Action != usersSyncUnlistedUser {
+					changes[j].status = "skipped"
+				}
+			}
+			return err
+		}
+		change.status = "applied"
+	}
+	return nil
+}
+
+func applyUsersSyncChange(ctx context.Context, client *asc.Client, change *usersSyncChange) error {
+	switch change.Action {
+	case usersSyncRevokeInvitation:
+		requestCtx, cancel := shared.ContextWithTimeout(ctx)
+		defer cancel()
+		if err := client.DeleteUserInvitation(requestCtx, change.ID); err != nil {
+			return fmt.Errorf("revoke invitation %s: %w", change.Email, err)
+		}
+	case usersSyncInvite:
+		member := change.member
+		allApps := member.AllApps
+		attrs := asc.UserInvitationCreateAttributes{
+			Email:               member.Email,
+			FirstName:           strings.TrimSpace(member.FirstName),
+			LastName:            strings.TrimSpace(member.LastName),
+			Roles:               member.Roles,
+			AllAppsVisible:      &allApps,
+			ProvisioningAllowed: member.ProvisioningAllowed,
+		}
+		requestCtx, cancel := shared.ContextWithTimeout(ctx)
+		defer cancel()
+		invitation, err := client.CreateUserInvitation(requestCtx, attrs, member.Apps)
+		if err != nil {
+			return fmt.Errorf("invite %s: %w", change.Email, err)
+		}
+		change.ID = invitation.Data.ID
+	case usersSyncUpdateUser:
+		if change.attributes != nil {
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			_, err := client.UpdateUser(requestCtx, change.ID, *change.attributes)
+			cancel()
+			if err != nil {
+				return fmt.Errorf("update user %s: %w", change.Email, err)
+			}
+		}
+		if change.apps != nil {
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			err := client.SetUserVisibleApps(requestCtx, change.ID, change.apps)
+			cancel()
+			if err != nil {
+				return fmt.Errorf("set visible apps of %s: %w", change.Email, err)
+			}
+		}
+	case usersSyncDeleteUser:
+		requestCtx, cancel := shared.ContextWithTimeout(ctx)
+		defer cancel()
+		if err := client.DeleteUser(requestCtx, change.ID); err != nil {
+			return fmt.Errorf("delete user %s: %w", change.Email, err)
+		}
+	default:
+		return fmt.Errorf("unknown change %q", change.Action)
+	}
+	return nil
+}
+
+func countUsersSyncDeletes(changes []usersSyncChange) int {
+	count := 0
+	for _, change := range changes {
+		if change.Action == usersSyncDeleteUser {
+			count++
+		}
+	}
+	return count
+}
+
+func usersSyncResult(path string, dryRun bool, changes []usersSyncChange) *asc.UsersSyncResult {
+	result := &asc.UsersSyncResult{
+		File:    filepath.Clean(path),
+		DryRun:  dryRun,
+		Changes: make([]asc.UsersSyncChange, 0, len(changes)),
+	}
+	for _, change := range changes {
+		status := change.status
+		switch {
+		case change.Action == usersSyncUnlistedUser:
+			status = "reported"
+		case dryRun:
+			status = "planned"
+		case status == "":
+			status = "applied"
+		}
+		result.Changes = append(result.Changes, asc.UsersSyncChange{
+			Action: change.Action,
+			Email:  change.Email,
+			ID:     change.ID,
+			Fields: change.Fields,
+			Status: status,
+		})
+	}
+	return result
+}
+
+func invitationMatches(invitation asc.Resource[asc.UserInvitationAttributes], apps []string, member *TeamMemberConfig) bool {
+	attrs := invitation.Attributes
+	if !sameStrings(normalizeRoles(attrs.Roles), member.Roles) || attrs.AllAppsVisible != member.AllApps {
+		return false
+	}
+	if member.ProvisioningAllowed != nil && attrs.ProvisioningAllowed != *member.ProvisioningAllowed {
+		return false
+	}
+	return member.AllApps || sameStrings(apps, member.Apps)
+}
+
+// userKeys returns the lowercased username and email of a user.
+func userKeys(attrs asc.UserAttributes) []string {
+	keys := make([]string, 0, 2)
+	for _, value := range []string{attrs.Username, attrs.Email} {
+		if trimmed := strings.ToLower(strings.TrimSpace(value)); trimmed != "" {
+			keys = append(keys, trimmed)
+		}
+	}
+	return keys
+}
+
+func userLabel(attrs asc.UserAttributes) string {
+	if username := strings.TrimSpace(attrs.Username); username != "" {
+		return username
+	}
+	return strings.TrimSpace(attrs.Email)
+}
+
+func desiredMember(keys []string, desired map[string]*TeamMemberConfig) *TeamMemberConfig {
+	for _, key := range keys {
+		if member, ok := desired[key]; ok {
+			return member
+		}
+	}
+	return nil
+}
+
+func isIgnored(keys []string, ignored map[string]bool) bool {
+	for _, key := range keys {
+		if ignored[key] {
+			return true
+		}
+	}
+	return false
+}
+
+func hasRole(roles []string, role string) bool {
+	for _, value := range roles {
+		if strings.EqualFold(value, role) {
+			return true
+		}
+	}
+	return false
+}
+
+func normalizeRoles(roles []string) []string {
+	normalized := make([]string, 0, len(roles))
+	for _, role := range roles {
+		normalized = append(normalized, strings.ToUpper(strings.TrimSpace(role)))
+	}
+	return uniqueSorted(normalized)
+}
+
+func uniqueSorted(values []string) []string {
+	seen := make(map[string]struct{}, len(values))
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		if _, ok := seen[value]; ok {
+			continue
+		}
+		seen[value] = struct{}{}
+		result = append(result, value)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func sameStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func formatApps(apps []string) string {
+	if len(apps) == 0 {
+		return "none"
+	}
+	return strings.Join(apps, ",")
+}
@@ -0,0 +1,169 @@
+package users
+
+import (
+	"context"
+	"flag"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestValidateTeamConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  TeamConfig
+		wantErr string
+	}{
+		{
+			name:    "empty users",
+			config:  TeamConfig{Ignore: []string{"ci@example.com"}},
+			wantErr: "at least one team member",
+		},
+		{
+			name:    "duplicate email",
+			config:  TeamConfig{Users: []TeamMemberConfig{{Email: "a@example.com", Roles: []string{"ADMIN"}, AllApps: true}, {Email: "A@example.com", Roles: []string{"ADMIN"}, AllApps: true}}},
+			wantErr: "duplicate user",
+		},
+		{
+			name:    "missing roles",
+			config:  TeamConfig{Users: []TeamMemberConfig{{Email: "a@example.com", AllApps: true}}},
+			wantErr: "roles are required",
+		},
+		{
+			name:    "all apps and apps",
+			config:  TeamConfig{Users: []TeamMemberConfig{{Email: "a@example.com", Roles: []string{"ADMIN"}, AllApps: true, Apps: []string{"1"}}}},
+			wantErr: "cannot be used together",
+		},
+		{
+			name:    "no apps",
+			config:  TeamConfig{Users: []TeamMemberConfig{{Email: "a@example.com", Roles: []string{"ADMIN"}}}},
+			wantErr: "allApps or apps is required",
+		},
+		{
+			name:    "account holder",
+			config:  TeamConfig{Users: []TeamMemberConfig{{Email: "a@example.com", Roles: []string{"account_holder"}, AllApps: true}}},
+			wantErr: "cannot be assigned",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateTeamConfig(&test.config)
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestPlanUsersSync(t *testing.T) {
+	config := &TeamConfig{
+		Users: []TeamMemberConfig{
+			{Email: "dev@example.com", Roles: []string{"developer", "MARKETING"}, Apps: []string{"app-2", "app-1"}},
+			{Email: "admin@example.com", Roles: []string{"ADMIN"}, AllApps: true},
+			{Email: "new@example.com", FirstName: "New", LastName: "Person", Roles: []string{"SALES"}, AllApps: true},
+			{Email: "pending@example.com", FirstName: "Pending", LastName: "Person", Roles: []string{"DEVELOPER"}, Apps: []string{"app-1"}},
+		},
+		Ignore: []string{"ci@example.com"},
+	}
+	if err := validateTeamConfig(config); err != nil {
+		t.Fatalf("validateTeamConfig() error: %v", err)
+	}
+
+	state := &teamState{
+		users: []asc.Resource[asc.UserAttributes]{
+			{ID: "u-owner", Attributes: asc.UserAttributes{Username: "owner@example.com", Roles: []string{"ACCOUNT_HOLDER", "ADMIN"}, AllAppsVisible: true}},
+			{ID: "u-dev", Attributes: asc.UserAttributes{Username: "Dev@example.com", Roles: []string{"DEVELOPER"}}},
+			{ID: "u-admin", Attributes: asc.UserAttributes{Username: "admin@example.com", Roles: []string{"ADMIN"}, AllAppsVisible: true}},
+			{ID: "u-ci", Attributes: asc.UserAttributes{Username: "ci@example.com", Roles: []string{"DEVELOPER"}}},
+			{ID: "u-old", Attributes: asc.UserAttributes{Username: "old@example.com", Roles: []string{"SALES"}, AllAppsVisible: true}},
+		},
+		userApps: map[string][]asc.Resource[asc.AppAttributes]{
+			"u-dev": {{ID: "app-1"}},
+		},
+		invitations: []asc.Resource[asc.UserInvitationAttributes]{
+			{ID: "inv-ok", Attributes: asc.UserInvitationAttributes{Email: "pending@example.com", Roles: []string{"DEVELOPER"}}},
+			{ID: "inv-stale", Attributes: asc.UserInvitationAttributes{Email: "gone@example.com", Roles: []string{"SALES"}, AllAppsVisible: true}},
+		},
+		invitationApps: map[string][]asc.Resource[asc.AppAttributes]{
+			"inv-ok": {{ID: "app-1"}},
+		},
+	}
+
+	changes, err := planUsersSync(state, config, false)
+	if err != nil {
+		t.Fatalf("planUsersSync() error: %v", err)
+	}
+
+	got := make([]string, 0, len(changes))
+	for _, change := range changes {
+		got = append(got, change.Action+" "+change.Email+" "+strings.Join(change.Fields, "; "))
+	}
+	want := []string{
+		"revoke-invitation gone@example.com ",
+		"invite new@example.com roles: SALES; allApps: true",
+		"update-user Dev@example.com roles: DEVELOPER -> DEVELOPER,MARKETING; apps: app-1 -> app-1,app-2",
+		"unlisted-user old@example.com ",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("unexpected plan:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+	if changes[2].attributes == nil || changes[2].attributes.AllAppsVisible != nil || len(changes[2].apps) != 2 {
+		t.Fatalf("unexpected update: %+v", changes[2])
+	}
+
+	changes, err = planUsersSync(state, config, true)
+	if err != nil {
+		t.Fatalf("planUsersSync() error: %v", err)
+	}
+	if last := changes[len(changes)-1]; last.Action != usersSyncDeleteUser || last.ID != "u-old" {
+		t.Fatalf("expected delete-user with --confirm, got %+v", last)
+	}
+}
+
+func TestPlanUsersSync_ReinvitesMismatchedInvitation(t *testing.T) {
+	config := &TeamConfig{Users: []TeamMemberConfig{
+		{Email: "pending@example.com", FirstName: "Pending", LastName: "Person", Roles: []string{"ADMIN"}, AllApps: true},
+	}}
+	if err := validateTeamConfig(config); err != nil {
+		t.Fatalf("validateTeamConfig() error: %v", err)
+	}
+	state := &teamState{
+		invitations: []asc.Resource[asc.UserInvitationAttributes]{
+			{ID: "inv-1", Attributes: asc.UserInvitationAttributes{Email: "pending@example.com", Roles: []string{"DEVELOPER"}, AllAppsVisible: true}},
+		},
+	}
+
+	changes, err := planUsersSync(state, config, false)
+	if err != nil {
+		t.Fatalf("planUsersSync() error: %v", err)
+	}
+	if len(changes) != 2 || changes[0].Action != usersSyncRevokeInvitation || changes[1].Action != usersSyncInvite {
+		t.Fatalf("expected revoke and re-invite, got %+v", changes)
+	}
+}
+
+func TestPlanUsersSync_InviteRequiresName(t *testing.T) {
+	config := &TeamConfig{Users: []TeamMemberConfig{{Email: "new@example.com", Roles: []string{"ADMIN"}, AllApps: true}}}
+	if err := validateTeamConfig(config); err != nil {
+		t.Fatalf("validateTeamConfig() error: %v", err)
+	}
+
+	_, err := planUsersSync(&teamState{}, config, false)
+	if err == nil || !strings.Contains(err.Error(), "firstName and lastName are required") {
+		t.Fatalf("expected missing name error, got %v", err)
+	}
+}
+
+func TestUsersSyncCommand_MissingFile(t *testing.T) {
+	cmd := UsersSyncCommand()
+
+	if err := cmd.FlagSet.Parse([]string{}); err != nil {
+		t.Fatalf("failed to parse flags: %v", err)
+	}
+
+	if err := cmd.Exec(context.Background(), []string{}); err != flag.ErrHelp {
+		t.Fatalf("expected flag.ErrHelp when --file is missing, got %v", err)
+	}
+}
@@ -28,6 +28,7 @@ func VersionsCommand() *ffcli.Command {
 			VersionsCustomerReviewsCommand(),
 			VersionsAppClipDefaultExperienceCommand(),
 			VersionsCreateCommand(),
+			VersionsCopyCommand(),
 			VersionsUpdateCommand(),
 			VersionsDeleteCommand(),
 			VersionsAttachBuildCommand(),
@@ -51,7 +52,7 @@ func VersionsListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Next page URL from a previous response")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -142,7 +143,7 @@ func VersionsGetCommand() *ffcli.Command {
 	includeBuild := fs.Bool("include-build", false, "Include attached build information")
 	includeSubmission := fs.Bool("include-submission", false, "Include submission information")
 	include := fs.String("include", "", "Include related resources: "+strings.Join(appStoreVersionIncludeList(), ", "))
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -234,7 +235,9 @@ func VersionsCreateCommand() *ffcli.Command {
 	platform := fs.String("platform", "IOS", "Platform: IOS, MAC_OS, TV_OS, VISION_OS")
 	copyright := fs.String("copyright", "", "Copyright text (e.g., '2026 My Company')")
 	releaseType := fs.String("release-type", "", "Release type: MANUAL, AFTER_APPROVAL, SCHEDULED")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	copyFrom := fs.String("copy-from", "", "Copy metadata from a version ID, or \"latest\" for the newest version on the same platform")
+	copyFlags := bindVersionCopyFlags(fs)
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -243,10 +246,16 @@ func VersionsCreateCommand() *ffcli.Command {
 		ShortHelp:  "Create a new app store version.",
 		LongHelp: `Create a new app store version.
 
+With --copy-from, localizations and review details are copied into the new
+version as "asc versions copy" does, and the output reports each copied
+resource.
+
 Examples:
   asc versions create --app "123456789" --version "2.0.0"
   asc versions create --app "123456789" --version "2.0.0" --platform IOS
-  asc versions create --app "123456789" --version "2.0.0" --copyright "2026 My Company" --release-type MANUAL`,
+  asc versions create --app "123456789" --version "2.0.0" --copyright "2026 My Company" --release-type MANUAL
+  asc versions create --app "123456789" --version "2.1.0" --copy-from latest --exclude-whats-new
+  asc versions create --app "123456789" --version "2.1.0" --copy-from "VERSION_ID" --copy-screenshots`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -265,6 +274,11 @@ Examples:
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
 			}
+			copySource := strings.TrimSpace(*copyFrom)
+			if copySource == "" && copyFlags.set() {
+				fmt.Fprintln(os.Stderr, "Error: --exclude-whats-new, --copy-screenshots and --copy-previews require --copy-from")
+				return flag.ErrHelp
+			}
 
 			client, err := shared.GetASCClient()
 			if err != nil {
@@ -274,6 +288,14 @@ Examples:
 			requestCtx, cancel := shared.ContextWithTimeout(ctx)
 			defer cancel()
 
+			// Resolve the source first so a bad --copy-from fails before anything is created.
+			if strings.EqualFold(copySource, copySourceLatest) {
+				copySource, err = shared.ResolveLatestAppStoreVersionID(requestCtx, client, resolvedAppID, normalizedPlatform, "")
+				if err != nil {
+					return fmt.Errorf("versions create: %w", err)
+				}
+			}
+
 			attrs := asc.AppStoreVersionCreateAttributes{
 				Platform:      asc.Platform(normalizedPlatform),
 				VersionString: strings.TrimSpace(*versionString),
@@ -296,8 +318,17 @@ Examples:
 				Platform:      string(resp.Data.Attributes.Platform),
 				State:         shared.ResolveAppStoreVersionState(resp.Data.Attributes),
 			}
+			if copySource == "" {
+				return shared.PrintOutput(result, *output, *pretty)
+			}
 
-			return shared.PrintOutput(result, *output, *pretty)
+			copyResult, err := copyVersionMetadata(ctx, client, copySource, resp.Data.ID, copyFlags.options())
+			if err != nil {
+				return fmt.Errorf("versions create: created version %s but copying from %s failed: %w", resp.Data.ID, copySource, err)
+			}
+			copyResult.Version = result
+
+			return shared.PrintOutput(copyResult, *output, *pretty)
 		},
 	}
 }
@@ -310,7 +341,7 @@ func VersionsUpdateCommand() *ffcli.Command {
 	releaseType := fs.String("release-type", "", "Release type: MANUAL, AFTER_APPROVAL, SCHEDULED")
 	earliestReleaseDate := fs.String("earliest-release-date", "", "Earliest release date (ISO 8601, e.g., 2026-02-01T08:00:00+00:00)")
 	versionString := fs.String("version", "", "Version string (e.g., 1.0.1)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -383,7 +414,7 @@ func VersionsDeleteCommand() *ffcli.Command {
 
 	versionID := fs.String("version-id", "", "App Store version ID (required)")
 	confirm := fs.Bool("confirm", false, "Confirm deletion (required)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -435,7 +466,7 @@ func VersionsAttachBuildCommand() *ffcli.Command {
 
 	versionID := fs.String("version-id", "", "App Store version ID (required)")
 	buildID := fs.String("build", "", "Build ID to attach (required)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -0,0 +1,435 @@
+package versions
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// Version copy actions reported in AppStoreVersionCopyItem.Action.
+const (
+	copyActionCreated = "created"
+	copyActionUpdated = "updated"
+	copyActionCopied  = "copied"
+	copyActionSkipped = "skipped"
+)
+
+// Resources reported in AppStoreVersionCopyItem.Resource.
+const (
+	copyResourceLocalization     = "localization"
+	copyResourceReviewDetail     = "review-detail"
+	copyResourceReviewAttachment = "review-attachment"
+	copyResourceScreenshotSet    = "screenshot-set"
+	copyResourcePreviewSet       = "preview-set"
+)
+
+// copySourceLatest selects the most recently created other version.
+const copySourceLatest = "latest"
+
+type versionCopyOptions struct {
+	excludeWhatsNew bool
+	screenshots     bool
+	previews        bool
+}
+
+// versionCopyFlags are shared by versions copy and versions create --copy-from.
+type versionCopyFlags struct {
+	excludeWhatsNew *bool
+	screenshots     *bool
+	previews        *bool
+}
+
+func bindVersionCopyFlags(fs *flag.FlagSet) versionCopyFlags {
+	return versionCopyFlags{
+		excludeWhatsNew: fs.Bool("exclude-whats-new", false, "Do not copy whatsNew (release notes)"),
+		screenshots:     fs.Bool("copy-screenshots", false, "Also copy screenshot sets for every locale"),
+		previews:        fs.Bool("copy-previews", false, "Also copy app preview sets for every locale"),
+	}
+}
+
+func (f versionCopyFlags) options() versionCopyOptions {
+	return versionCopyOptions{
+		excludeWhatsNew: *f.excludeWhatsNew,
+		screenshots:     *f.screenshots,
+		previews:        *f.previews,
+	}
+}
+
+func (f versionCopyFlags) set() bool {
+	return *f.excludeWhatsNew || *f.screenshots || *f.previews
+}
+
+// localizationPair links a source localization to its copy.
+type localizationPair struct {
+	locale   string
+	sourceID string
+	targetID string
+}
+
+// VersionsCopyCommand returns the versions copy subcommand.
+func VersionsCopyCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("versions copy", flag.ExitOnError)
+
+	from := fs.String("from", "", "Source version ID, or \"latest\" for the newest other version of the target's platform (required)")
+	to := fs.String("to", "", "Target version ID (required)")
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID; required with --from latest)")
+	copyFlags := bindVersionCopyFlags(fs)
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, tsv, yaml, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "copy",
+		ShortUsage: "asc versions copy --from VERSION_ID|latest --to VERSION_ID [flags]",
+		ShortHelp:  "Copy metadata from one app store version to another.",
+		LongHelp: `Copy metadata from one app store version to another.
+
+Copies every version localization (description, keywords, marketing and
+support URLs, promotional text, whatsNew) and the App Review details. Locales
+missing on the target are created; existing ones are overwritten field by
+field. With --copy-screenshots and --copy-previews, each source set is
+downloaded and the matching target set is replaced with it, keeping order.
+
+App Store Connect does not return review attachment files, so attachments are
+listed as skipped; upload them with "asc review attachments-upload".
+
+Examples:
+  asc versions copy --from "OLD_VERSION_ID" --to "NEW_VERSION_ID"
+  asc versions copy --from latest --to "NEW_VERSION_ID" --app "123456789" --exclude-whats-new
+  asc versions copy --from "OLD_VERSION_ID" --to "NEW_VERSION_ID" --copy-screenshots --copy-previews --output table`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			fromValue := strings.TrimSpace(*from)
+			toValue := strings.TrimSpace(*to)
+			if fromValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --from is required")
+				return flag.ErrHelp
+			}
+			if toValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --to is required")
+				return flag.ErrHelp
+			}
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if strings.EqualFold(fromValue, copySourceLatest) && resolvedAppID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --app is required with --from latest (or set ASC_APP_ID)")
+				return flag.ErrHelp
+			}
+			if fromValue == toValue {
+				fmt.Fprintln(os.Stderr, "Error: --from and --to must be different versions")
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("versions copy: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			sourceID := fromValue
+			if strings.EqualFold(fromValue, copySourceLatest) {
+				target, err := client.GetAppStoreVersion(requestCtx, toValue)
+				if err != nil {
+					return fmt.Errorf("versions copy: failed to fetch target version: %w", err)
+				}
+				sourceID, err = shared.ResolveLatestAppStoreVersionID(requestCtx, client, resolvedAppID, string(target.Data.Attributes.Platform), toValue)
+				if err != nil {
+					return fmt.Errorf("versions copy: %w", err)
+				}
+			}
+
+			result, err := copyVersionMetadata(ctx, client, sourceID, toValue, copyFlags.options())
+			if err != nil {
+				return fmt.Errorf("versions copy: %w", err)
+			}
+
+			return shared.PrintOutput(result, *output, *pretty)
+		},
+	}
+}
+
+// copyVersionMetadata copies localizations, review details and, when
+// requested, screenshot and preview sets from sourceID to targetID.
+func copyVersionMetadata(ctx context.Context, client *asc.Client, sourceID, targetID string, opts versionCopyOptions) (*asc.AppStoreVersionCopyResult, error) {
+	result := &asc.AppStoreVersionCopyResult{
+		SourceVersionID: sourceID,
+		TargetVersionID: targetID,
+		Items:           []asc.AppStoreVersionCopyItem{},
+	}
+
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+
+	pairs, err := copyVersionLocalizations(requestCtx, client, sourceID, targetID, opts, result)
+	if err != nil {
+		return nil, err
+	}
+	if err := copyReviewDetail(requestCtx, client, sourceID, targetID, result); err != nil {
+		return nil, err
+	}
+	if opts.screenshots || opts.previews {
+		if err := copyVersionAssets(ctx, client, pairs, opts, result); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, item := range result.Items {
+		if item.Action == copyActionSkipped {
+			result.Skipped++
+		} else {
+			result.Copied++
+		}
+	}
+	return result, nil
+}
+
+func copyVersionLocalizations(ctx context.Context, client *asc.Client, sourceID, targetID string, opts versionCopyOptions, result *asc.AppStoreVersionCopyResult) ([]localizationPair, error) {
+	source, err := shared.FetchVersionLocalizations(ctx, client, sourceID)
+	if err != nil {
+		return nil, err
+	}
+	target, err := shared.FetchVersionLocalizations(ctx, client, targetID)
+	if err != nil {
+		return nil, err
+	}
+	targetIDs := make(map[string]string, len(target))
+	for _, item := range target {
+		targetIDs[item.Attributes.Locale] = item.ID
+	}
+	sort.Slice(source, func(i, j int) bool {
+		return source[i].Attributes.Locale < source[j].Attributes.Locale
+	})
+
+	pairs := make([]localizationPair, 0, len(source))
+	for _, localization := range source {
+		locale := localization.Attributes.Locale
+		attrs := localization.Attributes
+		attrs.Locale = ""
+		if opts.excludeWhatsNew {
+			attrs.WhatsNew = ""
+		}
+
+		item := asc.AppStoreVersionCopyItem{Resource: copyResourceLocalization, Locale: locale, SourceID: localization.ID}
+		existingID, exists := targetIDs[locale]
+		switch {
+		case attrs == asc.AppStoreVersionLocalizationAttributes{}:
+			item.Action = copyActionSkipped
+			item.TargetID = existingID
+			item.Message = "no values to copy"
+		case exists:
+			if _, err := client.UpdateAppStoreVersionLocalization(ctx, existingID, attrs); err != nil {
+				return nil, fmt.Errorf("failed to update %s localization: %w", locale, err)
+			}
+			item.Action = copyActionUpdated
+			item.TargetID = existingID
+		default:
+			attrs.Locale = locale
+			resp, err := client.CreateAppStoreVersionLocalization(ctx, targetID, attrs)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create %s localization: %w", locale, err)
+			}
+			targetIDs[locale] = resp.Data.ID
+			item.Action = copyActionCreated
+			item.TargetID = resp.Data.ID
+		}
+		result.Items = append(result.Items, item)
+
+		if targetIDs[locale] != "" {
+			pairs = append(pairs, localizationPair{locale: locale, sourceID: localization.ID, targetID: targetIDs[locale]})
+		}
+	}
+	return pairs, nil
+}
+
+func copyReviewDetail(ctx context.Context, client *asc.Client, sourceID, targetID string, result *asc.AppStoreVersionCopyResult) error {
+	source, err := client.GetAppStoreReviewDetailForVersion(ctx, sourceID)
+	if err != nil {
+		if asc.IsNotFound(err) {
+			result.Items = append(result.Items, asc.AppStoreVersionCopyItem{
+				Resource: copyResourceReviewDetail,
+				Action:   copyActionSkipped,
+				Message:  "source version has no review details",
+			})
+			return nil
+		}
+		return fmt.Errorf("failed to fetch review details: %w", err)
+	}
+
+	attrs := reviewDetailCopyAttributes(source.Data.Attributes)
+	item := asc.AppStoreVersionCopyItem{Resource: copyResourceReviewDetail, SourceID: source.Data.ID}
+	target, err := client.GetAppStoreReviewDetailForVersion(ctx, targetID)
+	switch {
+	case err == nil:
+		if _, err := client.UpdateAppStoreReviewDetail(ctx, target.Data.ID, attrs); err != nil {
+			return fmt.Errorf("failed to update review details: %w", err)
+		}
+		item.Action = copyActionUpdated
+		item.TargetID = target.Data.ID
+	case asc.IsNotFound(err):
+		createAttrs := asc.AppStoreReviewDetailCreateAttributes(attrs)
+		resp, err := client.CreateAppStoreReviewDetail(ctx, targetID, &createAttrs)
+		if err != nil {
+			return fmt.Errorf("failed to create review details: %w", err)
+		}
+		item.Action = copyActionCreated
+		item.TargetID = resp.Data.ID
+	default:
+		return fmt.Errorf("failed to fetch target review details: %w", err)
+	}
+	result.Items = append(result.Items, item)
+
+	attachments, err := client.GetAppStoreReviewAttachmentsForReviewDetail(ctx, source.Data.ID, asc.WithAppStoreReviewAttachmentsLimit(200))
+	if err != nil {
+		return fmt.Errorf("failed to fetch review attachments: %w", err)
+	}
+	for _, attachment := range attachments.Data {
+		result.Items = append(result.Items, asc.AppStoreVersionCopyItem{
+			Resource: copyResourceReviewAttachment,
+			Action:   copyActionSkipped,
+			SourceID: attachment.ID,
+			Message: fmt.Sprintf("%s: attachment files cannot be downloaded; upload it with asc review attachments-upload --review-detail %s",
+				attachment.Attributes.FileName, item.TargetID),
+		})
+	}
+	return nil
+}
+
+// reviewDetailCopyAttributes copies non-empty review detail fields.
+func reviewDetailCopyAttributes(attrs asc.AppStoreReviewDetailAttributes) asc.AppStoreReviewDetailUpdateAttributes {
+	optional := func(value string) *string {
+		if strings.TrimSpace(value) == "" {
+			return nil
+		}
+		return &value
+	}
+	demoAccountRequired := attrs.DemoAccountRequired
+	return asc.AppStoreReviewDetailUpdateAttributes{
+		ContactFirstName:    optional(attrs.ContactFirstName),
+		ContactLastName:     optional(attrs.ContactLastName),
+		ContactPhone:        optional(attrs.ContactPhone),
+		ContactEmail:        optional(attrs.ContactEmail),
+		DemoAccountName:     optional(attrs.DemoAccountName),
+		DemoAccountPassword: optional(attrs.DemoAccountPassword),
+		DemoAccountRequired: &demoAccountRequired,
+		Notes:               optional(attrs.Notes),
+	}
+}
+
+// copyVersionAssets downloads each source screenshot and preview set and
+// replaces the matching target set with the same files in the same order.
+func copyVersionAssets(ctx context.Context, client *asc.Client, pairs []localizationPair, opts versionCopyOptions, result *asc.AppStoreVersionCopyResult) error {
+	assetCtx, cancel := shared.ContextWithAssetUploadTimeout(ctx)
+	defer cancel()
+
+	tempDir, err := os.MkdirTemp("", "asc-version-copy-*")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tempDir)
+
+	for _, pair := range pairs {
+		if opts.screenshots {
+			sets, err := client.GetAppScreenshotSets(assetCtx, pair.sourceID)
+			if err != nil {
+				return fmt.Errorf("failed to fetch screenshot sets for %s: %w", pair.locale, err)
+			}
+			for _, set := range sets.Data {
+				displayType := set.Attributes.ScreenshotDisplayType
+				item := asc.AppStoreVersionCopyItem{Resource: copyResourceScreenshotSet, Locale: pair.locale, Type: displayType, SourceID: set.ID}
+				screenshots, err := client.GetAppScreenshots(assetCtx, set.ID)
+				if err != nil {
+					return fmt.Errorf("failed to fetch screenshots for set %s: %w", set.ID, err)
+				}
+				var files []string
+				for i, screenshot := range screenshots.Data {
+					url := shared.ScreenshotDownloadURL(screenshot.Attributes)
+					if url == "" {
+						continue
+					}
+					path := copiedAssetPath(tempDir, pair.locale, displayType, i, screenshot.Attributes.FileName, ".png")
+					if err := shared.DownloadAssetFile(assetCtx, client, url, path, false); err != nil {
+						return fmt.Errorf("failed to download screenshot %s: %w", screenshot.ID, err)
+					}
+					files = append(files, path)
+				}
+				if len(files) == 0 {
+					item.Action = copyActionSkipped
+					item.Message = "no processed screenshots to copy"
+					result.Items = append(result.Items, item)
+					continue
+				}
+				target, err := shared.EnsureScreenshotSet(assetCtx, client, pair.targetID, displayType)
+				if err != nil {
+					return err
+				}
+				if _, _, err := shared.SyncScreenshotSet(assetCtx, client, target.ID, files, true); err != nil {
+					return fmt.Errorf("failed to copy %s screenshots for %s: %w", displayType, pair.locale, err)
+				}
+				item.Action = copyActionCopied
+				item.TargetID = target.ID
+				item.Count = len(files)
+				result.Items = append(result.Items, item)
+			}
+		}
+
+		if opts.previews {
+			sets, err := client.GetAppPreviewSets(assetCtx, pair.sourceID)
+			if err != nil {
+				return fmt.Errorf("failed to fetch preview sets for %s: %w", pair.locale, err)
+			}
+			for _, set := range sets.Data {
+				previewType := set.Attributes.PreviewType
+				item := asc.AppStoreVersionCopyItem{Resource: copyResourcePreviewSet, Locale: pair.locale, Type: previewType, SourceID: set.ID}
+				previews, err := client.GetAppPreviews(assetCtx, set.ID)
+				if err != nil {
+					return fmt.Errorf("failed to fetch previews for set %s: %w", set.ID, err)
+				}
+				var files []string
+				for i, preview := range previews.Data {
+					if preview.Attributes.VideoURL == "" {
+						continue
+					}
+					path := copiedAssetPath(tempDir, pair.locale, previewType, i, preview.Attributes.FileName, ".mov")
+					if err := shared.DownloadAssetFile(assetCtx, client, preview.Attributes.VideoURL, path, false); err != nil {
+						return fmt.Errorf("failed to download preview %s: %w", preview.ID, err)
+					}
+					files = append(files, path)
+				}
+				if len(files) == 0 {
+					item.Action = copyActionSkipped
+					item.Message = "no processed previews to copy"
+					result.Items = append(result.Items, item)
+					continue
+				}
+				target, err := shared.EnsurePreviewSet(assetCtx, client, pair.targetID, previewType)
+				if err != nil {
+					return err
+				}
+				if _, _, err := shared.SyncPreviewSet(assetCtx, client, target.ID, files, true); err != nil {
+					return fmt.Errorf("failed to copy %s previews for %s: %w", previewType, pair.locale, err)
+				}
+				item.Action = copyActionCopied
+				item.TargetID = target.ID
+				item.Count = len(files)
+				result.Items = append(result.Items, item)
+			}
+		}
+	}
+	return nil
+}
+
+// copiedAssetPath keeps the original file name (so the target asset has the
+// same name) in a per-position directory so equal names cannot collide.
+func copiedAssetPath(tempDir, locale, setType string, index int, fileName, defaultExt string) string {
+	return filepath.Join(tempDir, locale, setType, fmt.Sprintf("%02d", index+1), shared.AssetFileName(fileName, defaultExt))
+}
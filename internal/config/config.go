@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -109,12 +110,37 @@ func parseDurationValue(raw string) (time.Duration, error) {
 	return time.Duration(seconds) * time.Second, nil
 }
 
+// API key types. Team keys sign with an issuer ID; Individual keys sign
+// with sub "user" and no issuer.
+const (
+	KeyTypeTeam       = "team"
+	KeyTypeIndividual = "individual"
+)
+
+// NormalizeKeyType returns the canonical key type. Empty means team.
+func NormalizeKeyType(value string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", KeyTypeTeam:
+		return KeyTypeTeam, nil
+	case KeyTypeIndividual:
+		return KeyTypeIndividual, nil
+	default:
+		return "", fmt.Errorf("key type must be %q or %q", KeyTypeTeam, KeyTypeIndividual)
+	}
+}
+
+// IsIndividualKeyType reports whether value names an Individual API key.
+func IsIndividualKeyType(value string) bool {
+	return strings.EqualFold(strings.TrimSpace(value), KeyTypeIndividual)
+}
+
 // Credential stores a named API credential in config.json.
 type Credential struct {
 	Name           string `json:"name"`
 	KeyID          string `json:"key_id"`
 	IssuerID       string `json:"issuer_id"`
 	PrivateKeyPath string `json:"private_key_path"`
+	KeyType        string `json:"key_type,omitempty"`
 }
 
 // Config holds the application configuration
@@ -122,9 +148,12 @@ type Config struct {
 	KeyID          string       `json:"key_id"`
 	IssuerID       string       `json:"issuer_id"`
 	PrivateKeyPath string       `json:"private_key_path"`
+	KeyType        string       `json:"key_type,omitempty"`
 	DefaultKeyName string       `json:"default_key_name"`
 	Keys           []Credential `json:"keys,omitempty"`
 	AppID          string       `json:"app_id"`
+	BaseURL        string       `json:"base_url,omitempty"`
+	DefaultOutput  string       `json:"default_output,omitempty"`
 
 	VendorNumber          string `json:"vendor_number"`
 	AnalyticsVendorNumber string `json:"analytics_vendor_number"`
@@ -138,6 +167,20 @@ type Config struct {
 	MaxDelay             string        `json:"max_delay"`
 	RetryLog             string        `json:"retry_log"`
 	Debug                string        `json:"debug"`
+
+	MetadataLint *MetadataLintConfig `json:"metadata_lint,omitempty"`
+}
+
+// MetadataLintConfig configures "asc metadata lint". Rules maps a rule ID to
+// "error", "warning" or "off"; Limits overrides per-field character limits.
+type MetadataLintConfig struct {
+	PrimaryLocale   string            `json:"primary_locale,omitempty"`
+	RequiredLocales []string          `json:"required_locales,omitempty"`
+	BannedWords     []string          `json:"banned_words,omitempty"`
+	BannedKeywords  []string          `json:"banned_keywords,omitempty"`
+	Placeholders    []string          `json:"placeholders,omitempty"`
+	Limits          map[string]int    `json:"limits,omitempty"`
+	Rules           map[string]string `json:"rules,omitempty"`
 }
 
 // ErrNotFound is returned when the config file doesn't exist
@@ -334,6 +377,17 @@ func (c *Config) Validate() error {
 	if err := validateMaxRetries(c.MaxRetries); err != nil {
 		return wrapInvalidConfig(err)
 	}
+	if err := validateBaseURL(c.BaseURL); err != nil {
+		return wrapInvalidConfig(err)
+	}
+	if _, err := NormalizeKeyType(c.KeyType); err != nil {
+		return wrapInvalidConfig(fmt.Errorf("key_type: %w", err))
+	}
+	for _, cred := range c.Keys {
+		if _, err := NormalizeKeyType(cred.KeyType); err != nil {
+			return wrapInvalidConfig(fmt.Errorf("keys[%s].key_type: %w", strings.TrimSpace(cred.Name), err))
+		}
+	}
 
 	baseDelay, baseSet, err := parseOptionalDuration("base_delay", c.BaseDelay)
 	if err != nil {
@@ -383,6 +437,18 @@ func validateMaxRetries(raw string) error {
 	return nil
 }
 
+func validateBaseURL(raw string) error {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil
+	}
+	parsed, err := url.Parse(raw)
+	if err != nil || parsed.Host == "" || (parsed.Scheme != "https" && parsed.Scheme != "http") {
+		return fmt.Errorf("base_url must be an absolute http(s) URL")
+	}
+	return nil
+}
+
 func parseOptionalDuration(field, raw string) (time.Duration, bool, error) {
 	raw = strings.TrimSpace(raw)
 	if raw == "" {
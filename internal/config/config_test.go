@@ -344,3 +344,60 @@ func TestLoadAtRejectsMaxDelayBelowBaseDelay(t *testing.T) {
 		t.Fatalf("expected ErrInvalidConfig, got %v", err)
 	}
 }
+
+func TestLoadAtRejectsInvalidBaseURL(t *testing.T) {
+	tempDir := t.TempDir()
+	path := filepath.Join(tempDir, "config.json")
+	cfg := &Config{
+		BaseURL: "localhost:8080",
+	}
+	if err := SaveAt(path, cfg); err != nil {
+		t.Fatalf("SaveAt() error: %v", err)
+	}
+
+	_, err := LoadAt(path)
+	if err == nil {
+		t.Fatal("expected error for invalid base URL, got nil")
+	}
+	if !errors.Is(err, ErrInvalidConfig) {
+		t.Fatalf("expected ErrInvalidConfig, got %v", err)
+	}
+}
+
+func TestLoadAtRejectsInvalidKeyType(t *testing.T) {
+	tempDir := t.TempDir()
+	path := filepath.Join(tempDir, "config.json")
+	cfg := &Config{
+		Keys: []Credential{{Name: "demo", KeyID: "KEY", PrivateKeyPath: "/tmp/AuthKey.p8", KeyType: "enterprise"}},
+	}
+	if err := SaveAt(path, cfg); err != nil {
+		t.Fatalf("SaveAt() error: %v", err)
+	}
+
+	_, err := LoadAt(path)
+	if !errors.Is(err, ErrInvalidConfig) {
+		t.Fatalf("expected ErrInvalidConfig, got %v", err)
+	}
+}
+
+func TestNormalizeKeyType(t *testing.T) {
+	tests := map[string]string{
+		"":           KeyTypeTeam,
+		"team":       KeyTypeTeam,
+		" Team ":     KeyTypeTeam,
+		"individual": KeyTypeIndividual,
+		"INDIVIDUAL": KeyTypeIndividual,
+	}
+	for input, want := range tests {
+		got, err := NormalizeKeyType(input)
+		if err != nil {
+			t.Fatalf("NormalizeKeyType(%q) error: %v", input, err)
+		}
+		if got != want {
+			t.Fatalf("NormalizeKeyType(%q) = %q, want %q", input, got, want)
+		}
+	}
+	if _, err := NormalizeKeyType("user"); err == nil {
+		t.Fatal("expected error for unknown key type")
+	}
+}
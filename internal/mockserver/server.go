@@ -0,0 +1,466 @@
+// Package mockserver implements a local stand-in for the App Store Connect API.
+//
+// Fixtures are JSON:API documents stored on disk using the request path:
+//
+//	GET /v1/apps            -> <dir>/v1/apps.json
+//	GET /v1/apps/123        -> <dir>/v1/apps/123.json
+//	GET /v1/apps/123/builds -> <dir>/v1/apps/123/builds.json
+//
+// Collection fixtures are paginated with links.next when a page size is
+// configured or the request sets limit. POST, PATCH and DELETE requests
+// mutate an in-memory copy of the fixtures so later reads observe them.
+package mockserver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Options configures a mock server.
+type Options struct {
+	// Dir is the fixture root directory.
+	Dir string
+	// PageSize is the default page size for collections (0 disables paging
+	// unless the request sets limit).
+	PageSize int
+	// RateLimitEvery returns 429 for every Nth request when > 0.
+	RateLimitEvery int
+	// RetryAfter is the Retry-After value sent with 429 responses.
+	RetryAfter time.Duration
+}
+
+// Server serves App Store Connect fixtures over HTTP.
+type Server struct {
+	opts Options
+
+	mu          sync.Mutex
+	requests    int
+	nextID      int
+	collections map[string]*collection
+	resources   map[string]map[string]any
+	deleted     map[string]bool
+}
+
+type collection struct {
+	order []string
+	items map[string]map[string]any
+}
+
+// New creates a server for the given options.
+func New(opts Options) (*Server, error) {
+	dir := strings.TrimSpace(opts.Dir)
+	if dir == "" {
+		return nil, fmt.Errorf("fixture directory is required")
+	}
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, fmt.Errorf("fixture directory: %w", err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("fixture directory %q is not a directory", dir)
+	}
+	if opts.PageSize < 0 {
+		return nil, fmt.Errorf("page size must be >= 0")
+	}
+	if opts.RateLimitEvery < 0 {
+		return nil, fmt.Errorf("rate limit interval must be >= 0")
+	}
+	opts.Dir = dir
+	return &Server{
+		opts:        opts,
+		collections: make(map[string]*collection),
+		resources:   make(map[string]map[string]any),
+		deleted:     make(map[string]bool),
+	}, nil
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ") {
+		writeError(w, http.StatusUnauthorized, "NOT_AUTHORIZED", "Authentication credentials are missing or invalid.")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests++
+	if s.opts.RateLimitEvery > 0 && s.requests%s.opts.RateLimitEvery == 0 {
+		seconds := int(s.opts.RetryAfter / time.Second)
+		if seconds < 1 {
+			seconds = 1
+		}
+		w.Header().Set("Retry-After", strconv.Itoa(seconds))
+		writeError(w, http.StatusTooManyRequests, "RATE_LIMIT_EXCEEDED", "The request rate limit has been reached.")
+		return
+	}
+
+	cleanPath := path.Clean("/" + strings.Trim(r.URL.Path, "/"))
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+		s.handleGet(w, r, cleanPath)
+	case http.MethodPost:
+		s.handlePost(w, r, cleanPath)
+	case http.MethodPatch:
+		s.handlePatch(w, r, cleanPath)
+	case http.MethodDelete:
+		s.handleDelete(w, cleanPath)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", fmt.Sprintf("Method %s is not allowed.", r.Method))
+	}
+}
+
+func (s *Server) handleGet(w http.ResponseWriter, r *http.Request, requestPath string) {
+	if s.deleted[requestPath] {
+		writeNotFound(w, requestPath)
+		return
+	}
+	if resource, ok := s.resources[requestPath]; ok {
+		writeJSON(w, http.StatusOK, map[string]any{"data": resource, "links": map[string]any{"self": selfURL(r)}})
+		return
+	}
+	if coll, err := s.loadCollection(requestPath); err != nil {
+		writeError(w, http.StatusInternalServerError, "FIXTURE_ERROR", err.Error())
+		return
+	} else if coll != nil {
+		s.writeCollection(w, r, coll)
+		return
+	}
+
+	doc, err := s.readFixture(requestPath)
+	if err == nil {
+		writeJSON(w, http.StatusOK, doc)
+		return
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		writeError(w, http.StatusInternalServerError, "FIXTURE_ERROR", err.Error())
+		return
+	}
+
+	resource, err := s.lookupResource(requestPath)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "FIXTURE_ERROR", err.Error())
+		return
+	}
+	if resource == nil {
+		writeNotFound(w, requestPath)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"data": resource, "links": map[string]any{"self": selfURL(r)}})
+}
+
+func (s *Server) handlePost(w http.ResponseWriter, r *http.Request, requestPath string) {
+	if isRelationshipPath(requestPath) {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	resource, err := decodeResource(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "PARAMETER_ERROR.INVALID", err.Error())
+		return
+	}
+
+	coll, err := s.loadCollection(requestPath)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "FIXTURE_ERROR", err.Error())
+		return
+	}
+	if coll == nil {
+		coll = &collection{items: make(map[string]map[string]any)}
+		s.collections[requestPath] = coll
+	}
+
+	s.nextID++
+	id := fmt.Sprintf("mock-%d", s.nextID)
+	resource["id"] = id
+	if _, ok := resource["type"]; !ok {
+		resource["type"] = path.Base(requestPath)
+	}
+	resource["links"] = map[string]any{"self": baseURL(r) + requestPath + "/" + id}
+	delete(resource, "relationships")
+
+	coll.order = append(coll.order, id)
+	coll.items[id] = resource
+	s.resources[requestPath+"/"+id] = resource
+
+	writeJSON(w, http.StatusCreated, map[string]any{"data": resource})
+}
+
+func (s *Server) handlePatch(w http.ResponseWriter, r *http.Request, requestPath string) {
+	current, err := s.lookupResource(requestPath)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "FIXTURE_ERROR", err.Error())
+		return
+	}
+	if current == nil {
+		writeNotFound(w, requestPath)
+		return
+	}
+
+	update, err := decodeResource(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "PARAMETER_ERROR.INVALID", err.Error())
+		return
+	}
+	if attrs, ok := update["attributes"].(map[string]any); ok {
+		merged, _ := current["attributes"].(map[string]any)
+		if merged == nil {
+			merged = make(map[string]any)
+		}
+		for key, value := range attrs {
+			merged[key] = value
+		}
+		current["attributes"] = merged
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{"data": current})
+}
+
+func (s *Server) handleDelete(w http.ResponseWriter, requestPath string) {
+	if isRelationshipPath(requestPath) {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	current, err := s.lookupResource(requestPath)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "FIXTURE_ERROR", err.Error())
+		return
+	}
+	if current == nil {
+		writeNotFound(w, requestPath)
+		return
+	}
+
+	parent, id := path.Split(requestPath)
+	parent = strings.TrimSuffix(parent, "/")
+	if coll := s.collections[parent]; coll != nil {
+		delete(coll.items, id)
+		for i, value := range coll.order {
+			if value == id {
+				coll.order = append(coll.order[:i], coll.order[i+1:]...)
+				break
+			}
+		}
+	}
+	delete(s.resources, requestPath)
+	s.deleted[requestPath] = true
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// lookupResource returns the mutable state for a single resource, loading it
+// from its own fixture or from its parent collection on first access.
+func (s *Server) lookupResource(requestPath string) (map[string]any, error) {
+	if s.deleted[requestPath] {
+		return nil, nil
+	}
+	if resource, ok := s.resources[requestPath]; ok {
+		return resource, nil
+	}
+
+	parent, id := path.Split(requestPath)
+	parent = strings.TrimSuffix(parent, "/")
+	if coll, err := s.loadCollection(parent); err != nil {
+		return nil, err
+	} else if coll != nil {
+		if resource, ok := coll.items[id]; ok {
+			s.resources[requestPath] = resource
+			return resource, nil
+		}
+	}
+
+	doc, err := s.readFixture(requestPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	resource, ok := doc["data"].(map[string]any)
+	if !ok {
+		return nil, nil
+	}
+	s.resources[requestPath] = resource
+	return resource, nil
+}
+
+// loadCollection returns the collection state for a path, or nil when the
+// fixture is missing or is not a collection document.
+func (s *Server) loadCollection(requestPath string) (*collection, error) {
+	if coll, ok := s.collections[requestPath]; ok {
+		return coll, nil
+	}
+
+	doc, err := s.readFixture(requestPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	items, ok := doc["data"].([]any)
+	if !ok {
+		return nil, nil
+	}
+
+	coll := &collection{items: make(map[string]map[string]any, len(items))}
+	for index, item := range items {
+		resource, ok := item.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%s: data[%d] is not an object", requestPath, index)
+		}
+		id, _ := resource["id"].(string)
+		if id == "" {
+			return nil, fmt.Errorf("%s: data[%d] is missing id", requestPath, index)
+		}
+		coll.order = append(coll.order, id)
+		coll.items[id] = resource
+	}
+	s.collections[requestPath] = coll
+	return coll, nil
+}
+
+func (s *Server) writeCollection(w http.ResponseWriter, r *http.Request, coll *collection) {
+	query := r.URL.Query()
+	pageSize := s.opts.PageSize
+	if value := strings.TrimSpace(query.Get("limit")); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 {
+			writeError(w, http.StatusBadRequest, "PARAMETER_ERROR.INVALID", "limit must be a positive integer")
+			return
+		}
+		pageSize = parsed
+	}
+	offset := 0
+	if value := strings.TrimSpace(query.Get("cursor")); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 0 {
+			writeError(w, http.StatusBadRequest, "PARAMETER_ERROR.INVALID", "cursor is invalid")
+			return
+		}
+		offset = parsed
+	}
+
+	total := len(coll.order)
+	if offset > total {
+		offset = total
+	}
+	end := total
+	if pageSize > 0 && offset+pageSize < total {
+		end = offset + pageSize
+	}
+
+	data := make([]any, 0, end-offset)
+	for _, id := range coll.order[offset:end] {
+		data = append(data, coll.items[id])
+	}
+
+	links := map[string]any{"self": selfURL(r)}
+	if end < total {
+		next := *r.URL
+		values := next.Query()
+		values.Set("cursor", strconv.Itoa(end))
+		if values.Get("limit") == "" {
+			values.Set("limit", strconv.Itoa(pageSize))
+		}
+		next.RawQuery = values.Encode()
+		links["next"] = baseURL(r) + next.RequestURI()
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"data":  data,
+		"links": links,
+		"meta": map[string]any{
+			"paging": map[string]any{"total": total, "limit": pageSize},
+		},
+	})
+}
+
+func (s *Server) readFixture(requestPath string) (map[string]any, error) {
+	rel := filepath.FromSlash(strings.TrimPrefix(requestPath, "/"))
+	if rel == "" || rel == "." {
+		return nil, os.ErrNotExist
+	}
+	candidates := []string{
+		filepath.Join(s.opts.Dir, rel+".json"),
+		filepath.Join(s.opts.Dir, rel, "index.json"),
+	}
+	for _, candidate := range candidates {
+		data, err := os.ReadFile(candidate)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return nil, err
+		}
+		var doc map[string]any
+		if err := json.Unmarshal(data, &doc); err != nil {
+			return nil, fmt.Errorf("parse fixture %s: %w", candidate, err)
+		}
+		return doc, nil
+	}
+	return nil, os.ErrNotExist
+}
+
+func decodeResource(body io.Reader) (map[string]any, error) {
+	var payload struct {
+		Data map[string]any `json:"data"`
+	}
+	if err := json.NewDecoder(body).Decode(&payload); err != nil {
+		return nil, fmt.Errorf("invalid request body: %w", err)
+	}
+	if payload.Data == nil {
+		return nil, fmt.Errorf("request body is missing data")
+	}
+	return payload.Data, nil
+}
+
+func isRelationshipPath(requestPath string) bool {
+	return strings.Contains(requestPath, "/relationships/")
+}
+
+func baseURL(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	return scheme + "://" + r.Host
+}
+
+func selfURL(r *http.Request) string {
+	u := url.URL{Path: r.URL.Path, RawQuery: r.URL.RawQuery}
+	return baseURL(r) + u.RequestURI()
+}
+
+func writeNotFound(w http.ResponseWriter, requestPath string) {
+	writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("The path %s has no fixture.", requestPath))
+}
+
+func writeError(w http.ResponseWriter, status int, code, detail string) {
+	writeJSON(w, status, map[string]any{
+		"errors": []map[string]any{{
+			"status": strconv.Itoa(status),
+			"code":   code,
+			"title":  http.StatusText(status),
+			"detail": detail,
+		}},
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
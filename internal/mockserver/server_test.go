@@ -0,0 +1,186 @@
+package mockserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func writeFixture(t *testing.T, dir, rel, body string) {
+	t.Helper()
+	path := filepath.Join(dir, filepath.FromSlash(rel))
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatalf("MkdirAll() error: %v", err)
+	}
+	if err := os.WriteFile(path, []byte(body), 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+}
+
+func newTestServer(t *testing.T, opts Options) *httptest.Server {
+	t.Helper()
+	if opts.Dir == "" {
+		opts.Dir = t.TempDir()
+	}
+	server, err := New(opts)
+	if err != nil {
+		t.Fatalf("New() error: %v", err)
+	}
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+	return httpServer
+}
+
+func doRequest(t *testing.T, method, url, body string) (*http.Response, map[string]any) {
+	t.Helper()
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("NewRequest() error: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer token")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Do() error: %v", err)
+	}
+	defer resp.Body.Close()
+	var doc map[string]any
+	if resp.StatusCode != http.StatusNoContent {
+		if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+			t.Fatalf("decode error: %v", err)
+		}
+	}
+	return resp, doc
+}
+
+func TestServer_PaginatesCollections(t *testing.T) {
+	dir := t.TempDir()
+	writeFixture(t, dir, "v1/apps.json", `{"data":[{"type":"apps","id":"1"},{"type":"apps","id":"2"},{"type":"apps","id":"3"}]}`)
+	server := newTestServer(t, Options{Dir: dir, PageSize: 2})
+
+	resp, doc := doRequest(t, http.MethodGet, server.URL+"/v1/apps", "")
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+	if got := len(doc["data"].([]any)); got != 2 {
+		t.Fatalf("expected 2 items on first page, got %d", got)
+	}
+	next, _ := doc["links"].(map[string]any)["next"].(string)
+	if !strings.HasPrefix(next, server.URL+"/v1/apps?") || !strings.Contains(next, "cursor=2") {
+		t.Fatalf("unexpected next link %q", next)
+	}
+
+	_, doc = doRequest(t, http.MethodGet, next, "")
+	data := doc["data"].([]any)
+	if len(data) != 1 || data[0].(map[string]any)["id"] != "3" {
+		t.Fatalf("unexpected second page %+v", data)
+	}
+	if _, ok := doc["links"].(map[string]any)["next"]; ok {
+		t.Fatalf("expected no next link on last page")
+	}
+}
+
+func TestServer_ServesItemsFromCollectionFixture(t *testing.T) {
+	dir := t.TempDir()
+	writeFixture(t, dir, "v1/apps.json", `{"data":[{"type":"apps","id":"1","attributes":{"name":"Demo"}}]}`)
+	server := newTestServer(t, Options{Dir: dir})
+
+	resp, doc := doRequest(t, http.MethodGet, server.URL+"/v1/apps/1", "")
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+	if doc["data"].(map[string]any)["attributes"].(map[string]any)["name"] != "Demo" {
+		t.Fatalf("unexpected resource %+v", doc)
+	}
+
+	resp, doc = doRequest(t, http.MethodGet, server.URL+"/v1/apps/404", "")
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected 404, got %d", resp.StatusCode)
+	}
+	if _, ok := doc["errors"]; !ok {
+		t.Fatalf("expected JSON:API errors body, got %+v", doc)
+	}
+}
+
+func TestServer_MutatesInMemoryState(t *testing.T) {
+	dir := t.TempDir()
+	writeFixture(t, dir, "v1/betaGroups.json", `{"data":[{"type":"betaGroups","id":"g1","attributes":{"name":"Alpha"}}]}`)
+	server := newTestServer(t, Options{Dir: dir})
+
+	resp, doc := doRequest(t, http.MethodPost, server.URL+"/v1/betaGroups", `{"data":{"type":"betaGroups","attributes":{"name":"Beta"}}}`)
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", resp.StatusCode)
+	}
+	createdID := doc["data"].(map[string]any)["id"].(string)
+
+	resp, _ = doRequest(t, http.MethodPatch, server.URL+"/v1/betaGroups/g1", `{"data":{"type":"betaGroups","id":"g1","attributes":{"feedbackEnabled":true}}}`)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+
+	_, doc = doRequest(t, http.MethodGet, server.URL+"/v1/betaGroups/g1", "")
+	attrs := doc["data"].(map[string]any)["attributes"].(map[string]any)
+	if attrs["name"] != "Alpha" || attrs["feedbackEnabled"] != true {
+		t.Fatalf("expected merged attributes, got %+v", attrs)
+	}
+
+	resp, _ = doRequest(t, http.MethodDelete, server.URL+"/v1/betaGroups/g1", "")
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d", resp.StatusCode)
+	}
+
+	_, doc = doRequest(t, http.MethodGet, server.URL+"/v1/betaGroups", "")
+	data := doc["data"].([]any)
+	if len(data) != 1 || data[0].(map[string]any)["id"] != createdID {
+		t.Fatalf("expected only created group, got %+v", data)
+	}
+
+	resp, _ = doRequest(t, http.MethodPost, server.URL+"/v1/betaGroups/g2/relationships/betaTesters", `{"data":[]}`)
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("expected 204 for relationship change, got %d", resp.StatusCode)
+	}
+}
+
+func TestServer_RateLimitsWithRetryAfter(t *testing.T) {
+	dir := t.TempDir()
+	writeFixture(t, dir, "v1/apps.json", `{"data":[]}`)
+	server := newTestServer(t, Options{Dir: dir, RateLimitEvery: 2, RetryAfter: 3 * time.Second})
+
+	resp, _ := doRequest(t, http.MethodGet, server.URL+"/v1/apps", "")
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+	resp, _ = doRequest(t, http.MethodGet, server.URL+"/v1/apps", "")
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("expected 429, got %d", resp.StatusCode)
+	}
+	if got := resp.Header.Get("Retry-After"); got != "3" {
+		t.Fatalf("expected Retry-After 3, got %q", got)
+	}
+}
+
+func TestServer_RequiresBearerToken(t *testing.T) {
+	server := newTestServer(t, Options{})
+
+	resp, err := http.Get(server.URL + "/v1/apps")
+	if err != nil {
+		t.Fatalf("Get() error: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected 401, got %d", resp.StatusCode)
+	}
+}
+
+func TestNew_RequiresDirectory(t *testing.T) {
+	if _, err := New(Options{}); err == nil {
+		t.Fatalf("expected error for missing directory")
+	}
+	if _, err := New(Options{Dir: filepath.Join(t.TempDir(), "missing")}); err == nil {
+		t.Fatalf("expected error for nonexistent directory")
+	}
+}
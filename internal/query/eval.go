@@ -0,0 +1,323 @@
+package query
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// expressionRef is the value of an &expression argument.
+type expressionRef struct {
+	node *node
+}
+
+func evaluate(n *node, value interface{}) (interface{}, error) {
+	switch n.kind {
+	case nodeIdentity:
+		return value, nil
+	case nodeLiteral:
+		return n.value, nil
+	case nodeField:
+		if obj, ok := value.(map[string]interface{}); ok {
+			return obj[n.name], nil
+		}
+		return nil, nil
+	case nodeSubexpression:
+		left, err := evaluate(n.children[0], value)
+		if err != nil {
+			return nil, err
+		}
+		return evaluate(n.children[1], left)
+	case nodeIndex:
+		list, ok := value.([]interface{})
+		if !ok {
+			return nil, nil
+		}
+		index := n.index
+		if index < 0 {
+			index += len(list)
+		}
+		if index < 0 || index >= len(list) {
+			return nil, nil
+		}
+		return list[index], nil
+	case nodeSlice:
+		base, err := evaluate(n.children[0], value)
+		if err != nil {
+			return nil, err
+		}
+		list, ok := base.([]interface{})
+		if !ok {
+			return nil, nil
+		}
+		return sliceList(list, n.slice), nil
+	case nodeFlatten:
+		base, err := evaluate(n.children[0], value)
+		if err != nil {
+			return nil, err
+		}
+		list, ok := base.([]interface{})
+		if !ok {
+			return nil, nil
+		}
+		flat := make([]interface{}, 0, len(list))
+		for _, item := range list {
+			if inner, ok := item.([]interface{}); ok {
+				flat = append(flat, inner...)
+			} else {
+				flat = append(flat, item)
+			}
+		}
+		return flat, nil
+	case nodeProjection:
+		base, err := evaluate(n.children[0], value)
+		if err != nil {
+			return nil, err
+		}
+		list, ok := base.([]interface{})
+		if !ok {
+			return nil, nil
+		}
+		return project(list, n.children[1], nil)
+	case nodeValueProjection:
+		base, err := evaluate(n.children[0], value)
+		if err != nil {
+			return nil, err
+		}
+		obj, ok := base.(map[string]interface{})
+		if !ok {
+			return nil, nil
+		}
+		keys := sortedKeys(obj)
+		values := make([]interface{}, 0, len(keys))
+		for _, key := range keys {
+			values = append(values, obj[key])
+		}
+		return project(values, n.children[1], nil)
+	case nodeFilterProjection:
+		base, err := evaluate(n.children[0], value)
+		if err != nil {
+			return nil, err
+		}
+		list, ok := base.([]interface{})
+		if !ok {
+			return nil, nil
+		}
+		return project(list, n.children[1], n.children[2])
+	case nodeComparator:
+		left, err := evaluate(n.children[0], value)
+		if err != nil {
+			return nil, err
+		}
+		right, err := evaluate(n.children[1], value)
+		if err != nil {
+			return nil, err
+		}
+		return compare(n.op, left, right), nil
+	case nodeAnd:
+		left, err := evaluate(n.children[0], value)
+		if err != nil || !truthy(left) {
+			return left, err
+		}
+		return evaluate(n.children[1], value)
+	case nodeOr:
+		left, err := evaluate(n.children[0], value)
+		if err != nil || truthy(left) {
+			return left, err
+		}
+		return evaluate(n.children[1], value)
+	case nodeNot:
+		child, err := evaluate(n.children[0], value)
+		if err != nil {
+			return nil, err
+		}
+		return !truthy(child), nil
+	case nodePipe:
+		left, err := evaluate(n.children[0], value)
+		if err != nil {
+			return nil, err
+		}
+		return evaluate(n.children[1], left)
+	case nodeMultiList:
+		if value == nil {
+			return nil, nil
+		}
+		out := make([]interface{}, 0, len(n.children))
+		for _, child := range n.children {
+			item, err := evaluate(child, value)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, item)
+		}
+		return out, nil
+	case nodeMultiHash:
+		if value == nil {
+			return nil, nil
+		}
+		out := make(map[string]interface{}, len(n.children))
+		for i, child := range n.children {
+			item, err := evaluate(child, value)
+			if err != nil {
+				return nil, err
+			}
+			out[n.keys[i]] = item
+		}
+		return out, nil
+	case nodeExpressionRef:
+		return expressionRef{node: n.children[0]}, nil
+	case nodeFunction:
+		args := make([]interface{}, 0, len(n.children))
+		for _, child := range n.children {
+			arg, err := evaluate(child, value)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, arg)
+		}
+		return callFunction(n.name, args)
+	}
+	return nil, fmt.Errorf("unsupported expression")
+}
+
+// project applies right to each element of list that passes condition,
+// dropping null results.
+func project(list []interface{}, right, condition *node) (interface{}, error) {
+	out := make([]interface{}, 0, len(list))
+	for _, item := range list {
+		if condition != nil {
+			keep, err := evaluate(condition, item)
+			if err != nil {
+				return nil, err
+			}
+			if !truthy(keep) {
+				continue
+			}
+		}
+		result, err := evaluate(right, item)
+		if err != nil {
+			return nil, err
+		}
+		if result != nil {
+			out = append(out, result)
+		}
+	}
+	return out, nil
+}
+
+func sliceList(list []interface{}, parts [3]*int) []interface{} {
+	step := 1
+	if parts[2] != nil {
+		step = *parts[2]
+	}
+	length := len(list)
+	bound := func(value *int, fallback int) int {
+		if value == nil {
+			return fallback
+		}
+		v := *value
+		if v < 0 {
+			v += length
+		}
+		if step > 0 {
+			return clamp(v, 0, length)
+		}
+		return clamp(v, -1, length-1)
+	}
+
+	out := []interface{}{}
+	if step > 0 {
+		for i := bound(parts[0], 0); i < bound(parts[1], length); i += step {
+			out = append(out, list[i])
+		}
+		return out
+	}
+	for i := bound(parts[0], length-1); i > bound(parts[1], -1); i += step {
+		out = append(out, list[i])
+	}
+	return out
+}
+
+func clamp(value, lower, upper int) int {
+	if value < lower {
+		return lower
+	}
+	if value > upper {
+		return upper
+	}
+	return value
+}
+
+func compare(op tokenKind, left, right interface{}) interface{} {
+	switch op {
+	case tokEQ:
+		return reflect.DeepEqual(left, right)
+	case tokNE:
+		return !reflect.DeepEqual(left, right)
+	}
+
+	// Ordering applies to numbers and, for convenience with ISO dates, strings.
+	var cmp int
+	switch l := left.(type) {
+	case float64:
+		r, ok := right.(float64)
+		if !ok {
+			return nil
+		}
+		switch {
+		case l < r:
+			cmp = -1
+		case l > r:
+			cmp = 1
+		}
+	case string:
+		r, ok := right.(string)
+		if !ok {
+			return nil
+		}
+		switch {
+		case l < r:
+			cmp = -1
+		case l > r:
+			cmp = 1
+		}
+	default:
+		return nil
+	}
+
+	switch op {
+	case tokLT:
+		return cmp < 0
+	case tokLTE:
+		return cmp <= 0
+	case tokGT:
+		return cmp > 0
+	default:
+		return cmp >= 0
+	}
+}
+
+func truthy(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	case string:
+		return v != ""
+	case []interface{}:
+		return len(v) > 0
+	case map[string]interface{}:
+		return len(v) > 0
+	}
+	return true
+}
+
+func sortedKeys(obj map[string]interface{}) []string {
+	keys := make([]string, 0, len(obj))
+	for key := range obj {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,237 @@
+package query
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type function struct {
+	arity int // -1 means variadic (at least one argument)
+	call  func(args []interface{}) (interface{}, error)
+}
+
+var functions map[string]function
+
+//nolint:gochecknoinits // sort_by refers back to evaluate, so the table is built at init
+func init() {
+	functions = map[string]function{
+		"length":      {1, fnLength},
+		"contains":    {2, fnContains},
+		"starts_with": {2, fnStartsWith},
+		"ends_with":   {2, fnEndsWith},
+		"keys":        {1, fnKeys},
+		"values":      {1, fnValues},
+		"join":        {2, fnJoin},
+		"sort":        {1, fnSort},
+		"sort_by":     {2, fnSortBy},
+		"reverse":     {1, fnReverse},
+		"to_string":   {1, fnToString},
+		"to_number":   {1, fnToNumber},
+		"not_null":    {-1, fnNotNull},
+	}
+}
+
+func callFunction(name string, args []interface{}) (interface{}, error) {
+	fn := functions[name]
+	if fn.arity >= 0 && len(args) != fn.arity {
+		return nil, fmt.Errorf("%s() takes %d argument(s), got %d", name, fn.arity, len(args))
+	}
+	if fn.arity < 0 && len(args) == 0 {
+		return nil, fmt.Errorf("%s() takes at least 1 argument", name)
+	}
+	return fn.call(args)
+}
+
+func fnLength(args []interface{}) (interface{}, error) {
+	switch v := args[0].(type) {
+	case string:
+		return float64(len([]rune(v))), nil
+	case []interface{}:
+		return float64(len(v)), nil
+	case map[string]interface{}:
+		return float64(len(v)), nil
+	}
+	return nil, fmt.Errorf("length() expects a string, array or object")
+}
+
+func fnContains(args []interface{}) (interface{}, error) {
+	switch subject := args[0].(type) {
+	case string:
+		search, ok := args[1].(string)
+		return ok && strings.Contains(subject, search), nil
+	case []interface{}:
+		for _, item := range subject {
+			if compare(tokEQ, item, args[1]) == true {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+	return nil, fmt.Errorf("contains() expects a string or array")
+}
+
+func fnStartsWith(args []interface{}) (interface{}, error) {
+	subject, ok1 := args[0].(string)
+	prefix, ok2 := args[1].(string)
+	if !ok1 || !ok2 {
+		return nil, fmt.Errorf("starts_with() expects strings")
+	}
+	return strings.HasPrefix(subject, prefix), nil
+}
+
+func fnEndsWith(args []interface{}) (interface{}, error) {
+	subject, ok1 := args[0].(string)
+	suffix, ok2 := args[1].(string)
+	if !ok1 || !ok2 {
+		return nil, fmt.Errorf("ends_with() expects strings")
+	}
+	return strings.HasSuffix(subject, suffix), nil
+}
+
+func fnKeys(args []interface{}) (interface{}, error) {
+	obj, ok := args[0].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("keys() expects an object")
+	}
+	keys := sortedKeys(obj)
+	out := make([]interface{}, len(keys))
+	for i, key := range keys {
+		out[i] = key
+	}
+	return out, nil
+}
+
+func fnValues(args []interface{}) (interface{}, error) {
+	obj, ok := args[0].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("values() expects an object")
+	}
+	keys := sortedKeys(obj)
+	out := make([]interface{}, len(keys))
+	for i, key := range keys {
+		out[i] = obj[key]
+	}
+	return out, nil
+}
+
+func fnJoin(args []interface{}) (interface{}, error) {
+	sep, ok := args[0].(string)
+	list, ok2 := args[1].([]interface{})
+	if !ok || !ok2 {
+		return nil, fmt.Errorf("join() expects a separator string and an array of strings")
+	}
+	parts := make([]string, len(list))
+	for i, item := range list {
+		s, ok := item.(string)
+		if !ok {
+			return nil, fmt.Errorf("join() expects an array of strings")
+		}
+		parts[i] = s
+	}
+	return strings.Join(parts, sep), nil
+}
+
+func fnSort(args []interface{}) (interface{}, error) {
+	list, ok := args[0].([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("sort() expects an array")
+	}
+	sorted := append([]interface{}(nil), list...)
+	var sortErr error
+	sort.SliceStable(sorted, func(i, j int) bool {
+		less, ok := compare(tokLT, sorted[i], sorted[j]).(bool)
+		if !ok {
+			sortErr = fmt.Errorf("sort() expects an array of numbers or strings")
+		}
+		return less
+	})
+	return sorted, sortErr
+}
+
+func fnSortBy(args []interface{}) (interface{}, error) {
+	list, ok := args[0].([]interface{})
+	ref, ok2 := args[1].(expressionRef)
+	if !ok || !ok2 {
+		return nil, fmt.Errorf("sort_by() expects an array and an &expression")
+	}
+	keys := make([]interface{}, len(list))
+	for i, item := range list {
+		key, err := evaluate(ref.node, item)
+		if err != nil {
+			return nil, err
+		}
+		keys[i] = key
+	}
+	indexes := make([]int, len(list))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	var sortErr error
+	sort.SliceStable(indexes, func(i, j int) bool {
+		less, ok := compare(tokLT, keys[indexes[i]], keys[indexes[j]]).(bool)
+		if !ok {
+			sortErr = fmt.Errorf("sort_by() keys must all be numbers or all be strings")
+		}
+		return less
+	})
+	sorted := make([]interface{}, len(list))
+	for i, index := range indexes {
+		sorted[i] = list[index]
+	}
+	return sorted, sortErr
+}
+
+func fnReverse(args []interface{}) (interface{}, error) {
+	switch v := args[0].(type) {
+	case string:
+		runes := []rune(v)
+		for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+			runes[i], runes[j] = runes[j], runes[i]
+		}
+		return string(runes), nil
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, item := range v {
+			out[len(v)-1-i] = item
+		}
+		return out, nil
+	}
+	return nil, fmt.Errorf("reverse() expects a string or array")
+}
+
+func fnToString(args []interface{}) (interface{}, error) {
+	if s, ok := args[0].(string); ok {
+		return s, nil
+	}
+	data, err := json.Marshal(args[0])
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+func fnToNumber(args []interface{}) (interface{}, error) {
+	switch v := args[0].(type) {
+	case float64:
+		return v, nil
+	case string:
+		n, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return nil, nil
+		}
+		return n, nil
+	}
+	return nil, nil
+}
+
+func fnNotNull(args []interface{}) (interface{}, error) {
+	for _, arg := range args {
+		if arg != nil {
+			return arg, nil
+		}
+	}
+	return nil, nil
+}
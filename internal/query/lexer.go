@@ -0,0 +1,245 @@
+package query
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokIdentifier
+	tokQuotedIdentifier
+	tokNumber
+	tokRawString
+	tokLiteral
+	tokDot
+	tokStar
+	tokFlatten
+	tokFilter
+	tokLBracket
+	tokRBracket
+	tokLBrace
+	tokRBrace
+	tokLParen
+	tokRParen
+	tokComma
+	tokColon
+	tokPipe
+	tokOr
+	tokAnd
+	tokNot
+	tokAt
+	tokAmpersand
+	tokEQ
+	tokNE
+	tokLT
+	tokLTE
+	tokGT
+	tokGTE
+)
+
+var tokenNames = map[tokenKind]string{
+	tokEOF:              "end of expression",
+	tokIdentifier:       "identifier",
+	tokQuotedIdentifier: "quoted identifier",
+	tokNumber:           "number",
+	tokRawString:        "raw string",
+	tokLiteral:          "literal",
+	tokDot:              "'.'",
+	tokStar:             "'*'",
+	tokFlatten:          "'[]'",
+	tokFilter:           "'[?'",
+	tokLBracket:         "'['",
+	tokRBracket:         "']'",
+	tokLBrace:           "'{'",
+	tokRBrace:           "'}'",
+	tokLParen:           "'('",
+	tokRParen:           "')'",
+	tokComma:            "','",
+	tokColon:            "':'",
+	tokPipe:             "'|'",
+	tokOr:               "'||'",
+	tokAnd:              "'&&'",
+	tokNot:              "'!'",
+	tokAt:               "'@'",
+	tokAmpersand:        "'&'",
+	tokEQ:               "'=='",
+	tokNE:               "'!='",
+	tokLT:               "'<'",
+	tokLTE:              "'<='",
+	tokGT:               "'>'",
+	tokGTE:              "'>='",
+}
+
+func (k tokenKind) String() string {
+	if name, ok := tokenNames[k]; ok {
+		return name
+	}
+	return "token"
+}
+
+type token struct {
+	kind  tokenKind
+	text  string
+	value interface{}
+	pos   int
+}
+
+// lex splits a query expression into tokens.
+func lex(input string) ([]token, error) {
+	var tokens []token
+	i := 0
+	for i < len(input) {
+		c := input[i]
+		start := i
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+			continue
+		case isIdentStart(c):
+			for i < len(input) && isIdentPart(input[i]) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokIdentifier, text: input[start:i], pos: start})
+			continue
+		case c == '-' || (c >= '0' && c <= '9'):
+			i++
+			for i < len(input) && input[i] >= '0' && input[i] <= '9' {
+				i++
+			}
+			n, err := strconv.Atoi(input[start:i])
+			if err != nil {
+				return nil, fmt.Errorf("invalid number at position %d", start)
+			}
+			tokens = append(tokens, token{kind: tokNumber, text: input[start:i], value: n, pos: start})
+			continue
+		case c == '"':
+			end, err := scanQuoted(input, i, '"')
+			if err != nil {
+				return nil, err
+			}
+			var name string
+			if err := json.Unmarshal([]byte(input[i:end]), &name); err != nil {
+				return nil, fmt.Errorf("invalid quoted identifier at position %d", start)
+			}
+			tokens = append(tokens, token{kind: tokQuotedIdentifier, text: name, pos: start})
+			i = end
+			continue
+		case c == '\'':
+			end, err := scanQuoted(input, i, '\'')
+			if err != nil {
+				return nil, err
+			}
+			raw := strings.ReplaceAll(input[i+1:end-1], `\'`, `'`)
+			tokens = append(tokens, token{kind: tokRawString, text: raw, value: raw, pos: start})
+			i = end
+			continue
+		case c == '`':
+			end, err := scanQuoted(input, i, '`')
+			if err != nil {
+				return nil, err
+			}
+			raw := strings.ReplaceAll(input[i+1:end-1], "\\`", "`")
+			var value interface{}
+			if err := json.Unmarshal([]byte(raw), &value); err != nil {
+				return nil, fmt.Errorf("invalid JSON literal at position %d: %v", start, err)
+			}
+			tokens = append(tokens, token{kind: tokLiteral, text: raw, value: value, pos: start})
+			i = end
+			continue
+		}
+
+		two := ""
+		if i+1 < len(input) {
+			two = input[i : i+2]
+		}
+		kind := tokEOF
+		width := 1
+		switch two {
+		case "[]":
+			kind, width = tokFlatten, 2
+		case "[?":
+			kind, width = tokFilter, 2
+		case "||":
+			kind, width = tokOr, 2
+		case "&&":
+			kind, width = tokAnd, 2
+		case "==":
+			kind, width = tokEQ, 2
+		case "!=":
+			kind, width = tokNE, 2
+		case "<=":
+			kind, width = tokLTE, 2
+		case ">=":
+			kind, width = tokGTE, 2
+		}
+		if kind == tokEOF {
+			switch c {
+			case '.':
+				kind = tokDot
+			case '*':
+				kind = tokStar
+			case '[':
+				kind = tokLBracket
+			case ']':
+				kind = tokRBracket
+			case '{':
+				kind = tokLBrace
+			case '}':
+				kind = tokRBrace
+			case '(':
+				kind = tokLParen
+			case ')':
+				kind = tokRParen
+			case ',':
+				kind = tokComma
+			case ':':
+				kind = tokColon
+			case '|':
+				kind = tokPipe
+			case '!':
+				kind = tokNot
+			case '@':
+				kind = tokAt
+			case '&':
+				kind = tokAmpersand
+			case '<':
+				kind = tokLT
+			case '>':
+				kind = tokGT
+			default:
+				return nil, fmt.Errorf("unexpected character %q at position %d", c, i)
+			}
+		}
+		tokens = append(tokens, token{kind: kind, text: input[i : i+width], pos: start})
+		i += width
+	}
+	tokens = append(tokens, token{kind: tokEOF, pos: len(input)})
+	return tokens, nil
+}
+
+// scanQuoted returns the index just past the closing quote.
+func scanQuoted(input string, start int, quote byte) (int, error) {
+	for i := start + 1; i < len(input); i++ {
+		switch input[i] {
+		case '\\':
+			i++
+		case quote:
+			return i + 1, nil
+		}
+	}
+	return 0, fmt.Errorf("unterminated %c at position %d", quote, start)
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || unicode.IsLetter(rune(c))
+}
+
+func isIdentPart(c byte) bool {
+	return isIdentStart(c) || (c >= '0' && c <= '9')
+}
@@ -0,0 +1,407 @@
+package query
+
+import "fmt"
+
+type nodeKind int
+
+const (
+	nodeIdentity nodeKind = iota
+	nodeField
+	nodeLiteral
+	nodeSubexpression
+	nodeIndex
+	nodeSlice
+	nodeProjection
+	nodeValueProjection
+	nodeFlatten
+	nodeFilterProjection
+	nodeComparator
+	nodeAnd
+	nodeOr
+	nodeNot
+	nodePipe
+	nodeMultiList
+	nodeMultiHash
+	nodeFunction
+	nodeExpressionRef
+)
+
+// node is an expression AST node. Fields are used according to kind.
+type node struct {
+	kind     nodeKind
+	name     string
+	op       tokenKind
+	value    interface{}
+	index    int
+	slice    [3]*int
+	keys     []string
+	children []*node
+}
+
+// Binding powers, lowest to highest, following the JMESPath grammar.
+var bindingPower = map[tokenKind]int{
+	tokPipe:     1,
+	tokOr:       2,
+	tokAnd:      3,
+	tokEQ:       5,
+	tokNE:       5,
+	tokLT:       5,
+	tokLTE:      5,
+	tokGT:       5,
+	tokGTE:      5,
+	tokFlatten:  9,
+	tokStar:     20,
+	tokFilter:   21,
+	tokDot:      40,
+	tokNot:      45,
+	tokLBrace:   50,
+	tokLBracket: 55,
+	tokLParen:   60,
+}
+
+// projectionStop is the binding power below which a projection ends.
+const projectionStop = 10
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func parse(expression string) (*node, error) {
+	tokens, err := lex(expression)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	ast, err := p.expression(0)
+	if err != nil {
+		return nil, err
+	}
+	if p.peek().kind != tokEOF {
+		return nil, p.unexpected()
+	}
+	return ast, nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) peekAt(offset int) token {
+	if p.pos+offset >= len(p.tokens) {
+		return p.tokens[len(p.tokens)-1]
+	}
+	return p.tokens[p.pos+offset]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *parser) expect(kind tokenKind) error {
+	if p.peek().kind != kind {
+		return fmt.Errorf("expected %s at position %d, got %s", kind, p.peek().pos, p.peek().kind)
+	}
+	p.next()
+	return nil
+}
+
+func (p *parser) unexpected() error {
+	return unexpectedToken(p.peek())
+}
+
+func unexpectedToken(tok token) error {
+	return fmt.Errorf("unexpected %s at position %d", tok.kind, tok.pos)
+}
+
+func (p *parser) expression(rbp int) (*node, error) {
+	left, err := p.nud(p.next())
+	if err != nil {
+		return nil, err
+	}
+	for rbp < bindingPower[p.peek().kind] {
+		left, err = p.led(p.next(), left)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return left, nil
+}
+
+func (p *parser) nud(tok token) (*node, error) {
+	switch tok.kind {
+	case tokLiteral, tokRawString:
+		return &node{kind: nodeLiteral, value: tok.value}, nil
+	case tokNumber:
+		// Bare numbers are accepted as literals for convenience (a > 3).
+		return &node{kind: nodeLiteral, value: float64(tok.value.(int))}, nil
+	case tokIdentifier:
+		if p.peek().kind == tokLParen {
+			p.next()
+			return p.function(tok.text)
+		}
+		return &node{kind: nodeField, name: tok.text}, nil
+	case tokQuotedIdentifier:
+		return &node{kind: nodeField, name: tok.text}, nil
+	case tokAt:
+		return &node{kind: nodeIdentity}, nil
+	case tokStar:
+		right, err := p.projectionRHS(bindingPower[tokStar])
+		if err != nil {
+			return nil, err
+		}
+		return &node{kind: nodeValueProjection, children: []*node{{kind: nodeIdentity}, right}}, nil
+	case tokFlatten:
+		right, err := p.projectionRHS(bindingPower[tokFlatten])
+		if err != nil {
+			return nil, err
+		}
+		flatten := &node{kind: nodeFlatten, children: []*node{{kind: nodeIdentity}}}
+		return &node{kind: nodeProjection, children: []*node{flatten, right}}, nil
+	case tokFilter:
+		return p.filter(&node{kind: nodeIdentity})
+	case tokLBracket:
+		switch p.peek().kind {
+		case tokNumber, tokColon:
+			return p.indexOrSlice(&node{kind: nodeIdentity})
+		case tokStar:
+			if p.peekAt(1).kind == tokRBracket {
+				p.next()
+				p.next()
+				right, err := p.projectionRHS(bindingPower[tokStar])
+				if err != nil {
+					return nil, err
+				}
+				return &node{kind: nodeProjection, children: []*node{{kind: nodeIdentity}, right}}, nil
+			}
+		}
+		return p.multiList()
+	case tokLBrace:
+		return p.multiHash()
+	case tokNot:
+		child, err := p.expression(bindingPower[tokNot])
+		if err != nil {
+			return nil, err
+		}
+		return &node{kind: nodeNot, children: []*node{child}}, nil
+	case tokLParen:
+		inner, err := p.expression(0)
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(tokRParen); err != nil {
+			return nil, err
+		}
+		return inner, nil
+	case tokAmpersand:
+		inner, err := p.expression(0)
+		if err != nil {
+			return nil, err
+		}
+		return &node{kind: nodeExpressionRef, children: []*node{inner}}, nil
+	}
+	return nil, unexpectedToken(tok)
+}
+
+func (p *parser) led(tok token, left *node) (*node, error) {
+	switch tok.kind {
+	case tokDot:
+		right, err := p.dotRHS(bindingPower[tokDot])
+		if err != nil {
+			return nil, err
+		}
+		return &node{kind: nodeSubexpression, children: []*node{left, right}}, nil
+	case tokPipe, tokOr, tokAnd:
+		right, err := p.expression(bindingPower[tok.kind])
+		if err != nil {
+			return nil, err
+		}
+		kind := map[tokenKind]nodeKind{tokPipe: nodePipe, tokOr: nodeOr, tokAnd: nodeAnd}[tok.kind]
+		return &node{kind: kind, children: []*node{left, right}}, nil
+	case tokEQ, tokNE, tokLT, tokLTE, tokGT, tokGTE:
+		right, err := p.expression(bindingPower[tok.kind])
+		if err != nil {
+			return nil, err
+		}
+		return &node{kind: nodeComparator, op: tok.kind, children: []*node{left, right}}, nil
+	case tokFlatten:
+		right, err := p.projectionRHS(bindingPower[tokFlatten])
+		if err != nil {
+			return nil, err
+		}
+		flatten := &node{kind: nodeFlatten, children: []*node{left}}
+		return &node{kind: nodeProjection, children: []*node{flatten, right}}, nil
+	case tokFilter:
+		return p.filter(left)
+	case tokLBracket:
+		switch p.peek().kind {
+		case tokNumber, tokColon:
+			return p.indexOrSlice(left)
+		case tokStar:
+			p.next()
+			if err := p.expect(tokRBracket); err != nil {
+				return nil, err
+			}
+			right, err := p.projectionRHS(bindingPower[tokStar])
+			if err != nil {
+				return nil, err
+			}
+			return &node{kind: nodeProjection, children: []*node{left, right}}, nil
+		}
+	}
+	return nil, unexpectedToken(tok)
+}
+
+// indexOrSlice parses the rest of "[n]" or "[start:stop:step]".
+func (p *parser) indexOrSlice(left *node) (*node, error) {
+	var parts [3]*int
+	part := 0
+	for p.peek().kind != tokRBracket {
+		switch p.peek().kind {
+		case tokNumber:
+			n := p.next().value.(int)
+			parts[part] = &n
+		case tokColon:
+			part++
+			if part > 2 {
+				return nil, p.unexpected()
+			}
+			p.next()
+		default:
+			return nil, p.unexpected()
+		}
+	}
+	p.next()
+
+	if part == 0 {
+		if parts[0] == nil {
+			return nil, fmt.Errorf("empty index")
+		}
+		index := &node{kind: nodeIndex, index: *parts[0]}
+		return &node{kind: nodeSubexpression, children: []*node{left, index}}, nil
+	}
+	if parts[2] != nil && *parts[2] == 0 {
+		return nil, fmt.Errorf("slice step cannot be 0")
+	}
+	slice := &node{kind: nodeSlice, slice: parts, children: []*node{left}}
+	right, err := p.projectionRHS(bindingPower[tokStar])
+	if err != nil {
+		return nil, err
+	}
+	return &node{kind: nodeProjection, children: []*node{slice, right}}, nil
+}
+
+func (p *parser) filter(left *node) (*node, error) {
+	condition, err := p.expression(0)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect(tokRBracket); err != nil {
+		return nil, err
+	}
+	right, err := p.projectionRHS(bindingPower[tokFilter])
+	if err != nil {
+		return nil, err
+	}
+	return &node{kind: nodeFilterProjection, children: []*node{left, right, condition}}, nil
+}
+
+func (p *parser) projectionRHS(rbp int) (*node, error) {
+	switch next := p.peek().kind; {
+	case bindingPower[next] < projectionStop:
+		return &node{kind: nodeIdentity}, nil
+	case next == tokLBracket, next == tokFilter:
+		return p.expression(rbp)
+	case next == tokDot:
+		p.next()
+		return p.dotRHS(rbp)
+	}
+	return nil, p.unexpected()
+}
+
+func (p *parser) dotRHS(rbp int) (*node, error) {
+	switch p.peek().kind {
+	case tokIdentifier, tokQuotedIdentifier, tokStar:
+		return p.expression(rbp)
+	case tokLBracket:
+		p.next()
+		return p.multiList()
+	case tokLBrace:
+		p.next()
+		return p.multiHash()
+	}
+	return nil, p.unexpected()
+}
+
+func (p *parser) multiList() (*node, error) {
+	list := &node{kind: nodeMultiList}
+	for {
+		item, err := p.expression(0)
+		if err != nil {
+			return nil, err
+		}
+		list.children = append(list.children, item)
+		if p.peek().kind == tokRBracket {
+			p.next()
+			return list, nil
+		}
+		if err := p.expect(tokComma); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (p *parser) multiHash() (*node, error) {
+	hash := &node{kind: nodeMultiHash}
+	for {
+		key := p.next()
+		if key.kind != tokIdentifier && key.kind != tokQuotedIdentifier {
+			return nil, unexpectedToken(key)
+		}
+		if err := p.expect(tokColon); err != nil {
+			return nil, err
+		}
+		value, err := p.expression(0)
+		if err != nil {
+			return nil, err
+		}
+		hash.keys = append(hash.keys, key.text)
+		hash.children = append(hash.children, value)
+		if p.peek().kind == tokRBrace {
+			p.next()
+			return hash, nil
+		}
+		if err := p.expect(tokComma); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (p *parser) function(name string) (*node, error) {
+	fn := &node{kind: nodeFunction, name: name}
+	if _, ok := functions[name]; !ok {
+		return nil, fmt.Errorf("unknown function %s()", name)
+	}
+	if p.peek().kind == tokRParen {
+		p.next()
+		return fn, nil
+	}
+	for {
+		arg, err := p.expression(0)
+		if err != nil {
+			return nil, err
+		}
+		fn.children = append(fn.children, arg)
+		if p.peek().kind == tokRParen {
+			p.next()
+			return fn, nil
+		}
+		if err := p.expect(tokComma); err != nil {
+			return nil, err
+		}
+	}
+}
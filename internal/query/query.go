@@ -0,0 +1,63 @@
+// Package query implements a JMESPath-style expression language for
+// filtering and reshaping JSON output in-process.
+//
+// Supported syntax: field access (a.b), quoted fields ("a-b"), indexes and
+// slices ([0], [-1], [1:3]), projections ([*], *, []), filters
+// ([?a == 'x' && b > `3`]), multiselect lists and hashes ([a, b],
+// {id: id, name: attributes.name}), pipes (|), @, and the functions length,
+// contains, starts_with, ends_with, keys, values, join, sort, sort_by,
+// reverse, to_string, to_number and not_null.
+package query
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Query is a compiled expression.
+type Query struct {
+	expression string
+	ast        *node
+}
+
+// Compile parses expression.
+func Compile(expression string) (*Query, error) {
+	expression = strings.TrimSpace(expression)
+	if expression == "" {
+		return nil, fmt.Errorf("query expression is empty")
+	}
+	ast, err := parse(expression)
+	if err != nil {
+		return nil, fmt.Errorf("invalid query %q: %w", expression, err)
+	}
+	return &Query{expression: expression, ast: ast}, nil
+}
+
+// String returns the source expression.
+func (q *Query) String() string {
+	return q.expression
+}
+
+// Evaluate applies the query to a generic JSON value (as produced by
+// encoding/json decoding into interface{}).
+func (q *Query) Evaluate(value interface{}) (interface{}, error) {
+	result, err := evaluate(q.ast, value)
+	if err != nil {
+		return nil, fmt.Errorf("query %q: %w", q.expression, err)
+	}
+	return result, nil
+}
+
+// ToGeneric converts any JSON-encodable value into its generic form.
+func ToGeneric(data interface{}) (interface{}, error) {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	var generic interface{}
+	if err := json.Unmarshal(encoded, &generic); err != nil {
+		return nil, err
+	}
+	return generic, nil
+}
@@ -0,0 +1,122 @@
+package query
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const sampleDocument = `{
+	"data": [
+		{"type": "builds", "id": "1", "attributes": {"version": "10", "processingState": "VALID", "size": 3, "tags": ["a", "b"]}},
+		{"type": "builds", "id": "2", "attributes": {"version": "11", "processingState": "PROCESSING", "size": 7, "tags": ["c"]}},
+		{"type": "builds", "id": "3", "attributes": {"version": "9", "processingState": "VALID", "size": 5, "tags": []}}
+	],
+	"links": {"self": "https://api.appstoreconnect.apple.com/v1/builds"}
+}`
+
+func decodeSample(t *testing.T) interface{} {
+	t.Helper()
+	var value interface{}
+	if err := json.Unmarshal([]byte(sampleDocument), &value); err != nil {
+		t.Fatalf("decode sample: %v", err)
+	}
+	return value
+}
+
+func TestEvaluate(t *testing.T) {
+	tests := []struct {
+		expression string
+		want       string
+	}{
+		{`data[0].id`, `"1"`},
+		{`data[-1].attributes.version`, `"9"`},
+		{`data[*].id`, `["1","2","3"]`},
+		{`data[].attributes.tags[]`, `["a","b","c"]`},
+		{`data[1:].id`, `["2","3"]`},
+		{`data[::-1].id`, `["3","2","1"]`},
+		{`data[?attributes.processingState == 'VALID'].id`, `["1","3"]`},
+		{`data[?attributes.size > ` + "`4`" + ` && attributes.processingState != 'VALID'].id`, `["2"]`},
+		{`data[?attributes.size >= 5].id`, `["2","3"]`},
+		{`data[?!contains(attributes.tags, 'a')].id`, `["2","3"]`},
+		{`data[*].{id: id, version: attributes.version}`, `[{"id":"1","version":"10"},{"id":"2","version":"11"},{"id":"3","version":"9"}]`},
+		{`data[0].[id, type]`, `["1","builds"]`},
+		{`data[*].id | [0]`, `"1"`},
+		{`length(data)`, `3`},
+		{`sort_by(data, &attributes.size)[*].id`, `["1","3","2"]`},
+		{`reverse(sort(data[*].attributes.version))`, `["9","11","10"]`},
+		{`join(',', data[*].id)`, `"1,2,3"`},
+		{`keys(links)`, `["self"]`},
+		{`data[?starts_with(attributes.version, '1')] | length(@)`, `2`},
+		{`links.*`, `["https://api.appstoreconnect.apple.com/v1/builds"]`},
+		{`not_null(missing, data[0].id)`, `"1"`},
+		{`"data"[0]."id"`, `"1"`},
+		{`missing.field`, `null`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expression, func(t *testing.T) {
+			q, err := Compile(test.expression)
+			if err != nil {
+				t.Fatalf("Compile() error: %v", err)
+			}
+			got, err := q.Evaluate(decodeSample(t))
+			if err != nil {
+				t.Fatalf("Evaluate() error: %v", err)
+			}
+			var want interface{}
+			if err := json.Unmarshal([]byte(test.want), &want); err != nil {
+				t.Fatalf("decode want: %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				encoded, _ := json.Marshal(got)
+				t.Fatalf("expected %s, got %s", test.want, encoded)
+			}
+		})
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	tests := []struct {
+		expression string
+		wantErr    string
+	}{
+		{``, "empty"},
+		{`data[`, "unexpected"},
+		{`data[?id == ]`, "unexpected"},
+		{`data.`, "unexpected"},
+		{`'unterminated`, "unterminated"},
+		{"`{bad`", "invalid JSON literal"},
+		{`nope(data)`, "unknown function nope()"},
+		{`data[::0]`, "step cannot be 0"},
+		{`data ~ id`, "unexpected character"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expression, func(t *testing.T) {
+			_, err := Compile(test.expression)
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestEvaluateFunctionErrors(t *testing.T) {
+	q, err := Compile(`length(data[0].attributes.size)`)
+	if err != nil {
+		t.Fatalf("Compile() error: %v", err)
+	}
+	if _, err := q.Evaluate(decodeSample(t)); err == nil || !strings.Contains(err.Error(), "length()") {
+		t.Fatalf("expected length() error, got %v", err)
+	}
+
+	q, err = Compile(`contains(data)`)
+	if err != nil {
+		t.Fatalf("Compile() error: %v", err)
+	}
+	if _, err := q.Evaluate(decodeSample(t)); err == nil || !strings.Contains(err.Error(), "takes 2 argument(s)") {
+		t.Fatalf("expected arity error, got %v", err)
+	}
+}